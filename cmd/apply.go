@@ -43,6 +43,9 @@ var (
 			e.Options.Pipeline.Target.Push = applyPush
 			e.Options.Pipeline.Target.Clean = applyClean
 			e.Options.Pipeline.Target.DryRun = false
+			e.Options.Parallelism = parallelism
+			e.Options.ReportFormat = reportFormat
+			e.Options.ReportFile = reportFile
 
 			err = run("apply")
 			if err != nil {
@@ -62,5 +65,9 @@ func init() {
 	applyCmd.Flags().BoolVarP(&applyCommit, "commit", "", true, "Record changes to the repository, '--commit=false'")
 	applyCmd.Flags().BoolVarP(&applyPush, "push", "", true, "Update remote refs '--push=false'")
 	applyCmd.Flags().BoolVar(&disableTLS, "disable-tls", false, "Disable TLS verification like '--disable-tls=true'")
+	applyCmd.Flags().StringArrayVar(&policyPublicKeys, "verify-key", []string{}, "Verify pulled policies signature using the given PEM public key(s), unsigned policies are refused, like '--verify-key=cosign.pub'")
+	applyCmd.Flags().IntVar(&parallelism, "parallelism", 1, "Run up to N pipelines concurrently like '--parallelism=4'")
 	applyCmd.Flags().BoolVar(&applyClean, "clean", false, "Remove updatecli working directory like '--clean=true'")
+	applyCmd.Flags().StringVar(&reportFormat, "report-format", "", "Export pipeline reports using the given format, accepted values are junit, json, or sarif, like '--report-format=junit'")
+	applyCmd.Flags().StringVar(&reportFile, "report-file", "", "Write the report exported with '--report-format' to the given file like '--report-file=updatecli.xml'")
 }
@@ -24,7 +24,7 @@ var (
 				os.Exit(1)
 			}
 
-			policies, err := c.GetPolicies(disableTLS)
+			policies, err := c.GetPolicies(disableTLS, policyPublicKeys)
 			if err != nil {
 				logrus.Errorf("command failed: %s", err)
 				os.Exit(1)
@@ -36,6 +36,9 @@ var (
 			e.Options.Pipeline.Target.Push = composeApplyPush
 			e.Options.Pipeline.Target.Clean = composeApplyClean
 			e.Options.Pipeline.Target.DryRun = false
+			e.Options.Parallelism = parallelism
+			e.Options.ReportFormat = reportFormat
+			e.Options.ReportFile = reportFile
 
 			err = run("compose/apply")
 			if err != nil {
@@ -52,7 +55,11 @@ func init() {
 	composeApplyCmd.Flags().BoolVarP(&composeApplyCommit, "commit", "", true, "Record changes to the repository, '--commit=false'")
 	composeApplyCmd.Flags().BoolVarP(&composeApplyPush, "push", "", true, "Update remote refs '--push=false'")
 	composeApplyCmd.Flags().BoolVar(&disableTLS, "disable-tls", false, "Disable TLS verification like '--disable-tls=true'")
+	composeApplyCmd.Flags().StringArrayVar(&policyPublicKeys, "verify-key", []string{}, "Verify pulled policies signature using the given PEM public key(s), unsigned policies are refused, like '--verify-key=cosign.pub'")
+	composeApplyCmd.Flags().IntVar(&parallelism, "parallelism", 1, "Run up to N pipelines concurrently like '--parallelism=4'")
 	composeApplyCmd.Flags().BoolVar(&composeApplyClean, "clean", false, "Remove updatecli working directory like '--clean=true'")
+	composeApplyCmd.Flags().StringVar(&reportFormat, "report-format", "", "Export pipeline reports using the given format, accepted values are junit, json, or sarif, like '--report-format=junit'")
+	composeApplyCmd.Flags().StringVar(&reportFile, "report-file", "", "Write the report exported with '--report-format' to the given file like '--report-file=updatecli.xml'")
 
 	composeCmd.AddCommand(composeApplyCmd)
 }
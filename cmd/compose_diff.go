@@ -20,7 +20,7 @@ var (
 				os.Exit(1)
 			}
 
-			policies, err := c.GetPolicies(disableTLS)
+			policies, err := c.GetPolicies(disableTLS, policyPublicKeys)
 			if err != nil {
 				logrus.Errorf("command failed: %s", err)
 				os.Exit(1)
@@ -32,6 +32,10 @@ var (
 			e.Options.Pipeline.Target.Push = false
 			e.Options.Pipeline.Target.Clean = composeCmdClean
 			e.Options.Pipeline.Target.DryRun = true
+			e.Options.Parallelism = parallelism
+			e.Options.ReportFormat = reportFormat
+			e.Options.ReportFile = reportFile
+			e.Options.SourceCacheTTL = sourceCacheTTL
 
 			err = run("compose/diff")
 			if err != nil {
@@ -46,6 +50,11 @@ func init() {
 	composeDiffCmd.Flags().StringVarP(&composeCmdFile, "file", "f", composeDefaultCmdFile, "Define the Updatecli compose file name")
 	composeDiffCmd.Flags().BoolVar(&composeCmdClean, "clean", false, "Remove updatecli working directory like '--clean=true'")
 	composeDiffCmd.Flags().BoolVar(&disableTLS, "disable-tls", false, "Disable TLS verification like '--disable-tls=true'")
+	composeDiffCmd.Flags().StringArrayVar(&policyPublicKeys, "verify-key", []string{}, "Verify pulled policies signature using the given PEM public key(s), unsigned policies are refused, like '--verify-key=cosign.pub'")
+	composeDiffCmd.Flags().IntVar(&parallelism, "parallelism", 1, "Run up to N pipelines concurrently like '--parallelism=4'")
+	composeDiffCmd.Flags().DurationVar(&sourceCacheTTL, "source-cache-ttl", 0, "Persist source results on disk and reuse them across runs for the given duration like '--source-cache-ttl=1h'")
+	composeDiffCmd.Flags().StringVar(&reportFormat, "report-format", "", "Export pipeline reports using the given format, accepted values are junit, json, or sarif, like '--report-format=junit'")
+	composeDiffCmd.Flags().StringVar(&reportFile, "report-file", "", "Write the report exported with '--report-format' to the given file like '--report-file=updatecli.xml'")
 
 	composeCmd.AddCommand(composeDiffCmd)
 }
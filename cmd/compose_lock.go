@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/updatecli/updatecli/pkg/core/compose"
+)
+
+var (
+	composeLockCmd = &cobra.Command{
+		Use:   "lock",
+		Short: "lock resolves the policies defined by the compose file and records their version in the compose lock file",
+		Long: `lock resolves the policies defined by the compose file, including semantic version constraints such as "~0.3",
+and records the selected versions and digests in the lock file stored next to the compose file.
+Policies already present in the lock file keep their locked version, use "updatecli compose update" to update them.`,
+		Run: func(cmd *cobra.Command, args []string) {
+
+			c, err := compose.New(composeCmdFile)
+			if err != nil {
+				logrus.Errorf("command failed: %s", err)
+				os.Exit(1)
+			}
+
+			if err := c.Lock(disableTLS, false); err != nil {
+				logrus.Errorf("command failed: %s", err)
+				os.Exit(1)
+			}
+		},
+	}
+)
+
+func init() {
+	composeLockCmd.Flags().StringVarP(&composeCmdFile, "file", "f", composeDefaultCmdFile, "Define the update-compose file")
+	composeLockCmd.Flags().BoolVar(&disableTLS, "disable-tls", false, "Disable TLS verification like '--disable-tls=true'")
+
+	composeCmd.AddCommand(composeLockCmd)
+}
@@ -21,7 +21,7 @@ var (
 				os.Exit(1)
 			}
 
-			policies, err := c.GetPolicies(disableTLS)
+			policies, err := c.GetPolicies(disableTLS, policyPublicKeys)
 			if err != nil {
 				logrus.Errorf("command failed: %s", err)
 				os.Exit(1)
@@ -50,6 +50,7 @@ func init() {
 	composeShowCmd.Flags().BoolVar(&composeCmdDisablePrepare, "disable-prepare", false, "--disable-prepare skip the Updatecli 'prepare' stage")
 	composeShowCmd.Flags().BoolVar(&composeCmdDisableTemplating, "disable-templating", false, "Disable manifest templating")
 	composeShowCmd.Flags().BoolVar(&disableTLS, "disable-tls", false, "Disable TLS verification like '--disable-tls=true'")
+	composeShowCmd.Flags().StringArrayVar(&policyPublicKeys, "verify-key", []string{}, "Verify pulled policies signature using the given PEM public key(s), unsigned policies are refused, like '--verify-key=cosign.pub'")
 
 	composeCmd.AddCommand(composeShowCmd)
 }
@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/updatecli/updatecli/pkg/core/compose"
+)
+
+var (
+	composeUpdateCmd = &cobra.Command{
+		Use:   "update",
+		Short: "update resolves again the policies defined by the compose file and rewrites the compose lock file",
+		Long: `update resolves again every policy defined by the compose file, selecting the latest version
+matching its semantic version constraint, and rewrites the lock file stored next to the compose file.`,
+		Run: func(cmd *cobra.Command, args []string) {
+
+			c, err := compose.New(composeCmdFile)
+			if err != nil {
+				logrus.Errorf("command failed: %s", err)
+				os.Exit(1)
+			}
+
+			if err := c.Lock(disableTLS, true); err != nil {
+				logrus.Errorf("command failed: %s", err)
+				os.Exit(1)
+			}
+		},
+	}
+)
+
+func init() {
+	composeUpdateCmd.Flags().StringVarP(&composeCmdFile, "file", "f", composeDefaultCmdFile, "Define the update-compose file")
+	composeUpdateCmd.Flags().BoolVar(&disableTLS, "disable-tls", false, "Disable TLS verification like '--disable-tls=true'")
+
+	composeCmd.AddCommand(composeUpdateCmd)
+}
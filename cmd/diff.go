@@ -34,6 +34,10 @@ var (
 			e.Options.Pipeline.Target.Push = false
 			e.Options.Pipeline.Target.Clean = diffClean
 			e.Options.Pipeline.Target.DryRun = true
+			e.Options.Parallelism = parallelism
+			e.Options.ReportFormat = reportFormat
+			e.Options.ReportFile = reportFile
+			e.Options.SourceCacheTTL = sourceCacheTTL
 
 			err = run("diff")
 			if err != nil {
@@ -51,4 +55,9 @@ func init() {
 	diffCmd.Flags().StringArrayVar(&secretsFiles, "secrets", []string{}, "Sets Sops secrets file uses for templating")
 	diffCmd.Flags().BoolVar(&diffClean, "clean", false, "Remove updatecli working directory like '--clean=true'")
 	diffCmd.Flags().BoolVar(&disableTLS, "disable-tls", false, "Disable TLS verification like '--disable-tls=true'")
+	diffCmd.Flags().StringArrayVar(&policyPublicKeys, "verify-key", []string{}, "Verify pulled policies signature using the given PEM public key(s), unsigned policies are refused, like '--verify-key=cosign.pub'")
+	diffCmd.Flags().IntVar(&parallelism, "parallelism", 1, "Run up to N pipelines concurrently like '--parallelism=4'")
+	diffCmd.Flags().DurationVar(&sourceCacheTTL, "source-cache-ttl", 0, "Persist source results on disk and reuse them across runs for the given duration like '--source-cache-ttl=1h'")
+	diffCmd.Flags().StringVar(&reportFormat, "report-format", "", "Export pipeline reports using the given format, accepted values are junit, json, or sarif, like '--report-format=junit'")
+	diffCmd.Flags().StringVar(&reportFile, "report-file", "", "Write the report exported with '--report-format' to the given file like '--report-file=updatecli.xml'")
 }
@@ -30,5 +30,6 @@ var (
 
 func init() {
 	manifestPullCmd.Flags().BoolVar(&disableTLS, "disable-tls", false, "Disable TLS verification like '--disable-tls=true'")
+	manifestPullCmd.Flags().StringArrayVar(&policyPublicKeys, "verify-key", []string{}, "Verify pulled policies signature using the given PEM public key(s), unsigned policies are refused, like '--verify-key=cosign.pub'")
 	manifestCmd.AddCommand(manifestPullCmd)
 }
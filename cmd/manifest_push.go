@@ -17,6 +17,8 @@ var (
 	manifestPushPolicyFile string
 	// manifestPushOverwrite is a boolean to overwrite existing manifest(s) in the registry
 	manifestPushOverwrite bool
+	// manifestPushSignKey is the path to the PEM private key used to sign the pushed policy
+	manifestPushSignKey string
 
 	// manifestPushCmd is the Cobra command to push OCI registry manifest(s)
 	manifestPushCmd = &cobra.Command{
@@ -60,6 +62,7 @@ func init() {
 	manifestPushCmd.Flags().StringArrayVar(&secretsFiles, "secrets", []string{}, "Sets secrets file uses for templating")
 	manifestPushCmd.Flags().BoolVar(&disableTLS, "disable-tls", false, "Disable TLS verification like '--disable-tls=true'")
 	manifestPushCmd.Flags().BoolVar(&manifestPushOverwrite, "overwrite", false, "Overwrite existing manifest(s) in the registry like '--overwrite=true'")
+	manifestPushCmd.Flags().StringVar(&manifestPushSignKey, "sign-key", "", "Sign the pushed policy using the given PEM private key like '--sign-key=policy.key'")
 
 	manifestCmd.AddCommand(manifestPushCmd)
 }
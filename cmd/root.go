@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"os"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"golang.org/x/exp/slices"
@@ -27,6 +28,11 @@ var (
 	verbose          bool
 	experimental     bool
 	disableTLS       bool
+	parallelism      int
+	sourceCacheTTL   time.Duration
+	reportFormat     string
+	reportFile       string
+	policyPublicKeys []string
 
 	rootCmd = &cobra.Command{
 		Use:   "updatecli",
@@ -85,7 +91,8 @@ func init() {
 		versionCmd,
 		docsCmd,
 		manCmd,
-		jsonschemaCmd)
+		jsonschemaCmd,
+		validateCmd)
 }
 
 func run(command string) error {
@@ -164,7 +171,7 @@ func run(command string) error {
 		}
 
 	case "manifest/pull":
-		err := e.PullFromRegistry(manifestPullPolicyReference, disableTLS)
+		err := e.PullFromRegistry(manifestPullPolicyReference, disableTLS, policyPublicKeys)
 		if err != nil {
 			logrus.Errorf("%s %s", result.FAILURE, err)
 			return err
@@ -179,7 +186,8 @@ func run(command string) error {
 			disableTLS,
 			manifestPushPolicyFile,
 			manifestPushFileStore,
-			manifestPushOverwrite)
+			manifestPushOverwrite,
+			manifestPushSignKey)
 
 		if err != nil {
 			logrus.Errorf("%s %s", result.FAILURE, err)
@@ -252,7 +260,7 @@ func getPolicyFilesFromRegistry() error {
 	}
 
 	for _, policy := range policyReferences {
-		policyManifest, policyValues, policySecrets, err := registry.Pull(policy, disableTLS)
+		policyManifest, policyValues, policySecrets, err := registry.Pull(policy, disableTLS, policyPublicKeys)
 		if err != nil {
 			return err
 		}
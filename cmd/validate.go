@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/spf13/cobra"
+
+	"github.com/updatecli/updatecli/pkg/core/engine/manifest"
+	"github.com/updatecli/updatecli/pkg/core/result"
+	"github.com/updatecli/updatecli/pkg/core/validate"
+)
+
+const (
+	validateOutputText string = "text"
+	validateOutputJSON string = "json"
+)
+
+var (
+	validateDisableTemplating bool
+	validateOutput            string
+
+	validateCmd = &cobra.Command{
+		Use:   "validate",
+		Short: "Validate manifest(s) without running them",
+		Long: `Validate manifest(s) without running them.
+Manifests are checked against the Updatecli jsonschema, then every scm, action, source, condition and target
+is validated, including references between resources and dependency loops.
+No network access is required.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if validateOutput != validateOutputText && validateOutput != validateOutputJSON {
+				logrus.Errorf("invalid output %q, accepted values are %q or %q", validateOutput, validateOutputText, validateOutputJSON)
+				os.Exit(1)
+			}
+
+			// Keep stdout parsable when diagnostics are printed as json
+			if validateOutput == validateOutputJSON {
+				logrus.SetOutput(os.Stderr)
+			}
+
+			e.Options.Manifests = append(e.Options.Manifests, manifest.Manifest{
+				Manifests: manifestFiles,
+				Values:    valuesFiles,
+				Secrets:   secretsFiles,
+			})
+
+			e.Options.Config.DisableTemplating = validateDisableTemplating
+
+			diagnostics, err := e.Validate()
+			if err != nil {
+				logrus.Errorf("%s %s", result.FAILURE, err)
+				os.Exit(1)
+			}
+
+			if err := printDiagnostics(diagnostics); err != nil {
+				logrus.Errorf("%s %s", result.FAILURE, err)
+				os.Exit(1)
+			}
+
+			if diagnostics.HasErrors() {
+				os.Exit(1)
+			}
+		},
+	}
+)
+
+func init() {
+	validateCmd.Flags().StringArrayVarP(&manifestFiles, "config", "c", []string{}, "Sets config file or directory. By default, Updatecli looks for a file named 'updatecli.yaml' or a directory named 'updatecli.d'")
+	validateCmd.Flags().StringArrayVarP(&valuesFiles, "values", "v", []string{}, "Sets values file uses for templating")
+	validateCmd.Flags().StringArrayVar(&secretsFiles, "secrets", []string{}, "Sets secrets file uses for templating")
+	validateCmd.Flags().BoolVar(&validateDisableTemplating, "disable-templating", false, "Disable manifest templating")
+	validateCmd.Flags().StringVarP(&validateOutput, "output", "o", validateOutputText, "Sets the diagnostics output format, accepted values are 'text' or 'json'")
+}
+
+// printDiagnostics prints diagnostics to stdout using the requested output format
+func printDiagnostics(diagnostics validate.Diagnostics) error {
+	if validateOutput == validateOutputJSON {
+		if diagnostics == nil {
+			diagnostics = validate.Diagnostics{}
+		}
+
+		output, err := json.MarshalIndent(diagnostics, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		fmt.Println(string(output))
+		return nil
+	}
+
+	if len(diagnostics) == 0 {
+		logrus.Infof("%s No issue detected", result.SUCCESS)
+		return nil
+	}
+
+	fmt.Println(diagnostics.String())
+
+	return nil
+}
@@ -0,0 +1,163 @@
+package compose
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/registry"
+	"gopkg.in/yaml.v3"
+)
+
+// lockFileHeader is written at the top of every lock file
+const lockFileHeader = "# This file is generated by \"updatecli compose lock\" and \"updatecli compose update\".\n# It must not be edited manually.\n"
+
+// resolvePolicy resolves a policy reference, it's overridden in tests
+var resolvePolicy = registry.ResolvePolicy
+
+// LockFile records the policy versions resolved for a compose file,
+// so every run uses the same policies until the lock file is updated.
+type LockFile struct {
+	// Policies contains the resolved policies
+	Policies []LockedPolicy `yaml:"policies"`
+}
+
+// LockedPolicy contains the version resolved for a policy reference
+type LockedPolicy struct {
+	// Policy contains the policy reference as defined in the compose file such as "ghcr.io/org/policies/go:~0.3"
+	Policy string `yaml:"policy"`
+	// Reference contains the resolved policy reference such as "ghcr.io/org/policies/go:0.3.2"
+	Reference string `yaml:"reference"`
+	// Digest contains the resolved policy manifest digest
+	Digest string `yaml:"digest"`
+}
+
+// LockFilename returns the lock file path of a compose file,
+// such as "updatecli-compose.lock" for "updatecli-compose.yaml"
+func LockFilename(composeFilename string) string {
+	return strings.TrimSuffix(composeFilename, filepath.Ext(composeFilename)) + ".lock"
+}
+
+// LoadLockFile loads a compose lock file.
+// It returns nil if the lock file doesn't exist.
+func LoadLockFile(filename string) (*LockFile, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading Updatecli compose lock file %q: %s", filename, err)
+	}
+
+	var lock LockFile
+	if err := yaml.Unmarshal(data, &lock); err != nil {
+		return nil, fmt.Errorf("parsing Updatecli compose lock file %q: %s", filename, err)
+	}
+
+	return &lock, nil
+}
+
+// Save writes the lock file
+func (l LockFile) Save(filename string) error {
+	data, err := yaml.Marshal(l)
+	if err != nil {
+		return fmt.Errorf("marshalling Updatecli compose lock file: %s", err)
+	}
+
+	if err := os.WriteFile(filename, append([]byte(lockFileHeader), data...), 0600); err != nil {
+		return fmt.Errorf("writing Updatecli compose lock file %q: %s", filename, err)
+	}
+
+	return nil
+}
+
+// Get returns the locked version of a policy reference
+func (l *LockFile) Get(policy string) (LockedPolicy, bool) {
+	if l == nil {
+		return LockedPolicy{}, false
+	}
+
+	for i := range l.Policies {
+		if l.Policies[i].Policy == policy {
+			return l.Policies[i], true
+		}
+	}
+
+	return LockedPolicy{}, false
+}
+
+// PinnedReference returns the policy reference pinned to its digest
+func (p LockedPolicy) PinnedReference() string {
+	return registry.ResolvedPolicy{Reference: p.Reference, Digest: p.Digest}.PinnedReference()
+}
+
+// Lock resolves the compose file policies and writes them to the lock file.
+// Policies already locked keep their version unless update is true,
+// and lock file entries no longer referenced by the compose file are removed.
+func (c *Compose) Lock(disableTLS, update bool) error {
+	lockFilename := LockFilename(c.filename)
+
+	current, err := LoadLockFile(lockFilename)
+	if err != nil {
+		return err
+	}
+
+	var lock LockFile
+	var errs []error
+
+	for i := range c.spec.Policies {
+		policy := c.spec.Policies[i].Policy
+		if policy == "" {
+			continue
+		}
+
+		if _, found := lock.Get(policy); found {
+			continue
+		}
+
+		if locked, found := current.Get(policy); found && !update {
+			logrus.Infof("Policy %q locked to %q", policy, locked.Reference)
+			lock.Policies = append(lock.Policies, locked)
+			continue
+		}
+
+		resolved, err := resolvePolicy(policy, disableTLS)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("resolving policy %q: %s", policy, err))
+			continue
+		}
+
+		locked := LockedPolicy{
+			Policy:    policy,
+			Reference: resolved.Reference,
+			Digest:    resolved.Digest,
+		}
+
+		previous, found := current.Get(policy)
+		switch {
+		case !found:
+			logrus.Infof("Policy %q locked to %q", policy, locked.Reference)
+		case previous.Digest != locked.Digest:
+			logrus.Infof("Policy %q updated from %q to %q", policy, previous.Reference, locked.Reference)
+		default:
+			logrus.Infof("Policy %q already up to date with %q", policy, locked.Reference)
+		}
+
+		lock.Policies = append(lock.Policies, locked)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("policies errors: %s", errs)
+	}
+
+	if err := lock.Save(lockFilename); err != nil {
+		return err
+	}
+
+	logrus.Infof("Updatecli compose lock file %q written", lockFilename)
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package compose
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/core/registry"
+)
+
+func TestLockFilename(t *testing.T) {
+	assert.Equal(t, "updatecli-compose.lock", LockFilename("updatecli-compose.yaml"))
+	assert.Equal(t, filepath.Join("dir", "compose.lock"), LockFilename(filepath.Join("dir", "compose.yml")))
+}
+
+func TestLoadLockFile(t *testing.T) {
+	lock, err := LoadLockFile(filepath.Join(t.TempDir(), "updatecli-compose.lock"))
+	require.NoError(t, err)
+	assert.Nil(t, lock)
+
+	_, found := lock.Get("ghcr.io/updatecli/policies/golang:~0.3")
+	assert.False(t, found)
+}
+
+func TestLock(t *testing.T) {
+	// available contains the policy versions returned by the fake resolver
+	available := map[string]registry.ResolvedPolicy{
+		"ghcr.io/updatecli/policies/golang:~0.3": {
+			Reference: "ghcr.io/updatecli/policies/golang:0.3.4",
+			Digest:    "sha256:0304",
+		},
+		"ghcr.io/updatecli/policies/npm:latest": {
+			Reference: "ghcr.io/updatecli/policies/npm:1.2.0",
+			Digest:    "sha256:0120",
+		},
+	}
+
+	resolvePolicy = func(reference string, disableTLS bool) (registry.ResolvedPolicy, error) {
+		r, ok := available[reference]
+		if !ok {
+			return registry.ResolvedPolicy{}, fmt.Errorf("policy %q not found", reference)
+		}
+		return r, nil
+	}
+	defer func() { resolvePolicy = registry.ResolvePolicy }()
+
+	dir := t.TempDir()
+	composeFile := filepath.Join(dir, "updatecli-compose.yaml")
+	require.NoError(t, os.WriteFile(composeFile, []byte(`policies:
+  - policy: ghcr.io/updatecli/policies/golang:~0.3
+  - policy: ghcr.io/updatecli/policies/npm:latest
+  - config:
+      - updatecli.d/local.yaml
+`), 0600))
+
+	c, err := New(composeFile)
+	require.NoError(t, err)
+
+	// Initial lock
+	require.NoError(t, c.Lock(false, false))
+
+	lock, err := LoadLockFile(filepath.Join(dir, "updatecli-compose.lock"))
+	require.NoError(t, err)
+	assert.Equal(t, &LockFile{
+		Policies: []LockedPolicy{
+			{
+				Policy:    "ghcr.io/updatecli/policies/golang:~0.3",
+				Reference: "ghcr.io/updatecli/policies/golang:0.3.4",
+				Digest:    "sha256:0304",
+			},
+			{
+				Policy:    "ghcr.io/updatecli/policies/npm:latest",
+				Reference: "ghcr.io/updatecli/policies/npm:1.2.0",
+				Digest:    "sha256:0120",
+			},
+		},
+	}, lock)
+
+	locked, found := lock.Get("ghcr.io/updatecli/policies/golang:~0.3")
+	require.True(t, found)
+	assert.Equal(t, "ghcr.io/updatecli/policies/golang@sha256:0304", locked.PinnedReference())
+
+	// A new matching version is published
+	available["ghcr.io/updatecli/policies/golang:~0.3"] = registry.ResolvedPolicy{
+		Reference: "ghcr.io/updatecli/policies/golang:0.3.5",
+		Digest:    "sha256:0305",
+	}
+
+	// Locking again keeps the existing versions
+	require.NoError(t, c.Lock(false, false))
+	lock, err = LoadLockFile(filepath.Join(dir, "updatecli-compose.lock"))
+	require.NoError(t, err)
+	locked, _ = lock.Get("ghcr.io/updatecli/policies/golang:~0.3")
+	assert.Equal(t, "ghcr.io/updatecli/policies/golang:0.3.4", locked.Reference)
+
+	// Updating resolves the constraints again
+	require.NoError(t, c.Lock(false, true))
+	lock, err = LoadLockFile(filepath.Join(dir, "updatecli-compose.lock"))
+	require.NoError(t, err)
+	locked, _ = lock.Get("ghcr.io/updatecli/policies/golang:~0.3")
+	assert.Equal(t, "ghcr.io/updatecli/policies/golang:0.3.5", locked.Reference)
+	assert.Equal(t, "sha256:0305", locked.Digest)
+
+	// Policies removed from the compose file are removed from the lock file
+	require.NoError(t, os.WriteFile(composeFile, []byte(`policies:
+  - policy: ghcr.io/updatecli/policies/npm:latest
+`), 0600))
+
+	c, err = New(composeFile)
+	require.NoError(t, err)
+	require.NoError(t, c.Lock(false, false))
+
+	lock, err = LoadLockFile(filepath.Join(dir, "updatecli-compose.lock"))
+	require.NoError(t, err)
+	require.Len(t, lock.Policies, 1)
+	assert.Equal(t, "ghcr.io/updatecli/policies/npm:latest", lock.Policies[0].Policy)
+
+	// Unresolvable policies return an error
+	require.NoError(t, os.WriteFile(composeFile, []byte(`policies:
+  - policy: ghcr.io/updatecli/policies/unknown:~1
+`), 0600))
+
+	c, err = New(composeFile)
+	require.NoError(t, err)
+	assert.Error(t, c.Lock(false, false))
+}
@@ -12,6 +12,8 @@ import (
 type Compose struct {
 	// spec contains the compose spec
 	spec Spec
+	// filename contains the compose file path
+	filename string
 }
 
 // New creates a new Compose object
@@ -38,12 +40,15 @@ func New(filename string) (Compose, error) {
 	}
 
 	c.spec = *spec
+	c.filename = filename
 
 	return c, nil
 }
 
-// GetPolicies returns a list of policies defined in the compose file
-func (c *Compose) GetPolicies(disableTLS bool) ([]manifest.Manifest, error) {
+// GetPolicies returns a list of policies defined in the compose file.
+// Pulled policies must be signed by one of the public keys, from the compose file or publicKeys, if any.
+// If a lock file exists next to the compose file, locked policies are pulled using their digest.
+func (c *Compose) GetPolicies(disableTLS bool, publicKeys []string) ([]manifest.Manifest, error) {
 	var manifests []manifest.Manifest
 	var errs []error
 
@@ -55,6 +60,14 @@ func (c *Compose) GetPolicies(disableTLS bool) ([]manifest.Manifest, error) {
 		errs = append(errs, err)
 	}
 
+	publicKeys = append(publicKeys, c.spec.PublicKeys...)
+
+	lockFilename := LockFilename(c.filename)
+	lock, err := LoadLockFile(lockFilename)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
 	for i := range c.spec.Policies {
 		if c.spec.Policies[i].IsZero() {
 			continue
@@ -66,7 +79,17 @@ func (c *Compose) GetPolicies(disableTLS bool) ([]manifest.Manifest, error) {
 		var err error
 
 		if c.spec.Policies[i].Policy != "" {
-			policyManifest, policyValues, policySecrets, err = registry.Pull(c.spec.Policies[i].Policy, disableTLS)
+			policyReference := c.spec.Policies[i].Policy
+
+			if locked, found := lock.Get(policyReference); found {
+				logrus.Infof("\tlocked version: %q", locked.Reference)
+				policyReference = locked.PinnedReference()
+			} else if lock != nil {
+				logrus.Warningf("Policy %q not found in the lock file %q, run \"updatecli compose lock\" to update it",
+					policyReference, lockFilename)
+			}
+
+			policyManifest, policyValues, policySecrets, err = registry.Pull(policyReference, disableTLS, publicKeys)
 			if err != nil {
 				errs = append(errs, fmt.Errorf("pulling policy %q: %s", c.spec.Policies[i].Policy, err))
 				continue
@@ -43,7 +43,7 @@ func TestGetPolicies(t *testing.T) {
 			updateCompose, err := New(data.file)
 			require.NoError(t, err)
 
-			gotManifests, err := updateCompose.GetPolicies(false)
+			gotManifests, err := updateCompose.GetPolicies(false, nil)
 			require.NoError(t, err)
 
 			assert.Equal(t, data.expectedManifests, gotManifests)
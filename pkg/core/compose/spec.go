@@ -7,6 +7,9 @@ type Spec struct {
 	Environments Environments `yaml:",omitempty"`
 	// Env_files contains a list of environment files
 	Env_files EnvFiles `yaml:"env_files,omitempty"`
+	// PublicKeys contains a list of PEM public key files used to verify policies signature.
+	// If defined, unsigned policies, or policies not signed by one of those keys, are refused.
+	PublicKeys []string `yaml:"publickeys,omitempty"`
 }
 
 type Policy struct {
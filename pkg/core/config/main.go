@@ -17,6 +17,7 @@ import (
 	"github.com/updatecli/updatecli/pkg/core/pipeline/action"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/autodiscovery"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/condition"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/policy"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/source"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/target"
@@ -183,6 +184,21 @@ type Spec struct {
 		---
 	*/
 	Targets map[string]target.Config `yaml:",omitempty"`
+	/*
+		"policy" defines rules restricting when, and to which versions, targets can be updated.
+		Targets not respecting the policy are skipped.
+
+		example:
+		---
+		policy:
+			minimumreleaseage: 3d
+			schedule:
+				- weekends
+				- "* 0-5 * * mon-fri"
+			timezone: Europe/Brussels
+		---
+	*/
+	Policy policy.Config `yaml:",omitempty"`
 	/*
 		"version" defines the minimum Updatecli version compatible with the manifest
 	*/
@@ -223,46 +239,13 @@ func New(option Option) (configs []Config, err error) {
 		return configs, err
 	}
 
-	readFile := func(path string) ([]byte, error) {
-		// Load updatecli manifest no matter the file extension
-		f, err := os.Open(path)
-
-		if err != nil {
-			return nil, err
-		}
-
-		return io.ReadAll(f)
-	}
-
 	var templatedManifestContent []byte
-	var rawManifestContent []byte
-
-	for _, partialFile := range option.PartialFiles {
-		partialContent, err := readFile(partialFile)
-		if err != nil {
-			return nil, fmt.Errorf("loading Updatecli partial manifest %q: %v", partialFile, err)
-		}
-
-		// Ignore partial files that are not in the same directory as the main manifest file
-		// This is to avoid loading partial files from other directories to reduce the complexity of the manifest.
-		if filepath.Dir(partialFile) != filepath.Dir(option.ManifestFile) {
-			logrus.Debugf("Ignoring partial from a different directory: %q", partialFile)
-			continue
-		}
-
-		logrus.Debugf("Partial content detected from: %q", partialFile)
-		rawManifestContent = append(rawManifestContent, partialContent...)
-	}
 
-	logrus.Infof("Loading Pipeline %q", option.ManifestFile)
-	// Load updatecli manifest no matter the file extension
-	rawManifestFileContent, err := readFile(option.ManifestFile)
+	rawManifestContent, err := readManifest(option)
 	if err != nil {
 		return configs, err
 	}
 
-	rawManifestContent = append(rawManifestContent, rawManifestFileContent...)
-
 	specs := []Spec{}
 
 	isCue := false
@@ -421,6 +404,48 @@ func New(option Option) (configs []Config, err error) {
 
 }
 
+// readManifest returns the manifest content, prefixed by the partials from the same directory
+func readManifest(option Option) ([]byte, error) {
+	readFile := func(path string) ([]byte, error) {
+		// Load updatecli manifest no matter the file extension
+		f, err := os.Open(path)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return io.ReadAll(f)
+	}
+
+	var rawManifestContent []byte
+
+	for _, partialFile := range option.PartialFiles {
+		partialContent, err := readFile(partialFile)
+		if err != nil {
+			return nil, fmt.Errorf("loading Updatecli partial manifest %q: %v", partialFile, err)
+		}
+
+		// Ignore partial files that are not in the same directory as the main manifest file
+		// This is to avoid loading partial files from other directories to reduce the complexity of the manifest.
+		if filepath.Dir(partialFile) != filepath.Dir(option.ManifestFile) {
+			logrus.Debugf("Ignoring partial from a different directory: %q", partialFile)
+			continue
+		}
+
+		logrus.Debugf("Partial content detected from: %q", partialFile)
+		rawManifestContent = append(rawManifestContent, partialContent...)
+	}
+
+	logrus.Infof("Loading Pipeline %q", option.ManifestFile)
+	// Load updatecli manifest no matter the file extension
+	rawManifestFileContent, err := readFile(option.ManifestFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(rawManifestContent, rawManifestFileContent...), nil
+}
+
 // IsManifestDifferentThanOnDisk checks if an Updatecli manifest in memory is the same than the one on disk
 func (c *Config) IsManifestDifferentThanOnDisk() (bool, error) {
 
@@ -550,6 +575,15 @@ func (config *Config) validateAutodiscovery() error {
 	return nil
 }
 
+func (config *Config) validatePolicy() error {
+	if err := config.Spec.Policy.Validate(); err != nil {
+		logrus.Errorln(err)
+		return ErrBadConfig
+	}
+
+	return nil
+}
+
 func (config *Config) validateSources() error {
 	for id, s := range config.Spec.Sources {
 		err := s.Validate()
@@ -558,6 +592,13 @@ func (config *Config) validateSources() error {
 			return ErrBadConfig
 		}
 
+		if s.Changelog.SCMID != "" {
+			if _, ok := config.Spec.SCMs[s.Changelog.SCMID]; !ok {
+				logrus.Errorf("the changelog scmid %q for source %q does not exist", s.Changelog.SCMID, id)
+				return ErrBadConfig
+			}
+		}
+
 		if IsTemplatedString(id) {
 			logrus.Errorf("sources key %q contains forbidden go template instruction", id)
 			return ErrNotAllowedTemplatedKey
@@ -716,6 +757,13 @@ func (config *Config) Validate() error {
 			fmt.Errorf("autodiscovery validation error:\n%s", err))
 	}
 
+	err = config.validatePolicy()
+	if err != nil {
+		errs = append(
+			errs,
+			fmt.Errorf("policy validation error:\n%s", err))
+	}
+
 	err = config.validateSCMs()
 	if err != nil {
 		errs = append(
@@ -0,0 +1,44 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Render returns the manifest content, prefixed by its partials, once templated.
+// Only yaml and json manifests are supported as cue manifests are not templated
+// using the Golang template engine.
+func Render(option Option) ([]byte, error) {
+	switch extension := filepath.Ext(option.ManifestFile); extension {
+	case ".tpl", ".tmpl", ".yaml", ".yml", ".json":
+		//
+	case ".cue":
+		return nil, fmt.Errorf("rendering cue manifest %q is not supported", option.ManifestFile)
+	default:
+		return nil, ErrConfigFileTypeNotSupported
+	}
+
+	rawManifestContent, err := readManifest(option)
+	if err != nil {
+		return nil, err
+	}
+
+	if option.DisableTemplating {
+		return rawManifestContent, nil
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+
+	t := Template{
+		CfgFile:      option.ManifestFile,
+		ValuesFiles:  option.ValuesFiles,
+		SecretsFiles: option.SecretsFiles,
+		fs:           os.DirFS(cwd),
+	}
+
+	return t.NewStringTemplate(rawManifestContent)
+}
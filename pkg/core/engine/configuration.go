@@ -24,32 +24,7 @@ func (e *Engine) LoadConfigurations() error {
 	for i := range e.Options.Manifests {
 		// If no manifest file is specified, we try to detect one
 		if len(e.Options.Manifests[i].Manifests) == 0 {
-			// Updatecli tries to load the file updatecli.yaml if no manifest was specified
-			// If updatecli.yaml doesn't exists then Updatecli parses the directory updatecli.d for any manifests.
-			// if there is no manifests in the directory updatecli.d then Updatecli returns no manifest files.
-
-			// defaultManifestFilenames defines the default updatecli configuration filenames
-			defaultManifestFilenames := []string{"updatecli.yaml"}
-			if cmdoptions.Experimental {
-				defaultManifestFilenames = append(defaultManifestFilenames, "updatecli.cue")
-			}
-			// defaultManifestDirname defines the default updatecli manifest directory
-			defaultManifestDirname := "updatecli.d"
-
-			// If no manifest file is specified, we try to detect one
-			for _, filename := range defaultManifestFilenames {
-				if _, err := os.Stat(filename); err == nil {
-					logrus.Debugf("Default Updatecli manifest detected %q", filename)
-					e.Options.Manifests[i].Manifests = append(e.Options.Manifests[i].Manifests, filename)
-				}
-			}
-
-			if fs, err := os.Stat(defaultManifestDirname); err == nil {
-				if fs.IsDir() {
-					logrus.Debugf("Default Updatecli manifest directory detected %q", defaultManifestDirname)
-					e.Options.Manifests[i].Manifests = append(e.Options.Manifests[i].Manifests, defaultManifestDirname)
-				}
-			}
+			e.Options.Manifests[i].Manifests = detectDefaultManifests()
 
 			if len(e.Options.Manifests[i].Manifests) == 0 {
 				ErrNoManifestDetectedCounter++
@@ -150,3 +125,35 @@ func (e *Engine) LoadConfigurations() error {
 
 	return nil
 }
+
+// detectDefaultManifests returns the default manifest locations found in the current directory.
+// Updatecli tries to load the file updatecli.yaml if no manifest was specified
+// If updatecli.yaml doesn't exists then Updatecli parses the directory updatecli.d for any manifests.
+// if there is no manifests in the directory updatecli.d then Updatecli returns no manifest files.
+func detectDefaultManifests() []string {
+	var manifests []string
+
+	// defaultManifestFilenames defines the default updatecli configuration filenames
+	defaultManifestFilenames := []string{"updatecli.yaml"}
+	if cmdoptions.Experimental {
+		defaultManifestFilenames = append(defaultManifestFilenames, "updatecli.cue")
+	}
+	// defaultManifestDirname defines the default updatecli manifest directory
+	defaultManifestDirname := "updatecli.d"
+
+	for _, filename := range defaultManifestFilenames {
+		if _, err := os.Stat(filename); err == nil {
+			logrus.Debugf("Default Updatecli manifest detected %q", filename)
+			manifests = append(manifests, filename)
+		}
+	}
+
+	if fs, err := os.Stat(defaultManifestDirname); err == nil {
+		if fs.IsDir() {
+			logrus.Debugf("Default Updatecli manifest directory detected %q", defaultManifestDirname)
+			manifests = append(manifests, defaultManifestDirname)
+		}
+	}
+
+	return manifests
+}
@@ -0,0 +1,116 @@
+package engine
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/action"
+	"github.com/updatecli/updatecli/pkg/core/reports"
+)
+
+// dashboard is a dependency dashboard issue shared by pipelines targeting the same repository
+type dashboard struct {
+	// title is the dashboard issue title
+	title string
+	// kind is the dashboard action kind such as "github/dashboard"
+	kind string
+	// handler updates the dashboard issue
+	handler action.DashboardHandler
+	// dryRun is true if the dashboard must not be updated
+	dryRun bool
+}
+
+// updateDashboards updates the dependency dashboard issues defined by pipeline actions.
+// Every dashboard lists all pipelines from the run, so dashboards are updated once all pipelines are executed.
+func (e *Engine) updateDashboards() error {
+	dashboards, keys := e.getDashboards()
+	if len(dashboards) == 0 {
+		return nil
+	}
+
+	logrus.Infof("\n\n%s\n", strings.ToTitle("Dependency Dashboards"))
+	logrus.Infof("%s\n\n", strings.Repeat("=", len("Dependency Dashboards")+1))
+
+	errs := []string{}
+
+	for _, key := range keys {
+		d := dashboards[key]
+
+		generateBody := func(currentBody string) (string, error) {
+			return reports.GenerateDashboard(currentBody, e.Reports)
+		}
+
+		if d.dryRun {
+			body, err := generateBody("")
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("%s: %s", d.title, err))
+				continue
+			}
+
+			logrus.Infof("[Dry Run] The dependency dashboard %q of kind %q is expected to be updated.", d.title, d.kind)
+			logrus.Debugf("The expected dependency dashboard would have the following content:\n\n%s",
+				strings.ReplaceAll(body, "\n", "\n\t|\t"))
+			continue
+		}
+
+		link, err := d.handler.UpdateDashboard(d.title, generateBody)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %s", d.title, err))
+			continue
+		}
+
+		logrus.Infof("Dependency dashboard %q available at %q", d.title, link)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf(
+			"errors occurred while updating dependency dashboards:\n\t* %s",
+			strings.Join(errs, "\n\t* "))
+	}
+
+	return nil
+}
+
+// getDashboards returns the dependency dashboards defined by pipeline actions, sorted by pipeline then action ID.
+// Dashboards sharing the same kind, repository, and title are only updated once.
+func (e *Engine) getDashboards() (map[string]dashboard, []string) {
+	dashboards := map[string]dashboard{}
+	keys := []string{}
+
+	for _, p := range e.Pipelines {
+		for _, id := range slices.Sorted(maps.Keys(p.Actions)) {
+			a := p.Actions[id]
+			if !a.Config.IsDashboard() {
+				continue
+			}
+
+			if a.Dashboard == nil || a.Scm == nil || a.Scm.Handler == nil {
+				logrus.Warningf("dependency dashboard %q from pipeline %q is not correctly initialized, skipping", id, p.Name)
+				continue
+			}
+
+			title := a.Config.Title
+			if title == "" {
+				title = reports.DefaultDashboardTitle
+			}
+
+			key := strings.Join([]string{a.Config.Kind, a.Scm.Handler.GetURL(), title}, "|")
+			if _, ok := dashboards[key]; ok {
+				continue
+			}
+
+			dashboards[key] = dashboard{
+				title:   title,
+				kind:    a.Config.Kind,
+				handler: a.Dashboard,
+				dryRun:  p.Options.Target.DryRun || !p.Options.Target.Push,
+			}
+			keys = append(keys, key)
+		}
+	}
+
+	return dashboards, keys
+}
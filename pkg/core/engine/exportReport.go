@@ -6,6 +6,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 	"github.com/updatecli/updatecli/pkg/core/cmdoptions"
+	"github.com/updatecli/updatecli/pkg/core/reports"
 )
 
 // exportReportToYAML is a function that exports the report of the pipeline to a specified format and location.
@@ -39,3 +40,32 @@ func (e *Engine) exportReportToYAML(filenameTimestamp bool) error {
 	}
 	return nil
 }
+
+// validateReportOptions ensures the report format and file options are consistent
+func (e *Engine) validateReportOptions() error {
+	switch {
+	case e.Options.ReportFormat == "" && e.Options.ReportFile == "":
+		return nil
+	case e.Options.ReportFormat == "":
+		return fmt.Errorf("a report format must be specified with --report-format when using --report-file")
+	case e.Options.ReportFile == "":
+		return fmt.Errorf("a report file must be specified with --report-file when using --report-format")
+	}
+
+	return reports.ValidateFormat(e.Options.ReportFormat)
+}
+
+// exportReports exports the reports of every pipeline to the report file, using the report format
+func (e *Engine) exportReports() error {
+	if e.Options.ReportFormat == "" || e.Options.ReportFile == "" {
+		return nil
+	}
+
+	if err := e.Reports.ExportToFile(e.Options.ReportFormat, e.Options.ReportFile); err != nil {
+		return err
+	}
+
+	logrus.Infof("Report exported using the %s format to %q", e.Options.ReportFormat, e.Options.ReportFile)
+
+	return nil
+}
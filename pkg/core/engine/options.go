@@ -1,6 +1,8 @@
 package engine
 
 import (
+	"time"
+
 	"github.com/updatecli/updatecli/pkg/core/config"
 	"github.com/updatecli/updatecli/pkg/core/engine/manifest"
 	"github.com/updatecli/updatecli/pkg/core/pipeline"
@@ -13,4 +15,12 @@ type Options struct {
 	Manifests     []manifest.Manifest
 	DisplayFlavor string
 	GraphFlavor   string
+	// Parallelism defines the maximum number of pipelines running concurrently
+	Parallelism int
+	// SourceCacheTTL enables the on-disk source cache when greater than zero
+	SourceCacheTTL time.Duration
+	// ReportFormat defines the format used to export reports to ReportFile, such as "junit", "json", or "sarif"
+	ReportFormat string
+	// ReportFile defines the file where reports are exported using ReportFormat
+	ReportFile string
 }
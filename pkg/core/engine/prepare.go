@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/source"
 	"github.com/updatecli/updatecli/pkg/core/tmp"
 )
 
@@ -20,6 +21,9 @@ func (e *Engine) Prepare() (err error) {
 		return err
 	}
 
+	// Identical sources are resolved once per run
+	e.Options.Pipeline.Source.Cache = source.NewCache(e.Options.SourceCacheTTL)
+
 	err = e.LoadConfigurations()
 	if !errors.Is(err, ErrNoManifestDetected) && err != nil {
 		logrus.Errorln(err)
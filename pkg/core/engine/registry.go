@@ -8,12 +8,13 @@ import (
 )
 
 // PullFromRegistry retrieves an Updatecli policy from an OCI registry.
-func (e *Engine) PullFromRegistry(policyReference string, disableTLS bool) (err error) {
+// If publicKeys is defined, the policy signature is verified.
+func (e *Engine) PullFromRegistry(policyReference string, disableTLS bool, publicKeys []string) (err error) {
 
 	PrintTitle("Registry")
 
 	//nolint:dogsled
-	_, _, _, err = registry.Pull(policyReference, disableTLS)
+	_, _, _, err = registry.Pull(policyReference, disableTLS, publicKeys)
 	if err != nil {
 		return err
 	}
@@ -22,7 +23,8 @@ func (e *Engine) PullFromRegistry(policyReference string, disableTLS bool) (err
 }
 
 // PushToRegistry pushes an Updatecli policy to an OCI registry.
-func (e *Engine) PushToRegistry(manifests, valuesFiles, secretsFiles, policyReference []string, disableTLS bool, policyMetadataFile, fileStore string, overwrite bool) error {
+// If signKey is defined, the policy is signed using that private key.
+func (e *Engine) PushToRegistry(manifests, valuesFiles, secretsFiles, policyReference []string, disableTLS bool, policyMetadataFile, fileStore string, overwrite bool, signKey string) error {
 
 	PrintTitle("Registry")
 
@@ -61,7 +63,7 @@ func (e *Engine) PushToRegistry(manifests, valuesFiles, secretsFiles, policyRefe
 
 	relativeFromFileStore(manifests)
 
-	err := registry.Push(policyMetadataFile, manifests, valuesFiles, secretsFiles, policyReference, disableTLS, fileStore, overwrite)
+	err := registry.Push(policyMetadataFile, manifests, valuesFiles, secretsFiles, policyReference, disableTLS, fileStore, overwrite, signKey)
 	if err != nil {
 		return err
 	}
@@ -1,24 +1,23 @@
 package engine
 
 import (
+	"sync"
+
 	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/log"
+	"github.com/updatecli/updatecli/pkg/core/pipeline"
 )
 
 // Run runs the full process
 func (e *Engine) Run() (err error) {
 
-	PrintTitle("Pipeline")
+	if err = e.validateReportOptions(); err != nil {
+		return err
+	}
 
-	for i := range e.Pipelines {
-		pipeline := e.Pipelines[i]
+	PrintTitle("Pipeline")
 
-		err := pipeline.Run()
-		if err != nil {
-			logrus.Printf("Pipeline %q failed\n", pipeline.Name)
-			logrus.Printf("Skipping due to:\n\t%s\n", err)
-			continue
-		}
-	}
+	e.runPipelines()
 
 	if err = e.runActions(); err != nil {
 		logrus.Errorf("running actions:\n%s", err)
@@ -33,6 +32,10 @@ func (e *Engine) Run() (err error) {
 		e.Reports = append(e.Reports, pipeline.Report)
 	}
 
+	if err = e.updateDashboards(); err != nil {
+		logrus.Errorf("updating dependency dashboards:\n%s", err)
+	}
+
 	if err = e.publishToUdash(); err != nil {
 		logrus.Errorf("publishing to Udash:\n%s", err)
 	}
@@ -41,9 +44,140 @@ func (e *Engine) Run() (err error) {
 		logrus.Errorf("exporting report:\n%s", err)
 	}
 
+	if err = e.exportReports(); err != nil {
+		logrus.Errorf("exporting report:\n%s", err)
+	}
+
 	if err = e.showReports(); err != nil {
 		return err
 	}
 
 	return nil
 }
+
+// runPipelines runs every pipeline, using up to e.Options.Parallelism workers.
+// When running concurrently, each pipeline receives its own logger,
+// plugins logging through the standard logger still write to the default output.
+func (e *Engine) runPipelines() {
+	if e.Options.Parallelism <= 1 || len(e.Pipelines) <= 1 {
+		for i := range e.Pipelines {
+			runPipeline(e.Pipelines[i])
+		}
+		return
+	}
+
+	groups := groupPipelines(e.Pipelines)
+
+	workers := e.Options.Parallelism
+	if workers > len(groups) {
+		workers = len(groups)
+	}
+
+	logrus.Infof("Running %d pipeline(s) using %d worker(s)", len(e.Pipelines), workers)
+
+	// Each pipeline logs to its own buffer, flushed once the pipeline is done,
+	// so that output from concurrent pipelines does not interleave.
+	output := logrus.StandardLogger().Out
+
+	var outputMu sync.Mutex
+	var wg sync.WaitGroup
+
+	queue := make(chan []*pipeline.Pipeline)
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for group := range queue {
+				for _, p := range group {
+					buffer := &log.Buffer{}
+
+					p.Logger = log.New(buffer)
+					runPipeline(p)
+					p.Logger = nil
+
+					outputMu.Lock()
+					if err := buffer.Flush(output); err != nil {
+						logrus.Errorf("flushing logs for pipeline %q: %s", p.Name, err)
+					}
+					outputMu.Unlock()
+				}
+			}
+		}()
+	}
+
+	for i := range groups {
+		queue <- groups[i]
+	}
+	close(queue)
+
+	wg.Wait()
+}
+
+// runPipeline runs a single pipeline and logs its failure if any
+func runPipeline(p *pipeline.Pipeline) {
+	err := p.Run()
+	if err != nil {
+		logger := log.OrStandard(p.Logger)
+		logger.Printf("Pipeline %q failed\n", p.Name)
+		logger.Printf("Skipping due to:\n\t%s\n", err)
+	}
+}
+
+// groupPipelines splits pipelines into groups that can safely run concurrently.
+// Pipelines sharing a pipelineid or an scm working directory end up in the same group,
+// and are run sequentially, in their original order.
+func groupPipelines(pipelines []*pipeline.Pipeline) [][]*pipeline.Pipeline {
+	// parent implements a minimal union-find over pipeline indexes
+	parent := make([]int, len(pipelines))
+	for i := range parent {
+		parent[i] = i
+	}
+
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+
+	owners := map[string]int{}
+	claim := func(key string, i int) {
+		if owner, ok := owners[key]; ok {
+			parent[find(i)] = find(owner)
+			return
+		}
+		owners[key] = i
+	}
+
+	for i, p := range pipelines {
+		if p.ID != "" {
+			claim("pipelineid:"+p.ID, i)
+		}
+
+		for _, s := range p.SCMs {
+			if s.Handler == nil {
+				continue
+			}
+			if dir := s.Handler.GetDirectory(); dir != "" {
+				claim("scm:"+dir, i)
+			}
+		}
+	}
+
+	index := map[int]int{}
+	groups := [][]*pipeline.Pipeline{}
+	for i, p := range pipelines {
+		root := find(i)
+		g, ok := index[root]
+		if !ok {
+			g = len(groups)
+			index[root] = g
+			groups = append(groups, []*pipeline.Pipeline{})
+		}
+		groups[g] = append(groups[g], p)
+	}
+
+	return groups
+}
@@ -0,0 +1,72 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/updatecli/updatecli/pkg/core/pipeline"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
+)
+
+func TestGroupPipelines(t *testing.T) {
+	newPipeline := func(name, id, scmDir string) *pipeline.Pipeline {
+		p := &pipeline.Pipeline{
+			Name: name,
+			ID:   id,
+			SCMs: map[string]scm.Scm{},
+		}
+		if scmDir != "" {
+			p.SCMs["default"] = scm.Scm{Handler: &scm.MockScm{WorkingDir: scmDir}}
+		}
+		return p
+	}
+
+	tests := []struct {
+		name           string
+		pipelines      []*pipeline.Pipeline
+		expectedGroups [][]string
+	}{
+		{
+			name: "Independent pipelines",
+			pipelines: []*pipeline.Pipeline{
+				newPipeline("a", "a", ""),
+				newPipeline("b", "b", "/tmp/b"),
+				newPipeline("c", "", ""),
+			},
+			expectedGroups: [][]string{{"a"}, {"b"}, {"c"}},
+		},
+		{
+			name: "Shared pipelineid",
+			pipelines: []*pipeline.Pipeline{
+				newPipeline("a", "shared", ""),
+				newPipeline("b", "b", ""),
+				newPipeline("c", "shared", ""),
+			},
+			expectedGroups: [][]string{{"a", "c"}, {"b"}},
+		},
+		{
+			name: "Shared scm directory, transitively",
+			pipelines: []*pipeline.Pipeline{
+				newPipeline("a", "a", "/tmp/repo"),
+				newPipeline("b", "b", "/tmp/other"),
+				newPipeline("c", "b", "/tmp/repo"),
+				newPipeline("d", "d", ""),
+			},
+			expectedGroups: [][]string{{"a", "b", "c"}, {"d"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotGroups := [][]string{}
+			for _, g := range groupPipelines(tt.pipelines) {
+				names := []string{}
+				for _, p := range g {
+					names = append(names, p.Name)
+				}
+				gotGroups = append(gotGroups, names)
+			}
+			assert.Equal(t, tt.expectedGroups, gotGroups)
+		})
+	}
+}
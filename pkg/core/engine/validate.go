@@ -0,0 +1,54 @@
+package engine
+
+import (
+	"github.com/updatecli/updatecli/pkg/core/config"
+	"github.com/updatecli/updatecli/pkg/core/validate"
+)
+
+// Validate validates every Updatecli manifest without running any pipeline
+// and returns the detected issues. No network access is needed.
+func (e *Engine) Validate() (validate.Diagnostics, error) {
+	var diagnostics validate.Diagnostics
+
+	ErrNoManifestDetectedCounter := 0
+
+	for i := range e.Options.Manifests {
+		// If no manifest file is specified, we try to detect one
+		if len(e.Options.Manifests[i].Manifests) == 0 {
+			e.Options.Manifests[i].Manifests = detectDefaultManifests()
+
+			if len(e.Options.Manifests[i].Manifests) == 0 {
+				ErrNoManifestDetectedCounter++
+				continue
+			}
+		}
+
+		manifestFiles, manifestPartials := sanitizeUpdatecliManifestFilePath(e.Options.Manifests[i].Manifests)
+		for _, manifestFile := range manifestFiles {
+			manifestDiagnostics, err := validate.Manifest(
+				config.Option{
+					PartialFiles:      manifestPartials,
+					ManifestFile:      manifestFile,
+					SecretsFiles:      e.Options.Manifests[i].Secrets,
+					ValuesFiles:       e.Options.Manifests[i].Values,
+					DisableTemplating: e.Options.Config.DisableTemplating,
+				})
+
+			switch err {
+			case config.ErrConfigFileTypeNotSupported:
+				// Updatecli ignores unsupported files when browsing manifest directories
+				continue
+			case nil:
+				diagnostics = append(diagnostics, manifestDiagnostics...)
+			default:
+				return diagnostics, err
+			}
+		}
+	}
+
+	if ErrNoManifestDetectedCounter == len(e.Options.Manifests) {
+		return nil, ErrNoManifestDetected
+	}
+
+	return diagnostics, nil
+}
@@ -0,0 +1,69 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/core/engine/manifest"
+	"github.com/updatecli/updatecli/pkg/core/validate"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name                string
+		wd                  string
+		expectedDiagnostics validate.Diagnostics
+		expectedError       error
+	}{
+		{
+			name: "Default file",
+			wd:   "testdata/defaultManifestFilename",
+		},
+		{
+			name: "Partial with one manifest",
+			wd:   "testdata/partialOneManifest",
+		},
+		{
+			name: "Default manifest directory with one failure",
+			wd:   "testdata/defaultManifestDirname_multiple_failure",
+			expectedDiagnostics: validate.Diagnostics{
+				{
+					File:     "updatecli.d/failure.yaml",
+					Line:     7,
+					Column:   5,
+					Severity: validate.SeverityError,
+					Path:     "sources.adopters.scmid",
+					Message:  `scm "updatecli" does not exist`,
+				},
+			},
+		},
+		{
+			name:          "No manifest",
+			wd:            "testdata",
+			expectedError: ErrNoManifestDetected,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Cleanup(chdir(t, tt.wd))
+
+			e := Engine{
+				Options: Options{
+					Manifests: []manifest.Manifest{
+						{},
+					},
+				},
+			}
+
+			got, err := e.Validate()
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedDiagnostics, got)
+		})
+	}
+}
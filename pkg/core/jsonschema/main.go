@@ -33,6 +33,8 @@ var (
 	commentDir string = path.Join(os.TempDir(), "updatecli", "_comments")
 	// commentURL defines the updatecli git url
 	commentURL string = "https://github.com/updatecli/updatecli.git"
+	// skipComments disables code comments retrieval
+	skipComments bool
 )
 
 type Schema struct {
@@ -90,7 +92,7 @@ func (s *Schema) GenerateSchema(object interface{}) error {
 
 	r.KeyNamer = strings.ToLower
 
-	r.CommentMap, err = GetPackageComments(commentDir)
+	r.CommentMap, err = getCommentMap()
 
 	if err != nil {
 		return err
@@ -162,6 +164,16 @@ func GetPackageComments(rootPackagePath string) (map[string]string, error) {
 	return r.CommentMap, nil
 }
 
+// getCommentMap returns the code comments used to populate jsonschema descriptions.
+// Comments are not retrieved when the jsonschema is only generated to validate documents.
+func getCommentMap() (map[string]string, error) {
+	if skipComments {
+		return nil, nil
+	}
+
+	return GetPackageComments(commentDir)
+}
+
 // CloneCommentDirectory clones the updatecli git repository in a
 // temporary location so we can parse comments
 func CloneCommentDirectory() error {
@@ -206,7 +218,7 @@ func AppendOneOfToJsonSchema(baseConfig interface{}, anyOf map[string]interface{
 	}
 
 	// Retrieve Updatecli code comments
-	commentMap, err = GetPackageComments(commentDir)
+	commentMap, err = getCommentMap()
 
 	if err != nil {
 		logrus.Errorf("retrieve code comments: %s", err.Error())
@@ -258,7 +270,7 @@ func AppendMapToJsonSchema(baseConfig interface{}, mapConfig map[string]interfac
 	var commentMap map[string]string
 
 	// Retrieve Updatecli code comments
-	commentMap, err = GetPackageComments(commentDir)
+	commentMap, err = getCommentMap()
 
 	if err != nil {
 		logrus.Errorf("retrieve code comments: %s", err.Error())
@@ -0,0 +1,246 @@
+package jsonschema
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	jschema "github.com/invopop/jsonschema"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+const (
+	// pathSeparator is used to split the jsonschema validation context into path elements
+	// as keys may contain dots.
+	pathSeparator string = "\x1f"
+	// rootContext is the jsonschema validation context of the document root
+	rootContext string = "(root)"
+)
+
+var (
+	// validatorMutex prevents concurrent schema generations without code comments
+	validatorMutex sync.Mutex
+)
+
+// Validator validates documents against a jsonschema generated from a Go object.
+type Validator struct {
+	schema *gojsonschema.Schema
+}
+
+// ValidationError describes a document value which does not respect the jsonschema
+type ValidationError struct {
+	// Path contains the keys leading to the invalid value, an empty path means the document root
+	Path []string
+	// Type identifies the validation error such as "required" or "additional_property_not_allowed"
+	Type string
+	// Message describes the validation error
+	Message string
+}
+
+// NewValidator returns a validator based on the jsonschema generated from object.
+// Code comments are not needed to validate documents, so they are not retrieved
+// which allows to validate documents without network access.
+func NewValidator(object interface{}) (*Validator, error) {
+	validatorMutex.Lock()
+	skipComments = true
+	defer func() {
+		skipComments = false
+		validatorMutex.Unlock()
+	}()
+
+	r := new(jschema.Reflector)
+
+	r.DoNotReference = true
+	r.RequiredFromJSONSchemaTags = true
+	r.KeyNamer = strings.ToLower
+
+	rawSchema, err := json.Marshal(r.Reflect(object))
+	if err != nil {
+		return nil, fmt.Errorf("marshal jsonschema: %w", err)
+	}
+
+	var schemaDocument interface{}
+	if err := json.Unmarshal(rawSchema, &schemaDocument); err != nil {
+		return nil, fmt.Errorf("unmarshal jsonschema: %w", err)
+	}
+	discriminateKinds(schemaDocument)
+
+	// The generated jsonschema relies on boolean schemas which are only supported since draft 6
+	loader := gojsonschema.NewSchemaLoader()
+	loader.AutoDetect = false
+	loader.Draft = gojsonschema.Draft7
+
+	schema, err := loader.Compile(gojsonschema.NewGoLoader(schemaDocument))
+	if err != nil {
+		return nil, fmt.Errorf("compile jsonschema: %w", err)
+	}
+
+	return &Validator{schema: schema}, nil
+}
+
+// Validate returns all values from document which do not respect the jsonschema
+func (v *Validator) Validate(document interface{}) ([]ValidationError, error) {
+	result, err := v.schema.Validate(gojsonschema.NewGoLoader(document))
+	if err != nil {
+		return nil, err
+	}
+
+	var validationErrors []ValidationError
+	for _, resultError := range result.Errors() {
+		if isDecodable(resultError) {
+			continue
+		}
+
+		path := []string{}
+		for _, element := range strings.Split(resultError.Context().String(pathSeparator), pathSeparator) {
+			if element == rootContext {
+				continue
+			}
+			path = append(path, element)
+		}
+
+		// Point to the unexpected key instead of its parent
+		if resultError.Type() == "additional_property_not_allowed" {
+			if property, ok := resultError.Details()["property"].(string); ok {
+				path = append(path, property)
+			}
+		}
+
+		validationErrors = append(validationErrors, ValidationError{
+			Path:    path,
+			Type:    resultError.Type(),
+			Message: resultError.Description(),
+		})
+	}
+
+	return withoutCompositeErrors(validationErrors), nil
+}
+
+// withoutCompositeErrors removes errors only stating that a value doesn't respect a combination of schemas,
+// such as "allOf", if more specific errors are reported for that value
+func withoutCompositeErrors(validationErrors []ValidationError) []ValidationError {
+	isComposite := func(errorType string) bool {
+		switch errorType {
+		case "number_all_of", "number_any_of", "number_one_of", "condition_then", "condition_else":
+			return true
+		}
+		return false
+	}
+
+	hasSpecificError := func(path []string) bool {
+		for _, validationError := range validationErrors {
+			if isComposite(validationError.Type) || len(validationError.Path) < len(path) {
+				continue
+			}
+			if slices.Equal(validationError.Path[:len(path)], path) {
+				return true
+			}
+		}
+		return false
+	}
+
+	result := []ValidationError{}
+	for _, validationError := range validationErrors {
+		if isComposite(validationError.Type) && hasSpecificError(validationError.Path) {
+			continue
+		}
+		result = append(result, validationError)
+	}
+
+	return result
+}
+
+// isDecodable returns true if the error is about a value accepted when decoding yaml manifests,
+// such as scalars used where a string is expected like "version: 1.2", or empty values.
+func isDecodable(resultError gojsonschema.ResultError) bool {
+	if resultError.Type() != "invalid_type" {
+		return false
+	}
+
+	switch resultError.Details()["given"] {
+	case "null":
+		return true
+	case "integer", "number", "boolean":
+		return resultError.Details()["expected"] == "string"
+	}
+
+	return false
+}
+
+// discriminateKinds replaces "oneOf" lists of resource schemas, discriminated by their "kind" value,
+// by conditional schemas. Validation errors are then reported for the schema matching the resource kind
+// instead of the closest one.
+func discriminateKinds(schema interface{}) {
+	switch s := schema.(type) {
+	case []interface{}:
+		for i := range s {
+			discriminateKinds(s[i])
+		}
+
+	case map[string]interface{}:
+		for key := range s {
+			discriminateKinds(s[key])
+		}
+
+		oneOf, ok := s["oneOf"].([]interface{})
+		if !ok {
+			return
+		}
+
+		kinds := []interface{}{}
+		allOf := []interface{}{}
+		for _, branch := range oneOf {
+			kind := getKind(branch)
+			if kind == nil {
+				return
+			}
+
+			kinds = append(kinds, kind)
+			allOf = append(allOf, map[string]interface{}{
+				"if": map[string]interface{}{
+					"properties": map[string]interface{}{
+						"kind": map[string]interface{}{"const": kind},
+					},
+					"required": []interface{}{"kind"},
+				},
+				"then": branch,
+			})
+		}
+
+		allOf = append(allOf, map[string]interface{}{
+			"properties": map[string]interface{}{
+				"kind": map[string]interface{}{"enum": kinds},
+			},
+		})
+
+		delete(s, "oneOf")
+		s["allOf"] = allOf
+	}
+}
+
+// getKind returns the unique "kind" value accepted by a resource schema, or nil if there is none
+func getKind(schema interface{}) interface{} {
+	s, ok := schema.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	properties, ok := s["properties"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	kind, ok := properties["kind"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	enum, ok := kind["enum"].([]interface{})
+	if !ok || len(enum) != 1 {
+		return nil
+	}
+
+	return enum[0]
+}
@@ -0,0 +1,104 @@
+package jsonschema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidator(t *testing.T) {
+	tests := []struct {
+		name     string
+		document map[string]interface{}
+		expected []ValidationError
+	}{
+		{
+			name: "Valid document",
+			document: map[string]interface{}{
+				"name": "test",
+				"conditions": map[string]interface{}{
+					"default": map[string]interface{}{
+						"kind":     "jenkins",
+						"sourceid": "default",
+					},
+				},
+			},
+			expected: []ValidationError{},
+		},
+		{
+			name: "Scalar used as a string and empty values are accepted",
+			document: map[string]interface{}{
+				"name":       1.2,
+				"pipelineid": nil,
+			},
+			expected: []ValidationError{},
+		},
+		{
+			name: "Unknown setting and missing kind",
+			document: map[string]interface{}{
+				"name": "test",
+				"conditions": map[string]interface{}{
+					"default": map[string]interface{}{
+						"sourceid": "default",
+						"unknown":  true,
+					},
+				},
+			},
+			expected: []ValidationError{
+				{
+					Path:    []string{"conditions", "default"},
+					Type:    "required",
+					Message: "kind is required",
+				},
+				{
+					Path:    []string{"conditions", "default", "unknown"},
+					Type:    "additional_property_not_allowed",
+					Message: "Additional property unknown is not allowed",
+				},
+			},
+		},
+	}
+
+	validator, err := NewValidator(&mockConfig{})
+	require.NoError(t, err)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := validator.Validate(tt.document)
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tt.expected, got)
+		})
+	}
+}
+
+func TestDiscriminateKinds(t *testing.T) {
+	schema := map[string]interface{}{
+		"oneOf": []interface{}{
+			map[string]interface{}{
+				"properties": map[string]interface{}{
+					"kind": map[string]interface{}{"enum": []interface{}{"file"}},
+				},
+			},
+			map[string]interface{}{
+				"properties": map[string]interface{}{
+					"kind": map[string]interface{}{"enum": []interface{}{"yaml"}},
+				},
+			},
+		},
+	}
+
+	discriminateKinds(schema)
+
+	assert.NotContains(t, schema, "oneOf")
+	require.Len(t, schema["allOf"], 3)
+
+	allOf := schema["allOf"].([]interface{})
+	assert.Equal(t,
+		map[string]interface{}{
+			"properties": map[string]interface{}{
+				"kind": map[string]interface{}{"enum": []interface{}{"file", "yaml"}},
+			},
+		},
+		allOf[2])
+}
@@ -0,0 +1,63 @@
+package log
+
+import (
+	"bytes"
+	"io"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// New returns a logger writing to w, using the standard logger formatter and level.
+func New(w io.Writer) *logrus.Logger {
+	std := logrus.StandardLogger()
+
+	logger := logrus.New()
+	logger.SetOutput(w)
+	logger.SetFormatter(std.Formatter)
+	logger.SetLevel(std.GetLevel())
+
+	return logger
+}
+
+// OrStandard returns logger, or the logrus standard logger if logger is nil
+func OrStandard(logger *logrus.Logger) *logrus.Logger {
+	if logger == nil {
+		return logrus.StandardLogger()
+	}
+	return logger
+}
+
+// Tee duplicates every log line written by logger to w,
+// in addition to its current output.
+// It returns a function restoring the previous output.
+func Tee(logger *logrus.Logger, w io.Writer) func() {
+	previous := logger.Out
+	logger.SetOutput(io.MultiWriter(previous, w))
+
+	return func() {
+		logger.SetOutput(previous)
+	}
+}
+
+// Buffer collects log lines emitted by a single pipeline so they can be
+// displayed at once, without interleaving with other pipelines running concurrently.
+type Buffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+// Write appends p to the buffer
+func (b *Buffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+// Flush writes the buffer content to w then resets the buffer
+func (b *Buffer) Flush(w io.Writer) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	_, err := b.buf.WriteTo(w)
+	return err
+}
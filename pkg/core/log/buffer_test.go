@@ -0,0 +1,72 @@
+package log
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	var out bytes.Buffer
+	logrus.SetFormatter(NewTextFormat())
+
+	buffers := []*Buffer{{}, {}}
+	lines := []string{"first", "second"}
+
+	var wg sync.WaitGroup
+	for i := range buffers {
+		wg.Add(1)
+		go func(b *Buffer, line string) {
+			defer wg.Done()
+			logger := New(b)
+			// Goroutines spawned by the pipeline keep using the same logger
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				logger.Info(line)
+			}()
+			<-done
+		}(buffers[i], lines[i])
+	}
+	wg.Wait()
+
+	for i := range buffers {
+		out.Reset()
+		require.NoError(t, buffers[i].Flush(&out))
+		assert.Equal(t, lines[i]+"\n", out.String())
+	}
+
+	out.Reset()
+	require.NoError(t, buffers[0].Flush(&out))
+	assert.Empty(t, out.String())
+}
+
+func TestTee(t *testing.T) {
+	var out, console bytes.Buffer
+	logrus.SetFormatter(NewTextFormat())
+
+	b := &Buffer{}
+	logger := New(b)
+
+	untee := Tee(logger, &console)
+	logger.Info("both")
+	untee()
+
+	logger.Info("buffer only")
+
+	assert.Equal(t, "both\n", console.String())
+
+	require.NoError(t, b.Flush(&out))
+	assert.Equal(t, "both\nbuffer only\n", out.String())
+}
+
+func TestOrStandard(t *testing.T) {
+	assert.Equal(t, logrus.StandardLogger(), OrStandard(nil))
+
+	logger := New(&Buffer{})
+	assert.Equal(t, logger, OrStandard(logger))
+}
@@ -11,10 +11,14 @@ import (
 	"github.com/updatecli/updatecli/pkg/core/jsonschema"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 	"github.com/updatecli/updatecli/pkg/core/reports"
+	azuredevops "github.com/updatecli/updatecli/pkg/plugins/resources/azuredevops/pullrequest"
 	bitbucket "github.com/updatecli/updatecli/pkg/plugins/resources/bitbucket/pullrequest"
+	giteadashboard "github.com/updatecli/updatecli/pkg/plugins/resources/gitea/dashboard"
 	gitea "github.com/updatecli/updatecli/pkg/plugins/resources/gitea/pullrequest"
+	gitlabdashboard "github.com/updatecli/updatecli/pkg/plugins/resources/gitlab/dashboard"
 	gitlab "github.com/updatecli/updatecli/pkg/plugins/resources/gitlab/mergerequest"
 	stash "github.com/updatecli/updatecli/pkg/plugins/resources/stash/pullrequest"
+	azuredevopsscm "github.com/updatecli/updatecli/pkg/plugins/scms/azuredevops"
 	bitbucketscm "github.com/updatecli/updatecli/pkg/plugins/scms/bitbucket"
 	giteascm "github.com/updatecli/updatecli/pkg/plugins/scms/gitea"
 	"github.com/updatecli/updatecli/pkg/plugins/scms/github"
@@ -23,11 +27,15 @@ import (
 )
 
 const (
-	gitlabIdentifier    = "gitlab"
-	githubIdentifier    = "github"
-	giteaIdentifier     = "gitea"
-	stashIdentifier     = "stash"
-	bitbucketIdentifier = "bitbucket"
+	gitlabIdentifier      = "gitlab"
+	githubIdentifier      = "github"
+	giteaIdentifier       = "gitea"
+	stashIdentifier       = "stash"
+	bitbucketIdentifier   = "bitbucket"
+	azureDevOpsIdentifier = "azuredevops"
+
+	// dashboardKindSuffix identifies dependency dashboard action kinds such as "github/dashboard"
+	dashboardKindSuffix = "/dashboard"
 )
 
 // ErrWrongConfig is returned when an action has missing mandatory attributes.
@@ -36,10 +44,19 @@ var ErrWrongConfig = errors.New("wrong action configuration")
 // ActionHandler interface defines required functions to be an action
 type ActionHandler interface {
 	CreateAction(report *reports.Action, resetDescription bool) error
-	CleanAction(report *reports.Action) error
+	// CleanAction cleans up an existing action, closeObsolete allows closing it
+	// when its working branch doesn't bring any changes anymore.
+	CleanAction(report *reports.Action, closeObsolete bool) error
 	CheckActionExist(report *reports.Action) error
 }
 
+// DashboardHandler interface defines required functions to be a dependency dashboard action
+type DashboardHandler interface {
+	// UpdateDashboard creates or updates the dashboard issue titled title,
+	// using the body generated from the current issue body, and returns the issue link.
+	UpdateDashboard(title string, generateBody func(currentBody string) (string, error)) (string, error)
+}
+
 // Config define action provided via an updatecli configuration
 type Config struct {
 	// Title defines the action title
@@ -67,7 +84,9 @@ type Action struct {
 	Config  Config
 	Scm     *scm.Scm
 	Handler ActionHandler
-	Report  reports.Action
+	// Dashboard is defined instead of Handler for dependency dashboard actions
+	Dashboard DashboardHandler
+	Report    reports.Action
 }
 
 // Validate ensures that an action configuration has required parameters.
@@ -117,6 +136,12 @@ func (c *Config) Validate() (err error) {
 	return err
 }
 
+// IsDashboard returns true if the action maintains a dependency dashboard issue.
+// Dependency dashboards are updated once all pipelines are executed, instead of per pipeline.
+func (c Config) IsDashboard() bool {
+	return strings.HasSuffix(c.Kind, dashboardKindSuffix)
+}
+
 // New returns a new Action based on an action config and an scm
 func New(config *Config, sourceControlManager *scm.Scm) (Action, error) {
 	newAction := Action{
@@ -174,6 +199,33 @@ func (a *Action) generateActionHandler() error {
 
 		a.Handler = &g
 
+	case "azuredevops/pullrequest":
+		actionSpec := azuredevops.Spec{}
+
+		if a.Scm.Config.Kind != azureDevOpsIdentifier {
+			return fmt.Errorf("scm of kind %q is not compatible with action of kind %q",
+				a.Scm.Config.Kind,
+				a.Config.Kind)
+		}
+
+		err := mapstructure.Decode(a.Config.Spec, &actionSpec)
+		if err != nil {
+			return err
+		}
+
+		ae, ok := a.Scm.Handler.(*azuredevopsscm.AzureDevOps)
+
+		if !ok {
+			return fmt.Errorf("scm is not of kind 'azuredevops'")
+		}
+
+		g, err := azuredevops.New(actionSpec, ae)
+		if err != nil {
+			return err
+		}
+
+		a.Handler = &g
+
 	case "gitea/pullrequest", giteaIdentifier:
 		actionSpec := gitea.Spec{}
 
@@ -282,6 +334,73 @@ func (a *Action) generateActionHandler() error {
 
 		a.Handler = &g
 
+	case "github/dashboard":
+		actionSpec := github.DashboardSpec{}
+
+		if a.Scm.Config.Kind != githubIdentifier {
+			return fmt.Errorf("scm of kind %q is not compatible with action of kind %q",
+				a.Scm.Config.Kind,
+				a.Config.Kind)
+		}
+
+		err := mapstructure.Decode(a.Config.Spec, &actionSpec)
+		if err != nil {
+			return err
+		}
+
+		gh, ok := a.Scm.Handler.(*github.Github)
+
+		if !ok {
+			return fmt.Errorf("scm is not of kind 'github'")
+		}
+
+		g, err := github.NewDashboard(actionSpec, gh)
+		if err != nil {
+			return err
+		}
+
+		a.Dashboard = &g
+
+	case "gitea/dashboard":
+		if a.Scm.Config.Kind != giteaIdentifier {
+			return fmt.Errorf("scm of kind %q is not compatible with action of kind %q",
+				a.Scm.Config.Kind,
+				a.Config.Kind)
+		}
+
+		ge, ok := a.Scm.Handler.(*giteascm.Gitea)
+
+		if !ok {
+			return fmt.Errorf("scm is not of kind 'gitea'")
+		}
+
+		g, err := giteadashboard.New(a.Config.Spec, ge)
+		if err != nil {
+			return err
+		}
+
+		a.Dashboard = &g
+
+	case "gitlab/dashboard":
+		if a.Scm.Config.Kind != gitlabIdentifier {
+			return fmt.Errorf("scm of kind %q is not compatible with action of kind %q",
+				a.Scm.Config.Kind,
+				a.Config.Kind)
+		}
+
+		ge, ok := a.Scm.Handler.(*gitlabscm.Gitlab)
+
+		if !ok {
+			return fmt.Errorf("scm is not of kind 'gitlab'")
+		}
+
+		g, err := gitlabdashboard.New(a.Config.Spec, ge)
+		if err != nil {
+			return err
+		}
+
+		a.Dashboard = &g
+
 	default:
 		logrus.Errorf("action of kind %q is not supported", a.Config.Kind)
 	}
@@ -294,11 +413,15 @@ func (Config) JSONSchema() *jschema.Schema {
 	type configAlias Config
 
 	anyOfSpec := map[string]interface{}{
-		"github/pullrequest":    &github.ActionSpec{},
-		"gitea/pullrequest":     &gitea.Spec{},
-		"stash/pullrequest":     &stash.Spec{},
-		"gitlab/mergerequest":   &gitlab.Spec{},
-		"bitbucket/pullrequest": &bitbucket.Spec{},
+		"github/pullrequest":      &github.ActionSpec{},
+		"gitea/pullrequest":       &gitea.Spec{},
+		"stash/pullrequest":       &stash.Spec{},
+		"gitlab/mergerequest":     &gitlab.Spec{},
+		"bitbucket/pullrequest":   &bitbucket.Spec{},
+		"azuredevops/pullrequest": &azuredevops.Spec{},
+		"github/dashboard":        &github.DashboardSpec{},
+		"gitea/dashboard":         &giteadashboard.Spec{},
+		"gitlab/dashboard":        &gitlabdashboard.Spec{},
 	}
 
 	return jsonschema.AppendOneOfToJsonSchema(configAlias{}, anyOfSpec)
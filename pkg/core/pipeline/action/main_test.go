@@ -91,3 +91,11 @@ func Test_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestIsDashboard(t *testing.T) {
+	assert.True(t, Config{Kind: "github/dashboard"}.IsDashboard())
+	assert.True(t, Config{Kind: "gitea/dashboard"}.IsDashboard())
+	assert.True(t, Config{Kind: "gitlab/dashboard"}.IsDashboard())
+	assert.False(t, Config{Kind: "github/pullrequest"}.IsDashboard())
+	assert.False(t, Config{Kind: "gitlab/mergerequest"}.IsDashboard())
+}
@@ -6,7 +6,6 @@ import (
 	"slices"
 	"strings"
 
-	"github.com/sirupsen/logrus"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/action"
 	"github.com/updatecli/updatecli/pkg/core/reports"
 	"github.com/updatecli/updatecli/pkg/core/result"
@@ -21,13 +20,13 @@ var (
 func (p *Pipeline) RunActions() error {
 
 	if len(p.Actions) == 0 {
-		logrus.Debugf("No action found for pipeline %q", p.Name)
+		p.logger().Debugf("No action found for pipeline %q", p.Name)
 		return nil
 	}
 
 	// Early return
 	if len(p.Targets) == 0 {
-		logrus.Debugf("No target found for pipeline %q, skipping depends on action", p.Name)
+		p.logger().Debugf("No target found for pipeline %q, skipping depends on action", p.Name)
 		return nil
 	}
 
@@ -36,9 +35,14 @@ func (p *Pipeline) RunActions() error {
 
 	for id := range p.Actions {
 
+		// Dependency dashboards are updated by the engine once every pipeline is executed
+		if p.Config.Spec.Actions[id].IsDashboard() {
+			continue
+		}
+
 		// Update pipeline before each action run
 		if err := p.Update(); err != nil {
-			logrus.Errorf("Pipeline %q failed to update: %s", p.Name, err.Error())
+			p.logger().Errorf("Pipeline %q failed to update: %s", p.Name, err.Error())
 			continue
 		}
 
@@ -57,20 +61,20 @@ func (p *Pipeline) RunActions() error {
 		showActionTitle := func() {
 			updateActionTitle()
 			if firstPipelineAction {
-				logrus.Infof("\n%s", p.Name)
+				p.logger().Infof("\n%s", p.Name)
 			}
-			logrus.Infof("  => %s\n\n", action.Title)
+			p.logger().Infof("  => %s\n\n", action.Title)
 			firstPipelineAction = false
 		}
 
 		relatedTargets, err := p.searchAssociatedTargetsID(id)
 		if err != nil {
-			logrus.Errorf("searching associated targets ID: %s", err.Error())
+			p.logger().Errorf("searching associated targets ID: %s", err.Error())
 			continue
 		}
 
 		if len(relatedTargets) == 0 {
-			logrus.Infof("No target found for action %q", id)
+			p.logger().Infof("No target found for action %q", id)
 			continue
 		}
 
@@ -100,12 +104,12 @@ func (p *Pipeline) RunActions() error {
 
 		// Ignoring failed targets
 		if len(failedTargetIDs) > 0 {
-			logrus.Errorf("%d target(s) (%s) failed for action %q", len(failedTargetIDs), strings.Join(failedTargetIDs, ","), id)
+			p.logger().Errorf("%d target(s) (%s) failed for action %q", len(failedTargetIDs), strings.Join(failedTargetIDs, ","), id)
 		}
 
 		// Ignoring skipped targets
 		if len(skippedTargetIDs) > 0 {
-			logrus.Debugf("%d target(s) (%s) skipped for action %q", len(skippedTargetIDs), strings.Join(skippedTargetIDs, ","), id)
+			p.logger().Debugf("%d target(s) (%s) skipped for action %q", len(skippedTargetIDs), strings.Join(skippedTargetIDs, ","), id)
 		}
 
 		// If no target require attention while processing action in a attention state,
@@ -121,12 +125,12 @@ func (p *Pipeline) RunActions() error {
 
 					err = action.Handler.CheckActionExist(&action.Report)
 					if err != nil {
-						logrus.Errorf("Action %q failed: %s", id, err.Error())
+						p.logger().Errorf("Action %q failed: %s", id, err.Error())
 					}
 
 					CheckedPipelines = append(CheckedPipelines, alreadyCheckedAction)
 					if action.Report.Link == "" {
-						logrus.Infof("No follow up action needed")
+						p.logger().Infof("No follow up action needed")
 					}
 
 					p.Report.Actions[id] = &action.Report
@@ -141,9 +145,11 @@ func (p *Pipeline) RunActions() error {
 		// It it's the case then we set the action to have a branch reset
 		// and remove the previous action description as it may be outdated.
 		isBranchReset := false
+		rebaseStrategy := ""
 		for _, t := range relatedTargets {
 			if p.Targets[t].Result.Scm.BranchReset {
 				isBranchReset = true
+				rebaseStrategy = p.Targets[t].Result.Scm.RebaseStrategy
 				break
 			}
 		}
@@ -185,23 +191,23 @@ func (p *Pipeline) RunActions() error {
 			action.Report.UpdatePipelineURL()
 		}
 		if isBranchReset {
-			logrus.Warningf("Git branch reset detected, the action must reset previous action description")
+			p.logger().Warningf("Git branch reset detected using the rebase strategy %q, the action must reset previous action description", rebaseStrategy)
 		}
 
 		if p.Options.Target.DryRun || !p.Options.Target.Push {
 			if len(attentionTargetIDs) > 0 {
-				logrus.Infof("[Dry Run] An action of kind %q is expected.", action.Config.Kind)
+				p.logger().Infof("[Dry Run] An action of kind %q is expected.", action.Config.Kind)
 
 				actionDebugOutput := fmt.Sprintf("The expected action would have the following information:\n\n##Title:\n%s\n##Report:\n\n%s\n\n=====\n",
 					action.Title,
 					action.Report.String())
-				logrus.Debugf("%s", strings.ReplaceAll(actionDebugOutput, "\n", "\n\t|\t"))
+				p.logger().Debugf("%s", strings.ReplaceAll(actionDebugOutput, "\n", "\n\t|\t"))
 			}
 
 			actionOutput := fmt.Sprintf("The expected action would have the following information:\n\n##Title:\n%s\n\n\n##Report:\n\n%s\n\n=====\n",
 				action.Title,
 				action.Report.String())
-			logrus.Debugf("%s", strings.ReplaceAll(actionOutput, "\n", "\n\t|\t"))
+			p.logger().Debugf("%s", strings.ReplaceAll(actionOutput, "\n", "\n\t|\t"))
 
 			action.Report.Description = actionOutput
 
@@ -234,8 +240,9 @@ func (p *Pipeline) RunCleanActions() error {
 	for _, action := range p.Actions {
 		if !p.Options.Target.DryRun {
 			if action.Handler != nil {
-				// At least we try to clean existing pullrequest
-				err := action.Handler.CleanAction(&action.Report)
+				// At least we try to clean existing pullrequest.
+				// Obsolete pull requests are only closed when changes are published
+				err := action.Handler.CleanAction(&action.Report, p.Options.Target.Push)
 				if err != nil {
 					errs = append(errs, err.Error())
 				}
@@ -244,9 +251,9 @@ func (p *Pipeline) RunCleanActions() error {
 	}
 
 	if len(errs) > 0 {
-		logrus.Errorf("Failed to clean up action(s): %s", strings.Join(errs, ", "))
+		p.logger().Errorf("Failed to clean up action(s): %s", strings.Join(errs, ", "))
 		for i := range errs {
-			logrus.Errorf("  - %s", errs[i])
+			p.logger().Errorf("  - %s", errs[i])
 		}
 		return fmt.Errorf("failed to clean up action(s):\n\t%s", strings.Join(errs, "\n\t* "))
 	}
@@ -313,7 +320,7 @@ func (p *Pipeline) detectActionTitle(action *action.Action) {
 	}
 
 	if len(action.Report.Targets) == 0 {
-		logrus.Debugf("We don't know how to name the action")
+		p.logger().Debugf("We don't know how to name the action")
 		return
 	}
 
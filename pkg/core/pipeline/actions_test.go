@@ -0,0 +1,72 @@
+package pipeline
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/action"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/target"
+	"github.com/updatecli/updatecli/pkg/core/reports"
+)
+
+// fakeActionHandler records the CleanAction calls
+type fakeActionHandler struct {
+	cleaned       bool
+	closeObsolete bool
+}
+
+func (f *fakeActionHandler) CreateAction(report *reports.Action, resetDescription bool) error {
+	return nil
+}
+
+func (f *fakeActionHandler) CleanAction(report *reports.Action, closeObsolete bool) error {
+	f.cleaned = true
+	f.closeObsolete = closeObsolete
+	return nil
+}
+
+func (f *fakeActionHandler) CheckActionExist(report *reports.Action) error {
+	return nil
+}
+
+func TestRunCleanActions(t *testing.T) {
+	testdata := []struct {
+		name                  string
+		options               target.Options
+		expectedCleaned       bool
+		expectedCloseObsolete bool
+	}{
+		{
+			name:                  "Push enabled",
+			options:               target.Options{Commit: true, Push: true},
+			expectedCleaned:       true,
+			expectedCloseObsolete: true,
+		},
+		{
+			name:            "Push disabled",
+			options:         target.Options{Commit: true, Push: false},
+			expectedCleaned: true,
+		},
+		{
+			name:    "Dry run",
+			options: target.Options{DryRun: true, Push: true},
+		},
+	}
+
+	for _, tt := range testdata {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := &fakeActionHandler{}
+
+			p := Pipeline{
+				Targets: map[string]target.Target{"default": {}},
+				Actions: map[string]action.Action{"default": {Handler: handler}},
+				Options: Options{Target: tt.options},
+			}
+
+			require.NoError(t, p.RunCleanActions())
+			assert.Equal(t, tt.expectedCleaned, handler.cleaned)
+			assert.Equal(t, tt.expectedCloseObsolete, handler.closeObsolete)
+		})
+	}
+}
@@ -8,13 +8,18 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/argocd"
+	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/bundler"
 	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/cargo"
+	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/ciconfig"
+	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/composer"
 	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/dockercompose"
 	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/dockerfile"
+	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/dotnet"
 	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/fleet"
 	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/flux"
 	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/githubaction"
 	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/golang"
+	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/gradle"
 	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/helm"
 	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/helmfile"
 	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/ko"
@@ -23,6 +28,7 @@ import (
 	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/nomad"
 	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/npm"
 	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/precommit"
+	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/python"
 	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/terraform"
 	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/terragrunt"
 	"github.com/updatecli/updatecli/pkg/plugins/autodiscovery/updatecli"
@@ -109,12 +115,33 @@ var crawlerMap = map[string]struct {
 		},
 		spec: argocd.Spec{},
 	},
+	"bitbucket/pipelines": {
+		newFunc: func(spec any, rootDir string, scmID string, actionID string) (Crawler, error) {
+			return ciconfig.New(spec, rootDir, scmID, actionID, ciconfig.FlavorBitbucketPipelines)
+		},
+		spec:  ciconfig.Spec{},
+		alias: []string{"bitbucket"},
+	},
+	"bundler": {
+		newFunc: func(spec any, rootDir string, scmID string, actionID string) (Crawler, error) {
+			return bundler.New(spec, rootDir, scmID, actionID)
+		},
+		spec:  bundler.Spec{},
+		alias: []string{"ruby", "rubygems"},
+	},
 	"cargo": {
 		newFunc: func(spec any, rootDir string, scmID string, actionID string) (Crawler, error) {
 			return cargo.New(spec, rootDir, scmID, actionID)
 		},
 		spec: cargo.Spec{},
 	},
+	"composer": {
+		newFunc: func(spec any, rootDir string, scmID string, actionID string) (Crawler, error) {
+			return composer.New(spec, rootDir, scmID, actionID)
+		},
+		spec:  composer.Spec{},
+		alias: []string{"php"},
+	},
 	"dockercompose": {
 		newFunc: func(spec any, rootDir string, scmID string, actionID string) (Crawler, error) {
 			return dockercompose.New(spec, rootDir, scmID, actionID)
@@ -127,6 +154,13 @@ var crawlerMap = map[string]struct {
 		},
 		spec: dockerfile.Spec{},
 	},
+	"dotnet": {
+		newFunc: func(spec any, rootDir string, scmID string, actionID string) (Crawler, error) {
+			return dotnet.New(spec, rootDir, scmID, actionID)
+		},
+		spec:  dotnet.Spec{},
+		alias: []string{"nuget"},
+	},
 	"flux": {
 		newFunc: func(spec any, rootDir string, scmID string, actionID string) (Crawler, error) {
 			return flux.New(spec, rootDir, scmID, actionID)
@@ -151,6 +185,13 @@ var crawlerMap = map[string]struct {
 		// then we will add it to the default autodiscovery.
 		ignoreDefault: true,
 	},
+	"gitlab/ci": {
+		newFunc: func(spec any, rootDir string, scmID string, actionID string) (Crawler, error) {
+			return ciconfig.New(spec, rootDir, scmID, actionID, ciconfig.FlavorGitLabCI)
+		},
+		spec:  ciconfig.Spec{},
+		alias: []string{"gitlab", "gitlabci"},
+	},
 	"golang": {
 		newFunc: func(spec any, rootDir string, scmID string, actionID string) (Crawler, error) {
 			return golang.New(spec, rootDir, scmID, actionID)
@@ -158,6 +199,12 @@ var crawlerMap = map[string]struct {
 		spec:  golang.Spec{},
 		alias: []string{"go", "golang/gomod"},
 	},
+	"gradle": {
+		newFunc: func(spec any, rootDir string, scmID string, actionID string) (Crawler, error) {
+			return gradle.New(spec, rootDir, scmID, actionID)
+		},
+		spec: gradle.Spec{},
+	},
 	"helm": {
 		newFunc: func(spec any, rootDir string, scmID string, actionID string) (Crawler, error) {
 			return helm.New(spec, rootDir, scmID, actionID)
@@ -207,6 +254,13 @@ var crawlerMap = map[string]struct {
 		},
 		spec: precommit.Spec{},
 	},
+	"python": {
+		newFunc: func(spec any, rootDir string, scmID string, actionID string) (Crawler, error) {
+			return python.New(spec, rootDir, scmID, actionID)
+		},
+		spec:  python.Spec{},
+		alias: []string{"pip", "poetry", "uv"},
+	},
 	"prow": {
 		newFunc: func(spec any, rootDir string, scmID string, actionID string) (Crawler, error) {
 			return kubernetes.New(spec, rootDir, scmID, actionID, kubernetes.FlavorProw)
@@ -237,6 +291,12 @@ var crawlerMap = map[string]struct {
 		},
 		spec: updatecli.Spec{},
 	},
+	"woodpecker": {
+		newFunc: func(spec any, rootDir string, scmID string, actionID string) (Crawler, error) {
+			return ciconfig.New(spec, rootDir, scmID, actionID, ciconfig.FlavorWoodpecker)
+		},
+		spec: ciconfig.Spec{},
+	},
 }
 
 // New returns an initiated autodiscovery object
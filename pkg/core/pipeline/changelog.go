@@ -0,0 +1,58 @@
+package pipeline
+
+import (
+	"os"
+
+	"github.com/updatecli/updatecli/pkg/core/pipeline/source"
+	"github.com/updatecli/updatecli/pkg/core/result"
+	"github.com/updatecli/updatecli/pkg/plugins/changelog/markdown"
+)
+
+// searchMarkdownChangelog retrieves the release notes between two versions
+// from the markdown changelog defined by a source.
+// Any error means an empty changelog
+func (p *Pipeline) searchMarkdownChangelog(s source.Source, from, to string) *result.Changelogs {
+	spec := s.Config.Changelog
+
+	workingDir, err := os.Getwd()
+	if err != nil {
+		p.logger().Debugf("ignored error, retrieving working directory: %s", err)
+	}
+
+	scmID := spec.SCMID
+	if scmID == "" && spec.File != "" {
+		scmID = s.Config.SCMID
+	}
+
+	if spec.File != "" && scmID != "" {
+		sc, ok := p.SCMs[scmID]
+		if !ok {
+			p.logger().Debugf("scm %q not found, skipping changelog", scmID)
+			return nil
+		}
+
+		if err := sc.Handler.Checkout(); err != nil {
+			p.logger().Debugf("ignored error, checking out scm %q: %s", scmID, err)
+			return nil
+		}
+		workingDir = sc.Handler.GetDirectory()
+	}
+
+	c, err := markdown.New(spec, workingDir)
+	if err != nil {
+		p.logger().Debugf("ignored error, loading changelog: %s", err)
+		return nil
+	}
+
+	changelogs, err := c.Search(from, to)
+	if err != nil {
+		p.logger().Debugf("ignored error, searching changelog: %s", err)
+		return nil
+	}
+
+	if len(changelogs) == 0 {
+		return nil
+	}
+
+	return &changelogs
+}
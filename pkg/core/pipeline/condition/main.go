@@ -3,13 +3,12 @@ package condition
 import (
 	"bytes"
 	"errors"
-	"io"
-	"os"
 	"strings"
 
 	jschema "github.com/invopop/jsonschema"
 	"github.com/sirupsen/logrus"
 	"github.com/updatecli/updatecli/pkg/core/jsonschema"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/resource"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 	"github.com/updatecli/updatecli/pkg/core/result"
@@ -29,6 +28,8 @@ type Condition struct {
 	Config Config
 	// Scm stores scm information
 	Scm *scm.ScmHandler
+	// Logger receives the condition logs, the standard logger is used when nil
+	Logger *logrus.Logger
 }
 
 // Config defines conditions input parameters
@@ -47,16 +48,15 @@ type Config struct {
 // Run tests if a specific condition is true
 func (c *Condition) Run(source string) (err error) {
 
+	logger := log.OrStandard(c.Logger)
+
 	var consoleOutput bytes.Buffer
-	// By default logrus logs to stderr, so I guess we want to keep this behavior...
-	logrus.SetOutput(io.MultiWriter(os.Stdout, &consoleOutput))
 	/*
 		The last defer will be executed first,
 		so in this case we want to first save the console output
-		before setting back the logrus output to stdout.
+		before removing the console output from the log destinations.
 	*/
-	// By default logrus logs to stdout and we want to keep this behavior.
-	defer logrus.SetOutput(os.Stdout)
+	defer log.Tee(logger, &consoleOutput)()
 	defer c.Result.SetConsoleOutput(&consoleOutput)
 
 	c.Result.Result = result.FAILURE
@@ -106,7 +106,7 @@ func (c *Condition) Run(source string) (err error) {
 	// FailWhen is used to reverse the expected condition value
 	// If failwhen is set to true, then we expected a condition returning "true" would be considered as a failure
 	if c.Config.FailWhen {
-		logrus.Debugf("Expected successful condition result to be %v", !c.Config.FailWhen)
+		logger.Debugf("Expected successful condition result to be %v", !c.Config.FailWhen)
 		if c.Result.Pass {
 			c.Result.Result = result.FAILURE
 			c.Result.Pass = false
@@ -116,7 +116,7 @@ func (c *Condition) Run(source string) (err error) {
 		}
 	}
 
-	logrus.Infof("%s %s", c.Result.Result, c.Result.Description)
+	logger.Infof("%s %s", c.Result.Result, c.Result.Description)
 
 	return nil
 }
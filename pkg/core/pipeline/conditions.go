@@ -10,6 +10,7 @@ func (p *Pipeline) RunCondition(id string) (r string, err error) {
 	condition := p.Conditions[id]
 	condition.Config = p.Config.Spec.Conditions[id]
 	condition.Result.Name = condition.Config.Name
+	condition.Logger = p.Logger
 	err = condition.Run(p.Sources[condition.Config.SourceID].Output)
 
 	p.Conditions[id] = condition
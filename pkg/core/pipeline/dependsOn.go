@@ -4,8 +4,8 @@ import (
 	"strings"
 )
 
-// parseDependsOnValue split the input string into category, key and booleanOperator
-func parseDependsOnValue(val string) (key, booleanOperator, category string) {
+// ParseDependsOnValue splits a "dependson" value into key, booleanOperator and category
+func ParseDependsOnValue(val string) (key, booleanOperator, category string) {
 	if val == "" {
 		return "", "", ""
 	}
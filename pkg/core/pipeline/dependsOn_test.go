@@ -65,7 +65,7 @@ func TestParseDependsOn(t *testing.T) {
 
 	for _, tt := range testdata {
 		t.Run(tt.dependsOn, func(t *testing.T) {
-			gotKey, gotBooleanOperator, _ := parseDependsOnValue(tt.dependsOn)
+			gotKey, gotBooleanOperator, _ := ParseDependsOnValue(tt.dependsOn)
 
 			require.Equal(t, tt.expectedKey, gotKey)
 			require.Equal(t, tt.expectedBooleanOperator, gotBooleanOperator)
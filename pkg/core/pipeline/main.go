@@ -8,6 +8,7 @@ import (
 	"github.com/heimdalr/dag"
 	"github.com/sirupsen/logrus"
 	"github.com/updatecli/updatecli/pkg/core/config"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/action"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/condition"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/resource"
@@ -40,6 +41,8 @@ type Pipeline struct {
 	Options Options
 	// Config contains the pipeline configuration defined by the user
 	Config *config.Config
+	// Logger receives the pipeline logs, the standard logger is used when nil
+	Logger *logrus.Logger
 	mu     sync.Mutex
 }
 
@@ -200,6 +203,7 @@ func (p *Pipeline) Init(config *config.Config, options Options) error {
 func (p *Pipeline) runFlowCallback(d *dag.DAG, id string, depsResults []dag.FlowResult) (v interface{}, err error) {
 	p.mu.Lock()         // Acquire lock at the start
 	defer p.mu.Unlock() // Release lock when function exits
+
 	if id == rootVertex {
 		return nil, nil
 	}
@@ -215,8 +219,8 @@ func (p *Pipeline) runFlowCallback(d *dag.DAG, id string, depsResults []dag.Flow
 	if !ok {
 		return nil, fmt.Errorf("failed to reconstruct leaf from interface: %s", id) // Should never happens
 	}
-	logrus.Infof("\n%s: %s\n", leaf.Category, id)
-	logrus.Infof("%s\n", strings.Repeat("-", len(id)))
+	p.logger().Infof("\n%s: %s\n", leaf.Category, id)
+	p.logger().Infof("%s\n", strings.Repeat("-", len(id)))
 
 	depsSourceIDs := []string{}
 	deps := map[string]*Node{}
@@ -243,7 +247,7 @@ func (p *Pipeline) runFlowCallback(d *dag.DAG, id string, depsResults []dag.Flow
 		source.Result.Config, err = resource.GetReportConfig(p.Config.Spec.Sources[id].ResourceConfig)
 
 		if err != nil {
-			logrus.Errorf("error while cleaning config: %v", err)
+			p.logger().Errorf("error while cleaning config: %v", err)
 		}
 
 		p.Report.Sources[id] = &source.Result
@@ -262,7 +266,7 @@ func (p *Pipeline) runFlowCallback(d *dag.DAG, id string, depsResults []dag.Flow
 		condition.Result.Name = p.Config.Spec.Conditions[id].Name
 		condition.Result.Config, err = resource.GetReportConfig(p.Config.Spec.Conditions[id].ResourceConfig)
 		if err != nil {
-			logrus.Errorf("error while cleaning config: %v", err)
+			p.logger().Errorf("error while cleaning config: %v", err)
 		}
 
 		p.Report.Conditions[id] = &condition.Result
@@ -281,7 +285,7 @@ func (p *Pipeline) runFlowCallback(d *dag.DAG, id string, depsResults []dag.Flow
 		target.Result.Config, err = resource.GetReportConfig(p.Config.Spec.Targets[id].ResourceConfig)
 		target.Result.DryRun = target.DryRun
 		if err != nil {
-			logrus.Errorf("error while cleaning config: %v", err)
+			p.logger().Errorf("error while cleaning config: %v", err)
 		}
 
 		p.Report.Targets[id] = &target.Result
@@ -289,7 +293,7 @@ func (p *Pipeline) runFlowCallback(d *dag.DAG, id string, depsResults []dag.Flow
 
 	shouldSkip := p.shouldSkipResource(&leaf, deps)
 	if shouldSkip {
-		logrus.Debugf("Skipping %s[%q] because of dependsOn conditions", leaf.Category, id)
+		p.logger().Debugf("Skipping %s[%q] because of dependsOn conditions", leaf.Category, id)
 		leaf.Result = result.SKIPPED
 
 		switch leaf.Category {
@@ -333,6 +337,31 @@ func (p *Pipeline) runFlowCallback(d *dag.DAG, id string, depsResults []dag.Flow
 			p.updateCondition(conditionId, leaf.Result)
 		case targetCategory:
 			targetId := strings.ReplaceAll(id, "target#", "")
+
+			reason, e := p.checkPolicy(targetId, depsSourceIDs)
+			if e != nil {
+				err = fmt.Errorf("checking pipeline policy for target %q: %w", targetId, e)
+				p.Report.Result = result.FAILURE
+				p.updateTarget(targetId, result.FAILURE)
+				updateTargetResult(targetId)
+
+				leaf.Result = result.FAILURE
+				break
+			}
+
+			if reason != "" {
+				p.logger().Infof("%s Skipping target %q: %s", result.SKIPPED, targetId, reason)
+				target := p.Targets[targetId]
+				target.Result.Result = result.SKIPPED
+				target.Result.Description = reason
+				p.Targets[targetId] = target
+
+				updateTargetResult(targetId)
+
+				leaf.Result = result.SKIPPED
+				break
+			}
+
 			r, changed, e := p.RunTarget(targetId, depsSourceIDs)
 			if e != nil {
 				err = e
@@ -354,9 +383,9 @@ func (p *Pipeline) runFlowCallback(d *dag.DAG, id string, depsResults []dag.Flow
 // Run execute an single pipeline
 func (p *Pipeline) Run() error {
 
-	logrus.Infof("\n\n%s\n", strings.Repeat("#", len(p.Name)+4))
-	logrus.Infof("# %s #\n", strings.ToTitle(p.Name))
-	logrus.Infof("%s\n", strings.Repeat("#", len(p.Name)+4))
+	p.logger().Infof("\n\n%s\n", strings.Repeat("#", len(p.Name)+4))
+	p.logger().Infof("# %s #\n", strings.ToTitle(p.Name))
+	p.logger().Infof("%s\n", strings.Repeat("#", len(p.Name)+4))
 
 	p.Report.Result = result.SUCCESS
 
@@ -378,9 +407,9 @@ func (p *Pipeline) Run() error {
 			continue
 		}
 		if leaf.Error != nil {
-			logrus.Infof("\n")
-			logrus.Errorf("something went wrong in %q : %s", leaf.ID, leaf.Error)
-			logrus.Infof("\n")
+			p.logger().Infof("\n")
+			p.logger().Errorf("something went wrong in %q : %s", leaf.ID, leaf.Error)
+			p.logger().Infof("\n")
 			hasError = true
 		}
 	}
@@ -423,6 +452,11 @@ func (p *Pipeline) Run() error {
 
 }
 
+// logger returns the logger receiving the pipeline logs
+func (p *Pipeline) logger() *logrus.Logger {
+	return log.OrStandard(p.Logger)
+}
+
 func (p *Pipeline) String() string {
 
 	result := fmt.Sprintf("%q: %q\n", "Name", p.Name)
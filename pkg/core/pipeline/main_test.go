@@ -49,6 +49,16 @@ func TestRun(t *testing.T) {
 				"3": "-",
 			},
 			expectedPipelineResult: "✔",
+		}, {
+			confPath: "../../../e2e/updatecli.d/success.d/policy.yaml",
+			expectedSourcesResult: map[string]string{
+				"1": "✔",
+			},
+			expectedConditionsResult: map[string]string{},
+			expectedTargetsResult: map[string]string{
+				"1": "-",
+			},
+			expectedPipelineResult: "-",
 		},
 	}
 
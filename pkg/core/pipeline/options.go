@@ -1,11 +1,13 @@
 package pipeline
 
 import (
+	"github.com/updatecli/updatecli/pkg/core/pipeline/source"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/target"
 )
 
 // Options hold target parameters
 
 type Options struct {
+	Source source.Options
 	Target target.Options
 }
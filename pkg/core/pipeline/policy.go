@@ -0,0 +1,67 @@
+package pipeline
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/updatecli/updatecli/pkg/core/pipeline/resource"
+)
+
+// timeNow returns the current time, it's overridden in tests
+var timeNow = time.Now
+
+// checkPolicy returns a non empty reason if the target must be skipped
+// because it doesn't respect the pipeline policy.
+func (p *Pipeline) checkPolicy(targetID string, sourceIDs []string) (string, error) {
+	policy := p.Config.Spec.Policy
+	if policy.IsZero() {
+		return "", nil
+	}
+
+	now := timeNow()
+
+	inSchedule, err := policy.InSchedule(now)
+	if err != nil {
+		return "", err
+	}
+
+	if !inSchedule {
+		return fmt.Sprintf("outside of the pipeline schedule %s", policy.ScheduleDescription()), nil
+	}
+
+	if policy.MinimumReleaseAge == "" {
+		return "", nil
+	}
+
+	sourceID := p.Targets[targetID].Config.SourceID
+	if sourceID == "" && len(sourceIDs) == 1 {
+		sourceID = sourceIDs[0]
+	}
+
+	source, ok := p.Sources[sourceID]
+	if !ok || source.OriginalOutput == "" {
+		p.logger().Debugf("no source version found for target %q, skipping minimum release age check", targetID)
+		return "", nil
+	}
+
+	r, err := resource.New(source.Config.ResourceConfig)
+	if err != nil {
+		return "", err
+	}
+
+	dater, ok := r.(resource.PublishDater)
+	if !ok {
+		p.logger().Debugf("source %q of kind %q can't retrieve publication dates, skipping minimum release age check",
+			sourceID, source.Config.Kind)
+		return "", nil
+	}
+
+	published, err := dater.PublishDate(source.OriginalOutput)
+	if err != nil {
+		p.logger().Warningf("unable to retrieve the publication date of %q, skipping minimum release age check: %s",
+			source.OriginalOutput, err)
+		return "", nil
+	}
+
+	return policy.CheckReleaseAge(source.OriginalOutput, published, now)
+}
@@ -0,0 +1,198 @@
+package policy
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// SCHEDULEWEEKENDS allows updates on Saturday and Sunday
+	SCHEDULEWEEKENDS string = "weekends"
+	// SCHEDULEWEEKDAYS allows updates from Monday to Friday
+	SCHEDULEWEEKDAYS string = "weekdays"
+)
+
+var (
+	// ErrWrongConfig is returned when a policy has invalid parameters
+	ErrWrongConfig = errors.New("wrong policy configuration")
+
+	// releaseAgeRegex matches durations expressed in days or weeks such as "3d" or "2w"
+	releaseAgeRegex = regexp.MustCompile(`^([0-9]+)\s*([dw])$`)
+)
+
+// Config defines rules restricting when, and to which versions, a pipeline is allowed to update its targets.
+type Config struct {
+	/*
+		"minimumreleaseage" defines how long a version must have been published before targets are updated to it.
+		Targets are skipped if the version returned by their source was published more recently.
+
+		accepted values:
+			* a number of days such as "3d"
+			* a number of weeks such as "1w"
+			* a Go duration such as "36h"
+
+		remark:
+			* the publication date is only available for some source kinds
+			  such as githubrelease, dockerimage, npm, pypi, and maven.
+			  Targets relying on other sources are not affected.
+	*/
+	MinimumReleaseAge string `yaml:",omitempty"`
+	/*
+		"schedule" defines the time windows during which targets can be updated.
+		Outside of those windows, targets are skipped.
+		The pipeline is within the schedule if any of the entries matches.
+
+		accepted values:
+			* "weekends"
+			* "weekdays"
+			* a cron expression with five fields, "minute hour day-of-month month day-of-week",
+			  matched against the current time such as "* 0-5 * * 1" for Monday before 6am.
+
+		default:
+			targets can be updated at any time
+	*/
+	Schedule []string `yaml:",omitempty"`
+	/*
+		"timezone" defines the IANA time zone used to evaluate the schedule.
+
+		default:
+			UTC
+
+		example:
+			* "Europe/Brussels"
+	*/
+	Timezone string `yaml:",omitempty"`
+}
+
+// IsZero returns true if no policy is defined
+func (c Config) IsZero() bool {
+	return c.MinimumReleaseAge == "" && len(c.Schedule) == 0 && c.Timezone == ""
+}
+
+// Validate returns an error if the policy contains invalid parameters
+func (c Config) Validate() error {
+	var errs []string
+
+	if _, err := c.ReleaseAge(); err != nil {
+		errs = append(errs, err.Error())
+	}
+
+	if _, err := c.location(); err != nil {
+		errs = append(errs, err.Error())
+	}
+
+	for _, s := range c.Schedule {
+		if _, err := parseSchedule(s); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%w: %s", ErrWrongConfig, strings.Join(errs, ", "))
+	}
+
+	return nil
+}
+
+// ReleaseAge returns the minimum release age, or 0 if not defined
+func (c Config) ReleaseAge() (time.Duration, error) {
+	if c.MinimumReleaseAge == "" {
+		return 0, nil
+	}
+
+	s := strings.TrimSpace(c.MinimumReleaseAge)
+
+	if m := releaseAgeRegex.FindStringSubmatch(s); m != nil {
+		n, err := strconv.Atoi(m[1])
+		if err != nil {
+			return 0, fmt.Errorf("invalid minimumreleaseage %q: %w", c.MinimumReleaseAge, err)
+		}
+
+		day := 24 * time.Hour
+		if m[2] == "w" {
+			return time.Duration(n) * 7 * day, nil
+		}
+		return time.Duration(n) * day, nil
+	}
+
+	d, err := time.ParseDuration(s)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("invalid minimumreleaseage %q, expected a duration such as \"3d\", \"1w\", or \"36h\"", c.MinimumReleaseAge)
+	}
+
+	return d, nil
+}
+
+// InSchedule returns true if targets can be updated at the given time
+func (c Config) InSchedule(now time.Time) (bool, error) {
+	if len(c.Schedule) == 0 {
+		return true, nil
+	}
+
+	location, err := c.location()
+	if err != nil {
+		return false, err
+	}
+
+	now = now.In(location)
+
+	for _, s := range c.Schedule {
+		schedule, err := parseSchedule(s)
+		if err != nil {
+			return false, err
+		}
+
+		if schedule.match(now) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// CheckReleaseAge returns a non empty reason if a version published at the given date
+// is too recent to be used at the time now.
+func (c Config) CheckReleaseAge(version string, published, now time.Time) (string, error) {
+	minimum, err := c.ReleaseAge()
+	if err != nil || minimum == 0 {
+		return "", err
+	}
+
+	age := now.Sub(published)
+	if age >= minimum {
+		return "", nil
+	}
+
+	return fmt.Sprintf("version %q was published on %s, less than the minimum release age of %s",
+		version,
+		published.UTC().Format(time.RFC3339),
+		c.MinimumReleaseAge,
+	), nil
+}
+
+// ScheduleDescription returns a human readable description of the schedule
+func (c Config) ScheduleDescription() string {
+	description := strings.Join(c.Schedule, ", ")
+	if c.Timezone != "" {
+		description = fmt.Sprintf("%s (%s)", description, c.Timezone)
+	}
+	return description
+}
+
+// location returns the time zone used to evaluate the schedule
+func (c Config) location() (*time.Location, error) {
+	if c.Timezone == "" {
+		return time.UTC, nil
+	}
+
+	location, err := time.LoadLocation(c.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone %q: %w", c.Timezone, err)
+	}
+
+	return location, nil
+}
@@ -0,0 +1,187 @@
+package policy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  Config
+		wantErr bool
+	}{
+		{
+			name: "Empty policy",
+		},
+		{
+			name: "Valid policy",
+			config: Config{
+				MinimumReleaseAge: "3d",
+				Schedule:          []string{"weekends", "* 0-5 * * mon-fri"},
+				Timezone:          "Europe/Brussels",
+			},
+		},
+		{
+			name:    "Wrong minimum release age",
+			config:  Config{MinimumReleaseAge: "three days"},
+			wantErr: true,
+		},
+		{
+			name:    "Wrong schedule",
+			config:  Config{Schedule: []string{"sometimes"}},
+			wantErr: true,
+		},
+		{
+			name:    "Wrong cron value",
+			config:  Config{Schedule: []string{"* 24 * * *"}},
+			wantErr: true,
+		},
+		{
+			name:    "Wrong timezone",
+			config:  Config{Timezone: "Mars/Olympus_Mons"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrWrongConfig)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestReleaseAge(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected time.Duration
+		wantErr  bool
+	}{
+		{value: "", expected: 0},
+		{value: "3d", expected: 72 * time.Hour},
+		{value: "2w", expected: 14 * 24 * time.Hour},
+		{value: "36h", expected: 36 * time.Hour},
+		{value: "-1h", wantErr: true},
+		{value: "3 days", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := Config{MinimumReleaseAge: tt.value}.ReleaseAge()
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestInSchedule(t *testing.T) {
+	// Saturday 2024-10-05 10:30 UTC
+	saturday := time.Date(2024, time.October, 5, 10, 30, 0, 0, time.UTC)
+	// Monday 2024-10-07 04:15 UTC
+	monday := time.Date(2024, time.October, 7, 4, 15, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		config   Config
+		now      time.Time
+		expected bool
+	}{
+		{
+			name:     "No schedule",
+			now:      monday,
+			expected: true,
+		},
+		{
+			name:     "Weekends on saturday",
+			config:   Config{Schedule: []string{"weekends"}},
+			now:      saturday,
+			expected: true,
+		},
+		{
+			name:     "Weekends on monday",
+			config:   Config{Schedule: []string{"weekends"}},
+			now:      monday,
+			expected: false,
+		},
+		{
+			name:     "Weekdays on monday",
+			config:   Config{Schedule: []string{"WEEKDAYS"}},
+			now:      monday,
+			expected: true,
+		},
+		{
+			name:     "Cron early monday",
+			config:   Config{Schedule: []string{"* 0-5 * * 1"}},
+			now:      monday,
+			expected: true,
+		},
+		{
+			name:     "Cron early monday with timezone",
+			config:   Config{Schedule: []string{"* 0-5 * * 1"}, Timezone: "Asia/Tokyo"},
+			now:      monday,
+			expected: false,
+		},
+		{
+			name:     "Cron with steps",
+			config:   Config{Schedule: []string{"*/15 */2 * * *"}},
+			now:      monday,
+			expected: true,
+		},
+		{
+			name:     "Cron with day of month or day of week",
+			config:   Config{Schedule: []string{"* * 1 * sat"}},
+			now:      saturday,
+			expected: true,
+		},
+		{
+			name:     "Any schedule matching",
+			config:   Config{Schedule: []string{"weekends", "* 4 * * mon"}},
+			now:      monday,
+			expected: true,
+		},
+		{
+			name:     "Sunday as 7",
+			config:   Config{Schedule: []string{"* * * * 7"}},
+			now:      saturday.AddDate(0, 0, 1),
+			expected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.config.InSchedule(tt.now)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestCheckReleaseAge(t *testing.T) {
+	now := time.Date(2024, time.October, 7, 12, 0, 0, 0, time.UTC)
+
+	c := Config{MinimumReleaseAge: "3d"}
+
+	reason, err := c.CheckReleaseAge("1.2.0", now.AddDate(0, 0, -4), now)
+	require.NoError(t, err)
+	assert.Empty(t, reason)
+
+	reason, err = c.CheckReleaseAge("1.3.0", now.AddDate(0, 0, -1), now)
+	require.NoError(t, err)
+	assert.Equal(t, `version "1.3.0" was published on 2024-10-06T12:00:00Z, less than the minimum release age of 3d`, reason)
+
+	reason, err = Config{}.CheckReleaseAge("1.3.0", now, now)
+	require.NoError(t, err)
+	assert.Empty(t, reason)
+}
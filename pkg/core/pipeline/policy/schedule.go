@@ -0,0 +1,174 @@
+package policy
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronField describes the accepted values of a cron expression field
+type cronField struct {
+	name  string
+	min   int
+	max   int
+	names map[string]int
+}
+
+var (
+	cronFields = []cronField{
+		{name: "minute", min: 0, max: 59},
+		{name: "hour", min: 0, max: 23},
+		{name: "day-of-month", min: 1, max: 31},
+		{name: "month", min: 1, max: 12, names: map[string]int{
+			"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+			"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+		}},
+		// Both 0 and 7 represent Sunday
+		{name: "day-of-week", min: 0, max: 7, names: map[string]int{
+			"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+		}},
+	}
+
+	// schedulePresets maps schedule presets to their cron expression
+	schedulePresets = map[string]string{
+		SCHEDULEWEEKENDS: "* * * * sat,sun",
+		SCHEDULEWEEKDAYS: "* * * * mon-fri",
+	}
+)
+
+// schedule is a parsed cron expression
+type schedule struct {
+	minutes     map[int]bool
+	hours       map[int]bool
+	daysOfMonth map[int]bool
+	months      map[int]bool
+	daysOfWeek  map[int]bool
+	// anyDayOfMonth and anyDayOfWeek are true when the related field is "*"
+	anyDayOfMonth bool
+	anyDayOfWeek  bool
+}
+
+// parseSchedule parses a schedule preset or a five fields cron expression
+func parseSchedule(s string) (schedule, error) {
+	expression := strings.ToLower(strings.TrimSpace(s))
+	if preset, ok := schedulePresets[expression]; ok {
+		expression = preset
+	}
+
+	fields := strings.Fields(expression)
+	if len(fields) != len(cronFields) {
+		return schedule{}, fmt.Errorf("invalid schedule %q, expected %q, %q, or a cron expression with %d fields",
+			s, SCHEDULEWEEKENDS, SCHEDULEWEEKDAYS, len(cronFields))
+	}
+
+	values := make([]map[int]bool, len(fields))
+	for i, field := range fields {
+		v, err := cronFields[i].parse(field)
+		if err != nil {
+			return schedule{}, fmt.Errorf("invalid schedule %q: %w", s, err)
+		}
+		values[i] = v
+	}
+
+	// Sunday can be written 0 or 7
+	if values[4][7] {
+		values[4][0] = true
+	}
+
+	return schedule{
+		minutes:       values[0],
+		hours:         values[1],
+		daysOfMonth:   values[2],
+		months:        values[3],
+		daysOfWeek:    values[4],
+		anyDayOfMonth: fields[2] == "*",
+		anyDayOfWeek:  fields[4] == "*",
+	}, nil
+}
+
+// match returns true if t is part of the schedule.
+// As with cron, if both the day of month and the day of week are restricted,
+// matching either of them is enough.
+func (s schedule) match(t time.Time) bool {
+	if !s.minutes[t.Minute()] || !s.hours[t.Hour()] || !s.months[int(t.Month())] {
+		return false
+	}
+
+	dayOfMonth := s.daysOfMonth[t.Day()]
+	dayOfWeek := s.daysOfWeek[int(t.Weekday())]
+
+	switch {
+	case s.anyDayOfMonth && s.anyDayOfWeek:
+		return true
+	case s.anyDayOfMonth:
+		return dayOfWeek
+	case s.anyDayOfWeek:
+		return dayOfMonth
+	}
+
+	return dayOfMonth || dayOfWeek
+}
+
+// parse returns the set of values matched by a cron field such as "*", "1-5", "*/15", or "mon,wed"
+func (f cronField) parse(field string) (map[int]bool, error) {
+	values := map[int]bool{}
+
+	for _, part := range strings.Split(field, ",") {
+		step := 1
+		if r, s, found := strings.Cut(part, "/"); found {
+			n, err := strconv.Atoi(s)
+			if err != nil || n <= 0 {
+				return nil, fmt.Errorf("wrong step %q in %s field %q", s, f.name, field)
+			}
+			step = n
+			part = r
+		}
+
+		start, end := f.min, f.max
+		if part != "*" {
+			low, high, isRange := strings.Cut(part, "-")
+
+			var err error
+			start, err = f.value(low)
+			if err != nil {
+				return nil, err
+			}
+
+			end = start
+			if isRange {
+				end, err = f.value(high)
+				if err != nil {
+					return nil, err
+				}
+			} else if step > 1 {
+				// "5/10" means every 10 starting from 5
+				end = f.max
+			}
+
+			if start > end {
+				return nil, fmt.Errorf("wrong range %q in %s field", part, f.name)
+			}
+		}
+
+		for i := start; i <= end; i += step {
+			values[i] = true
+		}
+	}
+
+	return values, nil
+}
+
+// value parses a single cron field value, either a number or a name such as "mon"
+func (f cronField) value(s string) (int, error) {
+	if n, ok := f.names[s]; ok {
+		return n, nil
+	}
+
+	n, err := strconv.Atoi(s)
+	if err != nil || n < f.min || n > f.max {
+		return 0, fmt.Errorf("wrong value %q in %s field, expected a value between %d and %d", s, f.name, f.min, f.max)
+	}
+
+	return n, nil
+}
@@ -3,12 +3,16 @@ package resource
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 	"github.com/updatecli/updatecli/pkg/core/result"
 	"github.com/updatecli/updatecli/pkg/core/transformer"
 	"github.com/updatecli/updatecli/pkg/plugins/resources/awsami"
+	azureDevOpsBranch "github.com/updatecli/updatecli/pkg/plugins/resources/azuredevops/branch"
+	azureDevOpsTag "github.com/updatecli/updatecli/pkg/plugins/resources/azuredevops/tag"
 	"github.com/updatecli/updatecli/pkg/plugins/resources/cargopackage"
+	"github.com/updatecli/updatecli/pkg/plugins/resources/composer"
 	"github.com/updatecli/updatecli/pkg/plugins/resources/csv"
 	"github.com/updatecli/updatecli/pkg/plugins/resources/dockerdigest"
 	"github.com/updatecli/updatecli/pkg/plugins/resources/dockerfile"
@@ -32,6 +36,9 @@ import (
 	"github.com/updatecli/updatecli/pkg/plugins/resources/json"
 	"github.com/updatecli/updatecli/pkg/plugins/resources/maven"
 	"github.com/updatecli/updatecli/pkg/plugins/resources/npm"
+	"github.com/updatecli/updatecli/pkg/plugins/resources/nuget"
+	"github.com/updatecli/updatecli/pkg/plugins/resources/pypi"
+	"github.com/updatecli/updatecli/pkg/plugins/resources/rubygems"
 	"github.com/updatecli/updatecli/pkg/plugins/resources/shell"
 	stashBranch "github.com/updatecli/updatecli/pkg/plugins/resources/stash/branch"
 	stashTag "github.com/updatecli/updatecli/pkg/plugins/resources/stash/tag"
@@ -97,10 +104,22 @@ func New(rs ResourceConfig) (resource Resource, err error) {
 
 		return awsami.New(rs.Spec)
 
+	case "azuredevops/branch":
+
+		return azureDevOpsBranch.New(rs.Spec)
+
+	case "azuredevops/tag":
+
+		return azureDevOpsTag.New(rs.Spec)
+
 	case "cargopackage":
 
 		return cargopackage.New(rs.Spec, rs.SCMID != "")
 
+	case "composer":
+
+		return composer.New(rs.Spec)
+
 	case "csv":
 
 		return csv.New(rs.Spec)
@@ -197,6 +216,18 @@ func New(rs ResourceConfig) (resource Resource, err error) {
 
 		return npm.New(rs.Spec)
 
+	case "nuget":
+
+		return nuget.New(rs.Spec)
+
+	case "pypi":
+
+		return pypi.New(rs.Spec)
+
+	case "rubygems":
+
+		return rubygems.New(rs.Spec)
+
 	case "shell":
 
 		return shell.New(rs.Spec)
@@ -267,11 +298,21 @@ type Resource interface {
 	ReportConfig() interface{}
 }
 
+// PublishDater is an optional interface implemented by resources
+// able to retrieve when a version was published.
+type PublishDater interface {
+	// PublishDate returns the date the given version was published
+	PublishDate(version string) (time.Time, error)
+}
+
 // Need to do reflect of ResourceConfig
 func GetResourceMapping() map[string]interface{} {
 	return map[string]interface{}{
 		"aws/ami":            &awsami.Spec{},
+		"azuredevops/branch": &azureDevOpsBranch.Spec{},
+		"azuredevops/tag":    &azureDevOpsTag.Spec{},
 		"cargopackage":       &cargopackage.Spec{},
+		"composer":           &composer.Spec{},
 		"csv":                &csv.Spec{},
 		"dockerdigest":       &dockerdigest.Spec{},
 		"dockerfile":         &dockerfile.Spec{},
@@ -296,6 +337,9 @@ func GetResourceMapping() map[string]interface{} {
 		"json":               &json.Spec{},
 		"maven":              &maven.Spec{},
 		"npm":                &npm.Spec{},
+		"nuget":              &nuget.Spec{},
+		"pypi":               &pypi.Spec{},
+		"rubygems":           &rubygems.Spec{},
 		"shell":              &shell.Spec{},
 		"stash/branch":       &stashBranch.Spec{},
 		"stash/tag":          &stashTag.Spec{},
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/mitchellh/mapstructure"
+	"github.com/updatecli/updatecli/pkg/plugins/scms/azuredevops"
 	"github.com/updatecli/updatecli/pkg/plugins/scms/bitbucket"
 	"github.com/updatecli/updatecli/pkg/plugins/scms/git"
 	"github.com/updatecli/updatecli/pkg/plugins/scms/gitea"
@@ -37,6 +38,7 @@ type ScmHandler interface {
 	IsRemoteBranchUpToDate() (bool, error)
 	GetBranches() (sourceBranch, workingBranch, targetBranch string)
 	GetURL() string
+	GetRebaseStrategy() string
 }
 
 func New(config *Config, pipelineID string) (Scm, error) {
@@ -61,6 +63,14 @@ func (s *Scm) GenerateSCM() error {
 	}
 
 	switch s.Config.Kind {
+	case "azuredevops":
+		g, err := azuredevops.New(s.Config.Spec, s.PipelineID)
+		if err != nil {
+			return err
+		}
+
+		s.Handler = g
+
 	case "bitbucket":
 		g, err := bitbucket.New(s.Config.Spec, s.PipelineID)
 		if err != nil {
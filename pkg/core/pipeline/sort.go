@@ -53,7 +53,7 @@ func addResourceToDag(dag *dag.DAG, id, Category string, DependsOn []string, Dep
 	// Craft the dendencies
 	var deps []Dependency
 	for _, dependency := range DependsOn {
-		key, booleanOperator, category := parseDependsOnValue(dependency)
+		key, booleanOperator, category := ParseDependsOnValue(dependency)
 		if category == "" {
 			// By default dependencies should be handled inside of one's category
 			category = Category
@@ -0,0 +1,227 @@
+package source
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/resource"
+	"github.com/updatecli/updatecli/pkg/core/tmp"
+)
+
+var (
+	// cacheableKinds lists resource kinds which only depend on remote information,
+	// and can therefore be resolved once per run. Sources reading local files are excluded
+	// as a previous pipeline may have modified them.
+	cacheableKinds = map[string]bool{
+		"aws/ami":            true,
+		"azuredevops/branch": true,
+		"azuredevops/tag":    true,
+		"cargopackage":       true,
+		"dockerdigest":       true,
+		"dockerimage":        true,
+		"gitea/branch":       true,
+		"gitea/release":      true,
+		"gitea/tag":          true,
+		"githubrelease":      true,
+		"gitlab/branch":      true,
+		"gitlab/release":     true,
+		"gitlab/tag":         true,
+		"golang":             true,
+		"golang/module":      true,
+		"helmchart":          true,
+		"jenkins":            true,
+		"maven":              true,
+		"npm":                true,
+		"nuget":              true,
+		"pypi":               true,
+		"stash/branch":       true,
+		"stash/tag":          true,
+		"temurin":            true,
+		"terraform/registry": true,
+	}
+)
+
+// CachedResult contains the information retrieved by a source execution
+// that can be shared with identical sources.
+type CachedResult struct {
+	// Result holds the source result
+	Result string
+	// Information stores the information detected by the source
+	Information string
+	// Description stores the source execution description
+	Description string
+}
+
+// cacheEntry holds a single cache value, its mutex ensures that concurrent
+// lookups for the same key wait for the first one to complete.
+type cacheEntry struct {
+	mu     sync.Mutex
+	found  bool
+	result CachedResult
+}
+
+// diskCacheEntry is the on-disk representation of a cache entry
+type diskCacheEntry struct {
+	CreatedAt time.Time
+	Result    CachedResult
+}
+
+// Cache is a run scoped, content addressed, source result cache.
+// Keys are computed from the resource kind, its report configuration, and its full spec.
+type Cache struct {
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+	// directory, if set, persists cache entries on disk
+	directory string
+	// ttl defines how long on-disk entries remain valid
+	ttl time.Duration
+}
+
+// NewCache returns an in-memory source cache.
+// If ttl is greater than zero, entries are also persisted under the updatecli temporary directory
+// and reused by subsequent runs until they expire.
+func NewCache(ttl time.Duration) *Cache {
+	c := Cache{
+		entries: map[string]*cacheEntry{},
+	}
+
+	if ttl > 0 {
+		c.directory = filepath.Join(tmp.Directory, "cache", "sources")
+		c.ttl = ttl
+	}
+
+	return &c
+}
+
+// CacheKey returns the cache key for a resource configuration,
+// or an empty string if the resource kind can't be cached.
+func CacheKey(rs resource.ResourceConfig) (string, error) {
+	if !cacheableKinds[rs.Kind] {
+		return "", nil
+	}
+
+	r, err := resource.New(rs)
+	if err != nil {
+		return "", err
+	}
+
+	// The report configuration strips credentials, so the full spec is also part of the key
+	// to prevent sources using different credentials from sharing a result.
+	spec, err := json.Marshal(rs.Spec)
+	if err != nil {
+		return "", fmt.Errorf("normalizing source spec: %w", err)
+	}
+	specSum := sha256.Sum256(spec)
+
+	// json.Marshal sorts map keys which gives us a normalized representation
+	data, err := json.Marshal(struct {
+		Kind   string
+		Config any
+		Spec   string
+	}{
+		Kind:   rs.Kind,
+		Config: r.ReportConfig(),
+		Spec:   hex.EncodeToString(specSum[:]),
+	})
+	if err != nil {
+		return "", fmt.Errorf("normalizing source configuration: %w", err)
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Get returns the cached result for key, or calls resolve to retrieve it.
+// Only successful results are stored.
+func (c *Cache) Get(key string, resolve func() (CachedResult, error)) (CachedResult, bool, error) {
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &cacheEntry{}
+		c.entries[key] = entry
+	}
+	c.mu.Unlock()
+
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	if entry.found {
+		return entry.result, true, nil
+	}
+
+	if r, ok := c.load(key); ok {
+		entry.found = true
+		entry.result = r
+		return r, true, nil
+	}
+
+	r, err := resolve()
+	if err != nil {
+		return r, false, err
+	}
+
+	entry.found = true
+	entry.result = r
+
+	if err := c.save(key, r); err != nil {
+		logrus.Debugf("saving source cache entry: %s", err)
+	}
+
+	return r, false, nil
+}
+
+// load retrieves a non expired entry from disk
+func (c *Cache) load(key string) (CachedResult, bool) {
+	if c.directory == "" {
+		return CachedResult{}, false
+	}
+
+	data, err := os.ReadFile(filepath.Join(c.directory, key+".json"))
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			logrus.Debugf("reading source cache entry: %s", err)
+		}
+		return CachedResult{}, false
+	}
+
+	var d diskCacheEntry
+	if err := json.Unmarshal(data, &d); err != nil {
+		logrus.Debugf("parsing source cache entry: %s", err)
+		return CachedResult{}, false
+	}
+
+	if time.Since(d.CreatedAt) > c.ttl {
+		return CachedResult{}, false
+	}
+
+	return d.Result, true
+}
+
+// save persists an entry on disk
+func (c *Cache) save(key string, r CachedResult) error {
+	if c.directory == "" {
+		return nil
+	}
+
+	if err := os.MkdirAll(c.directory, 0755); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(diskCacheEntry{
+		CreatedAt: time.Now(),
+		Result:    r,
+	})
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(c.directory, key+".json"), data, 0600)
+}
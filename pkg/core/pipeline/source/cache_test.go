@@ -0,0 +1,124 @@
+package source
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/resource"
+)
+
+func TestCacheGet(t *testing.T) {
+	c := NewCache(0)
+
+	calls := 0
+	resolve := func() (CachedResult, error) {
+		calls++
+		return CachedResult{Result: "✔", Information: "1.0.0"}, nil
+	}
+
+	r, cached, err := c.Get("key", resolve)
+	require.NoError(t, err)
+	assert.False(t, cached)
+	assert.Equal(t, "1.0.0", r.Information)
+
+	r, cached, err = c.Get("key", resolve)
+	require.NoError(t, err)
+	assert.True(t, cached)
+	assert.Equal(t, "1.0.0", r.Information)
+	assert.Equal(t, 1, calls)
+}
+
+func TestCacheGetError(t *testing.T) {
+	c := NewCache(0)
+
+	_, _, err := c.Get("key", func() (CachedResult, error) {
+		return CachedResult{}, errors.New("unreachable")
+	})
+	require.Error(t, err)
+
+	// Failures are not cached
+	r, cached, err := c.Get("key", func() (CachedResult, error) {
+		return CachedResult{Information: "1.0.0"}, nil
+	})
+	require.NoError(t, err)
+	assert.False(t, cached)
+	assert.Equal(t, "1.0.0", r.Information)
+}
+
+func TestCacheOnDisk(t *testing.T) {
+	dir := t.TempDir()
+
+	first := NewCache(time.Hour)
+	first.directory = dir
+
+	_, _, err := first.Get("key", func() (CachedResult, error) {
+		return CachedResult{Information: "1.0.0"}, nil
+	})
+	require.NoError(t, err)
+
+	// A new run reuses the on-disk entry
+	second := NewCache(time.Hour)
+	second.directory = dir
+
+	r, cached, err := second.Get("key", func() (CachedResult, error) {
+		return CachedResult{Information: "2.0.0"}, nil
+	})
+	require.NoError(t, err)
+	assert.True(t, cached)
+	assert.Equal(t, "1.0.0", r.Information)
+
+	// Expired entries are ignored
+	expired := NewCache(time.Nanosecond)
+	expired.directory = dir
+	time.Sleep(time.Millisecond)
+
+	r, cached, err = expired.Get("key", func() (CachedResult, error) {
+		return CachedResult{Information: "2.0.0"}, nil
+	})
+	require.NoError(t, err)
+	assert.False(t, cached)
+	assert.Equal(t, "2.0.0", r.Information)
+}
+
+func TestCacheKey(t *testing.T) {
+	newConfig := func(kind string, spec map[string]any) resource.ResourceConfig {
+		return resource.ResourceConfig{Kind: kind, Spec: spec}
+	}
+
+	a, err := CacheKey(newConfig("dockerimage", map[string]any{"image": "nginx", "architecture": "amd64"}))
+	require.NoError(t, err)
+	require.NotEmpty(t, a)
+
+	b, err := CacheKey(newConfig("dockerimage", map[string]any{"architecture": "amd64", "image": "nginx"}))
+	require.NoError(t, err)
+	assert.Equal(t, a, b)
+
+	c, err := CacheKey(newConfig("dockerimage", map[string]any{"image": "alpine", "architecture": "amd64"}))
+	require.NoError(t, err)
+	assert.NotEqual(t, a, c)
+
+	d, err := CacheKey(newConfig("file", map[string]any{"file": "README.md"}))
+	require.NoError(t, err)
+	assert.Empty(t, d)
+}
+
+func TestCacheKeyCredentials(t *testing.T) {
+	newConfig := func(token string) resource.ResourceConfig {
+		return resource.ResourceConfig{Kind: "npm", Spec: map[string]any{"name": "axios", "registrytoken": token}}
+	}
+
+	a, err := CacheKey(newConfig("token-a"))
+	require.NoError(t, err)
+	require.NotEmpty(t, a)
+
+	b, err := CacheKey(newConfig("token-b"))
+	require.NoError(t, err)
+	assert.NotEqual(t, a, b, "sources only differing by their credentials must not share a cache entry")
+
+	c, err := CacheKey(newConfig("token-a"))
+	require.NoError(t, err)
+	assert.Equal(t, a, c)
+}
@@ -3,7 +3,6 @@ package source
 import (
 	"bytes"
 	"errors"
-	"io"
 	"os"
 	"strings"
 
@@ -11,9 +10,11 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/updatecli/updatecli/pkg/core/jsonschema"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/resource"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 	"github.com/updatecli/updatecli/pkg/core/result"
+	"github.com/updatecli/updatecli/pkg/plugins/changelog/markdown"
 )
 
 // Source defines how a value is retrieved from a specific source
@@ -28,11 +29,25 @@ type Source struct {
 	Config Config
 	// Scm stores scm information
 	Scm *scm.ScmHandler
+	// Logger receives the source logs, the standard logger is used when nil
+	Logger *logrus.Logger
 }
 
 // Config struct defines a source configuration
 type Config struct {
 	resource.ResourceConfig `yaml:",inline"`
+	/*
+		"changelog" defines a markdown changelog, following the Keep a Changelog format,
+		used to retrieve the release notes between the current and the new version.
+
+		remark:
+			* when defined, it replaces the changelog retrieved by the source kind, if any.
+
+		example:
+			changelog:
+			  url: https://raw.githubusercontent.com/updatecli/updatecli/main/CHANGELOG.md
+	*/
+	Changelog markdown.Spec `yaml:",omitempty"`
 }
 
 var (
@@ -41,18 +56,17 @@ var (
 )
 
 // Run execute actions defined by the source configuration
-func (s *Source) Run() (err error) {
+func (s *Source) Run(o *Options) (err error) {
+
+	logger := log.OrStandard(s.Logger)
 
 	var consoleOutput bytes.Buffer
-	// By default logrus logs to stderr, so I guess we want to keep this behavior...
-	logrus.SetOutput(io.MultiWriter(os.Stdout, &consoleOutput))
 	/*
 		The last defer will be executed first,
 		so in this case we want to first save the console output
-		before setting back the logrus output to stdout.
+		before removing the console output from the log destinations.
 	*/
-	// By default logrus logs to stdout and we want to keep this behavior.
-	defer logrus.SetOutput(os.Stdout)
+	defer log.Tee(logger, &consoleOutput)()
 	defer s.Result.SetConsoleOutput(&consoleOutput)
 
 	source, err := resource.New(s.Config.ResourceConfig)
@@ -87,35 +101,72 @@ func (s *Source) Run() (err error) {
 		workingDir = SCM.GetDirectory()
 	}
 
-	err = source.Source(workingDir, &s.Result)
+	err = s.resolve(source, workingDir, o)
 
 	s.Output = s.Result.Information
 	s.OriginalOutput = s.Result.Information
 
 	if err != nil {
 		s.Result.Result = result.FAILURE
-		logrus.Errorf("%s %s", s.Result.Result, err)
+		logger.Errorf("%s %s", s.Result.Result, err)
 		return err
 	}
 
-	logrus.Infof("%s %s", s.Result.Result, s.Result.Description)
+	logger.Infof("%s %s", s.Result.Result, s.Result.Description)
 
 	if len(s.Config.Transformers) > 0 {
 		s.Output, err = s.Config.Transformers.Apply(s.Output)
 		if err != nil {
-			logrus.Errorf("%s %s", s.Result.Result, err)
+			logger.Errorf("%s %s", s.Result.Result, err)
 			s.Result.Result = result.FAILURE
 			return err
 		}
 	}
 
 	if len(s.Output) == 0 && s.Result.Result == result.SUCCESS {
-		logrus.Debugln("empty source detected")
+		logger.Debugln("empty source detected")
 	}
 
 	return err
 }
 
+// resolve retrieves the source information, from the cache when possible.
+// Sources relying on an scm are never cached as their result depends on the repository content.
+func (s *Source) resolve(source resource.Resource, workingDir string, o *Options) error {
+	if o == nil || o.Cache == nil || s.Scm != nil {
+		return source.Source(workingDir, &s.Result)
+	}
+
+	key, err := CacheKey(s.Config.ResourceConfig)
+	if err != nil || key == "" {
+		if err != nil {
+			log.OrStandard(s.Logger).Debugf("computing source cache key: %s", err)
+		}
+		return source.Source(workingDir, &s.Result)
+	}
+
+	r, cached, err := o.Cache.Get(key, func() (CachedResult, error) {
+		err := source.Source(workingDir, &s.Result)
+		return CachedResult{
+			Result:      s.Result.Result,
+			Information: s.Result.Information,
+			Description: s.Result.Description,
+		}, err
+	})
+	if err != nil {
+		return err
+	}
+
+	if cached {
+		log.OrStandard(s.Logger).Debugf("source information retrieved from cache")
+		s.Result.Result = r.Result
+		s.Result.Information = r.Information
+		s.Result.Description = r.Description
+	}
+
+	return nil
+}
+
 // JSONSchema implements the json schema interface to generate the "source" jsonschema.
 func (Config) JSONSchema() *jschema.Schema {
 
@@ -175,6 +226,12 @@ func (c *Config) Validate() error {
 		gotError = true
 	}
 
+	err = c.Changelog.Validate()
+	if err != nil {
+		logrus.Errorln(err)
+		gotError = true
+	}
+
 	if len(missingParameters) > 0 {
 		logrus.Errorf("missing value for parameter(s) [%q]", strings.Join(missingParameters, ","))
 		gotError = true
@@ -0,0 +1,7 @@
+package source
+
+// Options hold source parameters
+type Options struct {
+	// Cache shares source results across pipelines, a nil value disables it
+	Cache *Cache
+}
@@ -10,8 +10,9 @@ func (p *Pipeline) RunSource(id string) (r string, err error) {
 	source := p.Sources[id]
 	source.Config = p.Config.Spec.Sources[id]
 	source.Result.Name = source.Config.Name
+	source.Logger = p.Logger
 
-	err = source.Run()
+	err = source.Run(&p.Options.Source)
 
 	p.Sources[id] = source
 
@@ -4,13 +4,12 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
-	"io"
-	"os"
 	"strings"
 
 	jschema "github.com/invopop/jsonschema"
 	"github.com/sirupsen/logrus"
 	"github.com/updatecli/updatecli/pkg/core/jsonschema"
+	"github.com/updatecli/updatecli/pkg/core/log"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/resource"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 	"github.com/updatecli/updatecli/pkg/core/result"
@@ -37,6 +36,8 @@ type Target struct {
 	DryRun bool
 	// Scm stores scm information
 	Scm *scm.ScmHandler
+	// Logger receives the target logs, the standard logger is used when nil
+	Logger *logrus.Logger
 }
 
 // Config defines target parameters
@@ -100,16 +101,15 @@ func (t *Target) Check() (bool, error) {
 
 // Run applies a specific target configuration
 func (t *Target) Run(source string, o *Options) (err error) {
+	logger := log.OrStandard(t.Logger)
+
 	var consoleOutput bytes.Buffer
-	// By default logrus logs to stderr, so I guess we want to keep this behavior...
-	logrus.SetOutput(io.MultiWriter(os.Stdout, &consoleOutput))
 	/*
 		The last defer will be executed first,
 		so in this case we want to first save the console output
-		before setting back the logrus output to stdout.
+		before removing the console output from the log destinations.
 	*/
-	// By default logrus logs to stdout and we want to keep this behavior...
-	defer logrus.SetOutput(os.Stdout)
+	defer log.Tee(logger, &consoleOutput)()
 	defer t.Result.SetConsoleOutput(&consoleOutput)
 
 	failTargetRun := func() {
@@ -126,7 +126,7 @@ func (t *Target) Run(source string, o *Options) (err error) {
 	}
 
 	if o.DryRun {
-		logrus.Infof("\n**Dry Run enabled**\n\n")
+		logger.Infof("\n**Dry Run enabled**\n\n")
 	}
 
 	target, err := resource.New(t.Config.ResourceConfig)
@@ -144,7 +144,7 @@ func (t *Target) Run(source string, o *Options) (err error) {
 		}
 
 		// Could be improve to show attention description in yellow, success in green, failure in red
-		logrus.Infof("%s - %s", t.Result.Result, t.Result.Description)
+		logger.Infof("%s - %s", t.Result.Result, t.Result.Description)
 
 		return nil
 	}
@@ -159,6 +159,7 @@ func (t *Target) Run(source string, o *Options) (err error) {
 
 	t.Result.Scm.URL = s.GetURL()
 	t.Result.Scm.Branch.Source, t.Result.Scm.Branch.Working, t.Result.Scm.Branch.Target = s.GetBranches()
+	t.Result.Scm.RebaseStrategy = s.GetRebaseStrategy()
 
 	if err = s.Checkout(); err != nil {
 		failTargetRun()
@@ -172,7 +173,7 @@ func (t *Target) Run(source string, o *Options) (err error) {
 	}
 
 	// Could be improve to show attention description in yellow, success in green, failure in red
-	logrus.Infof("%s - %s", t.Result.Result, t.Result.Description)
+	logger.Infof("%s - %s", t.Result.Result, t.Result.Description)
 
 	isRemoteBranchUpToDate, err := s.IsRemoteBranchUpToDate()
 	if err != nil {
@@ -187,7 +188,7 @@ func (t *Target) Run(source string, o *Options) (err error) {
 			return nil
 		}
 
-		logrus.Infof("\n\u26A0 While nothing change in the current pipeline run, according to the git history, some commits must be pushed\n")
+		logger.Infof("\n\u26A0 While nothing change in the current pipeline run, according to the git history, some commits must be pushed\n")
 		t.Result.Description = fmt.Sprintf("%s\n\n%s", t.Result.Description, "While nothing change in the current pipeline run, according to the git history, some commits must pushed")
 
 		// Even though the target has no changes, it has something to commit.
@@ -4,7 +4,6 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/sirupsen/logrus"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/resource"
 	"github.com/updatecli/updatecli/pkg/core/result"
 )
@@ -27,6 +26,7 @@ func (p *Pipeline) RunTarget(id string, sourceIds []string) (r string, changed b
 	// Ensure the result named contains the up to date target name after templating
 	target.Result.Name = target.Config.Name
 	target.Result.DryRun = target.DryRun
+	target.Logger = p.Logger
 
 	err = target.Run(p.Sources[target.Config.SourceID].Output, &p.Options.Target)
 	if err != nil {
@@ -44,7 +44,7 @@ func (p *Pipeline) RunTarget(id string, sourceIds []string) (r string, changed b
 		// If we have more than one sourceID then we can't define in a reliable way which one to use
 		// as the order of the sourceIDs is not guaranteed.
 		default:
-			logrus.Debugf("Target depends on a too many sources that we can't determine which one to use for the changelog")
+			p.logger().Debugf("Target depends on a too many sources that we can't determine which one to use for the changelog")
 		}
 	}
 
@@ -52,20 +52,25 @@ func (p *Pipeline) RunTarget(id string, sourceIds []string) (r string, changed b
 		// Once the source is executed, then it can retrieve its changelog
 		// Any error means an empty changelog
 		if source, found := p.Sources[changelogSourceID]; found {
-			c, err := resource.New(source.Config.ResourceConfig)
+			var changelogs *result.Changelogs
 
-			if err == nil {
-
-				changelogs := c.Changelog(target.Result.Information, source.OriginalOutput)
+			switch source.Config.Changelog.IsZero() {
+			case true:
+				c, err := resource.New(source.Config.ResourceConfig)
+				if err == nil {
+					changelogs = c.Changelog(target.Result.Information, source.OriginalOutput)
+				}
+			case false:
+				changelogs = p.searchMarkdownChangelog(source, target.Result.Information, source.OriginalOutput)
+			}
 
-				if changelogs != nil {
-					target.Result.Changelogs = *changelogs
+			if changelogs != nil {
+				target.Result.Changelogs = *changelogs
 
-					logrus.Infof("%s", changelogs.String())
+				p.logger().Infof("%s", changelogs.String())
 
-				} else {
-					logrus.Debugln("no changelog detected")
-				}
+			} else {
+				p.logger().Debugln("no changelog detected")
 			}
 		}
 	}
@@ -2,6 +2,7 @@ package registry
 
 import (
 	"context"
+	"crypto"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -21,7 +22,25 @@ import (
 )
 
 // Pull pulls an OCI image from a registry.
-func Pull(ociName string, disableTLS bool) (manifests []string, values []string, secrets []string, err error) {
+// If publicKeys is defined, the policy must be signed by one of those keys.
+func Pull(ociName string, disableTLS bool, publicKeys []string) (manifests []string, values []string, secrets []string, err error) {
+
+	var keys []crypto.PublicKey
+	if len(publicKeys) > 0 {
+		keys, err = LoadPublicKeys(publicKeys)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("load public keys: %w", err)
+		}
+	}
+
+	if _, tag, _ := SplitPolicyReference(ociName); IsTagConstraint(tag) {
+		resolved, err := ResolvePolicy(ociName, disableTLS)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("resolve policy version: %w", err)
+		}
+		logrus.Infof("Policy %q resolved to %q", ociName, resolved.Reference)
+		ociName = resolved.Reference
+	}
 
 	ref, err := registry.ParseReference(ociName)
 	if err != nil {
@@ -66,6 +85,13 @@ func Pull(ociName string, disableTLS bool) (manifests []string, values []string,
 		return nil, nil, nil, fmt.Errorf("fetch remote content: %w", err)
 	}
 
+	if len(keys) > 0 {
+		if err := verifyManifest(ctx, repo, remoteManifestSpec.Digest, keys); err != nil {
+			return nil, nil, nil, fmt.Errorf("verify policy %q: %w", ociName, err)
+		}
+		logrus.Infof("Policy %q signature verified", ociName)
+	}
+
 	// Create the policy root directory
 	policyRootDir := filepath.Join(getReferencePath(remoteManifestSpec.Digest.String())...)
 
@@ -90,9 +116,9 @@ func Pull(ociName string, disableTLS bool) (manifests []string, values []string,
 	} else {
 		logrus.Infof("Pulling Updatecli policy %q\n", ociName)
 
-		manifestDescriptor, err := oras.Copy(ctx, repo, ref.Reference, fs, ref.Reference, oras.DefaultCopyOptions)
+		manifestDescriptor, err := copyManifest(ctx, repo, fs, remoteManifestSpec, ref.Reference)
 		if err != nil {
-			return nil, nil, nil, fmt.Errorf("copy: %w", err)
+			return nil, nil, nil, err
 		}
 
 		manifestData, err := content.FetchAll(ctx, fs, manifestDescriptor)
@@ -130,6 +156,22 @@ func Pull(ociName string, disableTLS bool) (manifests []string, values []string,
 	return manifests, values, secrets, nil
 }
 
+// copyManifest copies the manifest fetched, and possibly verified, from src to dst, tagged as tag.
+// The manifest is copied by digest, so a tag moved in the meantime can't lead to pulling another, unverified, manifest.
+func copyManifest(ctx context.Context, src oras.ReadOnlyTarget, dst oras.Target, manifest spec.Descriptor, tag string) (spec.Descriptor, error) {
+	manifestDescriptor, err := oras.Copy(ctx, src, manifest.Digest.String(), dst, tag, oras.DefaultCopyOptions)
+	if err != nil {
+		return spec.Descriptor{}, fmt.Errorf("copy: %w", err)
+	}
+
+	if manifestDescriptor.Digest != manifest.Digest {
+		return spec.Descriptor{}, fmt.Errorf("copied manifest digest %q doesn't match the expected digest %q",
+			manifestDescriptor.Digest, manifest.Digest)
+	}
+
+	return manifestDescriptor, nil
+}
+
 // getReferencePath returns the path to the file store for a given reference.
 func getReferencePath(ref string) []string {
 	refPath := []string{
@@ -1,6 +1,9 @@
 package registry
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -9,9 +12,12 @@ import (
 
 	"context"
 
+	spec "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/testcontainers/testcontainers-go"
 	"github.com/testcontainers/testcontainers-go/wait"
 	oras "oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content/file"
+	"oras.land/oras-go/v2/content/memory"
 	"oras.land/oras-go/v2/registry"
 	"oras.land/oras-go/v2/registry/remote"
 	"oras.land/oras-go/v2/registry/remote/auth"
@@ -111,7 +117,8 @@ func TestPushPullPolicy(t *testing.T) {
 				data.toPushPolicyName,
 				data.disableTLS,
 				data.toPushFileStore,
-				data.overwrite)
+				data.overwrite,
+				"")
 			require.NoError(t, err)
 
 			err = Push(
@@ -122,12 +129,14 @@ func TestPushPullPolicy(t *testing.T) {
 				data.toPushPolicyName,
 				data.disableTLS,
 				data.toPushFileStore,
-				data.overwrite)
+				data.overwrite,
+				"")
 			require.NoError(t, err)
 
 			gotManifests, gotValues, gotSecrets, err := Pull(
 				data.toPushPolicyName[0],
 				data.disableTLS,
+				nil,
 			)
 			require.NoError(t, err)
 
@@ -206,3 +215,74 @@ func sanitizeDirPath(policyRef string, disableTLS bool, manifestFiles, valuesFil
 
 	return manifestFiles, valuesFiles, secretsFiles, nil
 }
+
+// resolveTarget overrides the descriptor resolved by a target, whatever the reference
+type resolveTarget struct {
+	oras.ReadOnlyTarget
+	descriptor spec.Descriptor
+}
+
+func (r resolveTarget) Resolve(ctx context.Context, reference string) (spec.Descriptor, error) {
+	return r.descriptor, nil
+}
+
+// TestCopyManifestMovedTag ensures the verified manifest is pulled, even if its tag is moved after the verification
+func TestCopyManifestMovedTag(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	dir := t.TempDir()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	privatePath, publicPath := writeKeyPair(t, dir, "policy", key)
+
+	signer, err := LoadPrivateKey(privatePath)
+	require.NoError(t, err)
+
+	publicKeys, err := LoadPublicKeys([]string{publicPath})
+	require.NoError(t, err)
+
+	pack := func(name string) spec.Descriptor {
+		descriptor, err := oras.PackManifest(ctx, store, oras.PackManifestVersion1_1, "application/vnd.updatecli.policy", oras.PackManifestOptions{
+			ManifestAnnotations: map[string]string{spec.AnnotationTitle: name},
+		})
+		require.NoError(t, err)
+		// Digest references are resolved by remote repositories
+		require.NoError(t, store.Tag(ctx, descriptor, descriptor.Digest.String()))
+		return descriptor
+	}
+
+	signedManifest := pack("signed")
+	unsignedManifest := pack("unsigned")
+
+	require.NoError(t, store.Tag(ctx, signedManifest, "1.0.0"))
+	require.NoError(t, signManifest(ctx, store, "ghcr.io/updatecli/policies/test", signedManifest.Digest, signer))
+
+	verified, err := store.Resolve(ctx, "1.0.0")
+	require.NoError(t, err)
+	require.NoError(t, verifyManifest(ctx, store, verified.Digest, publicKeys))
+
+	// The tag is moved to an unsigned manifest after the verification
+	require.NoError(t, store.Tag(ctx, unsignedManifest, "1.0.0"))
+
+	fs, err := file.New(t.TempDir())
+	require.NoError(t, err)
+	defer fs.Close()
+
+	got, err := copyManifest(ctx, store, fs, verified, "1.0.0")
+	require.NoError(t, err)
+	require.Equal(t, signedManifest.Digest, got.Digest)
+
+	pulled, err := fs.Resolve(ctx, "1.0.0")
+	require.NoError(t, err)
+	require.Equal(t, signedManifest.Digest, pulled.Digest)
+
+	// A source returning another manifest than the verified one must be rejected
+	fs, err = file.New(t.TempDir())
+	require.NoError(t, err)
+	defer fs.Close()
+
+	_, err = copyManifest(ctx, resolveTarget{ReadOnlyTarget: store, descriptor: unsignedManifest}, fs, verified, "1.0.0")
+	require.Error(t, err)
+}
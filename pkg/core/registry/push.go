@@ -2,6 +2,7 @@ package registry
 
 import (
 	"context"
+	"crypto"
 	"errors"
 	"fmt"
 	"os"
@@ -20,7 +21,8 @@ import (
 )
 
 // Push pushes updatecli manifest(s) as an OCI image to an OCI registry.
-func Push(policyMetadataFile string, manifests []string, values []string, secrets []string, policyReferenceNames []string, disableTLS bool, fileStore string, overwrite bool) error {
+// If signKey is defined, the pushed policy is signed using that private key.
+func Push(policyMetadataFile string, manifests []string, values []string, secrets []string, policyReferenceNames []string, disableTLS bool, fileStore string, overwrite bool, signKey string) error {
 	var err error
 
 	policySpec, err := LoadPolicyFile(policyMetadataFile)
@@ -28,6 +30,14 @@ func Push(policyMetadataFile string, manifests []string, values []string, secret
 		return fmt.Errorf("load policy file: %w", err)
 	}
 
+	var signer crypto.Signer
+	if signKey != "" {
+		signer, err = LoadPrivateKey(signKey)
+		if err != nil {
+			return fmt.Errorf("load signing key: %w", err)
+		}
+	}
+
 	logrus.Infof("Pushing Updatecli policy:\n\t=> %s\n\n", strings.Join(policyReferenceNames, "\n\t=> "))
 
 	if fileStore == "" {
@@ -149,6 +159,14 @@ func Push(policyMetadataFile string, manifests []string, values []string, secret
 		if err != nil {
 			return fmt.Errorf("upload artifact to %s: %w", repo.Reference.Reference, err)
 		}
+
+		// 4. Sign the pushed policy
+		if signer != nil {
+			if err = signManifest(ctx, repo, refName.Context().Name(), manifestDescriptor.Digest, signer); err != nil {
+				return fmt.Errorf("sign policy %s: %w", policyReferenceNames[i], err)
+			}
+			logrus.Infof("policy %s signed", policyReferenceNames[i])
+		}
 	}
 
 	return nil
@@ -0,0 +1,128 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	oras "oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/registry/remote"
+	"oras.land/oras-go/v2/registry/remote/auth"
+)
+
+// ResolvedPolicy contains the policy version selected for a policy reference
+type ResolvedPolicy struct {
+	// Reference contains the policy name and the resolved tag such as "ghcr.io/org/policies/go:0.3.2"
+	Reference string
+	// Digest contains the policy manifest digest
+	Digest string
+}
+
+// PinnedReference returns the policy reference pinned to its digest
+func (r ResolvedPolicy) PinnedReference() string {
+	name, _, _ := SplitPolicyReference(r.Reference)
+	return name + "@" + r.Digest
+}
+
+// SplitPolicyReference splits a policy reference into its name, tag, and digest.
+// Unlike registry.ParseReference, the tag can be a version constraint such as "~0.3".
+func SplitPolicyReference(reference string) (name, tag, digest string) {
+	name = reference
+
+	if i := strings.Index(name, "@"); i >= 0 {
+		digest = name[i+1:]
+		name = name[:i]
+	}
+
+	// The tag separator is the last colon after the last slash,
+	// so registry ports such as "localhost:5000" are not considered as a tag
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		tag = name[i+1:]
+		name = name[:i]
+	}
+
+	return name, tag, digest
+}
+
+// IsTagConstraint returns true if the tag is a semantic version constraint such as "~0.3", "^1", or ">=1.0, <2.0"
+// rather than an OCI tag.
+func IsTagConstraint(tag string) bool {
+	if strings.ContainsAny(tag, "~^<>=*, |!") {
+		return true
+	}
+
+	// Wildcards such as "1.x" or "1.2.X"
+	for _, segment := range strings.Split(tag, ".") {
+		if segment == "x" || segment == "X" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ResolvePolicy returns the policy version matching a policy reference.
+// The reference tag can be an exact tag, "latest", or a semantic version constraint,
+// in which case the highest matching semver tag is selected.
+func ResolvePolicy(reference string, disableTLS bool) (ResolvedPolicy, error) {
+	name, tag, digest := SplitPolicyReference(reference)
+
+	var err error
+
+	switch {
+	case digest != "":
+		// The reference is already pinned
+	case tag == "" || tag == ociLatestTag:
+		tag, err = getLatestTagSortedBySemver(name, disableTLS)
+		if err != nil {
+			return ResolvedPolicy{}, fmt.Errorf("get latest tag sorted by semver: %w", err)
+		}
+	case IsTagConstraint(tag):
+		tag, err = getLatestTagMatchingConstraint(name, tag, disableTLS)
+		if err != nil {
+			return ResolvedPolicy{}, fmt.Errorf("get latest tag matching constraint: %w", err)
+		}
+	}
+
+	resolved := ResolvedPolicy{
+		Reference: name,
+		Digest:    digest,
+	}
+
+	if tag != "" {
+		resolved.Reference = name + ":" + tag
+	}
+
+	if resolved.Digest != "" {
+		return resolved, nil
+	}
+
+	repo, err := remote.NewRepository(name)
+	if err != nil {
+		return ResolvedPolicy{}, fmt.Errorf("new repository: %w", err)
+	}
+
+	if disableTLS {
+		logrus.Debugln("TLS connection is disabled")
+		repo.PlainHTTP = true
+	}
+
+	if err := getCredentialsFromDockerStore(repo); err != nil {
+		return ResolvedPolicy{}, fmt.Errorf("credstore from docker: %w", err)
+	}
+
+	ctx := auth.AppendRepositoryScope(context.Background(), repo.Reference, auth.ActionPull)
+
+	descriptor, err := oras.Resolve(ctx, repo, tag, oras.DefaultResolveOptions)
+	if err != nil {
+		return ResolvedPolicy{}, fmt.Errorf("resolve %q: %w", resolved.Reference, err)
+	}
+
+	resolved.Digest = descriptor.Digest.String()
+
+	logrus.Debugf("policy %q resolved to %q (%s)", reference, resolved.Reference, resolved.Digest)
+
+	return resolved, nil
+}
@@ -0,0 +1,155 @@
+package registry
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitPolicyReference(t *testing.T) {
+	tests := []struct {
+		name           string
+		reference      string
+		expectedName   string
+		expectedTag    string
+		expectedDigest string
+	}{
+		{
+			name:         "No tag",
+			reference:    "ghcr.io/updatecli/policies/golang",
+			expectedName: "ghcr.io/updatecli/policies/golang",
+		},
+		{
+			name:         "Tag",
+			reference:    "ghcr.io/updatecli/policies/golang:0.3.0",
+			expectedName: "ghcr.io/updatecli/policies/golang",
+			expectedTag:  "0.3.0",
+		},
+		{
+			name:         "Constraint",
+			reference:    "ghcr.io/updatecli/policies/golang:~0.3",
+			expectedName: "ghcr.io/updatecli/policies/golang",
+			expectedTag:  "~0.3",
+		},
+		{
+			name:         "Registry port without tag",
+			reference:    "localhost:5000/policies/golang",
+			expectedName: "localhost:5000/policies/golang",
+		},
+		{
+			name:         "Registry port with constraint",
+			reference:    "localhost:5000/policies/golang:>=1.0, <2.0",
+			expectedName: "localhost:5000/policies/golang",
+			expectedTag:  ">=1.0, <2.0",
+		},
+		{
+			name:           "Tag and digest",
+			reference:      "ghcr.io/updatecli/policies/golang:0.3.0@sha256:0123",
+			expectedName:   "ghcr.io/updatecli/policies/golang",
+			expectedTag:    "0.3.0",
+			expectedDigest: "sha256:0123",
+		},
+		{
+			name:           "Digest",
+			reference:      "ghcr.io/updatecli/policies/golang@sha256:0123",
+			expectedName:   "ghcr.io/updatecli/policies/golang",
+			expectedDigest: "sha256:0123",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, tag, digest := SplitPolicyReference(tt.reference)
+			assert.Equal(t, tt.expectedName, name)
+			assert.Equal(t, tt.expectedTag, tag)
+			assert.Equal(t, tt.expectedDigest, digest)
+		})
+	}
+}
+
+func TestIsTagConstraint(t *testing.T) {
+	tests := []struct {
+		tag      string
+		expected bool
+	}{
+		{tag: "", expected: false},
+		{tag: "latest", expected: false},
+		{tag: "0.3.0", expected: false},
+		{tag: "v1.2.3-rc.1", expected: false},
+		{tag: "~0.3", expected: true},
+		{tag: "^1", expected: true},
+		{tag: ">=1.0, <2.0", expected: true},
+		{tag: "1.x", expected: true},
+		{tag: "*", expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			assert.Equal(t, tt.expected, IsTagConstraint(tt.tag))
+		})
+	}
+}
+
+func TestLatestSemverTag(t *testing.T) {
+	tags := []string{"latest", "0.2.0", "0.3.0", "0.3.4", "0.4.0", "1.0.0-rc.1", "v0.3.2"}
+
+	tests := []struct {
+		name        string
+		constraint  string
+		expected    string
+		expectedErr bool
+	}{
+		{
+			// Without constraint, pre-releases are considered as well
+			name:     "No constraint",
+			expected: "1.0.0-rc.1",
+		},
+		{
+			name:       "Tilde constraint",
+			constraint: "~0.3",
+			expected:   "0.3.4",
+		},
+		{
+			name:       "Range constraint",
+			constraint: ">=0.2, <0.3",
+			expected:   "0.2.0",
+		},
+		{
+			name:       "Prerelease constraint",
+			constraint: ">=1.0.0-0",
+			expected:   "1.0.0-rc.1",
+		},
+		{
+			name:        "No matching tag",
+			constraint:  "^2",
+			expectedErr: true,
+		},
+		{
+			name:        "Invalid constraint",
+			constraint:  "~~0.3",
+			expectedErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := latestSemverTag(tags, tt.constraint)
+			if tt.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestResolvedPolicyPinnedReference(t *testing.T) {
+	r := ResolvedPolicy{
+		Reference: "localhost:5000/policies/golang:0.3.4",
+		Digest:    "sha256:0123",
+	}
+
+	assert.Equal(t, "localhost:5000/policies/golang@sha256:0123", r.PinnedReference())
+}
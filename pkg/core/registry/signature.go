@@ -0,0 +1,268 @@
+package registry
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/opencontainers/go-digest"
+	v1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/sirupsen/logrus"
+	oras "oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/content/memory"
+	"oras.land/oras-go/v2/errdef"
+)
+
+const (
+	// signatureMediaType is the media type of the layer holding the signed payload, as used by cosign
+	signatureMediaType string = "application/vnd.dev.cosign.simplesigning.v1+json"
+	// signatureAnnotation is the layer annotation holding the base64 encoded signature, as used by cosign
+	signatureAnnotation string = "dev.cosignproject.cosign/signature"
+	// signatureConfigMediaType is the config media type of the signature manifest
+	signatureConfigMediaType string = "application/vnd.oci.image.config.v1+json"
+	// signatureType is the payload type of a container image signature
+	signatureType string = "cosign container image signature"
+)
+
+var (
+	// ErrPolicyNotSigned is returned when no signature can be found for a policy
+	ErrPolicyNotSigned = errors.New("policy is not signed")
+	// ErrPolicySignatureInvalid is returned when no policy signature can be verified with the provided public keys
+	ErrPolicySignatureInvalid = errors.New("policy signature is invalid")
+)
+
+// signaturePayload is the signed payload, following the cosign "simple signing" format
+// https://github.com/sigstore/cosign/blob/main/specs/SIGNATURE_SPEC.md
+type signaturePayload struct {
+	Critical struct {
+		Identity struct {
+			DockerReference string `json:"docker-reference"`
+		} `json:"identity"`
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+		Type string `json:"type"`
+	} `json:"critical"`
+	Optional map[string]string `json:"optional"`
+}
+
+// signatureTag returns the tag where the signature of a manifest digest is stored, following the cosign convention
+func signatureTag(manifestDigest digest.Digest) string {
+	return fmt.Sprintf("%s-%s.sig", manifestDigest.Algorithm(), manifestDigest.Encoded())
+}
+
+// LoadPrivateKey loads a PEM encoded ECDSA, RSA, or Ed25519 private key
+func LoadPrivateKey(filename string) (crypto.Signer, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("reading private key: %w", err)
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data found in %q", filename)
+	}
+
+	var key any
+
+	switch block.Type {
+	case "PRIVATE KEY":
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "ENCRYPTED SIGSTORE PRIVATE KEY", "ENCRYPTED COSIGN PRIVATE KEY", "ENCRYPTED PRIVATE KEY":
+		return nil, fmt.Errorf("encrypted private key %q is not supported, please provide an unencrypted PEM private key", filename)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block %q in %q", block.Type, filename)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("parsing private key %q: %w", filename, err)
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key type %T in %q", key, filename)
+	}
+
+	return signer, nil
+}
+
+// LoadPublicKeys loads PEM encoded ECDSA, RSA, or Ed25519 public keys, such as cosign.pub
+func LoadPublicKeys(filenames []string) ([]crypto.PublicKey, error) {
+	var keys []crypto.PublicKey
+
+	for _, filename := range filenames {
+		data, err := os.ReadFile(filename)
+		if err != nil {
+			return nil, fmt.Errorf("reading public key: %w", err)
+		}
+
+		block, _ := pem.Decode(data)
+		if block == nil || block.Type != "PUBLIC KEY" {
+			return nil, fmt.Errorf("no PEM public key found in %q", filename)
+		}
+
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("parsing public key %q: %w", filename, err)
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+// signPayload signs the payload using the private key
+func signPayload(signer crypto.Signer, payload []byte) ([]byte, error) {
+	switch signer.(type) {
+	case ed25519.PrivateKey:
+		return signer.Sign(rand.Reader, payload, crypto.Hash(0))
+	case *ecdsa.PrivateKey, *rsa.PrivateKey:
+		hash := sha256.Sum256(payload)
+		return signer.Sign(rand.Reader, hash[:], crypto.SHA256)
+	}
+
+	return nil, fmt.Errorf("unsupported private key type %T", signer)
+}
+
+// verifyPayload returns true if the signature of the payload matches the public key
+func verifyPayload(publicKey crypto.PublicKey, payload, signature []byte) bool {
+	switch key := publicKey.(type) {
+	case ed25519.PublicKey:
+		return ed25519.Verify(key, payload, signature)
+	case *ecdsa.PublicKey:
+		hash := sha256.Sum256(payload)
+		return ecdsa.VerifyASN1(key, hash[:], signature)
+	case *rsa.PublicKey:
+		hash := sha256.Sum256(payload)
+		return rsa.VerifyPKCS1v15(key, crypto.SHA256, hash[:], signature) == nil
+	}
+
+	logrus.Debugf("unsupported public key type %T", publicKey)
+	return false
+}
+
+// signManifest pushes to target a signature of the manifest digest, following the cosign tag based layout
+func signManifest(ctx context.Context, target oras.Target, repository string, manifestDigest digest.Digest, signer crypto.Signer) error {
+	p := signaturePayload{}
+	p.Critical.Identity.DockerReference = repository
+	p.Critical.Image.DockerManifestDigest = manifestDigest.String()
+	p.Critical.Type = signatureType
+
+	payload, err := json.Marshal(p)
+	if err != nil {
+		return fmt.Errorf("marshal signature payload: %w", err)
+	}
+
+	signature, err := signPayload(signer, payload)
+	if err != nil {
+		return fmt.Errorf("sign payload: %w", err)
+	}
+
+	store := memory.New()
+
+	layer := content.NewDescriptorFromBytes(signatureMediaType, payload)
+	layer.Annotations = map[string]string{
+		signatureAnnotation: base64.StdEncoding.EncodeToString(signature),
+	}
+
+	if err := store.Push(ctx, layer, bytes.NewReader(payload)); err != nil {
+		return fmt.Errorf("store signature payload: %w", err)
+	}
+
+	signatureDescriptor, err := oras.PackManifest(ctx, store, oras.PackManifestVersion1_0, signatureConfigMediaType, oras.PackManifestOptions{
+		Layers: []v1.Descriptor{layer},
+	})
+	if err != nil {
+		return fmt.Errorf("pack signature manifest: %w", err)
+	}
+
+	tag := signatureTag(manifestDigest)
+
+	if err := store.Tag(ctx, signatureDescriptor, tag); err != nil {
+		return fmt.Errorf("tag signature manifest: %w", err)
+	}
+
+	if _, err := oras.Copy(ctx, store, tag, target, tag, oras.DefaultCopyOptions); err != nil {
+		return fmt.Errorf("upload signature: %w", err)
+	}
+
+	return nil
+}
+
+// verifyManifest ensures that the manifest digest was signed by one of the public keys
+func verifyManifest(ctx context.Context, target oras.ReadOnlyTarget, manifestDigest digest.Digest, publicKeys []crypto.PublicKey) error {
+	tag := signatureTag(manifestDigest)
+
+	signatureDescriptor, signatureReader, err := oras.Fetch(ctx, target, tag, oras.DefaultFetchOptions)
+	if err != nil {
+		if errors.Is(err, errdef.ErrNotFound) {
+			return fmt.Errorf("%w: no signature found for digest %q", ErrPolicyNotSigned, manifestDigest)
+		}
+		return fmt.Errorf("fetch signature: %w", err)
+	}
+
+	signatureData, err := content.ReadAll(signatureReader, signatureDescriptor)
+	if err != nil {
+		return fmt.Errorf("read signature manifest: %w", err)
+	}
+
+	manifest := v1.Manifest{}
+	if err := json.Unmarshal(signatureData, &manifest); err != nil {
+		return fmt.Errorf("unmarshal signature manifest: %w", err)
+	}
+
+	for _, layer := range manifest.Layers {
+		if layer.MediaType != signatureMediaType {
+			continue
+		}
+
+		signature, err := base64.StdEncoding.DecodeString(layer.Annotations[signatureAnnotation])
+		if err != nil || len(signature) == 0 {
+			logrus.Debugf("ignoring signature layer %q without valid signature annotation", layer.Digest)
+			continue
+		}
+
+		// content.FetchAll verifies that the payload matches the layer digest
+		payload, err := content.FetchAll(ctx, target, layer)
+		if err != nil {
+			return fmt.Errorf("fetch signature payload: %w", err)
+		}
+
+		p := signaturePayload{}
+		if err := json.Unmarshal(payload, &p); err != nil {
+			logrus.Debugf("ignoring signature layer %q with invalid payload: %s", layer.Digest, err)
+			continue
+		}
+
+		if p.Critical.Image.DockerManifestDigest != manifestDigest.String() {
+			logrus.Debugf("ignoring signature layer %q signing a different digest %q", layer.Digest, p.Critical.Image.DockerManifestDigest)
+			continue
+		}
+
+		for _, key := range publicKeys {
+			if verifyPayload(key, payload, signature) {
+				return nil
+			}
+		}
+	}
+
+	return fmt.Errorf("%w: no signature for digest %q matches the provided public keys", ErrPolicySignatureInvalid, manifestDigest)
+}
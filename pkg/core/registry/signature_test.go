@@ -0,0 +1,165 @@
+package registry
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/opencontainers/go-digest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"oras.land/oras-go/v2/content/memory"
+)
+
+// writeKeyPair writes a PEM encoded key pair to dir and returns the private and public key paths
+func writeKeyPair(t *testing.T, dir, name string, privateKey crypto.Signer) (string, string) {
+	t.Helper()
+
+	privateData, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	require.NoError(t, err)
+
+	publicData, err := x509.MarshalPKIXPublicKey(privateKey.Public())
+	require.NoError(t, err)
+
+	privatePath := filepath.Join(dir, name+".key")
+	publicPath := filepath.Join(dir, name+".pub")
+
+	require.NoError(t, os.WriteFile(privatePath, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateData}), 0600))
+	require.NoError(t, os.WriteFile(publicPath, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicData}), 0600))
+
+	return privatePath, publicPath
+}
+
+func TestSignVerifyManifest(t *testing.T) {
+	dir := t.TempDir()
+
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	_, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	_, otherPublicPath := writeKeyPair(t, dir, "other", otherKey)
+
+	tests := []struct {
+		name string
+		key  crypto.Signer
+	}{
+		{name: "ecdsa", key: ecdsaKey},
+		{name: "ed25519", key: ed25519Key},
+		{name: "rsa", key: rsaKey},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			store := memory.New()
+
+			privatePath, publicPath := writeKeyPair(t, dir, tt.name, tt.key)
+
+			signer, err := LoadPrivateKey(privatePath)
+			require.NoError(t, err)
+
+			publicKeys, err := LoadPublicKeys([]string{publicPath})
+			require.NoError(t, err)
+
+			otherPublicKeys, err := LoadPublicKeys([]string{otherPublicPath})
+			require.NoError(t, err)
+
+			policyDigest := digest.FromString("policy " + tt.name)
+
+			require.NoError(t, signManifest(ctx, store, "ghcr.io/updatecli/policies/test", policyDigest, signer))
+
+			// Valid signature
+			require.NoError(t, verifyManifest(ctx, store, policyDigest, publicKeys))
+
+			// Any of the keys can match
+			require.NoError(t, verifyManifest(ctx, store, policyDigest, append(otherPublicKeys, publicKeys...)))
+
+			// Signed by another key
+			err = verifyManifest(ctx, store, policyDigest, otherPublicKeys)
+			require.ErrorIs(t, err, ErrPolicySignatureInvalid)
+
+			// Unsigned policy
+			err = verifyManifest(ctx, store, digest.FromString("unsigned"), publicKeys)
+			require.ErrorIs(t, err, ErrPolicyNotSigned)
+		})
+	}
+}
+
+func TestVerifyManifestTampered(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	dir := t.TempDir()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	privatePath, publicPath := writeKeyPair(t, dir, "policy", key)
+
+	signer, err := LoadPrivateKey(privatePath)
+	require.NoError(t, err)
+
+	publicKeys, err := LoadPublicKeys([]string{publicPath})
+	require.NoError(t, err)
+
+	signedDigest := digest.FromString("signed policy")
+	tamperedDigest := digest.FromString("tampered policy")
+
+	require.NoError(t, signManifest(ctx, store, "ghcr.io/updatecli/policies/test", signedDigest, signer))
+
+	// Copy the valid signature to the tag of another digest, the signed payload doesn't match anymore
+	signatureDescriptor, err := store.Resolve(ctx, signatureTag(signedDigest))
+	require.NoError(t, err)
+	require.NoError(t, store.Tag(ctx, signatureDescriptor, signatureTag(tamperedDigest)))
+
+	err = verifyManifest(ctx, store, tamperedDigest, publicKeys)
+	require.ErrorIs(t, err, ErrPolicySignatureInvalid)
+}
+
+func TestLoadKeys(t *testing.T) {
+	dir := t.TempDir()
+
+	encrypted := filepath.Join(dir, "cosign.key")
+	require.NoError(t, os.WriteFile(encrypted, pem.EncodeToMemory(&pem.Block{Type: "ENCRYPTED SIGSTORE PRIVATE KEY", Bytes: []byte("xxx")}), 0600))
+
+	_, err := LoadPrivateKey(encrypted)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not supported")
+
+	_, err = LoadPrivateKey(filepath.Join(dir, "missing.key"))
+	require.Error(t, err)
+
+	_, err = LoadPublicKeys([]string{encrypted})
+	require.Error(t, err)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	ecData, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	ecPath := filepath.Join(dir, "ec.key")
+	require.NoError(t, os.WriteFile(ecPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: ecData}), 0600))
+
+	signer, err := LoadPrivateKey(ecPath)
+	require.NoError(t, err)
+	assert.IsType(t, &ecdsa.PrivateKey{}, signer)
+}
+
+func TestSignatureTag(t *testing.T) {
+	d := digest.Digest("sha256:2a4a5d8b8f2b0d3e0b94a9f2c5d1e6c4f2c5b0a1d5e3f4a2b6c8d0e1f2a3b4c5")
+	assert.Equal(t, "sha256-2a4a5d8b8f2b0d3e0b94a9f2c5d1e6c4f2c5b0a1d5e3f4a2b6c8d0e1f2a3b4c5.sig", signatureTag(d))
+}
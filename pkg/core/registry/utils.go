@@ -20,6 +20,12 @@ import (
 
 // getLatestTagSortedBySemver returns the latest tag sorted by semver
 func getLatestTagSortedBySemver(refName string, disableTLS bool) (string, error) {
+	return getLatestTagMatchingConstraint(refName, "", disableTLS)
+}
+
+// getLatestTagMatchingConstraint returns the latest semver tag matching the constraint,
+// or the latest semver tag if the constraint is empty
+func getLatestTagMatchingConstraint(refName, constraint string, disableTLS bool) (string, error) {
 
 	repo, err := remote.NewRepository(refName)
 	if err != nil {
@@ -43,6 +49,27 @@ func getLatestTagSortedBySemver(refName string, disableTLS bool) (string, error)
 		return "", fmt.Errorf("get tags: %w", err)
 	}
 
+	latestTag, err := latestSemverTag(tags, constraint)
+	if err != nil {
+		return "", err
+	}
+
+	logrus.Debugf("latest tag identified %q", latestTag)
+
+	return latestTag, nil
+}
+
+// latestSemverTag returns the highest semver tag matching the constraint, if any
+func latestSemverTag(tags []string, constraint string) (string, error) {
+	var c *semver.Constraints
+	if constraint != "" {
+		var err error
+		c, err = semver.NewConstraint(constraint)
+		if err != nil {
+			return "", fmt.Errorf("parse version constraint %q: %w", constraint, err)
+		}
+	}
+
 	result := []*semver.Version{}
 	for i := range tags {
 		s, err := semver.NewVersion(tags[i])
@@ -51,20 +78,23 @@ func getLatestTagSortedBySemver(refName string, disableTLS bool) (string, error)
 			continue
 		}
 
+		if c != nil && !c.Check(s) {
+			continue
+		}
+
 		result = append(result, s)
 	}
 
 	if len(result) == 0 {
+		if constraint != "" {
+			return "", fmt.Errorf("no semver tags found matching constraint %q", constraint)
+		}
 		return "", fmt.Errorf("no valid semver tags found")
 	}
 
-	sort.Sort(semver.Collection(result))
 	sort.Sort(sort.Reverse(semver.Collection(result)))
 
-	latestTag := result[0].Original()
-	logrus.Debugf("latest tag identified %q", latestTag)
-
-	return latestTag, nil
+	return result[0].Original(), nil
 }
 
 // getCredentialsFromDockerStore get the credentials from the docker credential store
@@ -0,0 +1,183 @@
+package reports
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/updatecli/updatecli/pkg/core/result"
+)
+
+const (
+	// DefaultDashboardTitle is the default title of the dependency dashboard issue
+	DefaultDashboardTitle string = "Updatecli Dependency Dashboard"
+
+	// dashboardDescription is the introduction shown at the top of the dependency dashboard issue
+	dashboardDescription string = "This issue lists the Updatecli pipelines executed on this repository with their latest known state.\nIt is automatically updated by Updatecli, manual changes will be overwritten."
+
+	// dashboardStartMarker and dashboardEndMarker delimit the pipelines section of the dashboard,
+	// which is merged with the previous dashboard content on every update.
+	dashboardStartMarker string = "<!-- updatecli-dashboard:pipelines -->"
+	dashboardEndMarker   string = "<!-- updatecli-dashboard:end -->"
+
+	// dashboardRerunMarker identifies the pipeline associated with a re-run checkbox
+	dashboardRerunMarker string = "<!-- updatecli-dashboard:rerun:%s -->"
+)
+
+// GenerateDashboard returns the dependency dashboard issue body.
+// Pipelines from the current run are merged into the pipelines listed by the previous issue body,
+// using the same merge logic than MergeFromMarkdown, so updating the dashboard is idempotent.
+func GenerateDashboard(previous string, reports Reports) (string, error) {
+	var pipelines []string
+	for _, report := range reports {
+		a := report.dashboardAction()
+		pipelines = append(pipelines, a.ToActionsMarkdownString())
+	}
+
+	merged, err := MergeFromMarkdown(dashboardPipelines(previous), strings.Join(pipelines, "\n\n"))
+	if err != nil {
+		return "", fmt.Errorf("merge dependency dashboard: %w", err)
+	}
+
+	var body strings.Builder
+
+	body.WriteString(dashboardDescription)
+	body.WriteString("\n\n")
+	body.WriteString(dashboardStartMarker)
+	body.WriteString("\n")
+	if merged != "" {
+		body.WriteString(merged)
+		body.WriteString("\n")
+	}
+	body.WriteString(dashboardEndMarker)
+
+	if footer := reports.dashboardFooter(); footer != "" {
+		body.WriteString("\n\n---\n\n")
+		body.WriteString(footer)
+	}
+
+	body.WriteString("\n")
+
+	return body.String(), nil
+}
+
+// dashboardPipelines returns the pipelines section of a dashboard issue body
+func dashboardPipelines(body string) string {
+	_, pipelines, found := strings.Cut(body, dashboardStartMarker)
+	if !found {
+		return ""
+	}
+
+	pipelines, _, _ = strings.Cut(pipelines, dashboardEndMarker)
+
+	return strings.TrimSpace(pipelines)
+}
+
+// dashboardAction converts a pipeline report into an action report
+// so it can be rendered and merged as markdown.
+func (r Report) dashboardAction() Action {
+	a := Action{
+		ID:            r.ID,
+		PipelineTitle: fmt.Sprintf("%s %s", r.Result, r.Name),
+	}
+
+	// Pull requests related to the pipeline
+	var pullRequests []string
+	for _, id := range sortedKeys(r.Actions) {
+		action := r.Actions[id]
+		if action == nil || action.Link == "" {
+			continue
+		}
+
+		title := action.Title
+		if title == "" {
+			title = action.Link
+		}
+		pullRequests = append(pullRequests, fmt.Sprintf("* Pull request: [%s](%s)", title, action.Link))
+	}
+
+	for _, id := range sortedKeys(r.Targets) {
+		target := r.Targets[id]
+
+		details := []string{}
+		if target.Information != "" {
+			details = append(details, fmt.Sprintf("* Current version: `%s`", target.Information))
+		}
+		if target.NewInformation != "" && target.NewInformation != target.Information {
+			details = append(details, fmt.Sprintf("* Latest version: `%s`", target.NewInformation))
+		}
+		if target.Result == result.ATTENTION {
+			details = append(details, pullRequests...)
+		}
+
+		description := dashboardTargetStatus(target.Result)
+		if len(details) > 0 {
+			description += "\n\n" + strings.Join(details, "\n")
+		}
+
+		name := target.Name
+		if name == "" {
+			name = id
+		}
+
+		a.Targets = append(a.Targets, ActionTarget{
+			ID:          id,
+			Title:       name,
+			Description: description,
+		})
+	}
+
+	return a
+}
+
+// dashboardTargetStatus returns a human readable target status
+func dashboardTargetStatus(status string) string {
+	switch status {
+	case result.SUCCESS:
+		return status + " Up to date"
+	case result.ATTENTION:
+		return status + " Update available"
+	case result.FAILURE:
+		return status + " Failed"
+	case result.SKIPPED:
+		return status + " Skipped"
+	}
+
+	return "Unknown status"
+}
+
+// dashboardFooter returns the dashboard section listing failing pipelines from the current run,
+// and a checkbox per pipeline that can be used to request a pipeline re-run.
+func (r Reports) dashboardFooter() string {
+	reports := make(Reports, len(r))
+	copy(reports, r)
+
+	sort.SliceStable(reports, func(i, j int) bool {
+		return reports[i].Name < reports[j].Name
+	})
+
+	var failures, reruns []string
+	for _, report := range reports {
+		if report.Result == result.FAILURE {
+			failure := fmt.Sprintf("* %s %s", report.Result, report.Name)
+			if report.Err != "" {
+				failure += fmt.Sprintf(": `%s`", strings.ReplaceAll(report.Err, "\n", " "))
+			}
+			failures = append(failures, failure)
+		}
+
+		if report.ID != "" {
+			reruns = append(reruns, fmt.Sprintf("- [ ] "+dashboardRerunMarker+" %s", report.ID, report.Name))
+		}
+	}
+
+	var sections []string
+	if len(failures) > 0 {
+		sections = append(sections, "## Failing pipelines\n\n"+strings.Join(failures, "\n"))
+	}
+	if len(reruns) > 0 {
+		sections = append(sections, "## Re-run pipelines\n\n"+strings.Join(reruns, "\n"))
+	}
+
+	return strings.Join(sections, "\n\n")
+}
@@ -0,0 +1,130 @@
+package reports
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/core/result"
+)
+
+func TestGenerateDashboard(t *testing.T) {
+	golang := Report{
+		ID:     "1111",
+		Name:   "Bump Golang version",
+		Result: result.ATTENTION,
+		Actions: map[string]*Action{
+			"default": {
+				Title: "deps: bump Golang to 1.22.1",
+				Link:  "https://github.com/updatecli/updatecli/pull/1",
+			},
+		},
+		Targets: map[string]*result.Target{
+			"gomod": {
+				Name:           "Update go.mod",
+				Result:         result.ATTENTION,
+				Information:    "1.22.0",
+				NewInformation: "1.22.1",
+			},
+		},
+	}
+
+	npm := Report{
+		ID:     "2222",
+		Name:   "Bump npm version",
+		Result: result.SUCCESS,
+		Targets: map[string]*result.Target{
+			"package": {
+				Name:           "Update package.json",
+				Result:         result.SUCCESS,
+				Information:    "10.0.0",
+				NewInformation: "10.0.0",
+			},
+		},
+	}
+
+	failing := Report{
+		ID:     "3333",
+		Name:   "Bump Helm chart",
+		Result: result.FAILURE,
+		Err:    "something went wrong",
+	}
+
+	expected := `This issue lists the Updatecli pipelines executed on this repository with their latest known state.
+It is automatically updated by Updatecli, manual changes will be overwritten.
+
+<!-- updatecli-dashboard:pipelines -->
+# ⚠ Bump Golang version
+
+Pipeline ID: ` + "`1111`" + `
+
+## Update go.mod
+
+Target ID: ` + "`gomod`" + `
+
+⚠ Update available
+
+* Current version: ` + "`1.22.0`" + `
+* Latest version: ` + "`1.22.1`" + `
+* Pull request: [deps: bump Golang to 1.22.1](https://github.com/updatecli/updatecli/pull/1)
+
+# ✔ Bump npm version
+
+Pipeline ID: ` + "`2222`" + `
+
+## Update package.json
+
+Target ID: ` + "`package`" + `
+
+✔ Up to date
+
+* Current version: ` + "`10.0.0`" + `
+
+# ✗ Bump Helm chart
+
+Pipeline ID: ` + "`3333`" + `
+<!-- updatecli-dashboard:end -->
+
+---
+
+## Failing pipelines
+
+* ✗ Bump Helm chart: ` + "`something went wrong`" + `
+
+## Re-run pipelines
+
+- [ ] <!-- updatecli-dashboard:rerun:1111 --> Bump Golang version
+- [ ] <!-- updatecli-dashboard:rerun:3333 --> Bump Helm chart
+- [ ] <!-- updatecli-dashboard:rerun:2222 --> Bump npm version
+`
+
+	got, err := GenerateDashboard("", Reports{golang, npm, failing})
+	require.NoError(t, err)
+	assert.Equal(t, expected, got)
+
+	// Updating the dashboard with the same reports doesn't change it
+	got, err = GenerateDashboard(got, Reports{golang, npm, failing})
+	require.NoError(t, err)
+	assert.Equal(t, expected, got)
+
+	// Pipelines from previous runs are kept while pipelines from the current run are updated
+	golang.Result = result.SUCCESS
+	golang.Targets["gomod"].Result = result.SUCCESS
+	golang.Targets["gomod"].Information = "1.22.1"
+
+	got, err = GenerateDashboard(got, Reports{golang})
+	require.NoError(t, err)
+
+	assert.Contains(t, got, "# ✔ Bump Golang version")
+	assert.NotContains(t, got, "# ⚠ Bump Golang version")
+	assert.NotContains(t, got, "Pull request:")
+	assert.Contains(t, got, "# ✔ Bump npm version")
+	assert.Contains(t, got, "# ✗ Bump Helm chart")
+	assert.NotContains(t, got, "## Failing pipelines")
+}
+
+func TestDashboardPipelines(t *testing.T) {
+	assert.Equal(t, "", dashboardPipelines(""))
+	assert.Equal(t, "", dashboardPipelines("Manually created issue"))
+	assert.Equal(t, "# pipeline", dashboardPipelines("intro\n\n"+dashboardStartMarker+"\n# pipeline\n"+dashboardEndMarker+"\n\nfooter"))
+}
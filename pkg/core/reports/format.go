@@ -0,0 +1,107 @@
+package reports
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	// REPORTFORMATJSON exports reports as a JSON document
+	REPORTFORMATJSON string = "json"
+	// REPORTFORMATJUNIT exports reports as a JUnit XML document, each target being a test case
+	REPORTFORMATJUNIT string = "junit"
+	// REPORTFORMATSARIF exports reports as a SARIF document, each outdated or failed target being a result
+	REPORTFORMATSARIF string = "sarif"
+)
+
+var (
+	// SupportedReportFormats lists the accepted values of the report format
+	SupportedReportFormats = []string{
+		REPORTFORMATJSON,
+		REPORTFORMATJUNIT,
+		REPORTFORMATSARIF,
+	}
+
+	// ErrUnsupportedReportFormat is returned when the report format is unknown
+	ErrUnsupportedReportFormat = errors.New("unsupported report format")
+)
+
+// ValidateFormat returns an error if format isn't a supported report format
+func ValidateFormat(format string) error {
+	for _, f := range SupportedReportFormats {
+		if f == format {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w %q, accepted values are %s",
+		ErrUnsupportedReportFormat, format, strings.Join(SupportedReportFormats, ", "))
+}
+
+// Export writes the reports to w, using the given format
+func (r Reports) Export(format string, w io.Writer) error {
+	switch format {
+	case REPORTFORMATJSON:
+		return r.exportToJSON(w)
+	case REPORTFORMATJUNIT:
+		return r.exportToJUnit(w)
+	case REPORTFORMATSARIF:
+		return r.exportToSARIF(w)
+	}
+
+	return ValidateFormat(format)
+}
+
+// ExportToFile writes the reports to filename, using the given format
+func (r Reports) ExportToFile(format, filename string) error {
+	if err := ValidateFormat(format); err != nil {
+		return err
+	}
+
+	if dir := filepath.Dir(filename); dir != "" {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("creating report directory: %w", err)
+		}
+	}
+
+	f, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("creating report file: %w", err)
+	}
+	defer f.Close()
+
+	if err := r.Export(format, f); err != nil {
+		return fmt.Errorf("exporting %s report: %w", format, err)
+	}
+
+	return f.Close()
+}
+
+// exportToJSON writes the full reports as an indented JSON document
+func (r Reports) exportToJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	if r == nil {
+		r = Reports{}
+	}
+
+	return encoder.Encode(r)
+}
+
+// sortedKeys returns the keys of a map sorted alphabetically,
+// so exported reports are stable across runs
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,145 @@
+package reports
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/core/result"
+)
+
+func testReports() Reports {
+	return Reports{
+		{
+			Name:       "Bump golang version",
+			PipelineID: "golang",
+			Result:     result.ATTENTION,
+			Targets: map[string]*result.Target{
+				"gomod": {
+					Name:           "Update go.mod",
+					Result:         result.ATTENTION,
+					Information:    "1.22.0",
+					NewInformation: "1.23.2",
+					Description:    "go.mod updated",
+					Files:          []string{"go.mod"},
+				},
+				"dockerfile": {
+					Name:        "Update Dockerfile",
+					Result:      result.SUCCESS,
+					Description: "Dockerfile already up to date",
+				},
+				"workflow": {
+					Name:        "Update workflow",
+					Result:      result.SKIPPED,
+					Description: "outside of the pipeline schedule weekends",
+				},
+			},
+		},
+		{
+			Name:   "Bump helm chart",
+			Result: result.FAILURE,
+			Err:    "source failed",
+			Targets: map[string]*result.Target{
+				"chart": {
+					Name:        "Update Chart.yaml",
+					Result:      result.FAILURE,
+					Description: "file not found",
+					Files:       []string{"charts/app/Chart.yaml"},
+				},
+			},
+		},
+		{
+			Name:   "Broken pipeline",
+			Result: result.FAILURE,
+			Err:    "pipeline failed",
+		},
+	}
+}
+
+func TestExportToJUnit(t *testing.T) {
+	buf := bytes.Buffer{}
+	require.NoError(t, testReports().Export(REPORTFORMATJUNIT, &buf))
+
+	got := junitTestSuites{}
+	require.NoError(t, xml.Unmarshal(buf.Bytes(), &got))
+
+	assert.Equal(t, 5, got.Tests)
+	assert.Equal(t, 1, got.Failures)
+	assert.Equal(t, 2, got.Errors)
+	assert.Equal(t, 1, got.Skipped)
+	require.Len(t, got.Suites, 3)
+
+	suite := got.Suites[0]
+	assert.Equal(t, "Bump golang version", suite.Name)
+	require.Len(t, suite.Cases, 3)
+	assert.Equal(t, "[dockerfile] Update Dockerfile", suite.Cases[0].Name)
+	assert.Nil(t, suite.Cases[0].Failure)
+	assert.Equal(t, "[gomod] Update go.mod", suite.Cases[1].Name)
+	require.NotNil(t, suite.Cases[1].Failure)
+	assert.Equal(t, `"1.22.0" should be updated to "1.23.2"`, suite.Cases[1].Failure.Content)
+	require.NotNil(t, suite.Cases[2].Skipped)
+	assert.Equal(t, "outside of the pipeline schedule weekends", suite.Cases[2].Skipped.Message)
+
+	require.Len(t, got.Suites[2].Cases, 1)
+	require.NotNil(t, got.Suites[2].Cases[0].Error)
+	assert.Equal(t, "pipeline failed", got.Suites[2].Cases[0].Error.Message)
+}
+
+func TestExportToSARIF(t *testing.T) {
+	buf := bytes.Buffer{}
+	require.NoError(t, testReports().Export(REPORTFORMATSARIF, &buf))
+
+	got := sarifLog{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &got))
+
+	assert.Equal(t, "2.1.0", got.Version)
+	require.Len(t, got.Runs, 1)
+	require.Len(t, got.Runs[0].Tool.Driver.Rules, 2)
+	require.Len(t, got.Runs[0].Results, 2)
+
+	outdated := got.Runs[0].Results[0]
+	assert.Equal(t, sarifRuleOutdated, outdated.RuleID)
+	assert.Equal(t, "warning", outdated.Level)
+	assert.Equal(t, `[Bump golang version] Update go.mod: "1.22.0" should be updated to "1.23.2"`, outdated.Message.Text)
+	require.Len(t, outdated.Locations, 1)
+	assert.Equal(t, "go.mod", outdated.Locations[0].PhysicalLocation.ArtifactLocation.URI)
+
+	failure := got.Runs[0].Results[1]
+	assert.Equal(t, sarifRuleFailure, failure.RuleID)
+	assert.Equal(t, 1, failure.RuleIndex)
+	assert.Equal(t, "charts/app/Chart.yaml", failure.Locations[0].PhysicalLocation.ArtifactLocation.URI)
+}
+
+func TestExportToJSON(t *testing.T) {
+	buf := bytes.Buffer{}
+	require.NoError(t, testReports().Export(REPORTFORMATJSON, &buf))
+
+	got := Reports{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &got))
+	require.Len(t, got, 3)
+	assert.Equal(t, "1.23.2", got[0].Targets["gomod"].NewInformation)
+}
+
+func TestExportToFile(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "reports", "updatecli.xml")
+
+	require.NoError(t, testReports().ExportToFile(REPORTFORMATJUNIT, filename))
+
+	data, err := os.ReadFile(filename)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "<testsuites")
+
+	err = testReports().ExportToFile("html", filename)
+	require.ErrorIs(t, err, ErrUnsupportedReportFormat)
+}
+
+func TestSarifURI(t *testing.T) {
+	assert.Equal(t, "go.mod", sarifURI("/src/project", "/src/project/go.mod"))
+	assert.Equal(t, "/tmp/updatecli/go.mod", sarifURI("/src/project", "/tmp/updatecli/go.mod"))
+	assert.Equal(t, "charts/Chart.yaml", sarifURI("/src/project", "charts/Chart.yaml"))
+}
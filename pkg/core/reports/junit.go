@@ -0,0 +1,156 @@
+package reports
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/updatecli/updatecli/pkg/core/result"
+)
+
+// junitTestSuites is the root element of a JUnit XML report
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Errors   int              `xml:"errors,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+// junitTestSuite represents a pipeline
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	ID       string          `xml:"id,attr,omitempty"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Errors   int             `xml:"errors,attr"`
+	Skipped  int             `xml:"skipped,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+// junitTestCase represents a target
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Error     *junitMessage `xml:"error,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+// junitMessage describes why a test case failed or was skipped
+type junitMessage struct {
+	Message string `xml:"message,attr,omitempty"`
+	Type    string `xml:"type,attr,omitempty"`
+	Content string `xml:",chardata"`
+}
+
+// exportToJUnit writes the reports as a JUnit XML document.
+// Each pipeline is a test suite and each target a test case:
+// a target needing a change is a failure, and a failing target is an error.
+func (r Reports) exportToJUnit(w io.Writer) error {
+	suites := junitTestSuites{Name: "updatecli"}
+
+	for _, report := range r {
+		suite := junitTestSuite{
+			Name: report.Name,
+			ID:   report.PipelineID,
+		}
+
+		if len(report.Targets) == 0 && report.Err != "" {
+			suite.Cases = append(suite.Cases, junitTestCase{
+				Name:      "pipeline",
+				ClassName: report.Name,
+				Error:     &junitMessage{Message: report.Err, Type: "pipeline"},
+			})
+		}
+
+		for _, id := range sortedKeys(report.Targets) {
+			suite.Cases = append(suite.Cases, newJUnitTestCase(report.Name, id, report.Targets[id]))
+		}
+
+		for _, c := range suite.Cases {
+			suite.Tests++
+			switch {
+			case c.Failure != nil:
+				suite.Failures++
+			case c.Error != nil:
+				suite.Errors++
+			case c.Skipped != nil:
+				suite.Skipped++
+			}
+		}
+
+		suites.Tests += suite.Tests
+		suites.Failures += suite.Failures
+		suites.Errors += suite.Errors
+		suites.Skipped += suite.Skipped
+		suites.Suites = append(suites.Suites, suite)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(suites); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// newJUnitTestCase converts a target result to a JUnit test case
+func newJUnitTestCase(pipelineName, id string, target *result.Target) junitTestCase {
+	c := junitTestCase{
+		Name:      fmt.Sprintf("[%s] %s", id, target.Name),
+		ClassName: pipelineName,
+		SystemOut: targetDetails(target),
+	}
+
+	switch target.Result {
+	case result.ATTENTION:
+		c.Failure = &junitMessage{
+			Message: target.Description,
+			Type:    "outdated",
+			Content: targetChange(target),
+		}
+	case result.FAILURE:
+		c.Error = &junitMessage{
+			Message: target.Description,
+			Type:    "failure",
+		}
+	case result.SKIPPED:
+		c.Skipped = &junitMessage{Message: target.Description}
+	}
+
+	return c
+}
+
+// targetChange describes the change needed by a target
+func targetChange(target *result.Target) string {
+	if target.Information == "" && target.NewInformation == "" {
+		return target.Description
+	}
+	return fmt.Sprintf("%q should be updated to %q", target.Information, target.NewInformation)
+}
+
+// targetDetails returns the files and changelogs related to a target
+func targetDetails(target *result.Target) string {
+	var details []string
+
+	if len(target.Files) > 0 {
+		details = append(details, "Files:\n  * "+strings.Join(target.Files, "\n  * "))
+	}
+
+	for _, changelog := range target.Changelogs {
+		details = append(details, changelog.String())
+	}
+
+	return strings.Join(details, "\n")
+}
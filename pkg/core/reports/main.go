@@ -39,7 +39,8 @@ SUMMARY:
 {{- if .Targets -}}
 {{- "\t" -}}Target:
 {{ range $ID, $target := .Targets }}
-{{- "\t" }}{{"\t"}}{{- $target.Result }} [{{ $ID }}] {{ $target.Name }}{{"\n"}}
+{{- "\t" }}{{"\t"}}{{- $target.Result }} [{{ $ID }}] {{ $target.Name }}
+{{- if and (eq $target.Result "-") $target.Description }} ({{ $target.Description }}){{ end }}{{"\n"}}
 {{- end }}
 {{ end }}
 {{- if .ReportURL }}
@@ -0,0 +1,181 @@
+package reports
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/updatecli/updatecli/pkg/core/result"
+	"github.com/updatecli/updatecli/pkg/core/version"
+)
+
+const (
+	// sarifSchema is the SARIF json schema location
+	sarifSchema string = "https://json.schemastore.org/sarif-2.1.0.json"
+	// sarifVersion is the SARIF specification version
+	sarifVersion string = "2.1.0"
+
+	// sarifRuleOutdated identifies targets needing an update
+	sarifRuleOutdated string = "updatecli/outdated"
+	// sarifRuleFailure identifies targets that couldn't be executed
+	sarifRuleFailure string = "updatecli/failure"
+)
+
+// sarifLog is the root element of a SARIF report
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Version        string      `json:"version,omitempty"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID                   string               `json:"id"`
+	ShortDescription     sarifMessage         `json:"shortDescription"`
+	DefaultConfiguration sarifRuleConfig      `json:"defaultConfiguration"`
+	Properties           *sarifRuleProperties `json:"properties,omitempty"`
+}
+
+type sarifRuleConfig struct {
+	Level string `json:"level"`
+}
+
+type sarifRuleProperties struct {
+	Tags []string `json:"tags,omitempty"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID     string                 `json:"ruleId"`
+	RuleIndex  int                    `json:"ruleIndex"`
+	Level      string                 `json:"level"`
+	Message    sarifMessage           `json:"message"`
+	Locations  []sarifLocation        `json:"locations,omitempty"`
+	Properties map[string]interface{} `json:"properties,omitempty"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+var sarifRules = []sarifRule{
+	{
+		ID:                   sarifRuleOutdated,
+		ShortDescription:     sarifMessage{Text: "Updatecli target is outdated"},
+		DefaultConfiguration: sarifRuleConfig{Level: "warning"},
+		Properties:           &sarifRuleProperties{Tags: []string{"dependencies"}},
+	},
+	{
+		ID:                   sarifRuleFailure,
+		ShortDescription:     sarifMessage{Text: "Updatecli target failed"},
+		DefaultConfiguration: sarifRuleConfig{Level: "error"},
+	},
+}
+
+// exportToSARIF writes the reports as a SARIF document.
+// Each target needing a change, or failing, is a result located in the target's files.
+func (r Reports) exportToSARIF(w io.Writer) error {
+	run := sarifRun{
+		Tool: sarifTool{
+			Driver: sarifDriver{
+				Name:           "updatecli",
+				InformationURI: "https://www.updatecli.io",
+				Version:        version.Version,
+				Rules:          sarifRules,
+			},
+		},
+		Results: []sarifResult{},
+	}
+
+	workingDir, _ := os.Getwd()
+
+	for _, report := range r {
+		for _, id := range sortedKeys(report.Targets) {
+			target := report.Targets[id]
+
+			var res sarifResult
+			switch target.Result {
+			case result.ATTENTION:
+				res = sarifResult{
+					RuleID:    sarifRuleOutdated,
+					RuleIndex: 0,
+					Level:     "warning",
+					Message:   sarifMessage{Text: fmt.Sprintf("[%s] %s: %s", report.Name, target.Name, targetChange(target))},
+				}
+			case result.FAILURE:
+				res = sarifResult{
+					RuleID:    sarifRuleFailure,
+					RuleIndex: 1,
+					Level:     "error",
+					Message:   sarifMessage{Text: fmt.Sprintf("[%s] %s: %s", report.Name, target.Name, target.Description)},
+				}
+			default:
+				continue
+			}
+
+			res.Properties = map[string]interface{}{
+				"pipeline": report.Name,
+				"targetID": id,
+			}
+
+			for _, file := range target.Files {
+				res.Locations = append(res.Locations, sarifLocation{
+					PhysicalLocation: sarifPhysicalLocation{
+						ArtifactLocation: sarifArtifactLocation{URI: sarifURI(workingDir, file)},
+					},
+				})
+			}
+
+			run.Results = append(run.Results, res)
+		}
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs:    []sarifRun{run},
+	})
+}
+
+// sarifURI returns the file location, relative to the working directory when possible
+func sarifURI(workingDir, file string) string {
+	if filepath.IsAbs(file) && workingDir != "" {
+		if rel, err := filepath.Rel(workingDir, file); err == nil && !strings.HasPrefix(rel, "..") {
+			file = rel
+		}
+	}
+
+	return filepath.ToSlash(file)
+}
@@ -10,6 +10,9 @@ type SCM struct {
 	ID string
 	// BranchReset defines if the scm branch was reset to the base branch during the pipeline execution
 	BranchReset bool
+	// RebaseStrategy defines the strategy applied to the working branch when its base branch moved,
+	// such as "never", "auto", or "recreate"
+	RebaseStrategy string
 }
 
 type GitBranch struct {
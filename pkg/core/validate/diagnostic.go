@@ -0,0 +1,79 @@
+package validate
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	// SeverityError is used for issues preventing a manifest from being executed
+	SeverityError string = "error"
+	// SeverityWarning is used for issues which don't prevent a manifest from being executed
+	SeverityWarning string = "warning"
+)
+
+// Diagnostic describes an issue detected in an Updatecli manifest
+type Diagnostic struct {
+	// File is the manifest file path
+	File string `json:"file"`
+	// Line is the 1-based line of the issue, 0 means the position is unknown
+	Line int `json:"line,omitempty"`
+	// Column is the 1-based column of the issue, 0 means the position is unknown
+	Column int `json:"column,omitempty"`
+	// Severity is either "error" or "warning"
+	Severity string `json:"severity"`
+	// Path is the dot separated path of the manifest setting, such as "targets.default.spec"
+	Path string `json:"path,omitempty"`
+	// Message describes the issue
+	Message string `json:"message"`
+}
+
+// String returns the diagnostic using the "file:line:column: severity: message" format
+func (d Diagnostic) String() string {
+	position := d.File
+	if d.Line > 0 {
+		position = fmt.Sprintf("%s:%d:%d", d.File, d.Line, d.Column)
+	}
+
+	if d.Path != "" {
+		return fmt.Sprintf("%s: %s: %s: %s", position, d.Severity, d.Path, d.Message)
+	}
+
+	return fmt.Sprintf("%s: %s: %s", position, d.Severity, d.Message)
+}
+
+// Diagnostics is a list of Diagnostic
+type Diagnostics []Diagnostic
+
+// HasErrors returns true if at least one diagnostic has the error severity
+func (d Diagnostics) HasErrors() bool {
+	for i := range d {
+		if d[i].Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// Sort sorts diagnostics by file and position
+func (d Diagnostics) Sort() {
+	sort.SliceStable(d, func(i, j int) bool {
+		if d[i].File != d[j].File {
+			return d[i].File < d[j].File
+		}
+		if d[i].Line != d[j].Line {
+			return d[i].Line < d[j].Line
+		}
+		return d[i].Column < d[j].Column
+	})
+}
+
+// String returns one diagnostic per line
+func (d Diagnostics) String() string {
+	lines := make([]string, 0, len(d))
+	for i := range d {
+		lines = append(lines, d[i].String())
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,229 @@
+package validate
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/updatecli/updatecli/pkg/core/config"
+)
+
+// manifestValidator holds the state needed to validate one Updatecli manifest
+type manifestValidator struct {
+	// file is the manifest file path
+	file string
+	// rawDocuments contains the untemplated manifest documents used to locate issues
+	rawDocuments []*yaml.Node
+	// document is the index of the manifest document being validated
+	document int
+	// diagnostics contains all detected issues
+	diagnostics Diagnostics
+}
+
+// Manifest validates an Updatecli manifest and returns every detected issue.
+// Validation doesn't require network access, as no resource is executed.
+// config.ErrConfigFileTypeNotSupported is returned for files which are not Updatecli manifests.
+func Manifest(option config.Option) (Diagnostics, error) {
+	v := manifestValidator{
+		file: option.ManifestFile,
+	}
+
+	content, err := config.Render(option)
+	switch err {
+	case nil:
+		// nothing to do
+	case config.ErrConfigFileTypeNotSupported:
+		return nil, err
+	default:
+		v.addError(nil, "rendering manifest: %s", err)
+		return v.diagnostics, nil
+	}
+
+	// Issues are located using the manifest file as written by the user,
+	// positions are unknown if the manifest isn't a valid yaml document before templating
+	if rawContent, err := os.ReadFile(option.ManifestFile); err == nil {
+		if rawDocuments, err := parseDocuments(rawContent); err == nil {
+			v.rawDocuments = rawDocuments
+		}
+	}
+
+	documents, err := parseDocuments(content)
+	if err != nil {
+		v.addError(nil, "parsing manifest: %s", err)
+		return v.diagnostics, nil
+	}
+
+	for i := range documents {
+		v.document = i
+		v.validateDocument(documents[i])
+	}
+
+	v.diagnostics.Sort()
+
+	return v.diagnostics, nil
+}
+
+// validateDocument validates one templated manifest document
+func (v *manifestValidator) validateDocument(document *yaml.Node) {
+	var data map[string]interface{}
+	if err := document.Decode(&data); err != nil {
+		v.addError(nil, "decoding manifest: %s", err)
+		return
+	}
+
+	var spec config.Spec
+	if err := document.Decode(&spec); err != nil {
+		v.addError(nil, "decoding manifest: %s", err)
+		return
+	}
+
+	// pullrequests deprecated over actions
+	if len(spec.PullRequests) > 0 {
+		switch len(spec.Actions) {
+		case 0:
+			v.addWarning([]string{"pullrequests"}, "the `pullrequests` keyword is deprecated in favor of `actions`")
+			spec.Actions = spec.PullRequests
+			data["actions"] = data["pullrequests"]
+		default:
+			v.addError([]string{"pullrequests"}, "the `pullrequests` and `actions` keywords are mutually exclusive, please use only `actions`")
+		}
+		spec.PullRequests = nil
+		delete(data, "pullrequests")
+	}
+
+	// title deprecated over name
+	if spec.Title != "" {
+		v.addWarning([]string{"title"}, "the `title` keyword is deprecated in favor of `name`")
+		delete(data, "title")
+	}
+
+	v.validateSpec(&spec)
+
+	// Resource validations normalize kinds, such as deprecated action kinds,
+	// so the jsonschema is validated against the kinds used at runtime
+	for id, a := range spec.Actions {
+		setKind(data, "actions", id, a.Kind)
+	}
+	for id, s := range spec.Sources {
+		setKind(data, "sources", id, s.Kind)
+	}
+	for id, c := range spec.Conditions {
+		setKind(data, "conditions", id, c.Kind)
+	}
+	for id, t := range spec.Targets {
+		setKind(data, "targets", id, t.Kind)
+	}
+
+	v.validateSchema(data)
+	v.validateReferences(&spec)
+	v.validateDependencyLoops(&spec)
+}
+
+// validateSpec runs the validation of every manifest section
+func (v *manifestValidator) validateSpec(spec *config.Spec) {
+	manifest := config.Config{Spec: *spec}
+	if spec.Version != "" {
+		if err := manifest.ValidateManifestCompatibility(); err != nil {
+			v.addError([]string{"version"}, "%s", err)
+		}
+	}
+
+	if err := spec.Policy.Validate(); err != nil {
+		v.addError([]string{"policy"}, "%s", err)
+	}
+
+	if err := spec.AutoDiscovery.GroupBy.Validate(); err != nil {
+		v.addError([]string{"autodiscovery", "groupby"}, "%s", err)
+	}
+
+	for _, id := range sortedKeys(spec.SCMs) {
+		if err := spec.SCMs[id].Validate(); err != nil {
+			v.addError([]string{"scms", id}, "invalid scm: %s", err)
+		}
+	}
+
+	for _, id := range sortedKeys(spec.Actions) {
+		a := spec.Actions[id]
+		if err := a.Validate(); err != nil {
+			v.addError([]string{"actions", id}, "invalid action: %s", err)
+		}
+		spec.Actions[id] = a
+	}
+
+	for _, id := range sortedKeys(spec.Sources) {
+		s := spec.Sources[id]
+		if err := s.Validate(); err != nil {
+			v.addError([]string{"sources", id}, "invalid source: %s", err)
+		}
+		spec.Sources[id] = s
+	}
+
+	for _, id := range sortedKeys(spec.Conditions) {
+		c := spec.Conditions[id]
+		if err := c.Validate(); err != nil {
+			v.addError([]string{"conditions", id}, "invalid condition: %s", err)
+		}
+		spec.Conditions[id] = c
+	}
+
+	for _, id := range sortedKeys(spec.Targets) {
+		t := spec.Targets[id]
+		if err := t.Validate(); err != nil {
+			v.addError([]string{"targets", id}, "invalid target: %s", err)
+		}
+		spec.Targets[id] = t
+	}
+}
+
+// setKind updates the kind of the resource id from the manifest section
+func setKind(data map[string]interface{}, section, id, kind string) {
+	resources, ok := data[section].(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	resource, ok := resources[id].(map[string]interface{})
+	if !ok || kind == "" {
+		return
+	}
+
+	resource["kind"] = kind
+}
+
+// addError records an error located at path
+func (v *manifestValidator) addError(path []string, format string, a ...interface{}) {
+	v.add(SeverityError, path, fmt.Sprintf(format, a...))
+}
+
+// addWarning records a warning located at path
+func (v *manifestValidator) addWarning(path []string, format string, a ...interface{}) {
+	v.add(SeverityWarning, path, fmt.Sprintf(format, a...))
+}
+
+func (v *manifestValidator) add(severity string, path []string, message string) {
+	d := Diagnostic{
+		File:     v.file,
+		Severity: severity,
+		Path:     strings.Join(path, "."),
+		Message:  message,
+	}
+
+	if v.document < len(v.rawDocuments) {
+		d.Line, d.Column = lookupPosition(v.rawDocuments[v.document], path)
+	}
+
+	v.diagnostics = append(v.diagnostics, d)
+}
+
+// sortedKeys returns map keys in a deterministic order
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
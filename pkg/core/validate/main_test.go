@@ -0,0 +1,204 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/updatecli/updatecli/pkg/core/config"
+)
+
+func TestManifest(t *testing.T) {
+	tests := []struct {
+		name          string
+		manifest      string
+		expected      Diagnostics
+		expectedError error
+	}{
+		{
+			name:     "Valid manifest",
+			manifest: "testdata/valid.yaml",
+			expected: Diagnostics{
+				{
+					File:     "testdata/valid.yaml",
+					Line:     16,
+					Column:   5,
+					Severity: SeverityWarning,
+					Path:     "sources.updatecli.spec",
+					Message:  "token is required",
+				},
+			},
+		},
+		{
+			name:     "Invalid manifest",
+			manifest: "testdata/invalid.yaml",
+			expected: Diagnostics{
+				{
+					File:     "testdata/invalid.yaml",
+					Line:     11,
+					Column:   3,
+					Severity: SeverityError,
+					Path:     "sources.first",
+					Message:  "dependency loop detected: source#first -> source#second -> source#first",
+				},
+				{
+					File:     "testdata/invalid.yaml",
+					Line:     23,
+					Column:   3,
+					Severity: SeverityError,
+					Path:     "conditions.docker",
+					Message:  "templated value references source undefined which does not exist",
+				},
+				{
+					File:     "testdata/invalid.yaml",
+					Line:     25,
+					Column:   5,
+					Severity: SeverityError,
+					Path:     "conditions.docker.sourceid",
+					Message:  `source "missing" does not exist`,
+				},
+				{
+					File:     "testdata/invalid.yaml",
+					Line:     34,
+					Column:   5,
+					Severity: SeverityError,
+					Path:     "targets.default.scmid",
+					Message:  `scm "github" does not exist`,
+				},
+				{
+					File:     "testdata/invalid.yaml",
+					Line:     36,
+					Column:   9,
+					Severity: SeverityError,
+					Path:     "targets.default.dependson.0",
+					Message:  `condition "missing" does not exist`,
+				},
+				{
+					File:     "testdata/invalid.yaml",
+					Line:     39,
+					Column:   7,
+					Severity: SeverityError,
+					Path:     "targets.default.spec.unknownsetting",
+					Message:  "Additional property unknownsetting is not allowed",
+				},
+			},
+		},
+		{
+			name:     "Invalid yaml",
+			manifest: "testdata/invalid_yaml.yaml",
+			expected: Diagnostics{
+				{
+					File:     "testdata/invalid_yaml.yaml",
+					Severity: SeverityError,
+					Message:  "parsing manifest: yaml: line 3: mapping values are not allowed in this context",
+				},
+			},
+		},
+		{
+			name:          "Unsupported file",
+			manifest:      "testdata/README.md",
+			expectedError: config.ErrConfigFileTypeNotSupported,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Manifest(config.Option{ManifestFile: tt.manifest})
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+
+			// The unknown kind message lists every supported kinds
+			for i := range got {
+				if got[i].Path == "sources.unknown.kind" {
+					assert.Contains(t, got[i].Message, "must be one of the following")
+					got = append(got[:i], got[i+1:]...)
+					break
+				}
+			}
+
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestLookupPosition(t *testing.T) {
+	documents, err := parseDocuments([]byte(`name: test
+sources:
+  default:
+    kind: file
+    dependson:
+      - first
+      - second
+---
+name: other
+`))
+	require.NoError(t, err)
+	require.Len(t, documents, 2)
+
+	tests := []struct {
+		name           string
+		document       int
+		path           []string
+		expectedLine   int
+		expectedColumn int
+	}{
+		{
+			name:           "Document root",
+			expectedLine:   1,
+			expectedColumn: 1,
+		},
+		{
+			name:           "Mapping key",
+			path:           []string{"sources", "default", "kind"},
+			expectedLine:   4,
+			expectedColumn: 5,
+		},
+		{
+			name:           "Sequence item",
+			path:           []string{"sources", "default", "dependson", "1"},
+			expectedLine:   7,
+			expectedColumn: 9,
+		},
+		{
+			name:           "Unknown key returns the closest parent",
+			path:           []string{"sources", "default", "spec", "file"},
+			expectedLine:   3,
+			expectedColumn: 3,
+		},
+		{
+			name:           "Second document",
+			document:       1,
+			path:           []string{"name"},
+			expectedLine:   9,
+			expectedColumn: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			line, column := lookupPosition(documents[tt.document], tt.path)
+			assert.Equal(t, tt.expectedLine, line)
+			assert.Equal(t, tt.expectedColumn, column)
+		})
+	}
+}
+
+func TestRestorePathCase(t *testing.T) {
+	data := map[string]interface{}{
+		"sources": map[string]interface{}{
+			"getLatestVersion": map[string]interface{}{
+				"transformers": []interface{}{
+					map[string]interface{}{"trimPrefix": "v"},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t,
+		[]string{"sources", "getLatestVersion", "transformers", "0", "trimPrefix"},
+		restorePathCase(data, []string{"sources", "getlatestversion", "transformers", "0", "trimprefix"}))
+}
@@ -0,0 +1,79 @@
+package validate
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strconv"
+
+	"gopkg.in/yaml.v3"
+)
+
+// parseDocuments returns the yaml nodes of every document from content
+func parseDocuments(content []byte) ([]*yaml.Node, error) {
+	var documents []*yaml.Node
+
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	for {
+		document := yaml.Node{}
+		if err := decoder.Decode(&document); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+		documents = append(documents, &document)
+	}
+
+	return documents, nil
+}
+
+// lookupPosition returns the line and column of the deepest node matching path.
+// Mapping entries are located by their key, sequence items by their index.
+func lookupPosition(document *yaml.Node, path []string) (line, column int) {
+	if document == nil {
+		return 0, 0
+	}
+
+	node := document
+	if node.Kind == yaml.DocumentNode && len(node.Content) > 0 {
+		node = node.Content[0]
+	}
+
+	line, column = node.Line, node.Column
+
+	for _, element := range path {
+		if node.Kind == yaml.AliasNode {
+			node = node.Alias
+		}
+
+		switch node.Kind {
+		case yaml.MappingNode:
+			var value *yaml.Node
+			for i := 0; i+1 < len(node.Content); i += 2 {
+				if node.Content[i].Value == element {
+					line, column = node.Content[i].Line, node.Content[i].Column
+					value = node.Content[i+1]
+					break
+				}
+			}
+			if value == nil {
+				return line, column
+			}
+			node = value
+
+		case yaml.SequenceNode:
+			index, err := strconv.Atoi(element)
+			if err != nil || index < 0 || index >= len(node.Content) {
+				return line, column
+			}
+			node = node.Content[index]
+			line, column = node.Line, node.Column
+
+		default:
+			return line, column
+		}
+	}
+
+	return line, column
+}
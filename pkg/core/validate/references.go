@@ -0,0 +1,243 @@
+package validate
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/updatecli/updatecli/pkg/core/config"
+	"github.com/updatecli/updatecli/pkg/core/pipeline"
+)
+
+const (
+	sourceCategory    string = "source"
+	conditionCategory string = "condition"
+	targetCategory    string = "target"
+)
+
+// resourceNode is a pipeline resource and the resources it depends on
+type resourceNode struct {
+	// path is the manifest path of the resource such as ["targets", "default"]
+	path []string
+	// dependencies contains the ids, formatted as "category#id", of the resources needed by this resource
+	dependencies []string
+}
+
+// validateReferences ensures that every sourceid, scmid, conditionids and dependson value
+// references a resource defined by the manifest
+func (v *manifestValidator) validateReferences(spec *config.Spec) {
+	exists := map[string]bool{}
+	for id := range spec.Sources {
+		exists[sourceCategory+"#"+id] = true
+	}
+	for id := range spec.Conditions {
+		exists[conditionCategory+"#"+id] = true
+	}
+	for id := range spec.Targets {
+		exists[targetCategory+"#"+id] = true
+	}
+
+	checkScmID := func(path []string, scmID string) {
+		if scmID == "" || scmID == config.LOCALSCMIDENTIFIER {
+			return
+		}
+		if _, ok := spec.SCMs[scmID]; !ok {
+			v.addError(path, "scm %q does not exist", scmID)
+		}
+	}
+
+	checkSourceID := func(path []string, sourceID string, disableSourceInput bool) {
+		switch {
+		case sourceID != "":
+			if _, ok := spec.Sources[sourceID]; !ok {
+				v.addError(append(path, "sourceid"), "source %q does not exist", sourceID)
+			}
+		case !disableSourceInput && len(spec.Sources) > 1:
+			v.addError(path, "empty 'sourceid' while the manifest defines more than one source")
+		}
+	}
+
+	checkDependsOn := func(path []string, category string, dependsOn []string) {
+		for i, value := range dependsOn {
+			key, operator, dependencyCategory := pipeline.ParseDependsOnValue(value)
+			if dependencyCategory == "" {
+				dependencyCategory = category
+			}
+
+			if operator != "and" && operator != "or" {
+				v.addError(append(path, "dependson", strconv.Itoa(i)), "unsupported boolean operator %q, accepted values are \"and\" or \"or\"", operator)
+			}
+
+			if !exists[dependencyCategory+"#"+key] {
+				v.addError(append(path, "dependson", strconv.Itoa(i)), "%s %q does not exist", dependencyCategory, key)
+			}
+		}
+	}
+
+	checkRuntimeDependencies := func(path []string, resourceConfig interface{}) {
+		for _, dependency := range runtimeDependencies(resourceConfig) {
+			category, _, _ := strings.Cut(dependency, "#")
+			if category != sourceCategory && category != conditionCategory && category != targetCategory {
+				continue
+			}
+			if !exists[dependency] {
+				v.addError(path, "templated value references %s which does not exist", strings.Replace(dependency, "#", " ", 1))
+			}
+		}
+	}
+
+	if spec.AutoDiscovery.ScmId != "" {
+		checkScmID([]string{"autodiscovery", "scmid"}, spec.AutoDiscovery.ScmId)
+	}
+
+	for _, id := range sortedKeys(spec.Actions) {
+		checkScmID([]string{"actions", id, "scmid"}, spec.Actions[id].ScmID)
+	}
+
+	for _, id := range sortedKeys(spec.Sources) {
+		s := spec.Sources[id]
+		path := []string{"sources", id}
+		checkScmID(append(path, "scmid"), s.SCMID)
+		checkScmID(append(path, "changelog", "scmid"), s.Changelog.SCMID)
+		checkDependsOn(path, sourceCategory, s.DependsOn)
+		checkRuntimeDependencies(path, s)
+	}
+
+	for _, id := range sortedKeys(spec.Conditions) {
+		c := spec.Conditions[id]
+		path := []string{"conditions", id}
+		checkScmID(append(path, "scmid"), c.SCMID)
+		checkSourceID(path, c.SourceID, c.DisableSourceInput)
+		checkDependsOn(path, conditionCategory, c.DependsOn)
+		checkRuntimeDependencies(path, c)
+	}
+
+	for _, id := range sortedKeys(spec.Targets) {
+		t := spec.Targets[id]
+		path := []string{"targets", id}
+		checkScmID(append(path, "scmid"), t.SCMID)
+		checkSourceID(path, t.SourceID, t.DisableSourceInput)
+		checkDependsOn(path, targetCategory, t.DependsOn)
+		checkRuntimeDependencies(path, t)
+
+		for i, conditionID := range t.DeprecatedConditionIDs {
+			if _, ok := spec.Conditions[conditionID]; !ok {
+				v.addError(append(path, "conditionids", strconv.Itoa(i)), "condition %q does not exist", conditionID)
+			}
+		}
+	}
+}
+
+// validateDependencyLoops ensures resources don't depend on each other in a loop,
+// as Updatecli couldn't define in which order to execute them.
+func (v *manifestValidator) validateDependencyLoops(spec *config.Spec) {
+	nodes := map[string]resourceNode{}
+
+	addNode := func(category, id string, dependsOn, dependencies []string, resourceConfig interface{}) {
+		node := resourceNode{
+			path:         []string{category + "s", id},
+			dependencies: append(dependencies, runtimeDependencies(resourceConfig)...),
+		}
+
+		for _, value := range dependsOn {
+			key, _, dependencyCategory := pipeline.ParseDependsOnValue(value)
+			if dependencyCategory == "" {
+				dependencyCategory = category
+			}
+			node.dependencies = append(node.dependencies, dependencyCategory+"#"+key)
+		}
+
+		sort.Strings(node.dependencies)
+		nodes[category+"#"+id] = node
+	}
+
+	for id, s := range spec.Sources {
+		addNode(sourceCategory, id, s.DependsOn, nil, s)
+	}
+
+	for id, c := range spec.Conditions {
+		dependencies := []string{}
+		if c.SourceID != "" {
+			dependencies = append(dependencies, sourceCategory+"#"+c.SourceID)
+		}
+		addNode(conditionCategory, id, c.DependsOn, dependencies, c)
+	}
+
+	for id, t := range spec.Targets {
+		dependencies := []string{}
+		if t.SourceID != "" {
+			dependencies = append(dependencies, sourceCategory+"#"+t.SourceID)
+		}
+		// By default, a target depends on all conditions
+		if !t.DisableConditions {
+			for conditionID := range spec.Conditions {
+				dependencies = append(dependencies, conditionCategory+"#"+conditionID)
+			}
+		}
+		addNode(targetCategory, id, t.DependsOn, dependencies, t)
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	state := map[string]int{}
+	stack := []string{}
+
+	var visit func(id string)
+	visit = func(id string) {
+		state[id] = visiting
+		stack = append(stack, id)
+
+		for _, dependency := range nodes[id].dependencies {
+			if _, ok := nodes[dependency]; !ok {
+				// Dangling references are reported by validateReferences
+				continue
+			}
+
+			switch state[dependency] {
+			case unvisited:
+				visit(dependency)
+			case visiting:
+				loop := []string{}
+				for i := len(stack) - 1; i >= 0; i-- {
+					loop = append([]string{stack[i]}, loop...)
+					if stack[i] == dependency {
+						break
+					}
+				}
+				loop = append(loop, dependency)
+				v.addError(nodes[dependency].path, "dependency loop detected: %s", strings.Join(loop, " -> "))
+			}
+		}
+
+		stack = stack[:len(stack)-1]
+		state[id] = visited
+	}
+
+	for _, id := range sortedKeys(nodes) {
+		if state[id] == unvisited {
+			visit(id)
+		}
+	}
+}
+
+// runtimeDependencies returns the resources referenced by Golang templates evaluated at runtime
+// such as '{{ source "default" }}'
+func runtimeDependencies(resourceConfig interface{}) []string {
+	content, err := yaml.Marshal(resourceConfig)
+	if err != nil {
+		return nil
+	}
+
+	dependencies, err := pipeline.ExtractDepsFromTemplate(string(content))
+	if err != nil {
+		return nil
+	}
+
+	return dependencies
+}
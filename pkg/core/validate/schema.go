@@ -0,0 +1,113 @@
+package validate
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/updatecli/updatecli/pkg/core/config"
+	"github.com/updatecli/updatecli/pkg/core/jsonschema"
+)
+
+var (
+	// schemaValidator validates manifests against the Updatecli manifest jsonschema
+	schemaValidator *jsonschema.Validator
+	// schemaValidatorErr is the error returned while generating the Updatecli manifest jsonschema
+	schemaValidatorErr error
+	// schemaValidatorOnce ensures the Updatecli manifest jsonschema is only generated once
+	schemaValidatorOnce sync.Once
+)
+
+// validateSchema validates the manifest document against the Updatecli manifest jsonschema
+func (v *manifestValidator) validateSchema(data map[string]interface{}) {
+	schemaValidatorOnce.Do(func() {
+		schemaValidator, schemaValidatorErr = jsonschema.NewValidator(config.Spec{})
+	})
+
+	if schemaValidatorErr != nil {
+		v.addWarning(nil, "skipping jsonschema validation: %s", schemaValidatorErr)
+		return
+	}
+
+	// Resource specs are decoded regardless of the key case, while the jsonschema uses lowercase keys
+	document := lowercaseKeys(data).(map[string]interface{})
+
+	// A pipeline name is generated from the manifest filename when not specified
+	if _, ok := document["name"]; !ok {
+		document["name"] = v.file
+	}
+
+	validationErrors, err := schemaValidator.Validate(document)
+	if err != nil {
+		v.addWarning(nil, "skipping jsonschema validation: %s", err)
+		return
+	}
+
+	for _, validationError := range validationErrors {
+		path := restorePathCase(data, validationError.Path)
+
+		switch validationError.Type {
+		case "required":
+			// Required values may be provided at runtime, such as a git url retrieved from the scm configuration
+			v.addWarning(path, "%s", validationError.Message)
+		default:
+			v.addError(path, "%s", validationError.Message)
+		}
+	}
+}
+
+// lowercaseKeys returns a copy of data where all map keys are lowercase
+func lowercaseKeys(data interface{}) interface{} {
+	switch d := data.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(d))
+		for key, value := range d {
+			result[strings.ToLower(key)] = lowercaseKeys(value)
+		}
+		return result
+
+	case []interface{}:
+		result := make([]interface{}, len(d))
+		for i := range d {
+			result[i] = lowercaseKeys(d[i])
+		}
+		return result
+	}
+
+	return data
+}
+
+// restorePathCase returns path using the keys case from data
+func restorePathCase(data interface{}, path []string) []string {
+	result := make([]string, 0, len(path))
+
+	for _, element := range path {
+		switch d := data.(type) {
+		case map[string]interface{}:
+			key := element
+			for k := range d {
+				if strings.EqualFold(k, element) {
+					key = k
+					break
+				}
+			}
+			result = append(result, key)
+			data = d[key]
+
+		case []interface{}:
+			result = append(result, element)
+			index, err := strconv.Atoi(element)
+			if err != nil || index < 0 || index >= len(d) {
+				data = nil
+				continue
+			}
+			data = d[index]
+
+		default:
+			result = append(result, element)
+			data = nil
+		}
+	}
+
+	return result
+}
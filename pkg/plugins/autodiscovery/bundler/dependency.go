@@ -0,0 +1,215 @@
+package bundler
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+const (
+	// sourceReplacePattern replaces the version surrounded by the first and the last capture groups
+	// with the rubygems source output
+	sourceReplacePattern string = `${1}{{ source "rubygems" }}${2}`
+)
+
+// discoverDependencyManifests discovers manifests for the gems declared in Gemfile files
+func (b Bundler) discoverDependencyManifests() ([][]byte, error) {
+
+	var manifests [][]byte
+
+	searchFromDir := b.rootDir
+	// If the spec.RootDir is an absolute path, then it as already been set
+	// correctly in the New function.
+	if b.spec.RootDir != "" && !path.IsAbs(b.spec.RootDir) {
+		searchFromDir = filepath.Join(b.rootDir, b.spec.RootDir)
+	}
+
+	foundFiles, err := searchGemfiles(searchFromDir)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, foundFile := range foundFiles {
+
+		relativeFile, err := filepath.Rel(b.rootDir, foundFile)
+		if err != nil {
+			// Let's try the next file if one fail
+			logrus.Debugln(err)
+			continue
+		}
+
+		logrus.Debugf("parsing file %q", relativeFile)
+
+		content, err := os.ReadFile(foundFile)
+		if err != nil {
+			logrus.Debugln(err)
+			continue
+		}
+
+		gems := parseGemfile(content)
+		if len(gems) == 0 {
+			logrus.Debugf("no gem found in %q\n", relativeFile)
+			continue
+		}
+
+		lockedVersions := map[string]string{}
+		if lockContent, err := os.ReadFile(filepath.Join(filepath.Dir(foundFile), gemfileLock)); err == nil {
+			lockedVersions = parseGemfileLock(lockContent)
+		}
+
+		lockFile := getLockFile(foundFile)
+
+		for _, g := range gems {
+
+			requirements := make([]string, len(g.Requirements))
+			for i := range g.Requirements {
+				requirements[i] = g.Requirements[i].String()
+			}
+			requirement := strings.Join(requirements, ", ")
+
+			if _, err := version.NewRubyGemsConstraint(requirement); err != nil {
+				logrus.Debugf("Ignoring gem %q from %q: %s", g.Name, relativeFile, err)
+				continue
+			}
+
+			// The locked version is the one installed, so it's the one checked against the matching rules
+			gemVersion := lockedVersions[g.Name]
+			if gemVersion == "" && len(g.Requirements) > 0 {
+				gemVersion = g.Requirements[0].Version
+			}
+
+			if len(b.spec.Ignore) > 0 {
+				if b.spec.Ignore.isMatchingRules(b.rootDir, relativeFile, g.Name, gemVersion) {
+					logrus.Debugf("Ignoring gem %q from %q, as matching ignore rule(s)\n", g.Name, relativeFile)
+					continue
+				}
+			}
+
+			if len(b.spec.Only) > 0 {
+				if !b.spec.Only.isMatchingRules(b.rootDir, relativeFile, g.Name, gemVersion) {
+					logrus.Debugf("Ignoring gem %q from %q, as not matching only rule(s)\n", g.Name, relativeFile)
+					continue
+				}
+			}
+
+			/*
+				A gem with a pinned requirement, such as "7.1.3", or a pessimistic one, such as "~> 7.1",
+				is bumped in the Gemfile using the version filter.
+				Other gems are only updated in Gemfile.lock within their requirements, as bundler would do.
+			*/
+			sourceVersionFilterKind := version.RUBYGEMSVERSIONKIND
+			sourceVersionFilterPattern := requirement
+			if sourceVersionFilterPattern == "" {
+				sourceVersionFilterPattern = "*"
+			}
+			sourceSegmentsPattern := ""
+
+			switch g.matchPattern != "" {
+			case true:
+				r := g.Requirements[0]
+
+				sourceVersionFilterKind = b.versionFilter.Kind
+				sourceVersionFilterPattern, err = b.versionFilter.GreaterThanPattern(r.Version)
+				if err != nil {
+					logrus.Debugf("building version filter pattern: %s", err)
+					sourceVersionFilterPattern = "*"
+				}
+
+				// A pessimistic requirement keeps its precision, so "~> 7.1" becomes "~> 7.2" and not "~> 7.2.1"
+				if r.Operator == "~>" {
+					sourceSegmentsPattern = segmentsPattern(r.Version)
+				}
+			case false:
+				if lockFile == "" {
+					logrus.Debugf("Ignoring gem %q from %q, requirement %q is handled by bundler and no lock file can be updated", g.Name, relativeFile, requirement)
+					continue
+				}
+			}
+
+			tmpl, err := template.New("manifest").Parse(manifestTemplate)
+			if err != nil {
+				logrus.Debugln(err)
+				continue
+			}
+
+			params := struct {
+				ActionID                   string
+				ManifestName               string
+				SourceID                   string
+				SourceName                 string
+				SourceGem                  string
+				SourceURL                  string
+				SourceVersionFilterKind    string
+				SourceVersionFilterPattern string
+				SourceSegmentsPattern      string
+				TargetID                   string
+				TargetName                 string
+				TargetMatchPattern         string
+				TargetReplacePattern       string
+				File                       string
+				LockFile                   string
+				LockTargetName             string
+				LockCommand                string
+				WorkDir                    string
+				ScmID                      string
+			}{
+				ActionID:                   b.actionID,
+				ManifestName:               fmt.Sprintf("Bump gem %q", g.Name),
+				SourceID:                   "rubygems",
+				SourceName:                 fmt.Sprintf("Get gem %q latest version", g.Name),
+				SourceGem:                  g.Name,
+				SourceURL:                  b.spec.URL,
+				SourceVersionFilterKind:    sourceVersionFilterKind,
+				SourceVersionFilterPattern: sourceVersionFilterPattern,
+				SourceSegmentsPattern:      sourceSegmentsPattern,
+				TargetID:                   "rubygems",
+				TargetName:                 fmt.Sprintf("deps(bundler): bump %q to {{ source %q }}", g.Name, "rubygems"),
+				// Patterns are rendered within yaml single quoted strings
+				TargetMatchPattern:   strings.ReplaceAll(g.matchPattern, "'", "''"),
+				TargetReplacePattern: sourceReplacePattern,
+				File:                 relativeFile,
+				LockFile:             lockFile,
+				LockTargetName:       fmt.Sprintf("deps(bundler): update %s following bump of %q to {{ source %q }}", lockFile, g.Name, "rubygems"),
+				LockCommand:          getLockCommand(g.Name),
+				WorkDir:              filepath.Dir(relativeFile),
+				ScmID:                b.scmID,
+			}
+
+			manifest := bytes.Buffer{}
+			if err := tmpl.Execute(&manifest, params); err != nil {
+				logrus.Debugln(err)
+				continue
+			}
+
+			manifests = append(manifests, manifest.Bytes())
+		}
+	}
+
+	logrus.Printf("%v manifests identified", len(manifests))
+
+	return manifests, nil
+}
+
+// segmentsPattern returns the regular expression capturing, at most, as many release segments as defined in v
+func segmentsPattern(v string) string {
+	segments := 0
+	for _, s := range strings.Split(v, ".") {
+		if s == "" || strings.Trim(s, "0123456789") != "" {
+			break
+		}
+		segments++
+	}
+
+	if segments == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf(`^(\d+(?:\.\d+){0,%d})`, segments-1)
+}
@@ -0,0 +1,106 @@
+package bundler
+
+import (
+	"bufio"
+	"bytes"
+	"regexp"
+	"strings"
+)
+
+var (
+	// gemRegex matches a gem declaration such as `gem "rails", "~> 7.1.0", require: false`
+	// capturing the declaration up to the gem name, the quoted requirements, and the remaining options
+	gemRegex = regexp.MustCompile(`^(\s*gem\s*\(?\s*["']([^"']+)["'])((?:\s*,\s*["'][^"']*["'])*)(.*)$`)
+	// requirementRegex matches a quoted requirement, capturing its operator and version
+	requirementRegex = regexp.MustCompile(`\s*,\s*(["'])\s*(~>|>=|<=|!=|=|>|<)?\s*([^"']*?)\s*["']`)
+	// sourceOptionRegex matches gem options installing a gem from somewhere else than a gem server
+	sourceOptionRegex = regexp.MustCompile(`\b(git|github|gitlab|bitbucket|path)\s*(:|=>)`)
+	// lockedSpecRegex matches a gem resolved in a Gemfile.lock such as "    rails (7.1.3)" or "    nokogiri (1.16.8-x86_64-linux)"
+	lockedSpecRegex = regexp.MustCompile(`^    ([^\s(]+) \(([^)\s-]+)(?:-[^)]+)?\)$`)
+)
+
+// requirement is a single gem requirement such as "~> 7.1.0"
+type requirement struct {
+	// Operator is the requirement operator, empty if not specified
+	Operator string
+	// Version is the requirement version
+	Version string
+}
+
+// String returns the requirement as used by the rubygems version filter
+func (r requirement) String() string {
+	if r.Operator == "" {
+		return r.Version
+	}
+	return r.Operator + " " + r.Version
+}
+
+// gem is a gem declared in a Gemfile
+type gem struct {
+	// Name is the gem name
+	Name string
+	// Requirements holds the gem requirements, such as "~> 7.1" or ">= 1.0"
+	Requirements []requirement
+	// matchPattern is the regular expression matching the requirement version,
+	// surrounded by two capture groups, if the requirement can be rewritten
+	matchPattern string
+}
+
+// parseGemfile returns the gems declared in a Gemfile.
+// Gems installed from a git repository or a local path are ignored.
+func parseGemfile(content []byte) []gem {
+	var gems []gem
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		m := gemRegex.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+
+		if sourceOptionRegex.MatchString(m[4]) {
+			continue
+		}
+
+		g := gem{Name: m[2]}
+
+		for _, r := range requirementRegex.FindAllStringSubmatch(m[3], -1) {
+			g.Requirements = append(g.Requirements, requirement{Operator: r[2], Version: r[3]})
+		}
+
+		// Only a single pinned or pessimistic requirement is rewritten, other requirements
+		// such as ">= 1.0" already accept newer versions
+		if len(g.Requirements) == 1 {
+			switch g.Requirements[0].Operator {
+			case "", "=", "~>":
+				loc := requirementRegex.FindStringSubmatchIndex(m[3])
+				prefix := m[1] + m[3][:loc[6]]
+				g.matchPattern = "(?m)^(" + regexp.QuoteMeta(prefix) + ")" +
+					regexp.QuoteMeta(g.Requirements[0].Version) +
+					"(" + regexp.QuoteMeta(m[3][loc[7]:loc[1]]) + ")"
+			}
+		}
+
+		gems = append(gems, g)
+	}
+
+	return gems
+}
+
+// parseGemfileLock returns the gem versions resolved in a Gemfile.lock, indexed by gem name
+func parseGemfileLock(content []byte) map[string]string {
+	versions := map[string]string{}
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		m := lockedSpecRegex.FindStringSubmatch(strings.TrimRight(scanner.Text(), "\r"))
+		if m == nil {
+			continue
+		}
+		versions[m[1]] = m[2]
+	}
+
+	return versions
+}
@@ -0,0 +1,88 @@
+package bundler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseGemfile(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected []gem
+	}{
+		{
+			name:    "Pinned requirement with options",
+			content: `gem 'puma', '6.4.0', require: false`,
+			expected: []gem{
+				{
+					Name:         "puma",
+					Requirements: []requirement{{Version: "6.4.0"}},
+					matchPattern: `(?m)^(gem 'puma', ')6\.4\.0(')`,
+				},
+			},
+		},
+		{
+			name:    "Pessimistic requirement with parenthesis",
+			content: `  gem("rails",  "~>7.1" )`,
+			expected: []gem{
+				{
+					Name:         "rails",
+					Requirements: []requirement{{Operator: "~>", Version: "7.1"}},
+					matchPattern: `(?m)^(  gem\("rails",  "~>)7\.1(")`,
+				},
+			},
+		},
+		{
+			name:    "Several requirements are not rewritten",
+			content: `gem "pg", ">= 0.18", "< 2.0"`,
+			expected: []gem{
+				{
+					Name:         "pg",
+					Requirements: []requirement{{Operator: ">=", Version: "0.18"}, {Operator: "<", Version: "2.0"}},
+				},
+			},
+		},
+		{
+			name:     "Git and path gems are ignored",
+			content:  "gem \"internal\", git: \"https://github.com/acme/internal.git\"\ngem 'local', :path => 'vendor/local'\n# gem 'commented', '1.0'",
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, parseGemfile([]byte(tt.content)))
+		})
+	}
+}
+
+func TestParseGemfileLock(t *testing.T) {
+	content := `GEM
+  remote: https://rubygems.org/
+  specs:
+    nokogiri (1.16.8-x86_64-linux)
+      racc (~> 1.4)
+    racc (1.8.1)
+    rails (7.1.0.rc1)
+
+DEPENDENCIES
+  nokogiri
+  rails (~> 7.1.0.rc1)
+`
+
+	assert.Equal(t,
+		map[string]string{
+			"nokogiri": "1.16.8",
+			"racc":     "1.8.1",
+			"rails":    "7.1.0.rc1",
+		},
+		parseGemfileLock([]byte(content)))
+}
+
+func TestSegmentsPattern(t *testing.T) {
+	assert.Equal(t, `^(\d+(?:\.\d+){0,0})`, segmentsPattern("7"))
+	assert.Equal(t, `^(\d+(?:\.\d+){0,2})`, segmentsPattern("7.1.0.rc1"))
+	assert.Equal(t, "", segmentsPattern("latest"))
+}
@@ -0,0 +1,119 @@
+package bundler
+
+import (
+	"path"
+	"strings"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+// Spec defines the parameters which can be provided to the Bundler crawler.
+type Spec struct {
+	// RootDir defines the root directory used to recursively search for Gemfile files
+	RootDir string `yaml:",omitempty"`
+	// Ignore allows to specify rule to ignore autodiscovery a specific gem based on a rule
+	Ignore MatchingRules `yaml:",omitempty"`
+	// Only allows to specify rule to only autodiscover manifest for a specific gem based on a rule
+	Only MatchingRules `yaml:",omitempty"`
+	/*
+		versionfilter provides parameters to specify the version pattern used when generating manifest.
+
+		kind - rubygems
+			versionfilter of kind `rubygems` uses gem requirements as version filtering
+			pattern accepts one of:
+				`patch` - patch only update patch version
+				`minor` - minor only update minor version
+				`major` - major only update major versions
+				`a gem requirement` such as `~> 7.1`
+
+		kind - regex
+			versionfilter of kind `regex` uses regular expression as version filtering
+			pattern accepts a valid regular expression
+
+		example:
+		```
+			versionfilter:
+				kind: rubygems
+				pattern: minor
+		```
+
+		and its type like regex, rubygems, or just latest.
+
+		remark:
+			The versionfilter only applies to gems with a pinned, such as "7.1.3", or pessimistic, such as "~> 7.1", requirement.
+			Other gems are updated in Gemfile.lock within their requirements.
+	*/
+	VersionFilter version.Filter `yaml:",omitempty"`
+	// URL defines the gem server used by the generated manifests
+	//
+	// default: https://rubygems.org/
+	URL string `yaml:",omitempty"`
+}
+
+// Bundler holds all information needed to generate gem manifests.
+type Bundler struct {
+	// actionID holds the actionID used by the newly generated manifest
+	actionID string
+	// spec defines the settings provided via an updatecli manifest
+	spec Spec
+	// rootDir defines the root directory from where looking for Gemfile files
+	rootDir string
+	// scmID holds the scmID used by the newly generated manifest
+	scmID string
+	// versionFilter holds the "valid" version.filter, that might be different from the user-specified filter (Spec.VersionFilter)
+	versionFilter version.Filter
+}
+
+// New return a new valid object.
+func New(spec interface{}, rootDir, scmID, actionID string) (Bundler, error) {
+	var s Spec
+
+	err := mapstructure.Decode(spec, &s)
+	if err != nil {
+		return Bundler{}, err
+	}
+
+	dir := rootDir
+	if path.IsAbs(s.RootDir) {
+		if scmID != "" {
+			logrus.Warningf("rootdir %q is an absolute path, scmID %q will be ignored", s.RootDir, scmID)
+		}
+		dir = s.RootDir
+	}
+
+	if len(dir) == 0 {
+		logrus.Errorln("no working directory defined")
+		return Bundler{}, err
+	}
+
+	newFilter := s.VersionFilter
+	if s.VersionFilter.IsZero() {
+		newFilter.Kind = version.RUBYGEMSVERSIONKIND
+		newFilter.Pattern = "*"
+	}
+
+	return Bundler{
+		actionID:      actionID,
+		spec:          s,
+		rootDir:       dir,
+		scmID:         scmID,
+		versionFilter: newFilter,
+	}, nil
+
+}
+
+func (b Bundler) DiscoverManifests() ([][]byte, error) {
+
+	logrus.Infof("\n\n%s\n", strings.ToTitle("Bundler"))
+	logrus.Infof("%s\n", strings.Repeat("=", len("Bundler")+1))
+
+	manifests, err := b.discoverDependencyManifests()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return manifests, nil
+}
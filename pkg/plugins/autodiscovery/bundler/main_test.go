@@ -0,0 +1,272 @@
+package bundler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+func TestDiscoverManifests(t *testing.T) {
+	// Lock file targets are only generated when bundler is installed
+	defaultIsCommandAvailable := isCommandAvailable
+	isCommandAvailable = func(string) bool { return true }
+	t.Cleanup(func() {
+		isCommandAvailable = defaultIsCommandAvailable
+	})
+
+	testdata := []struct {
+		name              string
+		rootDir           string
+		spec              Spec
+		expectedPipelines []string
+	}{
+		{
+			name:    "No lock file",
+			rootDir: "testdata/nolockfile",
+			expectedPipelines: []string{`name: 'Bump gem "rails"'
+sources:
+  rubygems:
+    name: 'Get gem "rails" latest version'
+    kind: 'rubygems'
+    spec:
+      name: 'rails'
+      versionfilter:
+        kind: 'rubygems'
+        pattern: '>= 7.1.0'
+    transformers:
+      - findsubmatch:
+          pattern: '^(\d+(?:\.\d+){0,2})'
+          captureindex: 1
+targets:
+  rubygems:
+    name: 'deps(bundler): bump "rails" to {{ source "rubygems" }}'
+    kind: 'file'
+    spec:
+      file: 'Gemfile'
+      matchpattern: '(?m)^(gem "rails", "~> )7\.1\.0(")'
+      replacepattern: '${1}{{ source "rubygems" }}${2}'
+    sourceid: 'rubygems'
+`, `name: 'Bump gem "puma"'
+sources:
+  rubygems:
+    name: 'Get gem "puma" latest version'
+    kind: 'rubygems'
+    spec:
+      name: 'puma'
+      versionfilter:
+        kind: 'rubygems'
+        pattern: '>= 6.4.0'
+targets:
+  rubygems:
+    name: 'deps(bundler): bump "puma" to {{ source "rubygems" }}'
+    kind: 'file'
+    spec:
+      file: 'Gemfile'
+      matchpattern: '(?m)^(gem ''puma'', '')6\.4\.0('')'
+      replacepattern: '${1}{{ source "rubygems" }}${2}'
+    sourceid: 'rubygems'
+`, `name: 'Bump gem "rspec-rails"'
+sources:
+  rubygems:
+    name: 'Get gem "rspec-rails" latest version'
+    kind: 'rubygems'
+    spec:
+      name: 'rspec-rails'
+      versionfilter:
+        kind: 'rubygems'
+        pattern: '>= 6.1'
+    transformers:
+      - findsubmatch:
+          pattern: '^(\d+(?:\.\d+){0,1})'
+          captureindex: 1
+targets:
+  rubygems:
+    name: 'deps(bundler): bump "rspec-rails" to {{ source "rubygems" }}'
+    kind: 'file'
+    spec:
+      file: 'Gemfile'
+      matchpattern: '(?m)^(  gem ''rspec-rails'', ''~> )6\.1('')'
+      replacepattern: '${1}{{ source "rubygems" }}${2}'
+    sourceid: 'rubygems'
+`},
+		},
+		{
+			name:    "No lock file with version filter",
+			rootDir: "testdata/nolockfile",
+			spec: Spec{
+				Only: MatchingRules{
+					{Gems: map[string]string{"rails": ""}},
+				},
+				VersionFilter: version.Filter{
+					Kind:    version.RUBYGEMSVERSIONKIND,
+					Pattern: "minor",
+				},
+			},
+			expectedPipelines: []string{`name: 'Bump gem "rails"'
+sources:
+  rubygems:
+    name: 'Get gem "rails" latest version'
+    kind: 'rubygems'
+    spec:
+      name: 'rails'
+      versionfilter:
+        kind: 'rubygems'
+        pattern: '>= 7.1.0, < 8'
+    transformers:
+      - findsubmatch:
+          pattern: '^(\d+(?:\.\d+){0,2})'
+          captureindex: 1
+targets:
+  rubygems:
+    name: 'deps(bundler): bump "rails" to {{ source "rubygems" }}'
+    kind: 'file'
+    spec:
+      file: 'Gemfile'
+      matchpattern: '(?m)^(gem "rails", "~> )7\.1\.0(")'
+      replacepattern: '${1}{{ source "rubygems" }}${2}'
+    sourceid: 'rubygems'
+`},
+		},
+		{
+			name:    "Lock file",
+			rootDir: "testdata/lockfile",
+			expectedPipelines: []string{`name: 'Bump gem "sinatra"'
+sources:
+  rubygems:
+    name: 'Get gem "sinatra" latest version'
+    kind: 'rubygems'
+    spec:
+      name: 'sinatra'
+      versionfilter:
+        kind: 'rubygems'
+        pattern: '>= 4.0'
+    transformers:
+      - findsubmatch:
+          pattern: '^(\d+(?:\.\d+){0,1})'
+          captureindex: 1
+targets:
+  rubygems:
+    name: 'deps(bundler): bump "sinatra" to {{ source "rubygems" }}'
+    kind: 'file'
+    spec:
+      file: 'Gemfile'
+      matchpattern: '(?m)^(gem "sinatra", "~> )4\.0(")'
+      replacepattern: '${1}{{ source "rubygems" }}${2}'
+    sourceid: 'rubygems'
+  Gemfile.lock:
+    name: 'deps(bundler): update Gemfile.lock following bump of "sinatra" to {{ source "rubygems" }}'
+    kind: 'shell'
+    dependson:
+      - 'rubygems'
+    disablesourceinput: true
+    spec:
+      command: |
+        ARGS=""
+        if [ "$DRY_RUN" = "true" ]; then
+          ARGS="--print"
+        fi
+        bundle lock $ARGS --conservative --update 'sinatra'
+      changedif:
+        kind: 'file/checksum'
+        spec:
+          files:
+            - 'Gemfile.lock'
+      environments:
+        - name: HOME
+        - name: PATH
+      workdir: '.'
+`, `name: 'Bump gem "rack"'
+sources:
+  rubygems:
+    name: 'Get gem "rack" latest version'
+    kind: 'rubygems'
+    spec:
+      name: 'rack'
+      versionfilter:
+        kind: 'rubygems'
+        pattern: '>= 3.0'
+targets:
+  Gemfile.lock:
+    name: 'deps(bundler): update Gemfile.lock following bump of "rack" to {{ source "rubygems" }}'
+    kind: 'shell'
+    disablesourceinput: true
+    spec:
+      command: |
+        ARGS=""
+        if [ "$DRY_RUN" = "true" ]; then
+          ARGS="--print"
+        fi
+        bundle lock $ARGS --conservative --update 'rack'
+      changedif:
+        kind: 'file/checksum'
+        spec:
+          files:
+            - 'Gemfile.lock'
+      environments:
+        - name: HOME
+        - name: PATH
+      workdir: '.'
+`},
+		},
+		{
+			name:    "Lock file with only rule on locked version",
+			rootDir: "testdata/lockfile",
+			spec: Spec{
+				Only: MatchingRules{
+					{Gems: map[string]string{"rack": "~> 3.0.10"}},
+				},
+			},
+			expectedPipelines: []string{`name: 'Bump gem "rack"'
+sources:
+  rubygems:
+    name: 'Get gem "rack" latest version'
+    kind: 'rubygems'
+    spec:
+      name: 'rack'
+      versionfilter:
+        kind: 'rubygems'
+        pattern: '>= 3.0'
+targets:
+  Gemfile.lock:
+    name: 'deps(bundler): update Gemfile.lock following bump of "rack" to {{ source "rubygems" }}'
+    kind: 'shell'
+    disablesourceinput: true
+    spec:
+      command: |
+        ARGS=""
+        if [ "$DRY_RUN" = "true" ]; then
+          ARGS="--print"
+        fi
+        bundle lock $ARGS --conservative --update 'rack'
+      changedif:
+        kind: 'file/checksum'
+        spec:
+          files:
+            - 'Gemfile.lock'
+      environments:
+        - name: HOME
+        - name: PATH
+      workdir: '.'
+`},
+		},
+	}
+
+	for _, tt := range testdata {
+		t.Run(tt.name, func(t *testing.T) {
+			resource, err := New(
+				tt.spec, tt.rootDir, "", "")
+			require.NoError(t, err)
+
+			pipelines, err := resource.DiscoverManifests()
+			require.NoError(t, err)
+
+			require.Equal(t, len(tt.expectedPipelines), len(pipelines))
+
+			for i, expectedPipeline := range tt.expectedPipelines {
+				assert.Equal(t, expectedPipeline, string(pipelines[i]))
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package bundler
+
+var (
+	// manifestTemplate is the Go template used to generate gem manifests
+	manifestTemplate string = `name: '{{ .ManifestName }}'
+{{- if .ActionID }}
+actions:
+  {{ .ActionID }}:
+    title: '{{ .TargetName }}'
+{{ end }}
+sources:
+  {{ .SourceID }}:
+    name: '{{ .SourceName }}'
+    kind: 'rubygems'
+    spec:
+      name: '{{ .SourceGem }}'
+{{- if .SourceURL }}
+      url: '{{ .SourceURL }}'
+{{- end }}
+      versionfilter:
+        kind: '{{ .SourceVersionFilterKind }}'
+        pattern: '{{ .SourceVersionFilterPattern }}'
+{{- if .SourceSegmentsPattern }}
+    transformers:
+      - findsubmatch:
+          pattern: '{{ .SourceSegmentsPattern }}'
+          captureindex: 1
+{{- end }}
+targets:
+{{- if .TargetMatchPattern }}
+  {{ .TargetID }}:
+    name: '{{ .TargetName }}'
+    kind: 'file'
+{{- if .ScmID }}
+    scmid: '{{ .ScmID }}'
+{{ end }}
+    spec:
+      file: '{{ .File }}'
+      matchpattern: '{{ .TargetMatchPattern }}'
+      replacepattern: '{{ .TargetReplacePattern }}'
+    sourceid: '{{ .SourceID }}'
+{{- end }}
+{{- if .LockFile }}
+  {{ .LockFile }}:
+    name: '{{ .LockTargetName }}'
+    kind: 'shell'
+{{- if .ScmID }}
+    scmid: '{{ .ScmID }}'
+{{ end }}
+{{- if .TargetMatchPattern }}
+    dependson:
+      - '{{ .TargetID }}'
+{{- end }}
+    disablesourceinput: true
+    spec:
+      command: |
+        ARGS=""
+        if [ "$DRY_RUN" = "true" ]; then
+          ARGS="--print"
+        fi
+        {{ .LockCommand }}
+      changedif:
+        kind: 'file/checksum'
+        spec:
+          files:
+            - '{{ .LockFile }}'
+      environments:
+        - name: HOME
+        - name: PATH
+      workdir: '{{ .WorkDir }}'
+{{- end }}
+`
+)
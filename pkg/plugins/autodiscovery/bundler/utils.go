@@ -0,0 +1,90 @@
+package bundler
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// gemfile is the file used by Bundler to declare gems
+	gemfile string = "Gemfile"
+	// gemfileLock is the lock file generated by Bundler
+	gemfileLock string = "Gemfile.lock"
+)
+
+var (
+	// ignoredDirectories lists directories that never contain project Gemfiles
+	ignoredDirectories = []string{".git", ".bundle", "vendor", "node_modules"}
+
+	// isCommandAvailable checks if a command can be executed, it's a variable so tests can override it
+	isCommandAvailable = func(name string) bool {
+		return exec.Command(name, "--version").Run() == nil
+	}
+)
+
+// searchGemfiles looks, recursively, for every files named Gemfile from a root directory.
+func searchGemfiles(rootDir string) ([]string, error) {
+
+	foundFiles := []string{}
+
+	logrus.Debugf("Looking for Gemfile files in %q", rootDir)
+
+	err := filepath.WalkDir(rootDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			fmt.Printf("prevent panic by handling failure accessing a path %q: %v\n", path, err)
+			return err
+		}
+
+		if d.IsDir() {
+			for _, ignored := range ignoredDirectories {
+				if d.Name() == ignored {
+					return filepath.SkipDir
+				}
+			}
+			return nil
+		}
+
+		if d.Name() == gemfile {
+			foundFiles = append(foundFiles, path)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return foundFiles, nil
+}
+
+// getLockFile returns the Gemfile.lock found next to a Gemfile, if Bundler is available to update it
+func getLockFile(gemfilePath string) string {
+	dir := filepath.Dir(gemfilePath)
+
+	if !isFileExist(filepath.Join(dir, gemfileLock)) {
+		return ""
+	}
+
+	if !isCommandAvailable("bundle") {
+		logrus.Warningf("%q detected in %q but bundler is not installed, skipping lock file update", gemfileLock, dir)
+		return ""
+	}
+
+	return gemfileLock
+}
+
+// getLockCommand returns the command updating a gem in Gemfile.lock without updating its dependencies shared with other gems
+func getLockCommand(gemName string) string {
+	return fmt.Sprintf("bundle lock $ARGS --conservative --update '%s'", gemName)
+}
+
+func isFileExist(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
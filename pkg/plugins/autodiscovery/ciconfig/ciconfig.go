@@ -0,0 +1,214 @@
+package ciconfig
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	goyaml "gopkg.in/yaml.v3"
+)
+
+const (
+	// referenceImage is a Docker image such as "golang:1.22"
+	referenceImage string = "image"
+	// referenceInclude is a GitLab project file included at a specific ref
+	referenceInclude string = "include"
+	// referenceComponent is a GitLab CI component such as "gitlab.com/components/sast/sast@2.0.2"
+	referenceComponent string = "component"
+)
+
+var (
+	// plainKeyRegex matches the yaml keys which don't need to be quoted in a yaml path
+	plainKeyRegex = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+)
+
+// reference describes a versioned artifact found in a CI configuration file
+type reference struct {
+	// kind is one of referenceImage, referenceInclude, or referenceComponent
+	kind string
+	// value holds the artifact reference, such as "golang:1.22" or "gitlab.com/components/sast/sast@2.0.2"
+	value string
+	// project holds the GitLab project path of an include
+	project string
+	// key holds the yaml path of value, such as "$.build.image"
+	key string
+}
+
+// loadReferences reads a CI configuration file for references that could be automatically updated.
+func loadReferences(filename, flavor string) ([]reference, error) {
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var document goyaml.Node
+	if err := goyaml.Unmarshal(content, &document); err != nil {
+		return nil, fmt.Errorf("parsing %q: %w", filename, err)
+	}
+
+	if document.Kind != goyaml.DocumentNode || len(document.Content) == 0 {
+		return nil, nil
+	}
+
+	root := document.Content[0]
+
+	references := searchImages(root, "$", flavor)
+	if flavor == FlavorGitLabCI {
+		references = append(references, searchIncludes(root)...)
+	}
+
+	return references, nil
+}
+
+// searchImages recursively looks for "image" keys, either defined as a string or as a map with a "name" key.
+// GitLab CI services, defined as a list of images, are also returned.
+func searchImages(node *goyaml.Node, key, flavor string) []reference {
+	var references []reference
+
+	switch node.Kind {
+	case goyaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			name := node.Content[i].Value
+			value := node.Content[i+1]
+
+			childKey := yamlPathChild(key, name)
+			if childKey == "" {
+				continue
+			}
+
+			switch {
+			case name == "image":
+				if ref, ok := imageReference(value, childKey); ok {
+					references = append(references, ref)
+				}
+
+			case name == "services" && flavor == FlavorGitLabCI && value.Kind == goyaml.SequenceNode:
+				for j, service := range value.Content {
+					if ref, ok := imageReference(service, fmt.Sprintf("%s[%d]", childKey, j)); ok {
+						references = append(references, ref)
+					}
+				}
+
+			case name == "include" && flavor == FlavorGitLabCI && key == "$":
+				// includes are handled by searchIncludes
+
+			default:
+				references = append(references, searchImages(value, childKey, flavor)...)
+			}
+		}
+
+	case goyaml.SequenceNode:
+		for i, item := range node.Content {
+			references = append(references, searchImages(item, fmt.Sprintf("%s[%d]", key, i), flavor)...)
+		}
+	}
+
+	return references
+}
+
+// imageReference returns the image defined by node, either as a string or as a map with a "name" key
+func imageReference(node *goyaml.Node, key string) (reference, bool) {
+	switch node.Kind {
+	case goyaml.ScalarNode:
+		// Images defined using variables, such as "$CI_REGISTRY_IMAGE:latest", can't be updated
+		if node.Value == "" || strings.Contains(node.Value, "$") {
+			return reference{}, false
+		}
+		return reference{kind: referenceImage, value: node.Value, key: key}, true
+
+	case goyaml.MappingNode:
+		if name := mappingValue(node, "name"); name != nil {
+			return imageReference(name, key+".name")
+		}
+	}
+
+	return reference{}, false
+}
+
+// searchIncludes returns the GitLab project includes and CI components defined by the top level "include" key
+func searchIncludes(root *goyaml.Node) []reference {
+	include := mappingValue(root, "include")
+	if include == nil {
+		return nil
+	}
+
+	var keys []string
+	var items []*goyaml.Node
+
+	switch include.Kind {
+	case goyaml.MappingNode:
+		keys = append(keys, "$.include")
+		items = append(items, include)
+	case goyaml.SequenceNode:
+		for i, item := range include.Content {
+			keys = append(keys, fmt.Sprintf("$.include[%d]", i))
+			items = append(items, item)
+		}
+	}
+
+	var references []reference
+	for i, item := range items {
+		key := keys[i]
+		if item.Kind != goyaml.MappingNode {
+			continue
+		}
+
+		if component := mappingValue(item, "component"); component != nil && component.Kind == goyaml.ScalarNode {
+			references = append(references, reference{
+				kind:  referenceComponent,
+				value: component.Value,
+				key:   key + ".component",
+			})
+			continue
+		}
+
+		project := mappingValue(item, "project")
+		ref := mappingValue(item, "ref")
+		if project == nil || ref == nil || project.Kind != goyaml.ScalarNode || ref.Kind != goyaml.ScalarNode {
+			continue
+		}
+
+		if strings.Contains(project.Value, "$") || strings.Contains(ref.Value, "$") {
+			continue
+		}
+
+		references = append(references, reference{
+			kind:    referenceInclude,
+			value:   ref.Value,
+			project: project.Value,
+			key:     key + ".ref",
+		})
+	}
+
+	return references
+}
+
+// mappingValue returns the value of key in a yaml mapping node, or nil if it is not defined
+func mappingValue(node *goyaml.Node, key string) *goyaml.Node {
+	if node.Kind != goyaml.MappingNode {
+		return nil
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+
+	return nil
+}
+
+// yamlPathChild returns the yaml path of key under parent, quoting key if needed such as "$.'test:unit job'".
+// It returns an empty string if key can't be represented in a yaml path.
+func yamlPathChild(parent, key string) string {
+	if plainKeyRegex.MatchString(key) {
+		return parent + "." + key
+	}
+
+	if key == "" || strings.Contains(key, "'") {
+		return ""
+	}
+
+	return fmt.Sprintf("%s.'%s'", parent, key)
+}
@@ -0,0 +1,216 @@
+package ciconfig
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/docker"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+var (
+	FlavorGitLabCI           string = "gitlab/ci"
+	FlavorWoodpecker         string = "woodpecker"
+	FlavorBitbucketPipelines string = "bitbucket/pipelines"
+
+	// flavors specifies the settings of each supported CI configuration format
+	flavors = map[string]flavorSettings{
+		FlavorGitLabCI: {
+			title:    "GitLab CI",
+			targetID: "gitlabci",
+			files:    []string{".gitlab-ci.yml"},
+		},
+		FlavorWoodpecker: {
+			title:    "Woodpecker",
+			targetID: "woodpecker",
+			files:    []string{".woodpecker.yml", ".woodpecker.yaml", ".woodpecker/*.yml", ".woodpecker/*.yaml"},
+		},
+		FlavorBitbucketPipelines: {
+			title:    "Bitbucket Pipelines",
+			targetID: "bitbucket",
+			files:    []string{"bitbucket-pipelines.yml"},
+		},
+	}
+	defaultVersionFilterPattern string = "*"
+	defaultVersionFilterKind    string = "semver"
+)
+
+// flavorSettings holds the settings specific to a CI configuration format
+type flavorSettings struct {
+	// title is displayed when discovering manifests
+	title string
+	// targetID is the identifier of the generated manifest targets
+	targetID string
+	// files specifies the default accepted CI configuration file names
+	files []string
+}
+
+// Spec defines the parameters which can be provided to the GitLab CI, Woodpecker, and Bitbucket Pipelines crawlers.
+type Spec struct {
+	// files allows to specify the accepted CI configuration file names.
+	// A pattern containing a "/" is matched against the file path relative to the root directory,
+	// otherwise it is matched against the file name.
+	//
+	// default:
+	//   gitlab/ci:
+	//     - ".gitlab-ci.yml"
+	//   woodpecker:
+	//     - ".woodpecker.yml"
+	//     - ".woodpecker.yaml"
+	//     - ".woodpecker/*.yml"
+	//     - ".woodpecker/*.yaml"
+	//   bitbucket/pipelines:
+	//     - "bitbucket-pipelines.yml"
+	Files []string `yaml:",omitempty"`
+	// ignore allows to specify rule to ignore autodiscovery a specific Docker image, GitLab include, or GitLab CI component based on a rule
+	//
+	// default: empty
+	//
+	Ignore MatchingRules `yaml:",omitempty"`
+	// only allows to specify rule to only autodiscover manifest for a specific Docker image, GitLab include, or GitLab CI component based on a rule
+	//
+	// default: empty
+	//
+	Only MatchingRules `yaml:",omitempty"`
+	// rootDir allows to specify the root directory from where looking for CI configuration files
+	//
+	// default: empty
+	RootDir string `yaml:",omitempty"`
+	// versionfilter provides parameters to specify the version pattern used when generating manifest.
+	//
+	// kind - semver
+	//		versionfilter of kind `semver` uses semantic versioning as version filtering
+	//		pattern accepts one of:
+	//			`patch` - patch only update patch version
+	//			`minor` - minor only update minor version
+	//			`major` - major only update major versions
+	//			`a version constraint` such as `>= 1.0.0`
+	//
+	//	kind - regex
+	//		versionfilter of kind `regex` uses regular expression as version filtering
+	//		pattern accepts a valid regular expression
+	//
+	//	example:
+	//	```
+	//		versionfilter:
+	//			kind: semver
+	//			pattern: minor
+	//	```
+	//
+	//	and its type like regex, semver, or just latest.
+	//
+	VersionFilter version.Filter `yaml:",omitempty"`
+	// CredentialsDocker provides a map of registry credentials where the key is the registry URL without scheme
+	CredentialsDocker map[string]docker.InlineKeyChain `yaml:",omitempty"`
+	// Digest provides parameters to specify if the generated manifest should pin Docker images using a digest on top of the tag.
+	//
+	// default: false
+	Digest *bool `yaml:",omitempty"`
+	// url defines the GitLab instance hosting the projects referenced by "include:project" and "$CI_SERVER_FQDN" CI components
+	//
+	// compatible:
+	//   * gitlab/ci
+	//
+	// default: "gitlab.com"
+	URL string `yaml:",omitempty"`
+	// token defines the token used to authenticate with the GitLab instance
+	//
+	// compatible:
+	//   * gitlab/ci
+	//
+	// default:
+	//   The default value is set to first environment detected
+	//   1. "UPDATECLI_GITLAB_TOKEN"
+	//   2. "GITLAB_TOKEN"
+	Token string `yaml:",omitempty"`
+}
+
+// CIConfig holds all information needed to generate manifests for CI configuration files.
+type CIConfig struct {
+	// actionID holds the value of the actionID parameter
+	actionID string
+	// digest holds the value of the digest parameter
+	digest bool
+	// files holds the list of accepted CI configuration file names
+	files []string
+	// flavor holds which CI configuration format to analyze, either gitlab/ci, woodpecker, or bitbucket/pipelines
+	flavor string
+	// rootDir defines the root directory from where looking for CI configuration files
+	rootDir string
+	// scmID hold the scmID used by the newly generated manifest
+	scmID string
+	// spec defines the settings provided via an updatecli manifest
+	spec Spec
+	// versionFilter holds the "valid" version.filter, that might be different from the user-specified filter (Spec.VersionFilter)
+	versionFilter version.Filter
+}
+
+// New return a new valid CIConfig object.
+func New(spec interface{}, rootDir, scmID, actionID, flavor string) (CIConfig, error) {
+	var s Spec
+
+	settings, ok := flavors[flavor]
+	if !ok {
+		return CIConfig{}, fmt.Errorf("unsupported CI configuration flavor %q", flavor)
+	}
+
+	err := mapstructure.Decode(spec, &s)
+	if err != nil {
+		return CIConfig{}, err
+	}
+
+	dir := rootDir
+	if path.IsAbs(s.RootDir) {
+		if scmID != "" {
+			logrus.Warningf("rootdir %q is an absolute path, scmID %q will be ignored", s.RootDir, scmID)
+		}
+		dir = s.RootDir
+	}
+
+	// If no RootDir have been provided via settings,
+	// then fallback to the current process path.
+	if len(dir) == 0 {
+		logrus.Errorln("no working directory defined")
+		return CIConfig{}, err
+	}
+
+	files := settings.files
+	if len(s.Files) > 0 {
+		files = s.Files
+	}
+
+	newFilter := s.VersionFilter
+	if s.VersionFilter.IsZero() {
+		// By default, Docker image tags and GitLab references use semantic versioning.
+		newFilter.Kind = defaultVersionFilterKind
+		newFilter.Pattern = defaultVersionFilterPattern
+	}
+
+	digest := false
+	if s.Digest != nil {
+		digest = *s.Digest
+	}
+
+	return CIConfig{
+		actionID:      actionID,
+		digest:        digest,
+		files:         files,
+		flavor:        flavor,
+		rootDir:       dir,
+		scmID:         scmID,
+		spec:          s,
+		versionFilter: newFilter,
+	}, nil
+}
+
+func (c CIConfig) DiscoverManifests() ([][]byte, error) {
+	title := flavors[c.flavor].title
+
+	logrus.Infof("\n\n%s\n", strings.ToTitle(title))
+	logrus.Infof("%s\n", strings.Repeat("=", len(title)+1))
+
+	return c.discoverManifests()
+}
@@ -0,0 +1,387 @@
+package ciconfig
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiscoverManifests(t *testing.T) {
+	digest := true
+
+	testdata := []struct {
+		name              string
+		rootDir           string
+		flavor            string
+		spec              Spec
+		expectedPipelines []string
+	}{
+		{
+			name:    "Scenario - GitLab CI",
+			rootDir: "testdata/gitlab",
+			flavor:  FlavorGitLabCI,
+			expectedPipelines: []string{`name: 'deps: bump Docker image "golang"'
+
+sources:
+  image:
+    name: 'get latest image tag for "golang"'
+    kind: 'dockerimage'
+    spec:
+      image: 'golang'
+      tagfilter: '^\d*(\.\d*){2}$'
+      versionfilter:
+        kind: 'semver'
+        pattern: '>=1.22.1'
+targets:
+  gitlabci:
+    name: 'deps: bump Docker image "golang" to {{ source "image" }}'
+    kind: 'yaml'
+    spec:
+      file: '.gitlab-ci.yml'
+      key: '$.default.image'
+    sourceid: 'image'
+    transformers:
+      - addprefix: 'golang:'
+`, `name: 'deps: bump Docker image "node"'
+
+sources:
+  image:
+    name: 'get latest image tag for "node"'
+    kind: 'dockerimage'
+    spec:
+      image: 'node'
+      tagfilter: '^\d*(\.\d*){2}$'
+      versionfilter:
+        kind: 'semver'
+        pattern: '>=20.11.0'
+targets:
+  gitlabci:
+    name: 'deps: bump Docker image "node" to {{ source "image" }}'
+    kind: 'yaml'
+    spec:
+      file: '.gitlab-ci.yml'
+      key: '$.''test:unit job''.image.name'
+    sourceid: 'image'
+    transformers:
+      - addprefix: 'node:'
+`, `name: 'deps: bump Docker image "postgres"'
+
+sources:
+  image:
+    name: 'get latest image tag for "postgres"'
+    kind: 'dockerimage'
+    spec:
+      image: 'postgres'
+      tagfilter: '^\d*(\.\d*){1}$'
+      versionfilter:
+        kind: 'semver'
+        pattern: '>=16.1'
+targets:
+  gitlabci:
+    name: 'deps: bump Docker image "postgres" to {{ source "image" }}'
+    kind: 'yaml'
+    spec:
+      file: '.gitlab-ci.yml'
+      key: '$.''test:unit job''.services[0]'
+    sourceid: 'image'
+    transformers:
+      - addprefix: 'postgres:'
+`, `name: 'deps: bump Docker image "redis"'
+
+sources:
+  image:
+    name: 'get latest image tag for "redis"'
+    kind: 'dockerimage'
+    spec:
+      image: 'redis'
+      tagfilter: '^\d*(\.\d*){2}$'
+      versionfilter:
+        kind: 'semver'
+        pattern: '>=7.2.4'
+targets:
+  gitlabci:
+    name: 'deps: bump Docker image "redis" to {{ source "image" }}'
+    kind: 'yaml'
+    spec:
+      file: '.gitlab-ci.yml'
+      key: '$.''test:unit job''.services[1].name'
+    sourceid: 'image'
+    transformers:
+      - addprefix: 'redis:'
+`, `name: 'deps: bump GitLab include "my-group/my-project"'
+
+sources:
+  tag:
+    name: 'get latest tag for GitLab project "my-group/my-project"'
+    kind: 'gitlab/tag'
+    spec:
+      owner: 'my-group'
+      repository: 'my-project'
+      versionfilter:
+        kind: 'semver'
+        pattern: '*'
+targets:
+  gitlabci:
+    name: 'deps: bump GitLab include "my-group/my-project" to {{ source "tag" }}'
+    kind: 'yaml'
+    spec:
+      file: '.gitlab-ci.yml'
+      key: '$.include[1].ref'
+    sourceid: 'tag'
+`, `name: 'deps: bump GitLab CI component "$CI_SERVER_FQDN/my-org/security-components/secret-detection"'
+
+sources:
+  release:
+    name: 'get latest release for GitLab CI component "$CI_SERVER_FQDN/my-org/security-components/secret-detection"'
+    kind: 'gitlab/release'
+    spec:
+      owner: 'my-org'
+      repository: 'security-components'
+      versionfilter:
+        kind: 'semver'
+        pattern: '*'
+targets:
+  gitlabci:
+    name: 'deps: bump GitLab CI component "$CI_SERVER_FQDN/my-org/security-components/secret-detection" to {{ source "release" }}'
+    kind: 'yaml'
+    spec:
+      file: '.gitlab-ci.yml'
+      key: '$.include[3].component'
+    sourceid: 'release'
+    transformers:
+      - addprefix: '$CI_SERVER_FQDN/my-org/security-components/secret-detection@'
+`},
+		},
+		{
+			name:    "Scenario - Woodpecker",
+			rootDir: "testdata/woodpecker",
+			flavor:  FlavorWoodpecker,
+			expectedPipelines: []string{`name: 'deps: bump Docker image "goreleaser/goreleaser"'
+
+sources:
+  image:
+    name: 'get latest image tag for "goreleaser/goreleaser"'
+    kind: 'dockerimage'
+    spec:
+      image: 'goreleaser/goreleaser'
+      tagfilter: '^v\d*(\.\d*){2}$'
+      versionfilter:
+        kind: 'semver'
+        pattern: '>=v1.24.0'
+targets:
+  woodpecker:
+    name: 'deps: bump Docker image "goreleaser/goreleaser" to {{ source "image" }}'
+    kind: 'yaml'
+    spec:
+      file: '.woodpecker/release.yaml'
+      key: '$.steps.release.image'
+    sourceid: 'image'
+    transformers:
+      - addprefix: 'goreleaser/goreleaser:'
+`, `name: 'deps: bump Docker image "golang"'
+
+sources:
+  image:
+    name: 'get latest image tag for "golang"'
+    kind: 'dockerimage'
+    spec:
+      image: 'golang'
+      tagfilter: '^\d*(\.\d*){2}$'
+      versionfilter:
+        kind: 'semver'
+        pattern: '>=1.22.1'
+targets:
+  woodpecker:
+    name: 'deps: bump Docker image "golang" to {{ source "image" }}'
+    kind: 'yaml'
+    spec:
+      file: '.woodpecker.yml'
+      key: '$.steps[0].image'
+    sourceid: 'image'
+    transformers:
+      - addprefix: 'golang:'
+`, `name: 'deps: bump Docker image "postgres"'
+
+sources:
+  image:
+    name: 'get latest image tag for "postgres"'
+    kind: 'dockerimage'
+    spec:
+      image: 'postgres'
+      tagfilter: '^\d*(\.\d*){1}$'
+      versionfilter:
+        kind: 'semver'
+        pattern: '>=16.1'
+targets:
+  woodpecker:
+    name: 'deps: bump Docker image "postgres" to {{ source "image" }}'
+    kind: 'yaml'
+    spec:
+      file: '.woodpecker.yml'
+      key: '$.services.database.image'
+    sourceid: 'image'
+    transformers:
+      - addprefix: 'postgres:'
+`},
+		},
+		{
+			name:    "Scenario - Bitbucket Pipelines",
+			rootDir: "testdata/bitbucket",
+			flavor:  FlavorBitbucketPipelines,
+			expectedPipelines: []string{`name: 'deps: bump Docker image "node"'
+
+sources:
+  image:
+    name: 'get latest image tag for "node"'
+    kind: 'dockerimage'
+    spec:
+      image: 'node'
+      tagfilter: '^\d*(\.\d*){2}$'
+      versionfilter:
+        kind: 'semver'
+        pattern: '>=20.11.0'
+targets:
+  bitbucket:
+    name: 'deps: bump Docker image "node" to {{ source "image" }}'
+    kind: 'yaml'
+    spec:
+      file: 'bitbucket-pipelines.yml'
+      key: '$.image'
+    sourceid: 'image'
+    transformers:
+      - addprefix: 'node:'
+`, `name: 'deps: bump Docker image "python"'
+
+sources:
+  image:
+    name: 'get latest image tag for "python"'
+    kind: 'dockerimage'
+    spec:
+      image: 'python'
+      tagfilter: '^\d*(\.\d*){2}$'
+      versionfilter:
+        kind: 'semver'
+        pattern: '>=3.12.1'
+targets:
+  bitbucket:
+    name: 'deps: bump Docker image "python" to {{ source "image" }}'
+    kind: 'yaml'
+    spec:
+      file: 'bitbucket-pipelines.yml'
+      key: '$.pipelines.default[0].step.image.name'
+    sourceid: 'image'
+    transformers:
+      - addprefix: 'python:'
+`},
+		},
+		{
+			name:    "Scenario - GitLab CI component hosted on a self-managed instance",
+			rootDir: "testdata/gitlab",
+			flavor:  FlavorGitLabCI,
+			spec: Spec{
+				URL:   "gitlab.example.com",
+				Token: "secret",
+				Only: MatchingRules{
+					{
+						Artifacts: map[string]string{
+							"$CI_SERVER_FQDN/my-org/security-components/secret-detection": "",
+						},
+					},
+				},
+			},
+			expectedPipelines: []string{`name: 'deps: bump GitLab CI component "$CI_SERVER_FQDN/my-org/security-components/secret-detection"'
+
+sources:
+  release:
+    name: 'get latest release for GitLab CI component "$CI_SERVER_FQDN/my-org/security-components/secret-detection"'
+    kind: 'gitlab/release'
+    spec:
+      url: 'gitlab.example.com'
+      token: 'secret'
+      owner: 'my-org'
+      repository: 'security-components'
+      versionfilter:
+        kind: 'semver'
+        pattern: '*'
+targets:
+  gitlabci:
+    name: 'deps: bump GitLab CI component "$CI_SERVER_FQDN/my-org/security-components/secret-detection" to {{ source "release" }}'
+    kind: 'yaml'
+    spec:
+      file: '.gitlab-ci.yml'
+      key: '$.include[3].component'
+    sourceid: 'release'
+    transformers:
+      - addprefix: '$CI_SERVER_FQDN/my-org/security-components/secret-detection@'
+`},
+		},
+		{
+			name:    "Scenario - Woodpecker with digest",
+			rootDir: "testdata/woodpecker",
+			flavor:  FlavorWoodpecker,
+			spec: Spec{
+				Digest: &digest,
+				Only: MatchingRules{
+					{
+						Path:      ".woodpecker.yml",
+						Artifacts: map[string]string{"golang": ""},
+					},
+				},
+			},
+			expectedPipelines: []string{`name: 'deps: bump Docker image digest for "golang"'
+
+sources:
+  image:
+    name: 'get latest image tag for "golang"'
+    kind: 'dockerimage'
+    spec:
+      image: 'golang'
+      tagfilter: '^\d*(\.\d*){2}$'
+      versionfilter:
+        kind: 'semver'
+        pattern: '>=1.22.1'
+
+  digest:
+    name: 'get image "golang" digest for tag {{ source "image" }}'
+    kind: 'dockerdigest'
+    spec:
+      image: 'golang'
+      tag: '{{ source "image" }}'
+    dependson:
+      - 'image'
+targets:
+  woodpecker:
+    name: 'deps: bump Docker image digest for "golang" to {{ source "digest" }}'
+    kind: 'yaml'
+    spec:
+      file: '.woodpecker.yml'
+      key: '$.steps[0].image'
+    sourceid: 'digest'
+    transformers:
+      - addprefix: 'golang:'
+`},
+		},
+	}
+
+	for _, tt := range testdata {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("UPDATECLI_GITLAB_TOKEN", "")
+			t.Setenv("GITLAB_TOKEN", "")
+
+			c, err := New(tt.spec, tt.rootDir, "", "", tt.flavor)
+			require.NoError(t, err)
+
+			var pipelines []string
+			rawPipelines, err := c.DiscoverManifests()
+			require.NoError(t, err)
+
+			assert.Equal(t, len(tt.expectedPipelines), len(rawPipelines))
+
+			for i := range rawPipelines {
+				pipelines = append(pipelines, string(rawPipelines[i]))
+				assert.Equal(t, tt.expectedPipelines[i], pipelines[i])
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package ciconfig
+
+const (
+	// manifestTemplateGitLabInclude is the Go template used to update the ref of a GitLab project include
+	manifestTemplateGitLabInclude string = `name: 'deps: bump GitLab include "{{ .Project }}"'
+{{- if .ActionID }}
+actions:
+  {{ .ActionID }}:
+    title: 'deps: update GitLab include "{{ .Project }}" to {{ "{{" }} source "tag" {{ "}}" }}'
+{{ end }}
+
+sources:
+  tag:
+    name: 'get latest tag for GitLab project "{{ .Project }}"'
+    kind: 'gitlab/tag'
+    spec:
+{{- if .URL }}
+      url: '{{ .URL }}'
+{{- end }}
+{{- if .Token }}
+      token: '{{ .Token }}'
+{{- end }}
+      owner: '{{ .Owner }}'
+      repository: '{{ .Repository }}'
+      versionfilter:
+        kind: '{{ .VersionFilterKind }}'
+        pattern: '{{ .VersionFilterPattern }}'
+targets:
+  {{ .TargetID }}:
+    name: 'deps: bump GitLab include "{{ .Project }}" to {{ "{{" }} source "tag" {{ "}}" }}'
+    kind: 'yaml'
+{{- if .ScmID }}
+    scmid: '{{ .ScmID }}'
+{{ end }}
+    spec:
+      file: '{{ .TargetFile }}'
+      key: '{{ .TargetKey }}'
+    sourceid: 'tag'
+`
+	// manifestTemplateGitLabComponent is the Go template used to update the version of a GitLab CI component
+	manifestTemplateGitLabComponent string = `name: 'deps: bump GitLab CI component "{{ .Component }}"'
+{{- if .ActionID }}
+actions:
+  {{ .ActionID }}:
+    title: 'deps: update GitLab CI component "{{ .Component }}" to {{ "{{" }} source "release" {{ "}}" }}'
+{{ end }}
+
+sources:
+  release:
+    name: 'get latest release for GitLab CI component "{{ .Component }}"'
+    kind: 'gitlab/release'
+    spec:
+{{- if .URL }}
+      url: '{{ .URL }}'
+{{- end }}
+{{- if .Token }}
+      token: '{{ .Token }}'
+{{- end }}
+      owner: '{{ .Owner }}'
+      repository: '{{ .Repository }}'
+      versionfilter:
+        kind: '{{ .VersionFilterKind }}'
+        pattern: '{{ .VersionFilterPattern }}'
+targets:
+  {{ .TargetID }}:
+    name: 'deps: bump GitLab CI component "{{ .Component }}" to {{ "{{" }} source "release" {{ "}}" }}'
+    kind: 'yaml'
+{{- if .ScmID }}
+    scmid: '{{ .ScmID }}'
+{{ end }}
+    spec:
+      file: '{{ .TargetFile }}'
+      key: '{{ .TargetKey }}'
+    sourceid: 'release'
+    transformers:
+      - addprefix: '{{ .Component }}@'
+`
+)
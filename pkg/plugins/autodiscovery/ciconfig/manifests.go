@@ -0,0 +1,260 @@
+package ciconfig
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/resources/dockerimage"
+	"github.com/updatecli/updatecli/pkg/plugins/resources/gitlab/client"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/dockermanifest"
+)
+
+// gitLabManifestParams contains the parameters used to generate GitLab include and CI component manifests
+type gitLabManifestParams struct {
+	ActionID             string
+	Component            string
+	Project              string
+	Owner                string
+	Repository           string
+	URL                  string
+	Token                string
+	TargetID             string
+	TargetFile           string
+	TargetKey            string
+	VersionFilterKind    string
+	VersionFilterPattern string
+	ScmID                string
+}
+
+func (c CIConfig) discoverManifests() ([][]byte, error) {
+	var manifests [][]byte
+
+	foundFiles, err := searchCIConfigFiles(c.rootDir, c.files)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, foundFile := range foundFiles {
+		logrus.Debugf("parsing file %q", foundFile)
+
+		relativeFoundFile, err := filepath.Rel(c.rootDir, foundFile)
+		if err != nil {
+			// Let's try the next file if we fail to get the relative path
+			logrus.Debugln(err)
+			continue
+		}
+
+		references, err := loadReferences(foundFile, c.flavor)
+		if err != nil {
+			logrus.Debugln(err)
+			continue
+		}
+
+		for _, ref := range references {
+			var manifest []byte
+
+			switch ref.kind {
+			case referenceImage:
+				manifest, err = c.getDockerManifest(relativeFoundFile, ref)
+			case referenceInclude:
+				manifest, err = c.getIncludeManifest(relativeFoundFile, ref)
+			case referenceComponent:
+				manifest, err = c.getComponentManifest(relativeFoundFile, ref)
+			}
+
+			if err != nil {
+				logrus.Errorf("getting manifest for %q from %q: %s", ref.value, relativeFoundFile, err)
+				continue
+			}
+
+			if manifest != nil {
+				manifests = append(manifests, manifest)
+			}
+		}
+	}
+
+	return manifests, nil
+}
+
+// getDockerManifest returns the Updatecli manifest updating a Docker image
+func (c CIConfig) getDockerManifest(relativeFoundFile string, ref reference) ([]byte, error) {
+	imageName, imageTag, imageDigest, err := dockerimage.ParseOCIReferenceInfo(ref.value)
+	if err != nil {
+		return nil, fmt.Errorf("parsing image %q: %s", ref.value, err)
+	}
+
+	// Same as the GitHub Action crawler, we can't retrieve the tag matching a digest
+	if imageDigest != "" && imageTag == "" {
+		logrus.Debugf("docker digest without specified tag is not supported at the moment for %q", ref.value)
+		return nil, nil
+	}
+
+	if c.isIgnored(relativeFoundFile, imageName, imageTag) {
+		return nil, nil
+	}
+
+	params := dockermanifest.NewParams(imageName, imageTag, c.spec.VersionFilter, c.versionFilter, c.spec.CredentialsDocker)
+	params.ActionID = c.actionID
+	params.TargetID = flavors[c.flavor].targetID
+	params.TargetFile = relativeFoundFile
+	params.TargetKey = escapeSingleQuote(ref.key)
+	params.TargetPrefix = imageName + ":"
+	params.ScmID = c.scmID
+
+	return dockermanifest.Generate(params, c.digest)
+}
+
+// getIncludeManifest returns the Updatecli manifest updating the ref of a GitLab project include
+func (c CIConfig) getIncludeManifest(relativeFoundFile string, ref reference) ([]byte, error) {
+	// Only refs following semantic versioning can be updated, branches and commit shas are ignored
+	if _, err := semver.StrictNewVersion(strings.TrimPrefix(ref.value, "v")); err != nil {
+		logrus.Debugf("ignoring GitLab include %q, ref %q is not a semantic version", ref.project, ref.value)
+		return nil, nil
+	}
+
+	i := strings.LastIndex(ref.project, "/")
+	if i < 1 {
+		return nil, fmt.Errorf("invalid GitLab project %q", ref.project)
+	}
+
+	if c.isIgnored(relativeFoundFile, ref.project, ref.value) {
+		return nil, nil
+	}
+
+	params, err := c.newGitLabManifestParams(relativeFoundFile, ref, c.spec.URL)
+	if err != nil {
+		return nil, err
+	}
+	params.Project = ref.project
+	params.Owner = ref.project[:i]
+	params.Repository = ref.project[i+1:]
+
+	return executeTemplate(manifestTemplateGitLabInclude, params)
+}
+
+// getComponentManifest returns the Updatecli manifest updating the version of a GitLab CI component
+func (c CIConfig) getComponentManifest(relativeFoundFile string, ref reference) ([]byte, error) {
+	host, owner, repository, component, componentVersion, ok := parseComponent(ref.value)
+	if !ok {
+		logrus.Debugf("ignoring GitLab CI component %q, not a valid component reference", ref.value)
+		return nil, nil
+	}
+
+	// Partial versions such as "1.2" and "~latest" are already resolved by GitLab
+	if _, err := semver.StrictNewVersion(componentVersion); err != nil {
+		logrus.Debugf("ignoring GitLab CI component %q, version %q is not a semantic version", component, componentVersion)
+		return nil, nil
+	}
+
+	if c.isIgnored(relativeFoundFile, component, componentVersion) {
+		return nil, nil
+	}
+
+	instance := host
+	if isServerFQDNVariable(host) {
+		instance = c.spec.URL
+	} else if strings.Contains(host, "$") {
+		logrus.Debugf("ignoring GitLab CI component %q, unknown GitLab instance %q", component, host)
+		return nil, nil
+	}
+
+	params, err := c.newGitLabManifestParams(relativeFoundFile, ref, instance)
+	if err != nil {
+		return nil, err
+	}
+	params.Component = component
+	params.Owner = owner
+	params.Repository = repository
+
+	return executeTemplate(manifestTemplateGitLabComponent, params)
+}
+
+// newGitLabManifestParams returns the parameters shared by GitLab include and CI component manifests.
+// The token is only set when instance is the GitLab instance configured by the spec.
+func (c CIConfig) newGitLabManifestParams(relativeFoundFile string, ref reference, instance string) (gitLabManifestParams, error) {
+	instanceURL, err := url.Parse(client.EnsureValidURL(instance))
+	if err != nil {
+		return gitLabManifestParams{}, fmt.Errorf("parsing GitLab url %q: %s", instance, err)
+	}
+
+	specURL, err := url.Parse(client.EnsureValidURL(c.spec.URL))
+	if err != nil {
+		return gitLabManifestParams{}, fmt.Errorf("parsing GitLab url %q: %s", c.spec.URL, err)
+	}
+
+	params := gitLabManifestParams{
+		ActionID:             c.actionID,
+		TargetID:             flavors[c.flavor].targetID,
+		TargetFile:           relativeFoundFile,
+		TargetKey:            escapeSingleQuote(ref.key),
+		VersionFilterKind:    c.versionFilter.Kind,
+		VersionFilterPattern: c.versionFilter.Pattern,
+		ScmID:                c.scmID,
+	}
+
+	if instanceURL.Host != client.GITLABDOMAIN {
+		params.URL = instance
+	}
+
+	if instanceURL.Host == specURL.Host {
+		params.Token = c.getGitLabToken()
+	}
+
+	// If a versionfilter is specified in the manifest then we want to be sure that it takes precedence
+	if !c.spec.VersionFilter.IsZero() {
+		params.VersionFilterPattern, err = c.versionFilter.GreaterThanPattern(ref.value)
+		if err != nil {
+			logrus.Debugf("building version filter pattern: %s", err)
+			params.VersionFilterPattern = "*"
+		}
+	}
+
+	return params, nil
+}
+
+// isIgnored returns true if the artifact doesn't respect the ignore or only rules
+func (c CIConfig) isIgnored(relativeFoundFile, artifactName, artifactVersion string) bool {
+	// Test if the ignore rule based on path is respected
+	if len(c.spec.Ignore) > 0 {
+		if c.spec.Ignore.isMatchingRules(c.rootDir, relativeFoundFile, artifactName, artifactVersion) {
+			logrus.Debugf("Ignoring %q from %q, as matching ignore rule(s)\n", artifactName, relativeFoundFile)
+			return true
+		}
+	}
+
+	// Test if the only rule based on path is respected
+	if len(c.spec.Only) > 0 {
+		if !c.spec.Only.isMatchingRules(c.rootDir, relativeFoundFile, artifactName, artifactVersion) {
+			logrus.Debugf("Ignoring %q from %q, as not matching only rule(s)\n", artifactName, relativeFoundFile)
+			return true
+		}
+	}
+
+	return false
+}
+
+// executeTemplate renders the manifest template using params
+func executeTemplate(manifestTemplate string, params any) ([]byte, error) {
+	tmpl, err := template.New("manifest").Parse(manifestTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := bytes.Buffer{}
+	if err := tmpl.Execute(&manifest, params); err != nil {
+		return nil, err
+	}
+
+	return manifest.Bytes(), nil
+}
+
+// escapeSingleQuote escapes s so it can be used in a single-quoted yaml string
+func escapeSingleQuote(s string) string {
+	return strings.ReplaceAll(s, "'", "''")
+}
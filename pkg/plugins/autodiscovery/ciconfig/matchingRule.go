@@ -0,0 +1,111 @@
+package ciconfig
+
+import (
+	"path/filepath"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/sirupsen/logrus"
+)
+
+// MatchingRule allows to specifies rules to identify manifest
+type MatchingRule struct {
+	// Path specifies a CI configuration filepath pattern, the pattern requires to match all of name, not just a subpart of the path.
+	Path string
+	// Artifacts specifies the list of artifacts to check
+	//
+	// The key is the artifact name and the value is the artifact version
+	//
+	// The artifact name must match one of:
+	//   * a Docker image name without tag, such as "golang"
+	//   * a GitLab project referenced by "include:project", such as "my-group/my-project"
+	//   * a GitLab CI component without version, such as "gitlab.com/components/sast/sast"
+	//
+	// If the value is empty, then the artifact name is enough to match
+	// If the value is a valid semantic version constraint, then the artifact version must match the constraint
+	Artifacts map[string]string
+}
+
+type MatchingRules []MatchingRule
+
+// isMatchingRules checks if a specific file content matches the "only" rule
+func (m MatchingRules) isMatchingRules(rootDir, filePath, artifactName, artifactVersion string) bool {
+	var ruleResults []bool
+
+	if len(m) > 0 {
+		for _, rule := range m {
+			/*
+				Check if rule.Path is matching. Path accepts wildcard path
+			*/
+
+			if rule.Path != "" {
+				if filepath.IsAbs(rule.Path) {
+					filePath = filepath.Join(rootDir, filePath)
+				}
+
+				match, err := filepath.Match(rule.Path, filePath)
+				if err != nil {
+					logrus.Errorf("%s - %q", err, rule.Path)
+					continue
+				}
+				ruleResults = append(ruleResults, match)
+				if match {
+					logrus.Debugf("file path %q matching rule %q", filePath, rule.Path)
+				}
+			}
+
+			/*
+				Checks if Artifacts is matching the policy constraint.
+			*/
+
+			if len(rule.Artifacts) > 0 {
+				match := false
+
+			outArtifact:
+				for name, reference := range rule.Artifacts {
+
+					if artifactName == name {
+						if reference == "" {
+							match = true
+							break outArtifact
+						}
+
+						v, err := semver.NewVersion(artifactVersion)
+						if err != nil {
+							match = artifactVersion == reference
+							logrus.Debugf("%q - %s", artifactVersion, err)
+							break outArtifact
+						}
+
+						c, err := semver.NewConstraint(reference)
+						if err != nil {
+							match = artifactVersion == reference
+							logrus.Debugf("%q %s", err, reference)
+							break outArtifact
+						}
+
+						match = c.Check(v)
+						break outArtifact
+					}
+				}
+				ruleResults = append(ruleResults, match)
+			}
+
+			/*
+				If at least one rule is failing then we return false
+			*/
+			isAllMatching := true
+			for i := range ruleResults {
+				if !ruleResults[i] {
+					isAllMatching = false
+				}
+			}
+			if isAllMatching {
+				return true
+			}
+			ruleResults = []bool{}
+		}
+		return false
+	}
+
+	return false
+}
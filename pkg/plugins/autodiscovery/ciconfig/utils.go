@@ -0,0 +1,116 @@
+package ciconfig
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	// componentRegex matches a GitLab CI component reference such as "gitlab.com/components/sast/sast@2.0.2"
+	// and captures the instance hostname, the project path, the component name, and the version
+	componentRegex = regexp.MustCompile(`^([^/@]+)/([^@]+/[^@]+)/([^/@]+)@([^/@]+)$`)
+	// serverFQDNVariables lists the GitLab predefined variables referencing the current GitLab instance
+	serverFQDNVariables = []string{"$CI_SERVER_FQDN", "${CI_SERVER_FQDN}", "$CI_SERVER_HOST", "${CI_SERVER_HOST}"}
+)
+
+// searchCIConfigFiles looks, recursively, for every file matching one of the patterns from a root directory.
+// A pattern containing a "/" is matched against the file path relative to rootDir, otherwise against the file name.
+func searchCIConfigFiles(rootDir string, files []string) ([]string, error) {
+	foundFiles := []string{}
+
+	logrus.Debugf("Looking for CI configuration file(s) in %q", rootDir)
+
+	err := filepath.WalkDir(rootDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			if d.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		relativePath, err := filepath.Rel(rootDir, path)
+		if err != nil {
+			return err
+		}
+
+		for _, pattern := range files {
+			name := d.Name()
+			if strings.Contains(pattern, "/") {
+				name = filepath.ToSlash(relativePath)
+			}
+
+			match, err := filepath.Match(pattern, name)
+			if err != nil {
+				logrus.Errorf("%s - %q", err, pattern)
+				continue
+			}
+
+			if match {
+				foundFiles = append(foundFiles, path)
+				break
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logrus.Debugf("%d CI configuration file(s) found", len(foundFiles))
+	for _, foundFile := range foundFiles {
+		logrus.Debugf("    * %q", foundFile)
+	}
+
+	return foundFiles, nil
+}
+
+// parseComponent splits a GitLab CI component reference into its instance hostname, project owner,
+// project repository, component path without version, and version.
+func parseComponent(component string) (host, owner, repository, name, version string, ok bool) {
+	m := componentRegex.FindStringSubmatch(component)
+	if m == nil {
+		return "", "", "", "", "", false
+	}
+
+	project := m[2]
+	i := strings.LastIndex(project, "/")
+
+	return m[1], project[:i], project[i+1:], strings.TrimSuffix(component, "@"+m[4]), m[4], true
+}
+
+// isServerFQDNVariable returns true if host references the current GitLab instance, such as "$CI_SERVER_FQDN"
+func isServerFQDNVariable(host string) bool {
+	for _, variable := range serverFQDNVariables {
+		if host == variable {
+			return true
+		}
+	}
+	return false
+}
+
+// getGitLabToken returns the GitLab token defined by the spec, or from the environment.
+func (c CIConfig) getGitLabToken() string {
+	if c.spec.Token != "" {
+		return c.spec.Token
+	}
+
+	if os.Getenv("UPDATECLI_GITLAB_TOKEN") != "" {
+		logrus.Debugf("environment variable UPDATECLI_GITLAB_TOKEN detected, using it values as GitLab token")
+		return os.Getenv("UPDATECLI_GITLAB_TOKEN")
+	} else if os.Getenv("GITLAB_TOKEN") != "" {
+		logrus.Debugf("environment variable GITLAB_TOKEN detected, using it values as GitLab token")
+		return os.Getenv("GITLAB_TOKEN")
+	}
+
+	return ""
+}
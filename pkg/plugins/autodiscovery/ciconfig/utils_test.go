@@ -0,0 +1,84 @@
+package ciconfig
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseComponent(t *testing.T) {
+	tests := []struct {
+		component          string
+		expectedHost       string
+		expectedOwner      string
+		expectedRepository string
+		expectedName       string
+		expectedVersion    string
+		expectedOK         bool
+	}{
+		{
+			component:          "gitlab.com/components/sast/sast@2.0.2",
+			expectedHost:       "gitlab.com",
+			expectedOwner:      "components",
+			expectedRepository: "sast",
+			expectedName:       "gitlab.com/components/sast/sast",
+			expectedVersion:    "2.0.2",
+			expectedOK:         true,
+		},
+		{
+			component:          "$CI_SERVER_FQDN/my-org/sub-group/components/build@1.0.0",
+			expectedHost:       "$CI_SERVER_FQDN",
+			expectedOwner:      "my-org/sub-group",
+			expectedRepository: "components",
+			expectedName:       "$CI_SERVER_FQDN/my-org/sub-group/components/build",
+			expectedVersion:    "1.0.0",
+			expectedOK:         true,
+		},
+		{
+			component: "gitlab.com/components/sast",
+		},
+		{
+			component: "gitlab.com/sast@1.0.0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.component, func(t *testing.T) {
+			host, owner, repository, name, version, ok := parseComponent(tt.component)
+			require.Equal(t, tt.expectedOK, ok)
+			assert.Equal(t, tt.expectedHost, host)
+			assert.Equal(t, tt.expectedOwner, owner)
+			assert.Equal(t, tt.expectedRepository, repository)
+			assert.Equal(t, tt.expectedName, name)
+			assert.Equal(t, tt.expectedVersion, version)
+		})
+	}
+}
+
+func TestYamlPathChild(t *testing.T) {
+	tests := []struct {
+		key      string
+		expected string
+	}{
+		{key: "build-job", expected: "$.build-job"},
+		{key: ".template", expected: "$.'.template'"},
+		{key: "test:unit job", expected: "$.'test:unit job'"},
+		{key: "it's", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			assert.Equal(t, tt.expected, yamlPathChild("$", tt.key))
+		})
+	}
+}
+
+func TestSearchCIConfigFiles(t *testing.T) {
+	got, err := searchCIConfigFiles("testdata/woodpecker", flavors[FlavorWoodpecker].files)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"testdata/woodpecker/.woodpecker/release.yaml",
+		"testdata/woodpecker/.woodpecker.yml",
+	}, got)
+}
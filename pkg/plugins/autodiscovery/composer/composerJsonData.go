@@ -0,0 +1,59 @@
+package composer
+
+import (
+	"encoding/json"
+	"os"
+)
+
+// composerJsonData represents the composer.json sections used to declare dependencies
+type composerJsonData struct {
+	Require    map[string]string `json:"require"`
+	RequireDev map[string]string `json:"require-dev"`
+}
+
+// composerLockData represents the composer.lock sections listing the installed packages
+type composerLockData struct {
+	Packages    []lockedPackage `json:"packages"`
+	PackagesDev []lockedPackage `json:"packages-dev"`
+}
+
+// lockedPackage is a package version resolved in composer.lock
+type lockedPackage struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// loadComposerJsonData reads a composer.json file
+func loadComposerJsonData(filename string) (composerJsonData, error) {
+	var data composerJsonData
+
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		return data, err
+	}
+
+	err = json.Unmarshal(content, &data)
+
+	return data, err
+}
+
+// loadLockedVersions returns the package versions resolved in a composer.lock file, indexed by package name
+func loadLockedVersions(filename string) (map[string]string, error) {
+	var data composerLockData
+
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(content, &data); err != nil {
+		return nil, err
+	}
+
+	versions := map[string]string{}
+	for _, p := range append(data.Packages, data.PackagesDev...) {
+		versions[p.Name] = p.Version
+	}
+
+	return versions, nil
+}
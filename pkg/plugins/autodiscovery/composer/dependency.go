@@ -0,0 +1,206 @@
+package composer
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+// discoverDependencyManifests discovers manifests for the packages required by composer.json files
+func (c Composer) discoverDependencyManifests() ([][]byte, error) {
+
+	var manifests [][]byte
+
+	searchFromDir := c.rootDir
+	// If the spec.RootDir is an absolute path, then it as already been set
+	// correctly in the New function.
+	if c.spec.RootDir != "" && !path.IsAbs(c.spec.RootDir) {
+		searchFromDir = filepath.Join(c.rootDir, c.spec.RootDir)
+	}
+
+	foundFiles, err := searchComposerJsonFiles(searchFromDir)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, foundFile := range foundFiles {
+
+		logrus.Debugf("parsing file %q", foundFile)
+
+		relativeFoundFile, err := filepath.Rel(c.rootDir, foundFile)
+		if err != nil {
+			// Let's try the next composer.json if one fail
+			logrus.Debugln(err)
+			continue
+		}
+
+		// It doesn't make sense to update composer.json if Updatecli do not have access to the composer command to update composer.lock
+		lockFile := ""
+		lockedVersions := map[string]string{}
+		if isLockFileDetected(filepath.Join(filepath.Dir(foundFile), composerLockFile)) {
+			if !isCommandAvailable("composer") {
+				logrus.Warning("skipping, Composer lock file detected but Updatecli couldn't detect the composer command to update it in case of a composer.json update")
+				continue
+			}
+
+			lockedVersions, err = loadLockedVersions(filepath.Join(filepath.Dir(foundFile), composerLockFile))
+			if err != nil {
+				logrus.Debugf("parsing %q: %s", composerLockFile, err)
+				continue
+			}
+			lockFile = composerLockFile
+		}
+
+		data, err := loadComposerJsonData(foundFile)
+		if err != nil {
+			logrus.Debugln(err)
+			continue
+		}
+
+		getManifest := func(dependencies map[string]string, dependencyType string) {
+			if len(dependencies) == 0 {
+				logrus.Debugf("no Composer %s found in %q\n", dependencyType, foundFile)
+				return
+			}
+
+			for _, dependencyName := range sortedKeys(dependencies) {
+				dependencyConstraint := dependencies[dependencyName]
+
+				if isPlatformPackage(dependencyName) {
+					logrus.Debugf("Ignoring platform package %q from %q", dependencyName, relativeFoundFile)
+					continue
+				}
+
+				if _, err := version.NewComposerConstraint(dependencyConstraint); err != nil {
+					logrus.Debugf("Ignoring Composer package %q from %q: %s", dependencyName, relativeFoundFile, err)
+					continue
+				}
+
+				// The locked version is the one installed, so it's the one checked against the matching rules
+				dependencyVersion := dependencyConstraint
+				if lockedVersion, ok := lockedVersions[dependencyName]; ok {
+					dependencyVersion = lockedVersion
+				}
+
+				if len(c.spec.Ignore) > 0 {
+					if c.spec.Ignore.isMatchingRules(c.rootDir, relativeFoundFile, dependencyName, dependencyVersion) {
+						logrus.Debugf("Ignoring Composer package %q from %q, as matching ignore rule(s)\n", dependencyName, relativeFoundFile)
+						continue
+					}
+				}
+
+				if len(c.spec.Only) > 0 {
+					if !c.spec.Only.isMatchingRules(c.rootDir, relativeFoundFile, dependencyName, dependencyVersion) {
+						logrus.Debugf("Ignoring Composer package %q from %q, as not matching only rule(s)\n", dependencyName, relativeFoundFile)
+						continue
+					}
+				}
+
+				/*
+					A package pinned to a specific version, such as "6.4.0", is bumped in composer.json
+					using the version filter.
+					A package using a version constraint, such as "^6.4", is only updated in composer.lock
+					within the constraint, as composer would do.
+				*/
+				_, err := version.NewComposer(dependencyConstraint)
+				isPinned := err == nil
+
+				sourceVersionFilterKind := version.COMPOSERVERSIONKIND
+				sourceVersionFilterPattern := dependencyConstraint
+				sourceTrimPrefix := ""
+
+				switch isPinned {
+				case true:
+					sourceVersionFilterKind = c.versionFilter.Kind
+					sourceVersionFilterPattern, err = c.versionFilter.GreaterThanPattern(dependencyConstraint)
+					if err != nil {
+						logrus.Debugf("building version filter pattern: %s", err)
+						sourceVersionFilterPattern = "*"
+					}
+
+					// Packagist versions are git tags which are usually prefixed by "v"
+					// while composer.json versions are not
+					if !strings.HasPrefix(dependencyConstraint, "v") {
+						sourceTrimPrefix = "v"
+					}
+				case false:
+					if lockFile == "" {
+						logrus.Debugf("Ignoring Composer package %q from %q, version constraint %q is handled by Composer and no lock file detected", dependencyName, relativeFoundFile, dependencyConstraint)
+						continue
+					}
+				}
+
+				tmpl, err := template.New("manifest").Parse(manifestTemplate)
+				if err != nil {
+					logrus.Debugln(err)
+					continue
+				}
+
+				params := struct {
+					ActionID                   string
+					ManifestName               string
+					SourceID                   string
+					SourceName                 string
+					SourcePackage              string
+					SourceURL                  string
+					SourceVersionFilterKind    string
+					SourceVersionFilterPattern string
+					SourceTrimPrefix           string
+					TargetID                   string
+					TargetName                 string
+					TargetKey                  string
+					TargetComposerJsonEnabled  bool
+					File                       string
+					LockFile                   string
+					LockTargetName             string
+					LockCommand                string
+					WorkDir                    string
+					ScmID                      string
+				}{
+					ActionID:                   c.actionID,
+					ManifestName:               fmt.Sprintf("Bump Composer package %q", dependencyName),
+					SourceID:                   "composer",
+					SourceName:                 fmt.Sprintf("Get Composer package %q latest version", dependencyName),
+					SourcePackage:              dependencyName,
+					SourceURL:                  c.spec.URL,
+					SourceVersionFilterKind:    sourceVersionFilterKind,
+					SourceVersionFilterPattern: sourceVersionFilterPattern,
+					SourceTrimPrefix:           sourceTrimPrefix,
+					TargetID:                   "composer",
+					TargetName:                 fmt.Sprintf("deps(composer): bump %q to {{ source %q }}", dependencyName, "composer"),
+					// Composer package names allow dots which have a different meaning in Dasel query
+					// Therefor we must escape it for Dasel query to work
+					TargetKey:                 fmt.Sprintf("%s.%s", dependencyType, strings.ReplaceAll(dependencyName, ".", `\.`)),
+					TargetComposerJsonEnabled: isPinned,
+					File:                      relativeFoundFile,
+					LockFile:                  lockFile,
+					LockTargetName:            fmt.Sprintf("deps(composer): update %s following bump of %q to {{ source %q }}", lockFile, dependencyName, "composer"),
+					LockCommand:               getLockCommand(dependencyName),
+					WorkDir:                   filepath.Dir(relativeFoundFile),
+					ScmID:                     c.scmID,
+				}
+
+				manifest := bytes.Buffer{}
+				if err := tmpl.Execute(&manifest, params); err != nil {
+					logrus.Debugln(err)
+					continue
+				}
+
+				manifests = append(manifests, manifest.Bytes())
+			}
+		}
+
+		getManifest(data.Require, "require")
+		getManifest(data.RequireDev, "require-dev")
+	}
+
+	logrus.Printf("%v manifests identified", len(manifests))
+
+	return manifests, nil
+}
@@ -0,0 +1,127 @@
+package composer
+
+import (
+	"path"
+	"strings"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+// Spec defines the parameters which can be provided to the Composer crawler.
+type Spec struct {
+	// RootDir defines the root directory used to recursively search for composer.json files
+	RootDir string `yaml:",omitempty"`
+	// Ignore allows to specify rule to ignore autodiscovery a specific Composer package based on a rule
+	Ignore MatchingRules `yaml:",omitempty"`
+	// Only allows to specify rule to only autodiscover manifest for a specific Composer package based on a rule
+	Only MatchingRules `yaml:",omitempty"`
+	/*
+		versionfilter provides parameters to specify the version pattern used when generating manifest.
+
+		kind - composer
+			versionfilter of kind `composer` uses Composer version constraints as version filtering
+			pattern accepts one of:
+				`patch` - patch only update patch version
+				`minor` - minor only update minor version
+				`major` - major only update major versions
+				`a version constraint` such as `^6.4`
+
+		kind - semver
+			versionfilter of kind `semver` uses semantic versioning as version filtering
+			pattern accepts one of:
+				`patch` - patch only update patch version
+				`minor` - minor only update minor version
+				`major` - major only update major versions
+				`a version constraint` such as `>= 1.0.0`
+
+		kind - regex
+			versionfilter of kind `regex` uses regular expression as version filtering
+			pattern accepts a valid regular expression
+
+		example:
+		```
+			versionfilter:
+				kind: composer
+				pattern: minor
+		```
+
+		and its type like regex, semver, composer, or just latest.
+
+		remark:
+			The versionfilter only applies to packages pinned to a specific version in composer.json.
+			Packages using a version constraint, such as "^6.4", are updated within that constraint.
+	*/
+	VersionFilter version.Filter `yaml:",omitempty"`
+	// URL defines the Composer repository used by the generated manifests
+	//
+	// default: https://repo.packagist.org/
+	URL string `yaml:",omitempty"`
+}
+
+// Composer holds all information needed to generate Composer package manifests.
+type Composer struct {
+	// actionID holds the actionID used by the newly generated manifest
+	actionID string
+	// spec defines the settings provided via an updatecli manifest
+	spec Spec
+	// rootDir defines the root directory from where looking for composer.json files
+	rootDir string
+	// scmID holds the scmID used by the newly generated manifest
+	scmID string
+	// versionFilter holds the "valid" version.filter, that might be different from the user-specified filter (Spec.VersionFilter)
+	versionFilter version.Filter
+}
+
+// New return a new valid object.
+func New(spec interface{}, rootDir, scmID, actionID string) (Composer, error) {
+	var s Spec
+
+	err := mapstructure.Decode(spec, &s)
+	if err != nil {
+		return Composer{}, err
+	}
+
+	dir := rootDir
+	if path.IsAbs(s.RootDir) {
+		if scmID != "" {
+			logrus.Warningf("rootdir %q is an absolute path, scmID %q will be ignored", s.RootDir, scmID)
+		}
+		dir = s.RootDir
+	}
+
+	if len(dir) == 0 {
+		logrus.Errorln("no working directory defined")
+		return Composer{}, err
+	}
+
+	newFilter := s.VersionFilter
+	if s.VersionFilter.IsZero() {
+		newFilter.Kind = version.COMPOSERVERSIONKIND
+		newFilter.Pattern = "*"
+	}
+
+	return Composer{
+		actionID:      actionID,
+		spec:          s,
+		rootDir:       dir,
+		scmID:         scmID,
+		versionFilter: newFilter,
+	}, nil
+
+}
+
+func (c Composer) DiscoverManifests() ([][]byte, error) {
+
+	logrus.Infof("\n\n%s\n", strings.ToTitle("Composer"))
+	logrus.Infof("%s\n", strings.Repeat("=", len("Composer")+1))
+
+	manifests, err := c.discoverDependencyManifests()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return manifests, nil
+}
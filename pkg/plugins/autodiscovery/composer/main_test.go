@@ -0,0 +1,253 @@
+package composer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+func TestDiscoverManifests(t *testing.T) {
+	// Lock file targets are only generated when composer is installed
+	defaultIsCommandAvailable := isCommandAvailable
+	isCommandAvailable = func(string) bool { return true }
+	t.Cleanup(func() {
+		isCommandAvailable = defaultIsCommandAvailable
+	})
+
+	testdata := []struct {
+		name              string
+		rootDir           string
+		spec              Spec
+		expectedPipelines []string
+	}{
+		{
+			name:    "No lock file",
+			rootDir: "testdata/nolockfile",
+			expectedPipelines: []string{`name: 'Bump Composer package "monolog/monolog"'
+sources:
+  composer:
+    name: 'Get Composer package "monolog/monolog" latest version'
+    kind: 'composer'
+    spec:
+      name: 'monolog/monolog'
+      versionfilter:
+        kind: 'composer'
+        pattern: '>=3.5.0'
+    transformers:
+      - trimprefix: 'v'
+targets:
+  composer:
+    name: 'deps(composer): bump "monolog/monolog" to {{ source "composer" }}'
+    kind: 'json'
+    spec:
+      file: 'composer.json'
+      key: 'require.monolog/monolog'
+    sourceid: 'composer'
+`, `name: 'Bump Composer package "phpunit/phpunit"'
+sources:
+  composer:
+    name: 'Get Composer package "phpunit/phpunit" latest version'
+    kind: 'composer'
+    spec:
+      name: 'phpunit/phpunit'
+      versionfilter:
+        kind: 'composer'
+        pattern: '>=v10.5.2'
+targets:
+  composer:
+    name: 'deps(composer): bump "phpunit/phpunit" to {{ source "composer" }}'
+    kind: 'json'
+    spec:
+      file: 'composer.json'
+      key: 'require-dev.phpunit/phpunit'
+    sourceid: 'composer'
+`},
+		},
+		{
+			name:    "No lock file with version filter",
+			rootDir: "testdata/nolockfile",
+			spec: Spec{
+				Only: MatchingRules{
+					{Packages: map[string]string{"monolog/monolog": ""}},
+				},
+				VersionFilter: version.Filter{
+					Kind:    version.COMPOSERVERSIONKIND,
+					Pattern: "minor",
+				},
+			},
+			expectedPipelines: []string{`name: 'Bump Composer package "monolog/monolog"'
+sources:
+  composer:
+    name: 'Get Composer package "monolog/monolog" latest version'
+    kind: 'composer'
+    spec:
+      name: 'monolog/monolog'
+      versionfilter:
+        kind: 'composer'
+        pattern: '>=3.5.0 <4'
+    transformers:
+      - trimprefix: 'v'
+targets:
+  composer:
+    name: 'deps(composer): bump "monolog/monolog" to {{ source "composer" }}'
+    kind: 'json'
+    spec:
+      file: 'composer.json'
+      key: 'require.monolog/monolog'
+    sourceid: 'composer'
+`},
+		},
+		{
+			name:    "Lock file",
+			rootDir: "testdata/lockfile",
+			expectedPipelines: []string{`name: 'Bump Composer package "guzzlehttp/guzzle"'
+sources:
+  composer:
+    name: 'Get Composer package "guzzlehttp/guzzle" latest version'
+    kind: 'composer'
+    spec:
+      name: 'guzzlehttp/guzzle'
+      versionfilter:
+        kind: 'composer'
+        pattern: '>=7.8.1'
+    transformers:
+      - trimprefix: 'v'
+targets:
+  composer:
+    name: 'deps(composer): bump "guzzlehttp/guzzle" to {{ source "composer" }}'
+    kind: 'json'
+    spec:
+      file: 'composer.json'
+      key: 'require.guzzlehttp/guzzle'
+    sourceid: 'composer'
+  composer.lock:
+    name: 'deps(composer): update composer.lock following bump of "guzzlehttp/guzzle" to {{ source "composer" }}'
+    kind: 'shell'
+    dependson:
+      - 'composer'
+    disablesourceinput: true
+    spec:
+      command: |
+        ARGS=""
+        if [ "$DRY_RUN" = "true" ]; then
+          ARGS="--dry-run"
+        fi
+        composer update $ARGS --no-install --no-scripts --no-interaction --with 'guzzlehttp/guzzle:{{ source "composer" }}' 'guzzlehttp/guzzle'
+      changedif:
+        kind: 'file/checksum'
+        spec:
+          files:
+            - 'composer.lock'
+            - 'composer.json'
+      environments:
+        - name: HOME
+        - name: PATH
+      workdir: '.'
+`, `name: 'Bump Composer package "laravel/framework"'
+sources:
+  composer:
+    name: 'Get Composer package "laravel/framework" latest version'
+    kind: 'composer'
+    spec:
+      name: 'laravel/framework'
+      versionfilter:
+        kind: 'composer'
+        pattern: '^11.0'
+targets:
+  composer.lock:
+    name: 'deps(composer): update composer.lock following bump of "laravel/framework" to {{ source "composer" }}'
+    kind: 'shell'
+    disablesourceinput: true
+    spec:
+      command: |
+        ARGS=""
+        if [ "$DRY_RUN" = "true" ]; then
+          ARGS="--dry-run"
+        fi
+        composer update $ARGS --no-install --no-scripts --no-interaction --with 'laravel/framework:{{ source "composer" }}' 'laravel/framework'
+      changedif:
+        kind: 'file/checksum'
+        spec:
+          files:
+            - 'composer.lock'
+            - 'composer.json'
+      environments:
+        - name: HOME
+        - name: PATH
+      workdir: '.'
+`},
+		},
+		{
+			name:    "Lock file with ignore rule on locked version",
+			rootDir: "testdata/lockfile",
+			spec: Spec{
+				Ignore: MatchingRules{
+					{Packages: map[string]string{"laravel/framework": ">=11.9"}},
+				},
+			},
+			expectedPipelines: []string{`name: 'Bump Composer package "guzzlehttp/guzzle"'
+sources:
+  composer:
+    name: 'Get Composer package "guzzlehttp/guzzle" latest version'
+    kind: 'composer'
+    spec:
+      name: 'guzzlehttp/guzzle'
+      versionfilter:
+        kind: 'composer'
+        pattern: '>=7.8.1'
+    transformers:
+      - trimprefix: 'v'
+targets:
+  composer:
+    name: 'deps(composer): bump "guzzlehttp/guzzle" to {{ source "composer" }}'
+    kind: 'json'
+    spec:
+      file: 'composer.json'
+      key: 'require.guzzlehttp/guzzle'
+    sourceid: 'composer'
+  composer.lock:
+    name: 'deps(composer): update composer.lock following bump of "guzzlehttp/guzzle" to {{ source "composer" }}'
+    kind: 'shell'
+    dependson:
+      - 'composer'
+    disablesourceinput: true
+    spec:
+      command: |
+        ARGS=""
+        if [ "$DRY_RUN" = "true" ]; then
+          ARGS="--dry-run"
+        fi
+        composer update $ARGS --no-install --no-scripts --no-interaction --with 'guzzlehttp/guzzle:{{ source "composer" }}' 'guzzlehttp/guzzle'
+      changedif:
+        kind: 'file/checksum'
+        spec:
+          files:
+            - 'composer.lock'
+            - 'composer.json'
+      environments:
+        - name: HOME
+        - name: PATH
+      workdir: '.'
+`},
+		},
+	}
+
+	for _, tt := range testdata {
+		t.Run(tt.name, func(t *testing.T) {
+			resource, err := New(
+				tt.spec, tt.rootDir, "", "")
+			require.NoError(t, err)
+
+			pipelines, err := resource.DiscoverManifests()
+			require.NoError(t, err)
+
+			require.Equal(t, len(tt.expectedPipelines), len(pipelines))
+
+			for i, expectedPipeline := range tt.expectedPipelines {
+				assert.Equal(t, expectedPipeline, string(pipelines[i]))
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package composer
+
+var (
+	// manifestTemplate is the Go template used to generate Composer package manifests
+	manifestTemplate string = `name: '{{ .ManifestName }}'
+{{- if .ActionID }}
+actions:
+  {{ .ActionID }}:
+    title: '{{ .TargetName }}'
+{{ end }}
+sources:
+  {{ .SourceID }}:
+    name: '{{ .SourceName }}'
+    kind: 'composer'
+    spec:
+      name: '{{ .SourcePackage }}'
+{{- if .SourceURL }}
+      url: '{{ .SourceURL }}'
+{{- end }}
+      versionfilter:
+        kind: '{{ .SourceVersionFilterKind }}'
+        pattern: '{{ .SourceVersionFilterPattern }}'
+{{- if .SourceTrimPrefix }}
+    transformers:
+      - trimprefix: '{{ .SourceTrimPrefix }}'
+{{- end }}
+targets:
+{{- if .TargetComposerJsonEnabled }}
+  {{ .TargetID }}:
+    name: '{{ .TargetName }}'
+    kind: 'json'
+{{- if .ScmID }}
+    scmid: '{{ .ScmID }}'
+{{ end }}
+    spec:
+      file: '{{ .File }}'
+      key: '{{ .TargetKey }}'
+    sourceid: '{{ .SourceID }}'
+{{- end }}
+{{- if .LockFile }}
+  {{ .LockFile }}:
+    name: '{{ .LockTargetName }}'
+    kind: 'shell'
+{{- if .ScmID }}
+    scmid: '{{ .ScmID }}'
+{{ end }}
+{{- if .TargetComposerJsonEnabled }}
+    dependson:
+      - '{{ .TargetID }}'
+{{- end }}
+    disablesourceinput: true
+    spec:
+      command: |
+        ARGS=""
+        if [ "$DRY_RUN" = "true" ]; then
+          ARGS="--dry-run"
+        fi
+        {{ .LockCommand }}
+      changedif:
+        kind: 'file/checksum'
+        spec:
+          files:
+            - '{{ .LockFile }}'
+            - 'composer.json'
+      environments:
+        - name: HOME
+        - name: PATH
+      workdir: '{{ .WorkDir }}'
+{{- end }}
+`
+)
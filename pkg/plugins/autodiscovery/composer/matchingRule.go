@@ -0,0 +1,103 @@
+package composer
+
+import (
+	"path/filepath"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+// MatchingRule allows to specifies rules to identify manifest
+type MatchingRule struct {
+	// Path specifies a composer.json path pattern, the pattern requires to match all of name, not just a substring.
+	Path string
+	// Packages specifies the list of Composer packages to check, indexed by package name.
+	// The value accepts a Composer version constraint, such as "^6.4", checked against the locked version
+	// or the version specified in composer.json
+	Packages map[string]string
+}
+
+type MatchingRules []MatchingRule
+
+// isMatchingRules checks if a specific file content matches the "only" rule
+func (m MatchingRules) isMatchingRules(rootDir, filePath, packageName, packageVersion string) bool {
+	var ruleResults []bool
+
+	if len(m) > 0 {
+		for _, rule := range m {
+			/*
+				Check if rule.Path is matching. Path accepts wildcard path
+			*/
+
+			if rule.Path != "" {
+				if filepath.IsAbs(rule.Path) {
+					filePath = filepath.Join(rootDir, filePath)
+				}
+
+				match, err := filepath.Match(rule.Path, filePath)
+				if err != nil {
+					logrus.Errorf("%s - %q", err, rule.Path)
+					continue
+				}
+				ruleResults = append(ruleResults, match)
+				if match {
+					logrus.Debugf("file path %q matching rule %q", filePath, rule.Path)
+				}
+			}
+
+			/*
+				Checks if policy is matching the policy constraint.
+			*/
+
+			if len(rule.Packages) > 0 {
+				match := false
+
+			outPackage:
+				for rulePackageName, rulePackageVersion := range rule.Packages {
+
+					if packageName == rulePackageName {
+						if rulePackageVersion == "" {
+							match = true
+							break outPackage
+						}
+
+						v, err := version.NewComposer(packageVersion)
+						if err != nil {
+							match = packageVersion == rulePackageVersion
+							logrus.Debugf("%q - %s", packageVersion, err)
+							break outPackage
+						}
+
+						c, err := version.NewComposerConstraint(rulePackageVersion)
+						if err != nil {
+							match = packageVersion == rulePackageVersion
+							logrus.Debugf("%q %s", err, rulePackageVersion)
+							break outPackage
+						}
+
+						match = c.Check(v)
+						break outPackage
+					}
+				}
+				ruleResults = append(ruleResults, match)
+			}
+
+			/*
+				If at least one rule is failing then we return false
+			*/
+			isAllMatching := true
+			for i := range ruleResults {
+				if !ruleResults[i] {
+					isAllMatching = false
+				}
+			}
+			if isAllMatching {
+				return true
+			}
+			ruleResults = []bool{}
+		}
+		return false
+	}
+
+	return false
+}
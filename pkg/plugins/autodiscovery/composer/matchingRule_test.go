@@ -0,0 +1,124 @@
+package composer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsMatchingRule(t *testing.T) {
+
+	dataset := []struct {
+		rules          MatchingRules
+		name           string
+		filePath       string
+		packageName    string
+		packageVersion string
+		rootDir        string
+		expectedResult bool
+	}{
+		{
+			name: "Matching path",
+			rules: MatchingRules{
+				MatchingRule{
+					Path: "composer.json",
+				},
+			},
+			filePath:       "composer.json",
+			expectedResult: true,
+		},
+		{
+			name: "Not matching path",
+			rules: MatchingRules{
+				MatchingRule{
+					Path: "composer.json",
+				},
+			},
+			filePath:       "website/composer.json",
+			expectedResult: false,
+		},
+		{
+			name: "Matching package without version",
+			rules: MatchingRules{
+				MatchingRule{
+					Packages: map[string]string{
+						"symfony/console": "",
+					},
+				},
+			},
+			filePath:       "composer.json",
+			packageName:    "symfony/console",
+			packageVersion: "v6.4.1",
+			expectedResult: true,
+		},
+		{
+			name: "Matching package version constraint",
+			rules: MatchingRules{
+				MatchingRule{
+					Path: "composer.json",
+					Packages: map[string]string{
+						"symfony/console": "^6.4",
+					},
+				},
+			},
+			filePath:       "composer.json",
+			packageName:    "symfony/console",
+			packageVersion: "v6.4.1",
+			expectedResult: true,
+		},
+		{
+			name: "Not matching package version constraint",
+			rules: MatchingRules{
+				MatchingRule{
+					Packages: map[string]string{
+						"symfony/console": "~5.4.0",
+					},
+				},
+			},
+			filePath:       "composer.json",
+			packageName:    "symfony/console",
+			packageVersion: "v6.4.1",
+			expectedResult: false,
+		},
+		{
+			name: "Version constraint compared as string",
+			rules: MatchingRules{
+				MatchingRule{
+					Packages: map[string]string{
+						"symfony/console": "^6.4",
+					},
+				},
+			},
+			filePath:       "composer.json",
+			packageName:    "symfony/console",
+			packageVersion: "^6.4",
+			expectedResult: true,
+		},
+		{
+			name: "Not matching package name",
+			rules: MatchingRules{
+				MatchingRule{
+					Packages: map[string]string{
+						"symfony/console": "",
+					},
+				},
+			},
+			filePath:       "composer.json",
+			packageName:    "monolog/monolog",
+			packageVersion: "3.5.0",
+			expectedResult: false,
+		},
+	}
+
+	for _, d := range dataset {
+		t.Run(d.name, func(t *testing.T) {
+			gotResult := d.rules.isMatchingRules(
+				d.rootDir,
+				d.filePath,
+				d.packageName,
+				d.packageVersion)
+
+			assert.Equal(t, d.expectedResult, gotResult)
+		})
+	}
+}
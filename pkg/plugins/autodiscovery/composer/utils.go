@@ -0,0 +1,96 @@
+package composer
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// composerJsonFile is the file used by Composer to declare dependencies
+	composerJsonFile string = "composer.json"
+	// composerLockFile is the lock file generated by Composer
+	composerLockFile string = "composer.lock"
+)
+
+var (
+	// ignoredDirectories lists directories that never contain project composer.json files
+	ignoredDirectories = []string{".git", "vendor", "node_modules"}
+
+	// isCommandAvailable checks if a command can be executed, it's a variable so tests can override it
+	isCommandAvailable = func(name string) bool {
+		return exec.Command(name, "--version").Run() == nil
+	}
+)
+
+// searchComposerJsonFiles looks, recursively, for every files named composer.json from a root directory.
+func searchComposerJsonFiles(rootDir string) ([]string, error) {
+
+	foundFiles := []string{}
+
+	logrus.Debugf("Looking for composer.json files in %q", rootDir)
+
+	err := filepath.WalkDir(rootDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			fmt.Printf("prevent panic by handling failure accessing a path %q: %v\n", path, err)
+			return err
+		}
+
+		// Updatecli should ignore all composer.json from directories such as "vendor"
+		// as they are automatically installed by Composer
+		if d.IsDir() {
+			for _, ignored := range ignoredDirectories {
+				if d.Name() == ignored {
+					return filepath.SkipDir
+				}
+			}
+			return nil
+		}
+
+		if d.Name() == composerJsonFile {
+			foundFiles = append(foundFiles, path)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return foundFiles, nil
+}
+
+// isPlatformPackage checks if a requirement is a platform package, such as "php" or "ext-json",
+// which is provided by the system and not by a Composer repository
+// https://getcomposer.org/doc/01-basic-usage.md#platform-packages
+func isPlatformPackage(packageName string) bool {
+	return !strings.Contains(packageName, "/")
+}
+
+func isLockFileDetected(lockfile string) bool {
+	_, err := os.Stat(lockfile)
+	return err == nil
+}
+
+// sortedKeys returns the keys of m in a deterministic order
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// getLockCommand returns the command updating composer.lock to the version returned by the composer source,
+// without installing packages nor running scripts
+func getLockCommand(packageName string) string {
+	return fmt.Sprintf("composer update $ARGS --no-install --no-scripts --no-interaction --with '%s:{{ source %q }}' '%s'", packageName, "composer", packageName)
+}
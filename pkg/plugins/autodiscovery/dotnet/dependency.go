@@ -0,0 +1,134 @@
+package dotnet
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"path/filepath"
+	"text/template"
+
+	"github.com/beevik/etree"
+	"github.com/sirupsen/logrus"
+)
+
+// discoverDependencyManifests discovers manifests for NuGet packages
+func (d Dotnet) discoverDependencyManifests() ([][]byte, error) {
+
+	var manifests [][]byte
+
+	searchFromDir := d.rootDir
+	// If the spec.RootDir is an absolute path, then it as already been set
+	// correctly in the New function.
+	if d.spec.RootDir != "" && !path.IsAbs(d.spec.RootDir) {
+		searchFromDir = filepath.Join(d.rootDir, d.spec.RootDir)
+	}
+
+	foundFiles, err := searchDotnetFiles(searchFromDir)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, foundFile := range foundFiles {
+
+		relativeFile, err := filepath.Rel(d.rootDir, foundFile)
+		if err != nil {
+			// Let's try the next file if one fail
+			logrus.Debugln(err)
+			continue
+		}
+
+		logrus.Debugf("parsing file %q", relativeFile)
+
+		doc := etree.NewDocument()
+		if err := doc.ReadFromFile(foundFile); err != nil {
+			logrus.Debugln(err)
+			continue
+		}
+
+		references := getPackageReferences(doc)
+		if len(references) == 0 {
+			logrus.Debugf("no NuGet package found in %q\n", relativeFile)
+			continue
+		}
+
+		for _, ref := range references {
+
+			if !isVersionSupported(ref.Name, ref.Version) {
+				continue
+			}
+
+			if len(d.spec.Ignore) > 0 {
+				if d.spec.Ignore.isMatchingRules(d.rootDir, relativeFile, ref.Name, ref.Version) {
+					logrus.Debugf("Ignoring package %q from %q, as matching ignore rule(s)\n", ref.Name, relativeFile)
+					continue
+				}
+			}
+
+			if len(d.spec.Only) > 0 {
+				if !d.spec.Only.isMatchingRules(d.rootDir, relativeFile, ref.Name, ref.Version) {
+					logrus.Debugf("Ignoring package %q from %q, as not matching only rule(s)\n", ref.Name, relativeFile)
+					continue
+				}
+			}
+
+			sourceVersionFilterKind := "semver"
+			sourceVersionFilterPattern := ">=" + ref.Version
+			if !d.spec.VersionFilter.IsZero() {
+				sourceVersionFilterKind = d.versionFilter.Kind
+				sourceVersionFilterPattern, err = d.versionFilter.GreaterThanPattern(ref.Version)
+				if err != nil {
+					logrus.Debugf("building version filter pattern: %s", err)
+					sourceVersionFilterPattern = "*"
+				}
+			}
+
+			tmpl, err := template.New("manifest").Parse(manifestTemplate)
+			if err != nil {
+				logrus.Debugln(err)
+				continue
+			}
+
+			params := struct {
+				ActionID                   string
+				ManifestName               string
+				SourceID                   string
+				SourceName                 string
+				SourcePackage              string
+				SourceURL                  string
+				SourceVersionFilterKind    string
+				SourceVersionFilterPattern string
+				TargetID                   string
+				TargetName                 string
+				TargetMatchPattern         string
+				TargetReplacePattern       string
+				File                       string
+				ScmID                      string
+			}{
+				ActionID:                   d.actionID,
+				ManifestName:               fmt.Sprintf("Bump NuGet package %q", ref.Name),
+				SourceID:                   "nuget",
+				SourceName:                 fmt.Sprintf("Get NuGet package %q latest version", ref.Name),
+				SourcePackage:              ref.Name,
+				SourceURL:                  d.spec.URL,
+				SourceVersionFilterKind:    sourceVersionFilterKind,
+				SourceVersionFilterPattern: sourceVersionFilterPattern,
+				TargetID:                   "nuget",
+				TargetName:                 fmt.Sprintf("deps(nuget): bump %q to {{ source %q }}", ref.Name, "nuget"),
+				TargetMatchPattern:         ref.matchPattern(),
+				TargetReplacePattern:       fmt.Sprintf(`${1}{{ source %q }}${2}`, "nuget"),
+				File:                       relativeFile,
+				ScmID:                      d.scmID,
+			}
+
+			manifest := bytes.Buffer{}
+			if err := tmpl.Execute(&manifest, params); err != nil {
+				logrus.Debugln(err)
+				continue
+			}
+
+			manifests = append(manifests, manifest.Bytes())
+		}
+	}
+
+	return manifests, nil
+}
@@ -0,0 +1,116 @@
+package dotnet
+
+import (
+	"path"
+	"strings"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+// Spec defines the parameters which can be provided to the dotnet crawler.
+type Spec struct {
+	// RootDir defines the root directory used to recursively search for .NET project files
+	RootDir string `yaml:",omitempty"`
+	// Ignore allows to specify rule to ignore autodiscovery a specific NuGet package based on a rule
+	Ignore MatchingRules `yaml:",omitempty"`
+	// Only allows to specify rule to only autodiscover manifest for a specific NuGet package based on a rule
+	Only MatchingRules `yaml:",omitempty"`
+	/*
+		versionfilter provides parameters to specify the version pattern used when generating manifest.
+
+		kind - semver
+			versionfilter of kind `semver` uses semantic versioning as version filtering
+			pattern accepts one of:
+				`patch` - patch only update patch version
+				`minor` - minor only update minor version
+				`major` - major only update major versions
+				`a version constraint` such as `>= 1.0.0`
+
+		kind - regex
+			versionfilter of kind `regex` uses regular expression as version filtering
+			pattern accepts a valid regular expression
+
+		example:
+		```
+			versionfilter:
+				kind: semver
+				pattern: minor
+		```
+
+		and its type like regex, semver, or just latest.
+	*/
+	VersionFilter version.Filter `yaml:",omitempty"`
+	// URL defines the NuGet feed used by the generated manifests
+	//
+	// default: https://api.nuget.org/v3/index.json
+	URL string `yaml:",omitempty"`
+}
+
+// Dotnet holds all information needed to generate NuGet manifests.
+type Dotnet struct {
+	// actionID holds the actionID used by the newly generated manifest
+	actionID string
+	// spec defines the settings provided via an updatecli manifest
+	spec Spec
+	// rootDir defines the root directory from where looking for .NET project files
+	rootDir string
+	// scmID holds the scmID used by the newly generated manifest
+	scmID string
+	// versionFilter holds the "valid" version.filter, that might be different from the user-specified filter (Spec.VersionFilter)
+	versionFilter version.Filter
+}
+
+// New return a new valid object.
+func New(spec interface{}, rootDir, scmID, actionID string) (Dotnet, error) {
+	var s Spec
+
+	err := mapstructure.Decode(spec, &s)
+	if err != nil {
+		return Dotnet{}, err
+	}
+
+	dir := rootDir
+	if path.IsAbs(s.RootDir) {
+		if scmID != "" {
+			logrus.Warningf("rootdir %q is an absolute path, scmID %q will be ignored", s.RootDir, scmID)
+		}
+		dir = s.RootDir
+	}
+
+	if len(dir) == 0 {
+		logrus.Errorln("no working directory defined")
+		return Dotnet{}, err
+	}
+
+	newFilter := s.VersionFilter
+	if s.VersionFilter.IsZero() {
+		// NuGet package versions follow semantic versioning
+		newFilter.Kind = "semver"
+		newFilter.Pattern = "*"
+	}
+
+	return Dotnet{
+		actionID:      actionID,
+		spec:          s,
+		rootDir:       dir,
+		scmID:         scmID,
+		versionFilter: newFilter,
+	}, nil
+
+}
+
+func (d Dotnet) DiscoverManifests() ([][]byte, error) {
+
+	logrus.Infof("\n\n%s\n", strings.ToTitle("Dotnet"))
+	logrus.Infof("%s\n", strings.Repeat("=", len("Dotnet")+1))
+
+	manifests, err := d.discoverDependencyManifests()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return manifests, nil
+}
@@ -0,0 +1,120 @@
+package dotnet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiscoverManifests(t *testing.T) {
+
+	testdata := []struct {
+		name              string
+		rootDir           string
+		spec              Spec
+		expectedPipelines []string
+	}{
+		{
+			name:    "SDK style project",
+			rootDir: "testdata/sdk",
+			expectedPipelines: []string{`name: 'Bump NuGet package "Newtonsoft.Json"'
+sources:
+  nuget:
+    name: 'Get NuGet package "Newtonsoft.Json" latest version'
+    kind: 'nuget'
+    spec:
+      package: 'Newtonsoft.Json'
+      versionfilter:
+        kind: 'semver'
+        pattern: '>=13.0.1'
+targets:
+  nuget:
+    name: 'deps(nuget): bump "Newtonsoft.Json" to {{ source "nuget" }}'
+    kind: 'file'
+    spec:
+      file: 'src/Api/Api.csproj'
+      matchpattern: '(<PackageReference\s[^>]*\bInclude="Newtonsoft\.Json"[^>]*\bVersion=")[^"]*(")'
+      replacepattern: '${1}{{ source "nuget" }}${2}'
+    sourceid: 'nuget'
+`, `name: 'Bump NuGet package "Swashbuckle.AspNetCore"'
+sources:
+  nuget:
+    name: 'Get NuGet package "Swashbuckle.AspNetCore" latest version'
+    kind: 'nuget'
+    spec:
+      package: 'Swashbuckle.AspNetCore'
+      versionfilter:
+        kind: 'semver'
+        pattern: '>=6.5.0'
+targets:
+  nuget:
+    name: 'deps(nuget): bump "Swashbuckle.AspNetCore" to {{ source "nuget" }}'
+    kind: 'file'
+    spec:
+      file: 'src/Api/Api.csproj'
+      matchpattern: '(<PackageReference\s[^>]*\bVersion=")[^"]*("[^>]*\bInclude="Swashbuckle\.AspNetCore")'
+      replacepattern: '${1}{{ source "nuget" }}${2}'
+    sourceid: 'nuget'
+`},
+		},
+		{
+			name:    "Central package management",
+			rootDir: "testdata/centralpackages",
+			spec: Spec{
+				URL: "https://nuget.example.com/v3/index.json",
+				Only: MatchingRules{
+					{Packages: map[string]string{"Microsoft.Extensions.Logging": ">=8"}},
+				},
+			},
+			expectedPipelines: []string{`name: 'Bump NuGet package "Microsoft.Extensions.Logging"'
+sources:
+  nuget:
+    name: 'Get NuGet package "Microsoft.Extensions.Logging" latest version'
+    kind: 'nuget'
+    spec:
+      package: 'Microsoft.Extensions.Logging'
+      url: 'https://nuget.example.com/v3/index.json'
+      versionfilter:
+        kind: 'semver'
+        pattern: '>=8.0.0'
+targets:
+  nuget:
+    name: 'deps(nuget): bump "Microsoft.Extensions.Logging" to {{ source "nuget" }}'
+    kind: 'file'
+    spec:
+      file: 'Directory.Packages.props'
+      matchpattern: '(<PackageVersion\s[^>]*\bInclude="Microsoft\.Extensions\.Logging"[^>]*\bVersion=")[^"]*(")'
+      replacepattern: '${1}{{ source "nuget" }}${2}'
+    sourceid: 'nuget'
+`},
+		},
+		{
+			name:    "Packages.config ignored",
+			rootDir: "testdata/packagesconfig",
+			spec: Spec{
+				Ignore: MatchingRules{
+					{Path: "packages.config"},
+				},
+			},
+		},
+	}
+
+	for _, tt := range testdata {
+
+		t.Run(tt.name, func(t *testing.T) {
+			resource, err := New(
+				tt.spec, tt.rootDir, "", "")
+			require.NoError(t, err)
+
+			pipelines, err := resource.DiscoverManifests()
+			require.NoError(t, err)
+
+			require.Equal(t, len(tt.expectedPipelines), len(pipelines))
+
+			for i, expectedPipeline := range tt.expectedPipelines {
+				assert.Equal(t, expectedPipeline, string(pipelines[i]))
+			}
+		})
+	}
+}
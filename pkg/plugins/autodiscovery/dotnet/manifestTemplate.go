@@ -0,0 +1,36 @@
+package dotnet
+
+var (
+	// manifestTemplate is the Go template used to generate NuGet manifests
+	manifestTemplate string = `name: '{{ .ManifestName }}'
+{{- if .ActionID }}
+actions:
+  {{ .ActionID }}:
+    title: '{{ .TargetName }}'
+{{ end }}
+sources:
+  {{ .SourceID }}:
+    name: '{{ .SourceName }}'
+    kind: 'nuget'
+    spec:
+      package: '{{ .SourcePackage }}'
+{{- if .SourceURL }}
+      url: '{{ .SourceURL }}'
+{{- end }}
+      versionfilter:
+        kind: '{{ .SourceVersionFilterKind }}'
+        pattern: '{{ .SourceVersionFilterPattern }}'
+targets:
+  {{ .TargetID }}:
+    name: '{{ .TargetName }}'
+    kind: 'file'
+{{- if .ScmID }}
+    scmid: '{{ .ScmID }}'
+{{ end }}
+    spec:
+      file: '{{ .File }}'
+      matchpattern: '{{ .TargetMatchPattern }}'
+      replacepattern: '{{ .TargetReplacePattern }}'
+    sourceid: '{{ .SourceID }}'
+`
+)
@@ -0,0 +1,170 @@
+package dotnet
+
+import (
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/beevik/etree"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// centralPackageFile is the file used by NuGet Central Package Management
+	centralPackageFile string = "Directory.Packages.props"
+	// packagesConfigFile is the legacy file used by packages.config projects
+	packagesConfigFile string = "packages.config"
+)
+
+var (
+	// projectFileExtensions lists the MSBuild project file extensions containing PackageReference
+	projectFileExtensions = []string{".csproj", ".fsproj", ".vbproj"}
+)
+
+// packageReference describes a NuGet package version defined in a file
+type packageReference struct {
+	// Name is the NuGet package ID
+	Name string
+	// Version is the package version defined in the file
+	Version string
+	// element is the xml element name defining the package
+	element string
+	// nameAttr is the xml attribute holding the package ID
+	nameAttr string
+	// versionAttr is the xml attribute holding the package version
+	versionAttr string
+	// versionFirst is true when the version attribute precedes the package ID attribute
+	versionFirst bool
+}
+
+// searchDotnetFiles looks, recursively, for every .NET project, Directory.Packages.props,
+// and packages.config files from a root directory.
+func searchDotnetFiles(rootDir string) ([]string, error) {
+
+	foundFiles := []string{}
+
+	logrus.Debugf("Looking for .NET project files in %q", rootDir)
+
+	err := filepath.WalkDir(rootDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			fmt.Printf("prevent panic by handling failure accessing a path %q: %v\n", path, err)
+			return err
+		}
+
+		// Updatecli should ignore build outputs
+		if d.IsDir() && (d.Name() == "bin" || d.Name() == "obj") {
+			return filepath.SkipDir
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		switch {
+		case d.Name() == centralPackageFile, d.Name() == packagesConfigFile:
+			foundFiles = append(foundFiles, path)
+		default:
+			for _, ext := range projectFileExtensions {
+				if filepath.Ext(d.Name()) == ext {
+					foundFiles = append(foundFiles, path)
+				}
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return foundFiles, nil
+}
+
+// getPackageReferences returns every NuGet package defined with an explicit version in doc
+func getPackageReferences(doc *etree.Document) []packageReference {
+	var references []packageReference
+
+	queries := []struct {
+		path        string
+		element     string
+		nameAttrs   []string
+		versionAttr string
+	}{
+		{path: "//PackageReference", element: "PackageReference", nameAttrs: []string{"Include", "Update"}, versionAttr: "Version"},
+		{path: "//PackageVersion", element: "PackageVersion", nameAttrs: []string{"Include", "Update"}, versionAttr: "Version"},
+		{path: "/packages/package", element: "package", nameAttrs: []string{"id"}, versionAttr: "version"},
+	}
+
+	for _, query := range queries {
+		for _, elem := range doc.FindElements(query.path) {
+			ref := packageReference{
+				element:     query.element,
+				versionAttr: query.versionAttr,
+			}
+
+			nameIndex, versionIndex := -1, -1
+			for i, attr := range elem.Attr {
+				switch {
+				case attr.Key == query.versionAttr:
+					ref.Version = attr.Value
+					versionIndex = i
+				case nameIndex < 0 && isOneOf(attr.Key, query.nameAttrs):
+					ref.Name = attr.Value
+					ref.nameAttr = attr.Key
+					nameIndex = i
+				}
+			}
+
+			if nameIndex < 0 || versionIndex < 0 {
+				// Version specified as a child element or inherited from
+				// Central Package Management can't be updated from here
+				continue
+			}
+
+			ref.versionFirst = versionIndex < nameIndex
+			references = append(references, ref)
+		}
+	}
+
+	return references
+}
+
+// isOneOf returns true if s is one of values
+func isOneOf(s string, values []string) bool {
+	for _, v := range values {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
+
+// isVersionSupported returns false for versions that can't be bumped by Updatecli
+// such as MSBuild properties, version ranges, or floating versions
+func isVersionSupported(packageName, packageVersion string) bool {
+	switch {
+	case packageVersion == "":
+		return false
+	case strings.Contains(packageVersion, "$("):
+		logrus.Debugf("Ignoring package %q as its version %q relies on a MSBuild property", packageName, packageVersion)
+		return false
+	case strings.ContainsAny(packageVersion, "[](),*"):
+		logrus.Debugf("Ignoring package %q as its version %q is a version range handled by NuGet", packageName, packageVersion)
+		return false
+	}
+	return true
+}
+
+// matchPattern returns the regular expression matching the package version in its file
+func (p packageReference) matchPattern() string {
+	name := fmt.Sprintf(`\b%s="%s"`, p.nameAttr, regexp.QuoteMeta(p.Name))
+
+	if p.versionFirst {
+		return fmt.Sprintf(`(<%s\s[^>]*\b%s=")[^"]*("[^>]*%s)`, p.element, p.versionAttr, name)
+	}
+
+	return fmt.Sprintf(`(<%s\s[^>]*%s[^>]*\b%s=")[^"]*(")`, p.element, name, p.versionAttr)
+}
@@ -0,0 +1,60 @@
+package dotnet
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchPattern(t *testing.T) {
+	testdata := []struct {
+		name     string
+		ref      packageReference
+		content  string
+		expected string
+	}{
+		{
+			name: "Include before Version",
+			ref: packageReference{
+				Name: "Newtonsoft.Json", element: "PackageReference", nameAttr: "Include", versionAttr: "Version",
+			},
+			content:  `<PackageReference Include="Newtonsoft.Json.Bson" Version="1.0.0" /><PackageReference Include="Newtonsoft.Json" Version="13.0.1" />`,
+			expected: `<PackageReference Include="Newtonsoft.Json.Bson" Version="1.0.0" /><PackageReference Include="Newtonsoft.Json" Version="13.0.3" />`,
+		},
+		{
+			name: "Version before Include",
+			ref: packageReference{
+				Name: "Polly", element: "PackageReference", nameAttr: "Include", versionAttr: "Version", versionFirst: true,
+			},
+			content:  `<PackageReference Version="7.0.0" Include="Polly" PrivateAssets="all" />`,
+			expected: `<PackageReference Version="13.0.3" Include="Polly" PrivateAssets="all" />`,
+		},
+		{
+			name: "packages.config",
+			ref: packageReference{
+				Name: "NUnit", element: "package", nameAttr: "id", versionAttr: "version",
+			},
+			content:  `<package id="NUnit" version="3.13.3" targetFramework="net48" />`,
+			expected: `<package id="NUnit" version="13.0.3" targetFramework="net48" />`,
+		},
+	}
+
+	for _, tt := range testdata {
+		t.Run(tt.name, func(t *testing.T) {
+			re, err := regexp.Compile(tt.ref.matchPattern())
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, re.ReplaceAllString(tt.content, "${1}13.0.3${2}"))
+		})
+	}
+}
+
+func TestIsVersionSupported(t *testing.T) {
+	assert.True(t, isVersionSupported("Serilog", "3.1.1"))
+	assert.True(t, isVersionSupported("Serilog", "4.0.0-dev-02108"))
+	assert.False(t, isVersionSupported("Serilog", "$(SerilogVersion)"))
+	assert.False(t, isVersionSupported("Serilog", "[3.0,4.0)"))
+	assert.False(t, isVersionSupported("Serilog", "3.*"))
+	assert.False(t, isVersionSupported("Serilog", ""))
+}
@@ -1,13 +1,12 @@
 package githubaction
 
 import (
-	"bytes"
 	"fmt"
 	"strings"
-	"text/template"
 
 	"github.com/sirupsen/logrus"
 	"github.com/updatecli/updatecli/pkg/plugins/resources/dockerimage"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/dockermanifest"
 )
 
 // dockerGHAManifestSpec contains the parameters to generate the Updatecli manifest specifically for Docker images.
@@ -71,91 +70,17 @@ func (g GitHubAction) getDockerManifest(spec *dockerGHAManifestSpec) ([]byte, er
 		}
 	}
 
-	sourceSpec := dockerimage.NewDockerImageSpecFromImage(imageName, imageTag, g.spec.CredentialsDocker)
+	params := dockermanifest.NewParams(imageName, imageTag, g.spec.VersionFilter, g.versionFilter, g.spec.CredentialsDocker)
+	params.ActionID = g.actionID
+	params.TargetID = "workflow"
+	params.TargetFile = spec.RelativeFoundFile
+	params.TargetKey = spec.TargetKey
+	params.TargetPrefix = imageName + ":"
+	params.ScmID = g.scmID
 
-	versionFilterKind := g.versionFilter.Kind
-	versionFilterPattern := g.versionFilter.Pattern
-	tagFilter := "*"
-	architecture := ""
-
-	if sourceSpec != nil {
-		versionFilterKind = sourceSpec.VersionFilter.Kind
-		versionFilterPattern = sourceSpec.VersionFilter.Pattern
-		tagFilter = sourceSpec.TagFilter
-		architecture = sourceSpec.Architecture
-	}
-
-	// If a versionfilter is specified in the manifest then we want to be sure that it takes precedence
-	if !g.spec.VersionFilter.IsZero() {
-		versionFilterKind = g.versionFilter.Kind
-		versionFilterPattern, err = g.versionFilter.GreaterThanPattern(imageTag)
-		tagFilter = ""
-		if err != nil {
-			logrus.Debugf("building version filter pattern: %s", err)
-			sourceSpec.VersionFilter.Pattern = "*"
-		}
-	}
-
-	var tmpl *template.Template
-	if g.digest && sourceSpec != nil {
-		tmpl, err = template.New("manifest").Parse(manifestTemplateDockerDigestAndLatest)
-		if err != nil {
-			return nil, err
-		}
-	} else if g.digest && sourceSpec == nil {
-		tmpl, err = template.New("manifest").Parse(manifestTemplateDockerDigest)
-		if err != nil {
-			return nil, err
-		}
-	} else if !g.digest && sourceSpec != nil {
-		tmpl, err = template.New("manifest").Parse(manifestTemplateDockerLatest)
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		logrus.Infoln("No source spec detected")
-		return nil, nil
-	}
-
-	targetPrefix := imageName + ":"
 	if strings.HasPrefix(spec.Image, "docker://") {
-		targetPrefix = "docker://" + imageName + ":"
-	}
-
-	params := struct {
-		ActionName           string
-		ActionID             string
-		ImageName            string
-		ImageTag             string
-		ImageArchitecture    string
-		TargetName           string
-		TargetFile           string
-		TargetKey            string
-		TargetPrefix         string
-		TagFilter            string
-		VersionFilterKind    string
-		VersionFilterPattern string
-		ScmID                string
-	}{
-		ActionName:           spec.ActionName,
-		ActionID:             g.actionID,
-		ImageName:            imageName,
-		ImageTag:             imageTag,
-		ImageArchitecture:    architecture,
-		TargetName:           fmt.Sprintf(`deps: bump docker image %q in %q to {{ source %q }}`, imageName, spec.RelativeFoundFile, imageName),
-		TargetFile:           spec.RelativeFoundFile,
-		TargetKey:            spec.TargetKey,
-		TargetPrefix:         targetPrefix,
-		TagFilter:            tagFilter,
-		VersionFilterKind:    versionFilterKind,
-		VersionFilterPattern: versionFilterPattern,
-		ScmID:                g.scmID,
-	}
-
-	manifest := bytes.Buffer{}
-	if err := tmpl.Execute(&manifest, params); err != nil {
-		return nil, err
+		params.TargetPrefix = "docker://" + imageName + ":"
 	}
 
-	return manifest.Bytes(), nil
+	return dockermanifest.Generate(params, g.digest)
 }
@@ -45,23 +45,27 @@ func (g Golang) discoverDependencyManifests() ([][]byte, error) {
 		}
 
 		goSumFound := false
-		goSumFilePath := filepath.Join(filepath.Dir(foundFile), "go.sum")
+		goSumFilePath := filepath.Join(filepath.Dir(foundFile), GoSumFile)
 		if _, err := os.Stat(goSumFilePath); err == nil {
 			goSumFound = true
 		}
 
-		// If the Go binary is available then we can run `go mod tidy` in case of the go.mod modification
+		// If a go.sum file is present, then it must be updated after the go.mod file change.
+		// If the Go binary is available then we run `go mod tidy` which also updates the module graph,
+		// otherwise only the module checksums are updated, which fails if the module requires newer dependencies.
 		goModTidyEnabled := false
-		switch isGolangInstalled() {
-		case true:
-			// If both go and go.sum are present, then we can run `go mod tidy` after go.mod file change
-			if goSumFound {
+		relativeGoSumFile := ""
+		if goSumFound {
+			switch isGolangInstalled() {
+			case true:
 				goModTidyEnabled = true
-			}
-
-		case false:
-			if goSumFound {
-				logrus.Warningf("File %q detected but not Golang so we can't run go mod tidy if %s is modified", goSumFilePath, foundFile)
+			case false:
+				logrus.Debugf("File %q detected but not Golang so we can't run go mod tidy if %s is modified, only module checksums are updated", goSumFilePath, foundFile)
+				relativeGoSumFile, err = filepath.Rel(g.rootDir, goSumFilePath)
+				if err != nil {
+					logrus.Debugln(err)
+					continue
+				}
 			}
 		}
 
@@ -106,7 +110,8 @@ func (g Golang) discoverDependencyManifests() ([][]byte, error) {
 				g.scmID,
 				g.actionID,
 				relativeWorkDir,
-				goModTidyEnabled)
+				goModTidyEnabled,
+				relativeGoSumFile)
 			if err != nil {
 				logrus.Debugf("skipping golang module %q module due to: %s", goModule, err)
 				continue
@@ -188,7 +193,7 @@ func getGolangVersionManifest(filename, versionFilterKind, versionFilterPattern,
 	return manifest.Bytes(), nil
 }
 
-func getGolangModuleManifest(filename, module, versionFilterKind, versionFilterPattern, scmID, actionID, workdir string, goModTidy bool) ([]byte, error) {
+func getGolangModuleManifest(filename, module, versionFilterKind, versionFilterPattern, scmID, actionID, workdir string, goModTidy bool, goSumFile string) ([]byte, error) {
 
 	tmpl, err := template.New("manifest").Parse(goModuleManifestTemplate)
 	if err != nil {
@@ -199,6 +204,7 @@ func getGolangModuleManifest(filename, module, versionFilterKind, versionFilterP
 	params := struct {
 		ActionID             string
 		GoModFile            string
+		GoSumFile            string
 		Module               string
 		VersionFilterKind    string
 		VersionFilterPattern string
@@ -208,6 +214,7 @@ func getGolangModuleManifest(filename, module, versionFilterKind, versionFilterP
 	}{
 		ActionID:             actionID,
 		GoModFile:            filename,
+		GoSumFile:            goSumFile,
 		Module:               module,
 		VersionFilterKind:    versionFilterKind,
 		VersionFilterPattern: versionFilterPattern,
@@ -12,6 +12,7 @@ func TestDiscoverManifests(t *testing.T) {
 	testdata := []struct {
 		name              string
 		rootDir           string
+		goNotInstalled    bool
 		expectedPipelines []string
 	}{
 		{
@@ -55,6 +56,56 @@ targets:
            - 'go.mod'
            - 'go.sum'
 `, `name: 'deps(golang): bump Go version'
+sources:
+  go:
+    name: 'Get latest Go version'
+    kind: 'golang'
+    spec:
+      versionfilter:
+        kind: 'semver'
+        pattern: '>=1.20.0'
+targets:
+  go:
+    name: 'deps(golang): bump Go version to {{ source "go" }}'
+    kind: 'golang/gomod'
+    sourceid: 'go'
+    spec:
+      file: 'go.mod'
+`,
+			},
+		},
+		{
+			name:           "Golang Version without go installed",
+			rootDir:        "testdata/noModule",
+			goNotInstalled: true,
+			expectedPipelines: []string{`name: 'deps(go): bump module gopkg.in/yaml.v3'
+sources:
+  module:
+    name: 'Get latest golang module gopkg.in/yaml.v3 version'
+    kind: 'golang/module'
+    spec:
+      module: 'gopkg.in/yaml.v3'
+      versionfilter:
+        kind: 'semver'
+        pattern: '>=3.0.1'
+targets:
+  module:
+    name: 'deps(go): bump module gopkg.in/yaml.v3 to {{ source "module" }}'
+    kind: 'golang/gomod'
+    sourceid: 'module'
+    spec:
+      file: 'go.mod'
+      module: 'gopkg.in/yaml.v3'
+  gosum:
+    name: 'deps(go): update module gopkg.in/yaml.v3 checksums to {{ source "module" }}'
+    kind: 'golang/module'
+    sourceid: 'module'
+    dependson:
+      - 'module'
+    spec:
+      file: 'go.sum'
+      module: 'gopkg.in/yaml.v3'
+`, `name: 'deps(golang): bump Go version'
 sources:
   go:
     name: 'Get latest Go version'
@@ -117,6 +168,11 @@ targets:
 
 	for _, tt := range testdata {
 		t.Run(tt.name, func(t *testing.T) {
+			if tt.goNotInstalled {
+				// The go command can't be found, so go.sum is updated without running "go mod tidy"
+				t.Setenv("PATH", t.TempDir())
+			}
+
 			resource, err := New(
 				Spec{}, tt.rootDir, "", "")
 			require.NoError(t, err)
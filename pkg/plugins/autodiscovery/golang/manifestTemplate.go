@@ -79,5 +79,19 @@ targets:
     scmid: '{{ .ScmID }}'
 {{ end }}
 {{- end }}
+{{- if .GoSumFile }}
+  gosum:
+    name: 'deps(go): update module {{ .Module }} checksums to {{ "{{" }} source "module" {{ "}}" }}'
+    kind: 'golang/module'
+    sourceid: 'module'
+    dependson:
+      - 'module'
+    spec:
+      file: '{{ .GoSumFile }}'
+      module: '{{ .Module }}'
+{{- if .ScmID }}
+    scmid: '{{ .ScmID }}'
+{{ end }}
+{{- end }}
 `
 )
@@ -13,6 +13,7 @@ import (
 
 const (
 	GoModFile string = "go.mod"
+	GoSumFile string = "go.sum"
 )
 
 // searchGoModFiles looks, recursively, for every files named go.mod from a root directory.
@@ -0,0 +1,72 @@
+package gradle
+
+import (
+	"regexp"
+)
+
+const (
+	// kotlinPluginPrefix is the plugin id prefix used by the Kotlin DSL kotlin("...") plugin shortcut
+	kotlinPluginPrefix string = "org.jetbrains.kotlin."
+)
+
+var (
+	// coordinatesRegex matches a dependency declared using the "group:artifact:version" notation such as
+	//   implementation "org.slf4j:slf4j-api:2.0.9"
+	//   implementation(platform("org.springframework.boot:spring-boot-dependencies:3.2.0"))
+	coordinatesRegex = regexp.MustCompile(`(?m)^\s*[A-Za-z]\w*\s*\(?\s*(?:(?:platform|enforcedPlatform)\s*\(\s*)?["']([\w.\-]+):([\w.\-]+):([^"'$:@\s]+)(?::[\w.\-]+)?(?:@\w+)?["']`)
+	// mapNotationRegex matches a dependency declared using the map notation such as
+	//   implementation group: 'org.slf4j', name: 'slf4j-api', version: '2.0.9'
+	//   implementation(group = "org.slf4j", name = "slf4j-api", version = "2.0.9")
+	mapNotationRegex = regexp.MustCompile(`(?m)^\s*[A-Za-z]\w*\s*\(?\s*group\s*[:=]\s*["']([\w.\-]+)["']\s*,\s*name\s*[:=]\s*["']([\w.\-]+)["']\s*,\s*version\s*[:=]\s*["']([^"'$]+)["']`)
+	// pluginRegex matches a plugin declared in a plugins block such as
+	//   id 'org.springframework.boot' version '3.2.0'
+	//   id("org.springframework.boot") version "3.2.0"
+	//   kotlin("jvm") version "1.9.21"
+	pluginRegex = regexp.MustCompile(`(?m)^\s*(id|kotlin)\s*\(?\s*["']([\w.\-]+)["']\s*\)?\s*version\s*\(?\s*["']([^"'$]+)["']`)
+)
+
+// parseBuildFile returns every pinned library and plugin declared in a Gradle build script,
+// using either the Groovy or the Kotlin DSL.
+func parseBuildFile(content []byte) []dependency {
+	var dependencies []dependency
+
+	found := map[string]bool{}
+	add := func(dep dependency) {
+		if !isPinnedVersion(dep.Version) || found[dep.Name+"@"+dep.Version] {
+			return
+		}
+		found[dep.Name+"@"+dep.Version] = true
+		dependencies = append(dependencies, dep)
+	}
+
+	for _, match := range pluginRegex.FindAllStringSubmatch(string(content), -1) {
+		notation, name, pinnedVersion := match[1], match[2], match[3]
+
+		id := name
+		if notation == "kotlin" {
+			id = kotlinPluginPrefix + name
+		}
+
+		add(newPlugin(id, pinnedVersion,
+			`(`+regexp.QuoteMeta(notation)+`\s*\(?\s*["']`+regexp.QuoteMeta(name)+`["']\s*\)?\s*version\s*\(?\s*["'])`+
+				regexp.QuoteMeta(pinnedVersion)+`(["'])`))
+	}
+
+	for _, match := range coordinatesRegex.FindAllStringSubmatch(string(content), -1) {
+		groupID, artifactID, pinnedVersion := match[1], match[2], match[3]
+
+		add(newLibrary(groupID, artifactID, pinnedVersion,
+			`(["']`+regexp.QuoteMeta(groupID+":"+artifactID+":")+`)`+
+				regexp.QuoteMeta(pinnedVersion)+`([:@"'])`))
+	}
+
+	for _, match := range mapNotationRegex.FindAllStringSubmatch(string(content), -1) {
+		groupID, artifactID, pinnedVersion := match[1], match[2], match[3]
+
+		add(newLibrary(groupID, artifactID, pinnedVersion,
+			`(group\s*[:=]\s*["']`+regexp.QuoteMeta(groupID)+`["']\s*,\s*name\s*[:=]\s*["']`+regexp.QuoteMeta(artifactID)+
+				`["']\s*,\s*version\s*[:=]\s*["'])`+regexp.QuoteMeta(pinnedVersion)+`(["'])`))
+	}
+
+	return dependencies
+}
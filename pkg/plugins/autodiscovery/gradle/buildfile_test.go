@@ -0,0 +1,114 @@
+package gradle
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBuildFile(t *testing.T) {
+	testdata := []struct {
+		name     string
+		file     string
+		expected []dependency
+	}{
+		{
+			name: "Groovy DSL",
+			file: "testdata/groovy/build.gradle",
+			expected: []dependency{
+				{
+					Name:           "org.springframework.boot",
+					GroupID:        "org.springframework.boot",
+					ArtifactID:     "org.springframework.boot.gradle.plugin",
+					Version:        "3.2.0",
+					isPlugin:       true,
+					matchPattern:   `(id\s*\(?\s*["']org\.springframework\.boot["']\s*\)?\s*version\s*\(?\s*["'])3\.2\.0(["'])`,
+					replacePattern: sourceReplacePattern,
+				},
+				{
+					Name:           "org.apache.commons:commons-lang3",
+					GroupID:        "org.apache.commons",
+					ArtifactID:     "commons-lang3",
+					Version:        "3.14.0",
+					matchPattern:   `(["']org\.apache\.commons:commons-lang3:)3\.14\.0([:@"'])`,
+					replacePattern: sourceReplacePattern,
+				},
+				{
+					Name:           "org.junit:junit-bom",
+					GroupID:        "org.junit",
+					ArtifactID:     "junit-bom",
+					Version:        "5.10.1",
+					matchPattern:   `(["']org\.junit:junit-bom:)5\.10\.1([:@"'])`,
+					replacePattern: sourceReplacePattern,
+				},
+				{
+					Name:           "com.google.code.gson:gson",
+					GroupID:        "com.google.code.gson",
+					ArtifactID:     "gson",
+					Version:        "2.10.1",
+					matchPattern:   `(group\s*[:=]\s*["']com\.google\.code\.gson["']\s*,\s*name\s*[:=]\s*["']gson["']\s*,\s*version\s*[:=]\s*["'])2\.10\.1(["'])`,
+					replacePattern: sourceReplacePattern,
+				},
+			},
+		},
+		{
+			name: "Kotlin DSL",
+			file: "testdata/kotlin/build.gradle.kts",
+			expected: []dependency{
+				{
+					Name:           "org.jetbrains.kotlin.jvm",
+					GroupID:        "org.jetbrains.kotlin.jvm",
+					ArtifactID:     "org.jetbrains.kotlin.jvm.gradle.plugin",
+					Version:        "1.9.21",
+					isPlugin:       true,
+					matchPattern:   `(kotlin\s*\(?\s*["']jvm["']\s*\)?\s*version\s*\(?\s*["'])1\.9\.21(["'])`,
+					replacePattern: sourceReplacePattern,
+				},
+				{
+					Name:           "com.diffplug.spotless",
+					GroupID:        "com.diffplug.spotless",
+					ArtifactID:     "com.diffplug.spotless.gradle.plugin",
+					Version:        "6.23.3",
+					isPlugin:       true,
+					matchPattern:   `(id\s*\(?\s*["']com\.diffplug\.spotless["']\s*\)?\s*version\s*\(?\s*["'])6\.23\.3(["'])`,
+					replacePattern: sourceReplacePattern,
+				},
+				{
+					Name:           "org.springframework.boot:spring-boot-dependencies",
+					GroupID:        "org.springframework.boot",
+					ArtifactID:     "spring-boot-dependencies",
+					Version:        "3.2.0",
+					matchPattern:   `(["']org\.springframework\.boot:spring-boot-dependencies:)3\.2\.0([:@"'])`,
+					replacePattern: sourceReplacePattern,
+				},
+				{
+					Name:           "io.ktor:ktor-server-core",
+					GroupID:        "io.ktor",
+					ArtifactID:     "ktor-server-core",
+					Version:        "2.3.7",
+					matchPattern:   `(["']io\.ktor:ktor-server-core:)2\.3\.7([:@"'])`,
+					replacePattern: sourceReplacePattern,
+				},
+				{
+					Name:           "com.google.code.gson:gson",
+					GroupID:        "com.google.code.gson",
+					ArtifactID:     "gson",
+					Version:        "2.10.1",
+					matchPattern:   `(group\s*[:=]\s*["']com\.google\.code\.gson["']\s*,\s*name\s*[:=]\s*["']gson["']\s*,\s*version\s*[:=]\s*["'])2\.10\.1(["'])`,
+					replacePattern: sourceReplacePattern,
+				},
+			},
+		},
+	}
+
+	for _, tt := range testdata {
+		t.Run(tt.name, func(t *testing.T) {
+			content, err := os.ReadFile(tt.file)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, parseBuildFile(content))
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package gradle
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+)
+
+// versionCatalog represents the sections of a Gradle version catalog such as gradle/libs.versions.toml
+type versionCatalog struct {
+	// Versions maps a version alias to a version
+	Versions map[string]any `toml:"versions"`
+	// Libraries maps a library alias to a library declaration
+	Libraries map[string]any `toml:"libraries"`
+	// Plugins maps a plugin alias to a plugin declaration
+	Plugins map[string]any `toml:"plugins"`
+}
+
+// catalogEntry is a library or a plugin declared in a version catalog
+type catalogEntry struct {
+	// alias is the key used in the [libraries] or [plugins] section
+	alias string
+	// name is either "group:artifact" for a library or the plugin id
+	name string
+	// version is the version declared inline
+	version string
+	// versionRef is the alias of the version declared in the [versions] section
+	versionRef string
+	// isPlugin is true for an entry of the [plugins] section
+	isPlugin bool
+	// isStringNotation is true when the entry is declared using the "name:version" notation
+	isStringNotation bool
+}
+
+// parseVersionCatalog returns every pinned library and plugin from a Gradle version catalog.
+// A version shared using version.ref is updated once, based on the first library or plugin referencing it.
+func parseVersionCatalog(content []byte) ([]dependency, error) {
+	var c versionCatalog
+
+	if err := toml.Unmarshal(content, &c); err != nil {
+		return nil, err
+	}
+
+	var entries []catalogEntry
+	for _, alias := range sortedKeys(c.Libraries) {
+		if entry, ok := parseCatalogEntry(alias, c.Libraries[alias], false); ok {
+			entries = append(entries, entry)
+		}
+	}
+	for _, alias := range sortedKeys(c.Plugins) {
+		if entry, ok := parseCatalogEntry(alias, c.Plugins[alias], true); ok {
+			entries = append(entries, entry)
+		}
+	}
+
+	var dependencies []dependency
+
+	references := map[string]catalogEntry{}
+	for _, entry := range entries {
+		if entry.versionRef == "" {
+			continue
+		}
+		if _, ok := references[entry.versionRef]; !ok {
+			references[entry.versionRef] = entry
+		}
+	}
+
+	for _, versionAlias := range sortedKeys(references) {
+		// Rich versions such as { strictly = "1.0" } are not supported
+		pinnedVersion, ok := c.Versions[versionAlias].(string)
+		if !ok || !isPinnedVersion(pinnedVersion) {
+			continue
+		}
+
+		dependencies = append(dependencies, references[versionAlias].toDependency(
+			pinnedVersion,
+			`(?m)^(\s*["']?`+regexp.QuoteMeta(versionAlias)+`["']?\s*=\s*["'])`+
+				regexp.QuoteMeta(pinnedVersion)+`(["'])`))
+	}
+
+	for _, entry := range entries {
+		if entry.version == "" || !isPinnedVersion(entry.version) {
+			continue
+		}
+
+		matchPattern := `(?m)^(\s*["']?` + regexp.QuoteMeta(entry.alias) + `["']?\s*=\s*\{[^}\n]*\bversion\s*=\s*["'])` +
+			regexp.QuoteMeta(entry.version) + `(["'])`
+		if entry.isStringNotation {
+			matchPattern = `(["']` + regexp.QuoteMeta(entry.name+":") + `)` +
+				regexp.QuoteMeta(entry.version) + `(["'])`
+		}
+
+		dependencies = append(dependencies, entry.toDependency(entry.version, matchPattern))
+	}
+
+	return dependencies, nil
+}
+
+// parseCatalogEntry parses a library or a plugin declared either using
+// the string notation "group:artifact:version", "plugin.id:version",
+// or a table such as { module = "group:artifact", version.ref = "alias" }
+func parseCatalogEntry(alias string, value any, isPlugin bool) (catalogEntry, bool) {
+	entry := catalogEntry{
+		alias:    alias,
+		isPlugin: isPlugin,
+	}
+
+	switch value := value.(type) {
+	case string:
+		entry.isStringNotation = true
+
+		// Only the last element is the version, as a plugin id doesn't contain any colon
+		i := strings.LastIndex(value, ":")
+		if i < 0 {
+			return catalogEntry{}, false
+		}
+		entry.name = value[:i]
+		entry.version = value[i+1:]
+
+		if !isPlugin && strings.Count(entry.name, ":") != 1 {
+			return catalogEntry{}, false
+		}
+
+	case map[string]any:
+		switch isPlugin {
+		case true:
+			entry.name, _ = value["id"].(string)
+		case false:
+			entry.name, _ = value["module"].(string)
+			if entry.name == "" {
+				group, _ := value["group"].(string)
+				name, _ := value["name"].(string)
+				if group != "" && name != "" {
+					entry.name = group + ":" + name
+				}
+			}
+		}
+
+		switch v := value["version"].(type) {
+		case string:
+			entry.version = v
+		case map[string]any:
+			entry.versionRef, _ = v["ref"].(string)
+		}
+
+	default:
+		return catalogEntry{}, false
+	}
+
+	if entry.name == "" {
+		return catalogEntry{}, false
+	}
+
+	return entry, true
+}
+
+// toDependency returns the dependency updating version using matchPattern
+func (c catalogEntry) toDependency(version, matchPattern string) dependency {
+	if c.isPlugin {
+		return newPlugin(c.name, version, matchPattern)
+	}
+
+	groupID, artifactID, _ := strings.Cut(c.name, ":")
+	return newLibrary(groupID, artifactID, version, matchPattern)
+}
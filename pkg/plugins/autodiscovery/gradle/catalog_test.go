@@ -0,0 +1,120 @@
+package gradle
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseVersionCatalog(t *testing.T) {
+	content, err := os.ReadFile("testdata/catalog/gradle/libs.versions.toml")
+	require.NoError(t, err)
+
+	got, err := parseVersionCatalog(content)
+	require.NoError(t, err)
+
+	expected := []dependency{
+		{
+			Name:           "org.jetbrains.kotlin:kotlin-reflect",
+			GroupID:        "org.jetbrains.kotlin",
+			ArtifactID:     "kotlin-reflect",
+			Version:        "1.9.21",
+			matchPattern:   `(?m)^(\s*["']?kotlin["']?\s*=\s*["'])1\.9\.21(["'])`,
+			replacePattern: sourceReplacePattern,
+		},
+		{
+			Name:           "com.google.guava:guava",
+			GroupID:        "com.google.guava",
+			ArtifactID:     "guava",
+			Version:        "32.1.3-jre",
+			matchPattern:   `(["']com\.google\.guava:guava:)32\.1\.3-jre(["'])`,
+			replacePattern: sourceReplacePattern,
+		},
+		{
+			Name:           "org.slf4j:slf4j-api",
+			GroupID:        "org.slf4j",
+			ArtifactID:     "slf4j-api",
+			Version:        "2.0.9",
+			matchPattern:   `(?m)^(\s*["']?slf4j-api["']?\s*=\s*\{[^}\n]*\bversion\s*=\s*["'])2\.0\.9(["'])`,
+			replacePattern: sourceReplacePattern,
+		},
+		{
+			Name:           "com.diffplug.spotless",
+			GroupID:        "com.diffplug.spotless",
+			ArtifactID:     "com.diffplug.spotless.gradle.plugin",
+			Version:        "6.23.3",
+			isPlugin:       true,
+			matchPattern:   `(["']com\.diffplug\.spotless:)6\.23\.3(["'])`,
+			replacePattern: sourceReplacePattern,
+		},
+	}
+
+	assert.Equal(t, expected, got)
+}
+
+func TestParseCatalogEntry(t *testing.T) {
+	testdata := []struct {
+		name          string
+		value         any
+		isPlugin      bool
+		expectedEntry catalogEntry
+		expectedOK    bool
+	}{
+		{
+			name:  "Library string notation",
+			value: "org.slf4j:slf4j-api:2.0.9",
+			expectedEntry: catalogEntry{
+				alias:            "alias",
+				name:             "org.slf4j:slf4j-api",
+				version:          "2.0.9",
+				isStringNotation: true,
+			},
+			expectedOK: true,
+		},
+		{
+			name:  "Library string notation without version",
+			value: "org.slf4j:slf4j-api",
+		},
+		{
+			name: "Library using group and name",
+			value: map[string]any{
+				"group":   "org.slf4j",
+				"name":    "slf4j-api",
+				"version": map[string]any{"ref": "slf4j"},
+			},
+			expectedEntry: catalogEntry{
+				alias:      "alias",
+				name:       "org.slf4j:slf4j-api",
+				versionRef: "slf4j",
+			},
+			expectedOK: true,
+		},
+		{
+			name:     "Plugin table",
+			value:    map[string]any{"id": "org.jetbrains.kotlin.jvm", "version": "1.9.21"},
+			isPlugin: true,
+			expectedEntry: catalogEntry{
+				alias:    "alias",
+				name:     "org.jetbrains.kotlin.jvm",
+				version:  "1.9.21",
+				isPlugin: true,
+			},
+			expectedOK: true,
+		},
+		{
+			name:     "Plugin without id",
+			value:    map[string]any{"version": "1.9.21"},
+			isPlugin: true,
+		},
+	}
+
+	for _, tt := range testdata {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := parseCatalogEntry("alias", tt.value, tt.isPlugin)
+			assert.Equal(t, tt.expectedOK, ok)
+			assert.Equal(t, tt.expectedEntry, got)
+		})
+	}
+}
@@ -0,0 +1,215 @@
+package gradle
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// gradlePluginPortal is the Maven repository used by the Gradle Plugin Portal
+	gradlePluginPortal string = "https://plugins.gradle.org/m2/"
+	// pluginMarkerSuffix is the suffix of the plugin marker artifact published for every Gradle plugin
+	pluginMarkerSuffix string = ".gradle.plugin"
+	// sourceReplacePattern replaces the version surrounded by the first and the last capture groups
+	// with the maven source output
+	sourceReplacePattern string = `${1}{{ source "maven" }}${2}`
+)
+
+// dependency represents a library or a plugin version found in a Gradle file
+type dependency struct {
+	// Name identifies the dependency, "group:artifact" for a library or the plugin id
+	Name string
+	// GroupID is the Maven groupID used to retrieve the dependency versions
+	GroupID string
+	// ArtifactID is the Maven artifactID used to retrieve the dependency versions
+	ArtifactID string
+	// Version is the version currently used
+	Version string
+	// isPlugin is true when the dependency is a Gradle plugin
+	isPlugin bool
+	// matchPattern is the regular expression matching the version, surrounded by two capture groups
+	matchPattern string
+	// replacePattern is the pattern used to replace the version
+	replacePattern string
+}
+
+// newLibrary returns a dependency for a Maven library
+func newLibrary(groupID, artifactID, version, matchPattern string) dependency {
+	return dependency{
+		Name:           groupID + ":" + artifactID,
+		GroupID:        groupID,
+		ArtifactID:     artifactID,
+		Version:        version,
+		matchPattern:   matchPattern,
+		replacePattern: sourceReplacePattern,
+	}
+}
+
+// newPlugin returns a dependency for a Gradle plugin,
+// whose versions are retrieved from the plugin marker artifact published on the Gradle Plugin Portal
+func newPlugin(id, version, matchPattern string) dependency {
+	return dependency{
+		Name:           id,
+		GroupID:        id,
+		ArtifactID:     id + pluginMarkerSuffix,
+		Version:        version,
+		isPlugin:       true,
+		matchPattern:   matchPattern,
+		replacePattern: sourceReplacePattern,
+	}
+}
+
+// discoverDependencyManifests discovers manifests for libraries and plugins
+// declared in Gradle build scripts and version catalogs
+func (g Gradle) discoverDependencyManifests() ([][]byte, error) {
+
+	var manifests [][]byte
+
+	foundFiles, err := searchGradleFiles(g.searchFromDir(), isDependencyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, foundFile := range foundFiles {
+
+		relativeFile, err := filepath.Rel(g.rootDir, foundFile)
+		if err != nil {
+			// Let's try the next file if one fail
+			logrus.Debugln(err)
+			continue
+		}
+
+		logrus.Debugf("parsing file %q", relativeFile)
+
+		content, err := os.ReadFile(foundFile)
+		if err != nil {
+			logrus.Debugln(err)
+			continue
+		}
+
+		var dependencies []dependency
+		switch strings.HasSuffix(foundFile, versionCatalogSuffix) {
+		case true:
+			dependencies, err = parseVersionCatalog(content)
+			if err != nil {
+				logrus.Debugf("parsing %q: %s", relativeFile, err)
+				continue
+			}
+		case false:
+			dependencies = parseBuildFile(content)
+		}
+
+		if len(dependencies) == 0 {
+			logrus.Debugf("no pinned Gradle dependency found in %q\n", relativeFile)
+			continue
+		}
+
+		for _, dep := range dependencies {
+
+			if len(g.spec.Ignore) > 0 {
+				if g.spec.Ignore.isMatchingRules(g.rootDir, relativeFile, dep.Name, dep.Version) {
+					logrus.Debugf("Ignoring dependency %q from %q, as matching ignore rule(s)\n", dep.Name, relativeFile)
+					continue
+				}
+			}
+
+			if len(g.spec.Only) > 0 {
+				if !g.spec.Only.isMatchingRules(g.rootDir, relativeFile, dep.Name, dep.Version) {
+					logrus.Debugf("Ignoring dependency %q from %q, as not matching only rule(s)\n", dep.Name, relativeFile)
+					continue
+				}
+			}
+
+			sourceVersionFilterKind := g.versionFilter.Kind
+			sourceVersionFilterPattern := g.versionFilter.Pattern
+			if !g.spec.VersionFilter.IsZero() {
+				sourceVersionFilterPattern, err = g.versionFilter.GreaterThanPattern(dep.Version)
+				if err != nil {
+					logrus.Debugf("building version filter pattern: %s", err)
+					sourceVersionFilterPattern = "*"
+				}
+			}
+
+			manifestName := fmt.Sprintf("Bump Gradle dependency %q", dep.Name)
+			sourceName := fmt.Sprintf("Get Maven artifact %q latest version", dep.Name)
+			sourceRepository := ""
+			sourceRepositories := g.spec.Repositories
+			if dep.isPlugin {
+				manifestName = fmt.Sprintf("Bump Gradle plugin %q", dep.Name)
+				sourceName = fmt.Sprintf("Get Gradle plugin %q latest version", dep.Name)
+				sourceRepository = gradlePluginPortal
+				sourceRepositories = nil
+			}
+
+			tmpl, err := template.New("manifest").Parse(manifestTemplate)
+			if err != nil {
+				logrus.Debugln(err)
+				continue
+			}
+
+			params := struct {
+				ActionID                   string
+				ManifestName               string
+				SourceID                   string
+				SourceName                 string
+				SourceGroupID              string
+				SourceArtifactID           string
+				SourceRepository           string
+				SourceRepositories         []string
+				SourceVersionFilterKind    string
+				SourceVersionFilterPattern string
+				TargetID                   string
+				TargetName                 string
+				TargetMatchPattern         string
+				TargetReplacePattern       string
+				File                       string
+				ScmID                      string
+			}{
+				ActionID:                   g.actionID,
+				ManifestName:               manifestName,
+				SourceID:                   "maven",
+				SourceName:                 sourceName,
+				SourceGroupID:              dep.GroupID,
+				SourceArtifactID:           dep.ArtifactID,
+				SourceRepository:           sourceRepository,
+				SourceRepositories:         sourceRepositories,
+				SourceVersionFilterKind:    sourceVersionFilterKind,
+				SourceVersionFilterPattern: sourceVersionFilterPattern,
+				TargetID:                   "gradle",
+				TargetName:                 fmt.Sprintf("deps(gradle): bump %q to {{ source %q }}", dep.Name, "maven"),
+				// Patterns are rendered within yaml single quoted strings
+				TargetMatchPattern:   strings.ReplaceAll(dep.matchPattern, "'", "''"),
+				TargetReplacePattern: dep.replacePattern,
+				File:                 relativeFile,
+				ScmID:                g.scmID,
+			}
+
+			manifest := bytes.Buffer{}
+			if err := tmpl.Execute(&manifest, params); err != nil {
+				logrus.Debugln(err)
+				continue
+			}
+
+			manifests = append(manifests, manifest.Bytes())
+		}
+	}
+
+	return manifests, nil
+}
+
+// searchFromDir returns the directory from where Gradle files are searched
+func (g Gradle) searchFromDir() string {
+	// If the spec.RootDir is an absolute path, then it as already been set
+	// correctly in the New function.
+	if g.spec.RootDir != "" && !path.IsAbs(g.spec.RootDir) {
+		return filepath.Join(g.rootDir, g.spec.RootDir)
+	}
+	return g.rootDir
+}
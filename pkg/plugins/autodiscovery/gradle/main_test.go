@@ -0,0 +1,155 @@
+package gradle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+func TestDiscoverManifests(t *testing.T) {
+	testdata := []struct {
+		name              string
+		rootDir           string
+		scmID             string
+		actionID          string
+		spec              Spec
+		expectedPipelines []string
+	}{
+		{
+			name:    "Gradle wrapper",
+			rootDir: "testdata/groovy",
+			spec: Spec{
+				Only: MatchingRules{
+					{Dependencies: map[string]string{"gradle": ""}},
+				},
+			},
+			expectedPipelines: []string{`name: 'Bump Gradle wrapper'
+sources:
+  gradle:
+    name: 'Get current Gradle release'
+    kind: 'json'
+    spec:
+      file: 'https://services.gradle.org/versions/current'
+      key: 'version'
+  checksum:
+    name: 'Get Gradle {{ source "gradle" }} distribution checksum'
+    kind: 'http'
+    dependson:
+      - 'gradle'
+    spec:
+      url: 'https://services.gradle.org/distributions/gradle-{{ source "gradle" }}-bin.zip.sha256'
+targets:
+  gradle:
+    name: 'deps(gradle): bump Gradle wrapper to {{ source "gradle" }}'
+    kind: 'file'
+    spec:
+      file: 'gradle/wrapper/gradle-wrapper.properties'
+      matchpattern: '(?m)^(\s*distributionUrl\s*[=:]\s*\S*gradle-)8\.5(-bin\.zip)'
+      replacepattern: '${1}{{ source "gradle" }}${2}'
+    sourceid: 'gradle'
+  checksum:
+    name: 'deps(gradle): update Gradle distribution checksum to {{ source "checksum" }}'
+    kind: 'file'
+    spec:
+      file: 'gradle/wrapper/gradle-wrapper.properties'
+      matchpattern: '(?m)^(\s*distributionSha256Sum\s*[=:]\s*)[0-9a-fA-F]{64}'
+      replacepattern: '${1}{{ source "checksum" }}'
+    sourceid: 'checksum'
+`},
+		},
+		{
+			name:    "Version catalog",
+			rootDir: "testdata/catalog",
+			spec: Spec{
+				Repositories: []string{"https://maven.google.com/"},
+				Only: MatchingRules{
+					{Dependencies: map[string]string{"org.slf4j:slf4j-api": ">=2"}},
+				},
+			},
+			expectedPipelines: []string{`name: 'Bump Gradle dependency "org.slf4j:slf4j-api"'
+sources:
+  maven:
+    name: 'Get Maven artifact "org.slf4j:slf4j-api" latest version'
+    kind: 'maven'
+    spec:
+      groupid: 'org.slf4j'
+      artifactid: 'slf4j-api'
+      repositories:
+        - 'https://maven.google.com/'
+      versionfilter:
+        kind: 'latest'
+        pattern: 'latest'
+targets:
+  gradle:
+    name: 'deps(gradle): bump "org.slf4j:slf4j-api" to {{ source "maven" }}'
+    kind: 'file'
+    spec:
+      file: 'gradle/libs.versions.toml'
+      matchpattern: '(?m)^(\s*["'']?slf4j-api["'']?\s*=\s*\{[^}\n]*\bversion\s*=\s*["''])2\.0\.9(["''])'
+      replacepattern: '${1}{{ source "maven" }}${2}'
+    sourceid: 'maven'
+`},
+		},
+		{
+			name:     "Kotlin DSL plugin",
+			rootDir:  "testdata/kotlin",
+			scmID:    "default",
+			actionID: "default",
+			spec: Spec{
+				VersionFilter: version.Filter{
+					Kind:    "semver",
+					Pattern: "minor",
+				},
+				Only: MatchingRules{
+					{Path: "settings.gradle.kts"},
+				},
+			},
+			expectedPipelines: []string{`name: 'Bump Gradle plugin "org.jetbrains.kotlin.plugin.serialization"'
+actions:
+  default:
+    title: 'deps(gradle): bump "org.jetbrains.kotlin.plugin.serialization" to {{ source "maven" }}'
+
+sources:
+  maven:
+    name: 'Get Gradle plugin "org.jetbrains.kotlin.plugin.serialization" latest version'
+    kind: 'maven'
+    spec:
+      groupid: 'org.jetbrains.kotlin.plugin.serialization'
+      artifactid: 'org.jetbrains.kotlin.plugin.serialization.gradle.plugin'
+      repository: 'https://plugins.gradle.org/m2/'
+      versionfilter:
+        kind: 'semver'
+        pattern: '1.x'
+targets:
+  gradle:
+    name: 'deps(gradle): bump "org.jetbrains.kotlin.plugin.serialization" to {{ source "maven" }}'
+    kind: 'file'
+    scmid: 'default'
+
+    spec:
+      file: 'settings.gradle.kts'
+      matchpattern: '(id\s*\(?\s*["'']org\.jetbrains\.kotlin\.plugin\.serialization["'']\s*\)?\s*version\s*\(?\s*["''])1\.9\.21(["''])'
+      replacepattern: '${1}{{ source "maven" }}${2}'
+    sourceid: 'maven'
+`},
+		},
+	}
+
+	for _, tt := range testdata {
+		t.Run(tt.name, func(t *testing.T) {
+			g, err := New(tt.spec, tt.rootDir, tt.scmID, tt.actionID)
+			require.NoError(t, err)
+
+			pipelines, err := g.DiscoverManifests()
+			require.NoError(t, err)
+
+			var got []string
+			for i := range pipelines {
+				got = append(got, string(pipelines[i]))
+			}
+			assert.Equal(t, tt.expectedPipelines, got)
+		})
+	}
+}
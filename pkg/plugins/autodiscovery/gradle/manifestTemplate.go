@@ -0,0 +1,93 @@
+package gradle
+
+var (
+	// manifestTemplate is the Go template used to generate Gradle dependency manifests
+	manifestTemplate string = `name: '{{ .ManifestName }}'
+{{- if .ActionID }}
+actions:
+  {{ .ActionID }}:
+    title: '{{ .TargetName }}'
+{{ end }}
+sources:
+  {{ .SourceID }}:
+    name: '{{ .SourceName }}'
+    kind: 'maven'
+    spec:
+      groupid: '{{ .SourceGroupID }}'
+      artifactid: '{{ .SourceArtifactID }}'
+{{- if .SourceRepository }}
+      repository: '{{ .SourceRepository }}'
+{{- end }}
+{{- if .SourceRepositories }}
+      repositories:
+{{- range $repo := .SourceRepositories }}
+        - '{{ $repo }}'
+{{- end }}
+{{- end }}
+      versionfilter:
+        kind: '{{ .SourceVersionFilterKind }}'
+        pattern: '{{ .SourceVersionFilterPattern }}'
+targets:
+  {{ .TargetID }}:
+    name: '{{ .TargetName }}'
+    kind: 'file'
+{{- if .ScmID }}
+    scmid: '{{ .ScmID }}'
+{{ end }}
+    spec:
+      file: '{{ .File }}'
+      matchpattern: '{{ .TargetMatchPattern }}'
+      replacepattern: '{{ .TargetReplacePattern }}'
+    sourceid: '{{ .SourceID }}'
+`
+
+	// wrapperManifestTemplate is the Go template used to generate Gradle wrapper manifests
+	wrapperManifestTemplate string = `name: '{{ .ManifestName }}'
+{{- if .ActionID }}
+actions:
+  {{ .ActionID }}:
+    title: '{{ .TargetName }}'
+{{ end }}
+sources:
+  {{ .SourceID }}:
+    name: '{{ .SourceName }}'
+    kind: 'json'
+    spec:
+      file: '{{ .SourceURL }}'
+      key: 'version'
+{{- if .ChecksumURL }}
+  {{ .ChecksumID }}:
+    name: '{{ .ChecksumName }}'
+    kind: 'http'
+    dependson:
+      - '{{ .SourceID }}'
+    spec:
+      url: '{{ .ChecksumURL }}'
+{{- end }}
+targets:
+  {{ .TargetID }}:
+    name: '{{ .TargetName }}'
+    kind: 'file'
+{{- if .ScmID }}
+    scmid: '{{ .ScmID }}'
+{{ end }}
+    spec:
+      file: '{{ .File }}'
+      matchpattern: '{{ .TargetMatchPattern }}'
+      replacepattern: '{{ .TargetReplacePattern }}'
+    sourceid: '{{ .SourceID }}'
+{{- if .ChecksumURL }}
+  {{ .ChecksumID }}:
+    name: '{{ .ChecksumTargetName }}'
+    kind: 'file'
+{{- if .ScmID }}
+    scmid: '{{ .ScmID }}'
+{{ end }}
+    spec:
+      file: '{{ .File }}'
+      matchpattern: '{{ .ChecksumMatchPattern }}'
+      replacepattern: '{{ .ChecksumReplacePattern }}'
+    sourceid: '{{ .ChecksumID }}'
+{{- end }}
+`
+)
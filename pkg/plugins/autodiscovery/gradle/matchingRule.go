@@ -0,0 +1,102 @@
+package gradle
+
+import (
+	"path/filepath"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/sirupsen/logrus"
+)
+
+// MatchingRule allows to specifies rules to identify manifest
+type MatchingRule struct {
+	// Path specifies a Gradle file path pattern, the pattern requires to match all of name, not just a substring.
+	Path string
+	// Dependencies specifies the list of Gradle dependencies to check, mapping a dependency to a version constraint.
+	// A dependency is identified by "group:artifact" for a library, the plugin id for a plugin, or "gradle" for the Gradle wrapper
+	Dependencies map[string]string
+}
+
+type MatchingRules []MatchingRule
+
+// isMatchingRules checks if a specific file content matches the "only" rule
+func (m MatchingRules) isMatchingRules(rootDir, filePath, name, version string) bool {
+	var ruleResults []bool
+
+	if len(m) > 0 {
+		for _, rule := range m {
+			/*
+				Check if rule.Path is matching. Path accepts wildcard path
+			*/
+
+			if rule.Path != "" {
+				if filepath.IsAbs(rule.Path) {
+					filePath = filepath.Join(rootDir, filePath)
+				}
+
+				match, err := filepath.Match(rule.Path, filePath)
+				if err != nil {
+					logrus.Errorf("%s - %q", err, rule.Path)
+					continue
+				}
+				ruleResults = append(ruleResults, match)
+				if match {
+					logrus.Debugf("file path %q matching rule %q", filePath, rule.Path)
+				}
+			}
+
+			/*
+				Checks if policy is matching the policy constraint.
+			*/
+
+			if len(rule.Dependencies) > 0 {
+				match := false
+
+			outDependency:
+				for ruleName, ruleVersion := range rule.Dependencies {
+
+					if name == ruleName {
+						if ruleVersion == "" {
+							match = true
+							break outDependency
+						}
+
+						v, err := semver.NewVersion(version)
+						if err != nil {
+							match = version == ruleVersion
+							logrus.Debugf("%q - %s", version, err)
+							break outDependency
+						}
+
+						c, err := semver.NewConstraint(ruleVersion)
+						if err != nil {
+							match = version == ruleVersion
+							logrus.Debugf("%q %s", err, ruleVersion)
+							break outDependency
+						}
+
+						match = c.Check(v)
+						break outDependency
+					}
+				}
+				ruleResults = append(ruleResults, match)
+			}
+
+			/*
+				If at least one rule is failing then we return false
+			*/
+			isAllMatching := true
+			for i := range ruleResults {
+				if !ruleResults[i] {
+					isAllMatching = false
+				}
+			}
+			if isAllMatching {
+				return true
+			}
+			ruleResults = []bool{}
+		}
+		return false
+	}
+
+	return false
+}
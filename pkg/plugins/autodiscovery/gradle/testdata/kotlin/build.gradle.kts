@@ -0,0 +1,11 @@
+plugins {
+    kotlin("jvm") version "1.9.21"
+    id("com.diffplug.spotless") version "6.23.3" apply false
+}
+
+dependencies {
+    implementation(platform("org.springframework.boot:spring-boot-dependencies:3.2.0"))
+    implementation("io.ktor:ktor-server-core:2.3.7")
+    implementation(group = "com.google.code.gson", name = "gson", version = "2.10.1")
+    testImplementation(kotlin("test"))
+}
@@ -0,0 +1,7 @@
+pluginManagement {
+    plugins {
+        id("org.jetbrains.kotlin.plugin.serialization") version "1.9.21"
+    }
+}
+
+rootProject.name = "kotlin"
@@ -0,0 +1,101 @@
+package gradle
+
+import (
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// versionCatalogSuffix is the suffix used by Gradle version catalog files such as gradle/libs.versions.toml
+	versionCatalogSuffix string = ".versions.toml"
+	// wrapperPropertiesFile is the file used by the Gradle wrapper to define the Gradle distribution
+	wrapperPropertiesFile string = "gradle-wrapper.properties"
+)
+
+var (
+	// buildFiles lists the Gradle build scripts where dependencies and plugins are declared
+	buildFiles = []string{"build.gradle", "build.gradle.kts", "settings.gradle", "settings.gradle.kts"}
+	// ignoredDirectories lists directories that never contain project Gradle files
+	ignoredDirectories = []string{".git", ".gradle", "build", "node_modules"}
+)
+
+// searchGradleFiles looks, recursively, for every Gradle file matching isGradleFile from a root directory.
+func searchGradleFiles(rootDir string, isGradleFile func(name string) bool) ([]string, error) {
+
+	foundFiles := []string{}
+
+	logrus.Debugf("Looking for Gradle files in %q", rootDir)
+
+	err := filepath.WalkDir(rootDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			fmt.Printf("prevent panic by handling failure accessing a path %q: %v\n", path, err)
+			return err
+		}
+
+		if d.IsDir() {
+			for _, ignored := range ignoredDirectories {
+				if d.Name() == ignored {
+					return filepath.SkipDir
+				}
+			}
+			return nil
+		}
+
+		if isGradleFile(d.Name()) {
+			foundFiles = append(foundFiles, path)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return foundFiles, nil
+}
+
+// isDependencyFile returns true if name is a Gradle build script or a version catalog
+func isDependencyFile(name string) bool {
+	if strings.HasSuffix(name, versionCatalogSuffix) {
+		return true
+	}
+
+	for _, buildFile := range buildFiles {
+		if name == buildFile {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isWrapperFile returns true if name is the Gradle wrapper properties file
+func isWrapperFile(name string) bool {
+	return name == wrapperPropertiesFile
+}
+
+// isPinnedVersion returns true if v is a fixed version.
+// Dynamic versions such as "1.+", "latest.release", version ranges and interpolated versions are ignored.
+func isPinnedVersion(v string) bool {
+	if v == "" || !strings.ContainsAny(v[:1], "0123456789") {
+		return false
+	}
+
+	return !strings.ContainsAny(v, "$[]()+,{} ")
+}
+
+// sortedKeys returns the keys of m sorted alphabetically, so manifests are generated in a stable order
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
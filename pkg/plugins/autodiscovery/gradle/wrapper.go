@@ -0,0 +1,168 @@
+package gradle
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"text/template"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// gradleVersionsURL returns the current Gradle release
+	gradleVersionsURL string = "https://services.gradle.org/versions/current"
+	// gradleDistributionsURL is the location of the Gradle distributions and their checksum
+	gradleDistributionsURL string = "https://services.gradle.org/distributions/"
+	// wrapperName is the name used to identify the Gradle wrapper in matching rules
+	wrapperName string = "gradle"
+)
+
+var (
+	// distributionURLRegex matches the Gradle distribution defined in gradle-wrapper.properties such as
+	//   distributionUrl=https\://services.gradle.org/distributions/gradle-8.5-bin.zip
+	distributionURLRegex = regexp.MustCompile(`(?m)^\s*distributionUrl\s*[=:]\s*\S*gradle-([\w.\-]+?)-(bin|all)\.zip\s*$`)
+	// distributionSha256SumRegex matches the Gradle distribution checksum defined in gradle-wrapper.properties
+	distributionSha256SumRegex = regexp.MustCompile(`(?m)^\s*distributionSha256Sum\s*[=:]\s*[0-9a-fA-F]{64}\s*$`)
+)
+
+// wrapper represents the Gradle distribution used by the Gradle wrapper
+type wrapper struct {
+	// Version is the Gradle version
+	Version string
+	// DistributionType is either "bin" or "all"
+	DistributionType string
+	// hasChecksum is true when the distribution checksum is verified by the wrapper
+	hasChecksum bool
+}
+
+// parseWrapperFile returns the Gradle distribution defined in a gradle-wrapper.properties file
+func parseWrapperFile(content []byte) (wrapper, bool) {
+	match := distributionURLRegex.FindSubmatch(content)
+	if match == nil {
+		return wrapper{}, false
+	}
+
+	w := wrapper{
+		Version:          string(match[1]),
+		DistributionType: string(match[2]),
+		hasChecksum:      distributionSha256SumRegex.Match(content),
+	}
+
+	if !isPinnedVersion(w.Version) {
+		return wrapper{}, false
+	}
+
+	return w, true
+}
+
+// discoverWrapperManifests discovers manifests updating the Gradle distribution used by the Gradle wrapper
+func (g Gradle) discoverWrapperManifests() ([][]byte, error) {
+
+	var manifests [][]byte
+
+	foundFiles, err := searchGradleFiles(g.searchFromDir(), isWrapperFile)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, foundFile := range foundFiles {
+
+		relativeFile, err := filepath.Rel(g.rootDir, foundFile)
+		if err != nil {
+			// Let's try the next file if one fail
+			logrus.Debugln(err)
+			continue
+		}
+
+		logrus.Debugf("parsing file %q", relativeFile)
+
+		content, err := os.ReadFile(foundFile)
+		if err != nil {
+			logrus.Debugln(err)
+			continue
+		}
+
+		w, ok := parseWrapperFile(content)
+		if !ok {
+			logrus.Debugf("no Gradle distribution found in %q\n", relativeFile)
+			continue
+		}
+
+		if len(g.spec.Ignore) > 0 {
+			if g.spec.Ignore.isMatchingRules(g.rootDir, relativeFile, wrapperName, w.Version) {
+				logrus.Debugf("Ignoring Gradle wrapper from %q, as matching ignore rule(s)\n", relativeFile)
+				continue
+			}
+		}
+
+		if len(g.spec.Only) > 0 {
+			if !g.spec.Only.isMatchingRules(g.rootDir, relativeFile, wrapperName, w.Version) {
+				logrus.Debugf("Ignoring Gradle wrapper from %q, as not matching only rule(s)\n", relativeFile)
+				continue
+			}
+		}
+
+		checksumURL := ""
+		if w.hasChecksum {
+			checksumURL = fmt.Sprintf("%sgradle-{{ source %q }}-%s.zip.sha256", gradleDistributionsURL, "gradle", w.DistributionType)
+		}
+
+		tmpl, err := template.New("manifest").Parse(wrapperManifestTemplate)
+		if err != nil {
+			logrus.Debugln(err)
+			continue
+		}
+
+		params := struct {
+			ActionID               string
+			ManifestName           string
+			SourceID               string
+			SourceName             string
+			SourceURL              string
+			ChecksumID             string
+			ChecksumName           string
+			ChecksumURL            string
+			ChecksumTargetName     string
+			ChecksumMatchPattern   string
+			ChecksumReplacePattern string
+			TargetID               string
+			TargetName             string
+			TargetMatchPattern     string
+			TargetReplacePattern   string
+			File                   string
+			ScmID                  string
+		}{
+			ActionID:               g.actionID,
+			ManifestName:           "Bump Gradle wrapper",
+			SourceID:               "gradle",
+			SourceName:             "Get current Gradle release",
+			SourceURL:              gradleVersionsURL,
+			ChecksumID:             "checksum",
+			ChecksumName:           fmt.Sprintf("Get Gradle {{ source %q }} distribution checksum", "gradle"),
+			ChecksumURL:            checksumURL,
+			ChecksumTargetName:     fmt.Sprintf("deps(gradle): update Gradle distribution checksum to {{ source %q }}", "checksum"),
+			ChecksumMatchPattern:   `(?m)^(\s*distributionSha256Sum\s*[=:]\s*)[0-9a-fA-F]{64}`,
+			ChecksumReplacePattern: `${1}{{ source "checksum" }}`,
+			TargetID:               "gradle",
+			TargetName:             fmt.Sprintf("deps(gradle): bump Gradle wrapper to {{ source %q }}", "gradle"),
+			TargetMatchPattern: `(?m)^(\s*distributionUrl\s*[=:]\s*\S*gradle-)` + regexp.QuoteMeta(w.Version) +
+				`(-` + w.DistributionType + `\.zip)`,
+			TargetReplacePattern: `${1}{{ source "gradle" }}${2}`,
+			File:                 relativeFile,
+			ScmID:                g.scmID,
+		}
+
+		manifest := bytes.Buffer{}
+		if err := tmpl.Execute(&manifest, params); err != nil {
+			logrus.Debugln(err)
+			continue
+		}
+
+		manifests = append(manifests, manifest.Bytes())
+	}
+
+	return manifests, nil
+}
@@ -0,0 +1,21 @@
+package gradle
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseWrapperFile(t *testing.T) {
+	content, err := os.ReadFile("testdata/groovy/gradle/wrapper/gradle-wrapper.properties")
+	require.NoError(t, err)
+
+	got, ok := parseWrapperFile(content)
+	require.True(t, ok)
+	assert.Equal(t, wrapper{Version: "8.5", DistributionType: "bin", hasChecksum: true}, got)
+
+	_, ok = parseWrapperFile([]byte("distributionUrl=https\\://example.com/gradle.zip\n"))
+	assert.False(t, ok)
+}
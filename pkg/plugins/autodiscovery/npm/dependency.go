@@ -51,15 +51,18 @@ func (n Npm) discoverDependencyManifests() ([][]byte, error) {
 			}
 		}
 
-		// It doesn't make sense to update the package.json if Updatecli do not have access to the npm command to update package-lock.json
+		// package-lock.json is updated using the npm command when available, as it also updates the dependency subtree.
+		// Otherwise we fallback to the npm target, which doesn't require the npm command
 		npmTargetCleanupManifestEnabled := false
+		packageLockEnabled := false
+		packageLockFile := filepath.Join(filepath.Dir(relativeFoundFile), "package-lock.json")
 		if isLockFileDetected(filepath.Join(filepath.Dir(foundFile), "package-lock.json")) {
 			switch isNpmInstalled() {
 			case true:
 				npmTargetCleanupManifestEnabled = true
 			case false:
-				logrus.Warning("skipping, NPM lock file detected but Updatecli couldn't detect the npm command to update it in case of a package.json update")
-				continue
+				logrus.Debugf("NPM lock file detected but Updatecli couldn't detect the npm command, falling back to the npm target to update %q", packageLockFile)
+				packageLockEnabled = true
 			}
 		}
 
@@ -101,7 +104,7 @@ func (n Npm) discoverDependencyManifests() ([][]byte, error) {
 				// If a version constraint is specified such as "~4.0.0" then package.json shouldn't be updated
 				// And if no lock file exist then we can skip this dependency
 				if yarnTargetCleanManifestEnabled &&
-					npmTargetCleanupManifestEnabled &&
+					(npmTargetCleanupManifestEnabled || packageLockEnabled) &&
 					isVersionConstraint {
 					continue
 				}
@@ -150,6 +153,8 @@ func (n Npm) discoverDependencyManifests() ([][]byte, error) {
 					TargetPackageJsonEnabled   bool
 					TargetYarnCleanupEnabled   bool
 					TargetNPMCleanupEnabled    bool
+					TargetPackageLockEnabled   bool
+					TargetPackageLockFile      string
 					TargetWorkdir              string
 					TargetNPMCommand           string
 					TargetYarnCommand          string
@@ -167,10 +172,16 @@ func (n Npm) discoverDependencyManifests() ([][]byte, error) {
 					TargetName:                 fmt.Sprintf("Bump %q package version to {{ source \"npm\" }}", dependencyName),
 					// NPM package allows dot in package name which has a different meaning in Dasel query
 					// Therefor we must escape it for Dasel query to work
-					TargetKey:                fmt.Sprintf("%s.%s", dependencyType, strings.ReplaceAll(dependencyName, ".", `\.`)),
-					TargetPackageJsonEnabled: !yarnTargetCleanManifestEnabled && !npmTargetCleanupManifestEnabled,
+					TargetKey: fmt.Sprintf("%s.%s", dependencyType, strings.ReplaceAll(dependencyName, ".", `\.`)),
+					// The yarn and npm commands update package.json, while the npm target requires package.json to be updated first
+					// unless the version constraint is already satisfied by the new version
+					TargetPackageJsonEnabled: !yarnTargetCleanManifestEnabled &&
+						!npmTargetCleanupManifestEnabled &&
+						!(packageLockEnabled && isVersionConstraint),
 					TargetYarnCleanupEnabled: yarnTargetCleanManifestEnabled,
 					TargetNPMCleanupEnabled:  npmTargetCleanupManifestEnabled,
+					TargetPackageLockEnabled: packageLockEnabled,
+					TargetPackageLockFile:    packageLockFile,
 					TargetWorkdir:            filepath.Dir(relativeFoundFile),
 					TargetNPMCommand:         getTargetCommand("npm", dependencyName),
 					TargetYarnCommand:        getTargetCommand("yarn", dependencyName),
@@ -12,6 +12,7 @@ func TestDiscoverManifests(t *testing.T) {
 	testdata := []struct {
 		name              string
 		rootDir           string
+		npmNotInstalled   bool
 		expectedPipelines []string
 	}{
 		{
@@ -45,6 +46,103 @@ targets:
        - name: PATH
       workdir: '.'
 
+`,
+			},
+		},
+		{
+			name:    "Npm lockfile with pinned version",
+			rootDir: "testdata/npmlockfilepinned",
+			expectedPipelines: []string{`name: 'Bump "axios" package version'
+sources:
+  npm:
+    name: 'Get "axios" package version'
+    kind: 'npm'
+    spec:
+      name: 'axios'
+      versionfilter:
+        kind: 'semver'
+        pattern: '>=1.6.0'
+targets:
+  package-lock.json:
+    name: 'Bump "axios" package version to {{ source "npm" }}'
+    disablesourceinput: true
+    kind: shell
+    spec:
+      command: |-
+        npm install --package-lock-only --dry-run=$DRY_RUN axios@{{ source "npm" }}
+      changedif:
+        kind: file/checksum
+        spec:
+          files:
+            - "package-lock.json"
+            - "package.json"
+      environments:
+       - name: PATH
+      workdir: '.'
+
+`,
+			},
+		},
+		{
+			name:            "Npm lockfile without npm installed",
+			rootDir:         "testdata/npmlockfile",
+			npmNotInstalled: true,
+			expectedPipelines: []string{`name: 'Bump "axios" package version'
+sources:
+  npm:
+    name: 'Get "axios" package version'
+    kind: 'npm'
+    spec:
+      name: 'axios'
+      versionfilter:
+        kind: 'semver'
+        pattern: '^1.0.0'
+targets:
+  package-lock.json:
+    name: 'Bump "axios" package version to {{ source "npm" }}'
+    kind: 'npm'
+    spec:
+      name: 'axios'
+      file: 'package-lock.json'
+    sourceid: 'npm'
+
+`,
+			},
+		},
+		{
+			name:            "Npm lockfile with pinned version without npm installed",
+			rootDir:         "testdata/npmlockfilepinned",
+			npmNotInstalled: true,
+			expectedPipelines: []string{`name: 'Bump "axios" package version'
+sources:
+  npm:
+    name: 'Get "axios" package version'
+    kind: 'npm'
+    spec:
+      name: 'axios'
+      versionfilter:
+        kind: 'semver'
+        pattern: '>=1.6.0'
+targets:
+  npm:
+    name: 'Bump "axios" package version to {{ source "npm" }}'
+    kind: 'json'
+    spec:
+      file: 'package.json'
+      key: 'dependencies.axios'
+    sourceid: 'npm'
+
+  package-lock.json:
+    name: 'Bump "axios" package version to {{ source "npm" }}'
+    dependson:
+      - npm
+
+    kind: 'npm'
+    spec:
+      name: 'axios'
+      file: 'package-lock.json'
+    sourceid: 'npm'
+
 `,
 			},
 		},
@@ -78,6 +176,11 @@ targets:
 	for _, tt := range testdata {
 
 		t.Run(tt.name, func(t *testing.T) {
+			if tt.npmNotInstalled {
+				// The npm command can't be found, so package-lock.json is updated using the npm target
+				t.Setenv("PATH", t.TempDir())
+			}
+
 			resource, err := New(
 				Spec{}, tt.rootDir, "", "")
 			require.NoError(t, err)
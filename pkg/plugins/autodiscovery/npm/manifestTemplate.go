@@ -50,6 +50,22 @@ targets:
        - name: PATH
       workdir: '{{ .TargetWorkdir }}'
 {{ end }}
+{{- if .TargetPackageLockEnabled }}
+  package-lock.json:
+    name: '{{ .TargetName }}'
+{{- if .TargetPackageJsonEnabled }}
+    dependson:
+      - {{ .TargetID }}
+{{ end }}
+    kind: 'npm'
+{{- if .ScmID }}
+    scmid: '{{ .ScmID }}'
+{{ end }}
+    spec:
+      name: '{{ .SourceNPMName }}'
+      file: '{{ .TargetPackageLockFile }}'
+    sourceid: '{{ .SourceID }}'
+{{ end }}
 {{- if .TargetYarnCleanupEnabled }}
   yarn.lock:
     name: '{{ .TargetName }}'
@@ -0,0 +1,163 @@
+package python
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// sourceReplacePattern replaces the version surrounded by the first and the last capture groups
+	// with the pypi source output
+	sourceReplacePattern string = `${1}{{ source "pypi" }}${2}`
+)
+
+// discoverDependencyManifests discovers manifests for pinned Python packages
+func (p Python) discoverDependencyManifests() ([][]byte, error) {
+
+	var manifests [][]byte
+
+	searchFromDir := p.rootDir
+	// If the spec.RootDir is an absolute path, then it as already been set
+	// correctly in the New function.
+	if p.spec.RootDir != "" && !path.IsAbs(p.spec.RootDir) {
+		searchFromDir = filepath.Join(p.rootDir, p.spec.RootDir)
+	}
+
+	foundFiles, err := searchPythonFiles(searchFromDir)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, foundFile := range foundFiles {
+
+		relativeFile, err := filepath.Rel(p.rootDir, foundFile)
+		if err != nil {
+			// Let's try the next file if one fail
+			logrus.Debugln(err)
+			continue
+		}
+
+		logrus.Debugf("parsing file %q", relativeFile)
+
+		content, err := os.ReadFile(foundFile)
+		if err != nil {
+			logrus.Debugln(err)
+			continue
+		}
+
+		isPyproject := filepath.Base(foundFile) == pyprojectFile
+
+		var dependencies []dependency
+		switch isPyproject {
+		case true:
+			dependencies, err = parsePyprojectFile(content)
+			if err != nil {
+				logrus.Debugf("parsing %q: %s", relativeFile, err)
+				continue
+			}
+		case false:
+			dependencies = parseRequirementsFile(content)
+		}
+
+		if len(dependencies) == 0 {
+			logrus.Debugf("no pinned Python package found in %q\n", relativeFile)
+			continue
+		}
+
+		for _, dep := range dependencies {
+
+			if len(p.spec.Ignore) > 0 {
+				if p.spec.Ignore.isMatchingRules(p.rootDir, relativeFile, dep.Name, dep.Version) {
+					logrus.Debugf("Ignoring package %q from %q, as matching ignore rule(s)\n", dep.Name, relativeFile)
+					continue
+				}
+			}
+
+			if len(p.spec.Only) > 0 {
+				if !p.spec.Only.isMatchingRules(p.rootDir, relativeFile, dep.Name, dep.Version) {
+					logrus.Debugf("Ignoring package %q from %q, as not matching only rule(s)\n", dep.Name, relativeFile)
+					continue
+				}
+			}
+
+			sourceVersionFilterKind := p.versionFilter.Kind
+			sourceVersionFilterPattern := p.versionFilter.Pattern
+			if !p.spec.VersionFilter.IsZero() {
+				sourceVersionFilterPattern, err = p.versionFilter.GreaterThanPattern(dep.Version)
+				if err != nil {
+					logrus.Debugf("building version filter pattern: %s", err)
+					sourceVersionFilterPattern = "*"
+				}
+			}
+
+			var lockFile, lockCommand string
+			if isPyproject {
+				lockFile, lockCommand = getLockFile(foundFile, dep.Name)
+			}
+
+			tmpl, err := template.New("manifest").Parse(manifestTemplate)
+			if err != nil {
+				logrus.Debugln(err)
+				continue
+			}
+
+			params := struct {
+				ActionID                   string
+				ManifestName               string
+				SourceID                   string
+				SourceName                 string
+				SourcePackage              string
+				SourceURL                  string
+				SourceVersionFilterKind    string
+				SourceVersionFilterPattern string
+				TargetID                   string
+				TargetName                 string
+				TargetMatchPattern         string
+				TargetReplacePattern       string
+				File                       string
+				LockFile                   string
+				LockTargetName             string
+				LockCommand                string
+				WorkDir                    string
+				ScmID                      string
+			}{
+				ActionID:                   p.actionID,
+				ManifestName:               fmt.Sprintf("Bump Python package %q", dep.Name),
+				SourceID:                   "pypi",
+				SourceName:                 fmt.Sprintf("Get Python package %q latest version", dep.Name),
+				SourcePackage:              dep.Name,
+				SourceURL:                  p.spec.URL,
+				SourceVersionFilterKind:    sourceVersionFilterKind,
+				SourceVersionFilterPattern: sourceVersionFilterPattern,
+				TargetID:                   "pypi",
+				TargetName:                 fmt.Sprintf("deps(python): bump %q to {{ source %q }}", dep.Name, "pypi"),
+				// Patterns are rendered within yaml single quoted strings
+				TargetMatchPattern:   strings.ReplaceAll(dep.matchPattern, "'", "''"),
+				TargetReplacePattern: dep.replacePattern,
+				File:                 relativeFile,
+				LockFile:             lockFile,
+				LockTargetName:       fmt.Sprintf("deps(python): update %s following bump of %q to {{ source %q }}", lockFile, dep.Name, "pypi"),
+				LockCommand:          lockCommand,
+				WorkDir:              filepath.Dir(relativeFile),
+				ScmID:                p.scmID,
+			}
+
+			manifest := bytes.Buffer{}
+			if err := tmpl.Execute(&manifest, params); err != nil {
+				logrus.Debugln(err)
+				continue
+			}
+
+			manifests = append(manifests, manifest.Bytes())
+		}
+	}
+
+	return manifests, nil
+}
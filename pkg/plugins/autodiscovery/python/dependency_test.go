@@ -0,0 +1,97 @@
+package python
+
+import (
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRequirementsFile(t *testing.T) {
+	content, err := os.ReadFile("testdata/requirements/requirements.txt")
+	require.NoError(t, err)
+
+	dependencies := parseRequirementsFile(content)
+	require.Len(t, dependencies, 1)
+	assert.Equal(t, "requests", dependencies[0].Name)
+	assert.Equal(t, "2.31.0", dependencies[0].Version)
+
+	re := regexp.MustCompile(dependencies[0].matchPattern)
+	assert.Contains(t,
+		re.ReplaceAllString(string(content), strings.ReplaceAll(dependencies[0].replacePattern, `{{ source "pypi" }}`, "2.32.3")),
+		`requests[security]==2.32.3 ; python_version >= "3.8"`)
+}
+
+func TestParsePyprojectFile(t *testing.T) {
+	tests := []struct {
+		name             string
+		file             string
+		expectedPackages map[string]string
+		expectedContent  []string
+	}{
+		{
+			name:             "PEP 621",
+			file:             "testdata/pep621/pyproject.toml",
+			expectedPackages: map[string]string{"httpx": "0.27.0", "pytest": "8.1.1"},
+			expectedContent:  []string{`"httpx==9.9.9",`, `"pytest == 9.9.9"`, `"pydantic>=2",`},
+		},
+		{
+			name:             "Poetry",
+			file:             "testdata/poetry/pyproject.toml",
+			expectedPackages: map[string]string{"requests": "2.31.0", "Zope.Interface": "6.2", "black": "24.3.0"},
+			expectedContent:  []string{`requests = "9.9.9"`, `"Zope.Interface" = { version = "==9.9.9", extras = ["test"] }`, `black = "9.9.9"`, `flask = "^3.0"`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content, err := os.ReadFile(tt.file)
+			require.NoError(t, err)
+
+			dependencies, err := parsePyprojectFile(content)
+			require.NoError(t, err)
+
+			gotPackages := map[string]string{}
+			updated := string(content)
+			for _, dep := range dependencies {
+				gotPackages[dep.Name] = dep.Version
+
+				re, err := regexp.Compile(dep.matchPattern)
+				require.NoError(t, err)
+				require.True(t, re.MatchString(updated), "pattern %q doesn't match", dep.matchPattern)
+				updated = re.ReplaceAllString(updated, strings.ReplaceAll(dep.replacePattern, `{{ source "pypi" }}`, "9.9.9"))
+			}
+
+			assert.Equal(t, tt.expectedPackages, gotPackages)
+			for _, expected := range tt.expectedContent {
+				assert.Contains(t, updated, expected)
+			}
+		})
+	}
+}
+
+func TestParsePoetryVersion(t *testing.T) {
+	tests := []struct {
+		value           any
+		expectedVersion string
+		expectedOk      bool
+	}{
+		{value: "2.31.0", expectedVersion: "2.31.0", expectedOk: true},
+		{value: "==2.31.0", expectedVersion: "2.31.0", expectedOk: true},
+		{value: map[string]any{"version": "1.0.post1"}, expectedVersion: "1.0.post1", expectedOk: true},
+		{value: "^2.31"},
+		{value: ">=2,<3"},
+		{value: "2.*"},
+		{value: "*"},
+		{value: map[string]any{"path": "../lib"}},
+	}
+
+	for _, tt := range tests {
+		gotVersion, gotOk := parsePoetryVersion(tt.value)
+		assert.Equal(t, tt.expectedOk, gotOk, "%v", tt.value)
+		assert.Equal(t, tt.expectedVersion, gotVersion, "%v", tt.value)
+	}
+}
@@ -0,0 +1,176 @@
+package python
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiscoverManifests(t *testing.T) {
+	// Lock file targets are only generated when uv or poetry are installed
+	defaultIsCommandAvailable := isCommandAvailable
+	isCommandAvailable = func(string) bool { return true }
+	t.Cleanup(func() {
+		isCommandAvailable = defaultIsCommandAvailable
+	})
+
+	testdata := []struct {
+		name              string
+		rootDir           string
+		spec              Spec
+		expectedPipelines []string
+	}{
+		{
+			name:    "Requirements file",
+			rootDir: "testdata/requirements",
+			spec: Spec{
+				Only: MatchingRules{
+					{Path: "requirements.txt"},
+				},
+			},
+			expectedPipelines: []string{`name: 'Bump Python package "requests"'
+sources:
+  pypi:
+    name: 'Get Python package "requests" latest version'
+    kind: 'pypi'
+    spec:
+      name: 'requests'
+      versionfilter:
+        kind: 'latest'
+        pattern: 'latest'
+targets:
+  pypi:
+    name: 'deps(python): bump "requests" to {{ source "pypi" }}'
+    kind: 'file'
+    spec:
+      file: 'requirements.txt'
+      matchpattern: '(?m)^(\s*(?i:requests)(?:\s*\[[^\]]*\])?\s*==\s*)2\.31\.0(\s|;|#|$)'
+      replacepattern: '${1}{{ source "pypi" }}${2}'
+    sourceid: 'pypi'
+`},
+		},
+		{
+			name:    "PEP 621 project with uv lock file",
+			rootDir: "testdata/pep621",
+			spec: Spec{
+				Ignore: MatchingRules{
+					{Packages: map[string]string{"pytest": ""}},
+				},
+			},
+			expectedPipelines: []string{`name: 'Bump Python package "httpx"'
+sources:
+  pypi:
+    name: 'Get Python package "httpx" latest version'
+    kind: 'pypi'
+    spec:
+      name: 'httpx'
+      versionfilter:
+        kind: 'latest'
+        pattern: 'latest'
+targets:
+  pypi:
+    name: 'deps(python): bump "httpx" to {{ source "pypi" }}'
+    kind: 'file'
+    spec:
+      file: 'pyproject.toml'
+      matchpattern: '(["'']\s*(?i:httpx)(?:\s*\[[^\]]*\])?\s*==\s*)0\.27\.0(["''\s;,])'
+      replacepattern: '${1}{{ source "pypi" }}${2}'
+    sourceid: 'pypi'
+  uv.lock:
+    name: 'deps(python): update uv.lock following bump of "httpx" to {{ source "pypi" }}'
+    kind: 'shell'
+    dependson:
+      - 'pypi'
+    disablesourceinput: true
+    spec:
+      command: |
+        ARGS=""
+        if [ "$DRY_RUN" = "true" ]; then
+          ARGS="--dry-run"
+        fi
+        uv lock $ARGS --upgrade-package 'httpx=={{ source "pypi" }}'
+      changedif:
+        kind: 'file/checksum'
+        spec:
+          files:
+            - 'uv.lock'
+      environments:
+        - name: HOME
+        - name: PATH
+      workdir: '.'
+`},
+		},
+		{
+			name:    "Poetry project",
+			rootDir: "testdata/poetry",
+			spec: Spec{
+				URL: "https://pypi.example.com/",
+				Only: MatchingRules{
+					{Packages: map[string]string{"zope-interface": ">=6"}},
+				},
+			},
+			expectedPipelines: []string{`name: 'Bump Python package "Zope.Interface"'
+sources:
+  pypi:
+    name: 'Get Python package "Zope.Interface" latest version'
+    kind: 'pypi'
+    spec:
+      name: 'Zope.Interface'
+      url: 'https://pypi.example.com/'
+      versionfilter:
+        kind: 'latest'
+        pattern: 'latest'
+targets:
+  pypi:
+    name: 'deps(python): bump "Zope.Interface" to {{ source "pypi" }}'
+    kind: 'file'
+    spec:
+      file: 'pyproject.toml'
+      matchpattern: '(?m)^(\s*["'']?(?i:zope[-_.]+interface)["'']?\s*=\s*(?:\{[^}\n]*\bversion\s*=\s*)?["''](?:==)?)6\.2(["''])'
+      replacepattern: '${1}{{ source "pypi" }}${2}'
+    sourceid: 'pypi'
+  poetry.lock:
+    name: 'deps(python): update poetry.lock following bump of "Zope.Interface" to {{ source "pypi" }}'
+    kind: 'shell'
+    dependson:
+      - 'pypi'
+    disablesourceinput: true
+    spec:
+      command: |
+        ARGS=""
+        if [ "$DRY_RUN" = "true" ]; then
+          ARGS="--dry-run"
+        fi
+        poetry update --lock $ARGS 'Zope.Interface'
+      changedif:
+        kind: 'file/checksum'
+        spec:
+          files:
+            - 'poetry.lock'
+      environments:
+        - name: HOME
+        - name: PATH
+      workdir: '.'
+`},
+		},
+	}
+
+	for _, tt := range testdata {
+
+		t.Run(tt.name, func(t *testing.T) {
+			resource, err := New(
+				tt.spec, tt.rootDir, "", "")
+			require.NoError(t, err)
+
+			pipelines, err := resource.DiscoverManifests()
+			require.NoError(t, err)
+
+			require.Equal(t, len(tt.expectedPipelines), len(pipelines))
+
+			for i, expectedPipeline := range tt.expectedPipelines {
+				assert.Equal(t, expectedPipeline, string(pipelines[i]))
+			}
+		})
+	}
+}
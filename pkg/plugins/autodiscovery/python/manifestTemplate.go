@@ -0,0 +1,63 @@
+package python
+
+var (
+	// manifestTemplate is the Go template used to generate Python package manifests
+	manifestTemplate string = `name: '{{ .ManifestName }}'
+{{- if .ActionID }}
+actions:
+  {{ .ActionID }}:
+    title: '{{ .TargetName }}'
+{{ end }}
+sources:
+  {{ .SourceID }}:
+    name: '{{ .SourceName }}'
+    kind: 'pypi'
+    spec:
+      name: '{{ .SourcePackage }}'
+{{- if .SourceURL }}
+      url: '{{ .SourceURL }}'
+{{- end }}
+      versionfilter:
+        kind: '{{ .SourceVersionFilterKind }}'
+        pattern: '{{ .SourceVersionFilterPattern }}'
+targets:
+  {{ .TargetID }}:
+    name: '{{ .TargetName }}'
+    kind: 'file'
+{{- if .ScmID }}
+    scmid: '{{ .ScmID }}'
+{{ end }}
+    spec:
+      file: '{{ .File }}'
+      matchpattern: '{{ .TargetMatchPattern }}'
+      replacepattern: '{{ .TargetReplacePattern }}'
+    sourceid: '{{ .SourceID }}'
+{{- if .LockFile }}
+  {{ .LockFile }}:
+    name: '{{ .LockTargetName }}'
+    kind: 'shell'
+{{- if .ScmID }}
+    scmid: '{{ .ScmID }}'
+{{ end }}
+    dependson:
+      - '{{ .TargetID }}'
+    disablesourceinput: true
+    spec:
+      command: |
+        ARGS=""
+        if [ "$DRY_RUN" = "true" ]; then
+          ARGS="--dry-run"
+        fi
+        {{ .LockCommand }}
+      changedif:
+        kind: 'file/checksum'
+        spec:
+          files:
+            - '{{ .LockFile }}'
+      environments:
+        - name: HOME
+        - name: PATH
+      workdir: '{{ .WorkDir }}'
+{{- end }}
+`
+)
@@ -0,0 +1,138 @@
+package python
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+// pyproject represents the pyproject.toml sections used to declare dependencies
+type pyproject struct {
+	// Project holds the PEP 621 metadata
+	Project struct {
+		Dependencies         []string            `toml:"dependencies"`
+		OptionalDependencies map[string][]string `toml:"optional-dependencies"`
+	} `toml:"project"`
+	Tool struct {
+		// Poetry holds the Poetry specific sections
+		Poetry struct {
+			Dependencies    map[string]any `toml:"dependencies"`
+			DevDependencies map[string]any `toml:"dev-dependencies"`
+			Group           map[string]struct {
+				Dependencies map[string]any `toml:"dependencies"`
+			} `toml:"group"`
+		} `toml:"poetry"`
+	} `toml:"tool"`
+}
+
+// parsePyprojectFile returns every pinned package from a pyproject.toml file,
+// defined either using PEP 621 or Poetry sections.
+func parsePyprojectFile(content []byte) ([]dependency, error) {
+	var p pyproject
+
+	if err := toml.Unmarshal(content, &p); err != nil {
+		return nil, err
+	}
+
+	var dependencies []dependency
+
+	requirements := p.Project.Dependencies
+	for _, extra := range sortedKeys(p.Project.OptionalDependencies) {
+		requirements = append(requirements, p.Project.OptionalDependencies[extra]...)
+	}
+
+	for _, requirement := range requirements {
+		name, pinnedVersion, ok := parseRequirement(requirement)
+		if !ok {
+			continue
+		}
+
+		dependencies = append(dependencies, dependency{
+			Name:    name,
+			Version: pinnedVersion,
+			matchPattern: `(["']\s*` + nameRegex(name) + `(?:\s*\[[^\]]*\])?\s*==\s*)` +
+				regexp.QuoteMeta(pinnedVersion) + `(["'\s;,])`,
+			replacePattern: sourceReplacePattern,
+		})
+	}
+
+	poetryDependencies := []map[string]any{
+		p.Tool.Poetry.Dependencies,
+		p.Tool.Poetry.DevDependencies,
+	}
+	for _, group := range sortedKeys(p.Tool.Poetry.Group) {
+		poetryDependencies = append(poetryDependencies, p.Tool.Poetry.Group[group].Dependencies)
+	}
+
+	for _, deps := range poetryDependencies {
+		for _, name := range sortedKeys(deps) {
+			if name == "python" {
+				continue
+			}
+
+			pinnedVersion, ok := parsePoetryVersion(deps[name])
+			if !ok {
+				continue
+			}
+
+			dependencies = append(dependencies, dependency{
+				Name:    name,
+				Version: pinnedVersion,
+				matchPattern: `(?m)^(\s*["']?` + nameRegex(name) + `["']?\s*=\s*(?:\{[^}\n]*\bversion\s*=\s*)?["'](?:==)?)` +
+					regexp.QuoteMeta(pinnedVersion) + `(["'])`,
+				replacePattern: sourceReplacePattern,
+			})
+		}
+	}
+
+	return dependencies, nil
+}
+
+// parsePoetryVersion returns the version of a Poetry dependency if it's pinned to an exact version.
+// A dependency is either defined as a version string such as "2.31.0", or a table such as { version = "2.31.0", extras = ["socks"] }
+func parsePoetryVersion(value any) (string, bool) {
+	var v string
+
+	switch value := value.(type) {
+	case string:
+		v = value
+	case map[string]any:
+		s, ok := value["version"].(string)
+		if !ok {
+			// git, path or url dependencies
+			return "", false
+		}
+		v = s
+	default:
+		return "", false
+	}
+
+	// In Poetry, a version without operator is an exact version
+	v = strings.TrimPrefix(strings.TrimSpace(v), "==")
+	if v == "" || !strings.ContainsAny(v[:1], "0123456789") {
+		return "", false
+	}
+
+	if strings.ContainsAny(v, "*,") {
+		return "", false
+	}
+
+	if _, err := version.NewPEP440(v); err != nil {
+		return "", false
+	}
+
+	return v, true
+}
+
+// sortedKeys returns the keys of m sorted alphabetically, so manifests are generated in a stable order
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,74 @@
+package python
+
+import (
+	"bufio"
+	"bytes"
+	"regexp"
+	"strings"
+
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+var (
+	// requirementRegex matches a PEP 508 requirement pinned to a single version, such as
+	// "requests[security] == 2.31.0 ; python_version >= '3.8'"
+	requirementRegex = regexp.MustCompile(`^\s*([A-Za-z0-9][A-Za-z0-9._-]*)\s*(?:\[[^\]]*\])?\s*==\s*([^\s;,#'"]+)\s*(?:[;#].*)?$`)
+)
+
+// dependency describes a pinned Python package found in a dependency file
+type dependency struct {
+	// Name is the package name as written in the file
+	Name string
+	// Version is the pinned package version
+	Version string
+	// matchPattern is the regular expression matching the version in its file.
+	// The version must be surrounded by the first and the last capture groups.
+	matchPattern string
+	// replacePattern is the replacement used with matchPattern
+	replacePattern string
+}
+
+// parseRequirement parses a PEP 508 requirement and returns the package name and version
+// if the requirement is pinned to an exact version.
+func parseRequirement(requirement string) (name, pinnedVersion string, ok bool) {
+	m := requirementRegex.FindStringSubmatch(requirement)
+	if m == nil {
+		return "", "", false
+	}
+
+	if _, err := version.NewPEP440(m[2]); err != nil {
+		return "", "", false
+	}
+
+	return m[1], m[2], true
+}
+
+// parseRequirementsFile returns every pinned package from a pip requirements file
+func parseRequirementsFile(content []byte) []dependency {
+	var dependencies []dependency
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		// Ignore comments, pip options such as "-r other.txt" or "--index-url", and urls
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "-") || strings.Contains(line, "://") {
+			continue
+		}
+
+		name, pinnedVersion, ok := parseRequirement(line)
+		if !ok {
+			continue
+		}
+
+		dependencies = append(dependencies, dependency{
+			Name:    name,
+			Version: pinnedVersion,
+			matchPattern: `(?m)^(\s*` + nameRegex(name) + `(?:\s*\[[^\]]*\])?\s*==\s*)` +
+				regexp.QuoteMeta(pinnedVersion) + `(\s|;|#|$)`,
+			replacePattern: sourceReplacePattern,
+		})
+	}
+
+	return dependencies
+}
@@ -0,0 +1,115 @@
+package python
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// pyprojectFile is the file used by PEP 621 and Poetry projects
+	pyprojectFile string = "pyproject.toml"
+	// uvLockFile is the lock file generated by uv
+	uvLockFile string = "uv.lock"
+	// poetryLockFile is the lock file generated by Poetry
+	poetryLockFile string = "poetry.lock"
+)
+
+var (
+	// requirementsFileRegex matches pip requirements file names such as requirements-dev.txt
+	requirementsFileRegex = regexp.MustCompile(`^requirements.*\.txt$`)
+	// nameSeparatorRegex matches the characters considered equivalent in a package name
+	nameSeparatorRegex = regexp.MustCompile(`[-_.]+`)
+	// ignoredDirectories lists directories that never contain project dependency files
+	ignoredDirectories = []string{".git", ".tox", ".nox", ".venv", "venv", "node_modules", "site-packages", "__pycache__"}
+
+	// isCommandAvailable checks if a command can be executed, it's a variable so tests can override it
+	isCommandAvailable = func(name string) bool {
+		return exec.Command(name, "--version").Run() == nil
+	}
+)
+
+// searchPythonFiles looks, recursively, for every requirements*.txt and pyproject.toml files from a root directory.
+func searchPythonFiles(rootDir string) ([]string, error) {
+
+	foundFiles := []string{}
+
+	logrus.Debugf("Looking for Python dependency files in %q", rootDir)
+
+	err := filepath.WalkDir(rootDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			fmt.Printf("prevent panic by handling failure accessing a path %q: %v\n", path, err)
+			return err
+		}
+
+		if d.IsDir() {
+			for _, ignored := range ignoredDirectories {
+				if d.Name() == ignored {
+					return filepath.SkipDir
+				}
+			}
+			return nil
+		}
+
+		if d.Name() == pyprojectFile || requirementsFileRegex.MatchString(d.Name()) {
+			foundFiles = append(foundFiles, path)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return foundFiles, nil
+}
+
+// normalizeName returns a package name normalized as defined by
+// https://packaging.python.org/en/latest/specifications/name-normalization/
+func normalizeName(name string) string {
+	return strings.ToLower(nameSeparatorRegex.ReplaceAllString(name, "-"))
+}
+
+// nameRegex returns a regular expression matching every spelling of a package name
+func nameRegex(name string) string {
+	parts := strings.Split(normalizeName(name), "-")
+	for i := range parts {
+		parts[i] = regexp.QuoteMeta(parts[i])
+	}
+	return "(?i:" + strings.Join(parts, "[-_.]+") + ")"
+}
+
+// getLockFile returns the lock file, and the command used to update it, found next to a pyproject.toml file
+func getLockFile(pyprojectPath, packageName string) (lockFile string, command string) {
+	dir := filepath.Dir(pyprojectPath)
+
+	switch {
+	case isFileExist(filepath.Join(dir, uvLockFile)):
+		if !isCommandAvailable("uv") {
+			logrus.Warningf("%q detected in %q but uv is not installed, skipping lock file update", uvLockFile, dir)
+			return "", ""
+		}
+		return uvLockFile, fmt.Sprintf("uv lock $ARGS --upgrade-package '%s=={{ source %q }}'", packageName, "pypi")
+
+	case isFileExist(filepath.Join(dir, poetryLockFile)):
+		if !isCommandAvailable("poetry") {
+			logrus.Warningf("%q detected in %q but poetry is not installed, skipping lock file update", poetryLockFile, dir)
+			return "", ""
+		}
+		return poetryLockFile, fmt.Sprintf("poetry update --lock $ARGS '%s'", packageName)
+	}
+
+	return "", ""
+}
+
+func isFileExist(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
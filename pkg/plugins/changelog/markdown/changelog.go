@@ -0,0 +1,226 @@
+package markdown
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/httpclient"
+	"github.com/updatecli/updatecli/pkg/core/result"
+)
+
+var (
+	// ErrWrongSpec is returned when a changelog spec has invalid parameters
+	ErrWrongSpec = errors.New("wrong changelog configuration")
+
+	// sectionDateRegex matches the release date following a version in a
+	// Keep a Changelog section title such as "[1.2.0] - 2024-10-01"
+	sectionDateRegex = regexp.MustCompile(`([0-9]{4}-[0-9]{2}-[0-9]{2})`)
+)
+
+// Spec defines where to retrieve a markdown changelog following the Keep a Changelog format
+// https://keepachangelog.com/
+type Spec struct {
+	/*
+		"url" defines the http(s) url of a markdown changelog
+
+		example:
+			* https://raw.githubusercontent.com/updatecli/updatecli/main/CHANGELOG.md
+	*/
+	URL string `yaml:",omitempty"`
+	/*
+		"file" defines the path of a markdown changelog file.
+
+		remark:
+			* the path is relative to the repository cloned by "scmid" if defined,
+			  otherwise to the current working directory
+	*/
+	File string `yaml:",omitempty"`
+	/*
+		"scmid" defines the scm configuration key of the git repository containing "file"
+	*/
+	SCMID string `yaml:",omitempty"`
+}
+
+// IsZero returns true if no changelog is defined
+func (s Spec) IsZero() bool {
+	return s.URL == "" && s.File == "" && s.SCMID == ""
+}
+
+// Validate returns an error if the changelog spec contains invalid parameters
+func (s Spec) Validate() error {
+	if s.IsZero() {
+		return nil
+	}
+
+	switch {
+	case s.URL != "" && s.File != "":
+		return fmt.Errorf("%w: %q and %q are mutually exclusive", ErrWrongSpec, "url", "file")
+	case s.URL == "" && s.File == "":
+		return fmt.Errorf("%w: one of %q or %q is required", ErrWrongSpec, "url", "file")
+	case s.URL != "" && s.SCMID != "":
+		return fmt.Errorf("%w: %q can only be used with %q", ErrWrongSpec, "scmid", "file")
+	}
+
+	return nil
+}
+
+// Changelog retrieves release notes from a markdown changelog
+type Changelog struct {
+	spec Spec
+	// workingDir is the directory used to resolve a relative changelog file
+	workingDir string
+	client     httpclient.HTTPClient
+}
+
+// New returns a new markdown changelog provider.
+// workingDir is used to resolve the changelog file path.
+func New(spec Spec, workingDir string) (*Changelog, error) {
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &Changelog{
+		spec:       spec,
+		workingDir: workingDir,
+		client:     httpclient.NewRetryClient(),
+	}, nil
+}
+
+// Search returns the changelog sections from the version "to" down to the version "from".
+// If "from" can't be found, only the section of the version "to" is returned.
+func (c *Changelog) Search(from, to string) (result.Changelogs, error) {
+	data, err := c.content()
+	if err != nil {
+		return nil, err
+	}
+
+	sections, err := ParseMarkdown(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return sections.changelogs(from, to, c.spec.URL), nil
+}
+
+// content returns the raw markdown changelog
+func (c *Changelog) content() ([]byte, error) {
+	if c.spec.File != "" {
+		path := c.spec.File
+		if !filepath.IsAbs(path) && c.workingDir != "" {
+			path = filepath.Join(c.workingDir, path)
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading changelog file: %w", err)
+		}
+		return data, nil
+	}
+
+	req, err := http.NewRequest(http.MethodGet, c.spec.URL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating changelog request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving changelog from url: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("retrieving changelog from url %q: %s", c.spec.URL, resp.Status)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading changelog from url: %w", err)
+	}
+
+	return data, nil
+}
+
+// changelogs returns the sections between the versions "to" and "from", both included,
+// in the order of the document, whatever the order of the two versions.
+func (s Sections) changelogs(from, to, url string) result.Changelogs {
+	toIndex := s.indexOf(to)
+	if toIndex < 0 {
+		logrus.Debugf("Version %q not found in changelog", to)
+		return nil
+	}
+
+	start, end := toIndex, toIndex
+
+	if from != "" {
+		fromIndex := s.indexOf(from)
+		switch {
+		case fromIndex < 0:
+			logrus.Debugf("Version %q not found in changelog so only the version %q is returned", from, to)
+		case fromIndex < toIndex:
+			start = fromIndex
+		default:
+			end = fromIndex
+		}
+	}
+
+	var changelogs result.Changelogs
+	for i := start; i <= end; i++ {
+		changelog := result.Changelog{
+			Title: sectionVersion(s[i].title),
+			Body:  s[i].descriptionMD,
+			URL:   url,
+		}
+
+		if m := sectionDateRegex.FindStringSubmatch(s[i].title); m != nil {
+			changelog.PublishedAt = m[1]
+		}
+
+		changelogs = append(changelogs, changelog)
+	}
+
+	return changelogs
+}
+
+// indexOf returns the index of the section describing the given version, or -1
+func (s Sections) indexOf(version string) int {
+	if version == "" {
+		return -1
+	}
+
+	for i := range s {
+		if normalizeVersion(sectionVersion(s[i].title)) == normalizeVersion(version) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// sectionVersion returns the version described by a section title
+// such as "[1.2.0] - 2024-10-01", "v1.2.0", or "1.2.0 (2024-10-01)"
+func sectionVersion(title string) string {
+	fields := strings.Fields(title)
+	if len(fields) == 0 {
+		return ""
+	}
+
+	version := fields[0]
+	// "[1.2.0](https://github.com/owner/repo/compare/v1.1.0...v1.2.0)"
+	if i := strings.Index(version, "]("); i > 0 {
+		version = version[:i]
+	}
+
+	return strings.Trim(version, "[]")
+}
+
+// normalizeVersion removes the optional "v" prefix of a version
+func normalizeVersion(version string) string {
+	return strings.TrimPrefix(strings.ToLower(version), "v")
+}
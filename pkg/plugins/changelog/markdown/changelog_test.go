@@ -0,0 +1,132 @@
+package markdown
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpecValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    Spec
+		wantErr bool
+	}{
+		{name: "Empty spec"},
+		{name: "URL", spec: Spec{URL: "https://example.com/CHANGELOG.md"}},
+		{name: "File with scm", spec: Spec{File: "CHANGELOG.md", SCMID: "default"}},
+		{name: "URL and file", spec: Spec{URL: "https://example.com/CHANGELOG.md", File: "CHANGELOG.md"}, wantErr: true},
+		{name: "URL with scm", spec: Spec{URL: "https://example.com/CHANGELOG.md", SCMID: "default"}, wantErr: true},
+		{name: "Only scm", spec: Spec{SCMID: "default"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.spec.Validate()
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrWrongSpec)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestChangelogSearch(t *testing.T) {
+	tests := []struct {
+		name           string
+		from           string
+		to             string
+		expectedTitles []string
+		expectedDates  []string
+	}{
+		{
+			name:           "Between two versions",
+			from:           "1.0.0",
+			to:             "1.2.0",
+			expectedTitles: []string{"1.2.0", "1.1.0", "1.0.0"},
+			expectedDates:  []string{"2024-10-01", "2024-08-15", "2024-06-01"},
+		},
+		{
+			name:           "Versions with prefix",
+			from:           "v1.1.0",
+			to:             "v1.2.0",
+			expectedTitles: []string{"1.2.0", "1.1.0"},
+			expectedDates:  []string{"2024-10-01", "2024-08-15"},
+		},
+		{
+			name:           "Reversed versions",
+			from:           "1.2.0",
+			to:             "1.1.0",
+			expectedTitles: []string{"1.2.0", "1.1.0"},
+			expectedDates:  []string{"2024-10-01", "2024-08-15"},
+		},
+		{
+			name:           "Unknown from version",
+			from:           "0.9.0",
+			to:             "1.1.0",
+			expectedTitles: []string{"1.1.0"},
+			expectedDates:  []string{"2024-08-15"},
+		},
+		{
+			name: "Unknown to version",
+			from: "1.0.0",
+			to:   "2.0.0",
+		},
+	}
+
+	c, err := New(Spec{File: "CHANGELOG.md"}, "testdata")
+	require.NoError(t, err)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.Search(tt.from, tt.to)
+			require.NoError(t, err)
+
+			var gotTitles, gotDates []string
+			for _, changelog := range got {
+				gotTitles = append(gotTitles, changelog.Title)
+				gotDates = append(gotDates, changelog.PublishedAt)
+			}
+
+			assert.Equal(t, tt.expectedTitles, gotTitles)
+			assert.Equal(t, tt.expectedDates, gotDates)
+		})
+	}
+}
+
+func TestChangelogSearchURL(t *testing.T) {
+	data, err := os.ReadFile("testdata/CHANGELOG.md")
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/CHANGELOG.md" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(data)
+	}))
+	defer server.Close()
+
+	c, err := New(Spec{URL: server.URL + "/CHANGELOG.md"}, "")
+	require.NoError(t, err)
+
+	got, err := c.Search("1.1.0", "1.2.0")
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+
+	assert.Equal(t, "1.2.0", got[0].Title)
+	assert.Equal(t, server.URL+"/CHANGELOG.md", got[0].URL)
+	assert.Contains(t, got[0].Body, "New feature")
+	assert.NotContains(t, got[0].Body, "Bug fix")
+
+	c, err = New(Spec{URL: server.URL + "/missing.md"}, "")
+	require.NoError(t, err)
+
+	_, err = c.Search("1.1.0", "1.2.0")
+	require.Error(t, err)
+}
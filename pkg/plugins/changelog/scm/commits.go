@@ -0,0 +1,246 @@
+package changelog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/result"
+)
+
+const (
+	// commitsPageSize is the number of commits retrieved per api request
+	commitsPageSize = 100
+	// commitsMaxPages bounds the number of api requests when searching the commit of the tag "from"
+	commitsMaxPages = 20
+)
+
+// Commits retrieves changelogs from the commits between two git tags,
+// for git forges without releases such as Bitbucket Server
+type Commits struct {
+	// Client is the go-scm client used to query the git forge api
+	Client *scm.Client
+	// URL is the git forge url, used to generate the changelog link
+	URL string
+	// Owner is the repository owner
+	Owner string
+	// Repository is the repository name
+	Repository string
+}
+
+// Search returns a single changelog, named after the tag "to",
+// listing commits added since the tag "from"
+func (c Commits) Search(from, to string) (result.Changelogs, error) {
+	if to == "" {
+		return nil, nil
+	}
+
+	ctx := context.Background()
+	// Timeout api query after 30sec
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	repository := strings.Join([]string{c.Owner, c.Repository}, "/")
+
+	fromSha := ""
+	if from != "" && from != to {
+		ref, _, err := c.Client.Git.FindTag(ctx, repository, from)
+		switch err {
+		case nil:
+			fromSha = ref.Sha
+		case scm.ErrNotFound:
+			logrus.Debugf("tag %q not found, only listing the latest commits of %q", from, to)
+		default:
+			return nil, fmt.Errorf("searching tag %q: %w", from, err)
+		}
+	}
+
+	commits, err := c.listCommits(ctx, repository, to, fromSha)
+	if err != nil {
+		return nil, fmt.Errorf("listing commits for %q: %w", to, err)
+	}
+
+	if len(commits) == 0 {
+		return nil, nil
+	}
+
+	var body []string
+	for _, commit := range commits {
+		body = append(body, fmt.Sprintf("* %s (%s)", commitTitle(commit.Message), shortSha(commit.Sha)))
+	}
+
+	changelog := result.Changelog{
+		Title: to,
+		Body:  strings.Join(body, "\n"),
+		URL:   c.compareURL(from, to),
+	}
+
+	if date := commits[0].Committer.Date; !date.IsZero() {
+		changelog.PublishedAt = date.String()
+	}
+
+	return result.Changelogs{changelog}, nil
+}
+
+// listCommits returns the commits reachable from ref, up to the commit fromSha excluded.
+// Pages are retrieved until fromSha is found, or the last page is reached.
+// If fromSha is empty, only the latest commits from the first page are returned.
+func (c Commits) listCommits(ctx context.Context, repository, ref, fromSha string) ([]*scm.Commit, error) {
+	var commits []*scm.Commit
+
+	page := 0
+	for i := 0; i < commitsMaxPages; i++ {
+		pageCommits, next, err := c.listCommitsPage(ctx, repository, ref, page)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, commit := range pageCommits {
+			if fromSha != "" && commit.Sha == fromSha {
+				return commits, nil
+			}
+			commits = append(commits, commit)
+		}
+
+		if fromSha == "" || next == 0 || len(pageCommits) == 0 {
+			return commits, nil
+		}
+
+		page = next
+	}
+
+	logrus.Debugf("commit %q not found in the latest %d commits of %q, changelog is truncated", fromSha, len(commits), ref)
+
+	return commits, nil
+}
+
+// listCommitsPage returns a page of commits reachable from ref, and the next page to retrieve, or 0 if it was the last one.
+// Page 0 is the first page.
+func (c Commits) listCommitsPage(ctx context.Context, repository, ref string, page int) ([]*scm.Commit, int, error) {
+	// The go-scm Bitbucket Server driver ignores pagination options when listing commits
+	if c.Client.Driver == scm.DriverStash {
+		return c.listStashCommitsPage(ctx, repository, ref, page)
+	}
+
+	if page == 0 {
+		page = 1
+	}
+
+	commits, res, err := c.Client.Git.ListCommits(ctx, repository, scm.CommitListOptions{
+		Ref:  ref,
+		Page: page,
+		Size: commitsPageSize,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if res == nil {
+		return commits, 0, nil
+	}
+
+	return commits, res.Page.Next, nil
+}
+
+// stashCommitsPage is a page of the Bitbucket Server commits api
+type stashCommitsPage struct {
+	Values []struct {
+		ID                 string `json:"id"`
+		Message            string `json:"message"`
+		CommitterTimestamp int64  `json:"committerTimestamp"`
+	} `json:"values"`
+	IsLastPage    bool `json:"isLastPage"`
+	NextPageStart int  `json:"nextPageStart"`
+}
+
+// listStashCommitsPage returns a page of commits from the Bitbucket Server api, where the page is the index of the first commit
+func (c Commits) listStashCommitsPage(ctx context.Context, repository, ref string, start int) ([]*scm.Commit, int, error) {
+	namespace, name := scm.Split(repository)
+
+	query := url.Values{}
+	query.Set("until", ref)
+	query.Set("start", strconv.Itoa(start))
+	query.Set("limit", strconv.Itoa(commitsPageSize))
+
+	res, err := c.Client.Do(ctx, &scm.Request{
+		Method: http.MethodGet,
+		Path: fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/commits?%s",
+			url.PathEscape(namespace), url.PathEscape(name), query.Encode()),
+		Header: map[string][]string{
+			"Accept": {"application/json"},
+		},
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	defer res.Body.Close()
+
+	if res.Status >= 300 {
+		return nil, 0, fmt.Errorf("unexpected status code %d", res.Status)
+	}
+
+	var out stashCommitsPage
+	if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+		return nil, 0, err
+	}
+
+	var commits []*scm.Commit
+	for _, v := range out.Values {
+		commit := &scm.Commit{Sha: v.ID, Message: v.Message}
+		if v.CommitterTimestamp > 0 {
+			commit.Committer.Date = time.UnixMilli(v.CommitterTimestamp)
+		}
+		commits = append(commits, commit)
+	}
+
+	if out.IsLastPage || out.NextPageStart <= start {
+		return commits, 0, nil
+	}
+
+	return commits, out.NextPageStart, nil
+}
+
+// compareURL returns the Bitbucket Server url comparing two tags,
+// or the commits url of the tag "to" if "from" is not defined
+func (c Commits) compareURL(from, to string) string {
+	if c.URL == "" {
+		return ""
+	}
+
+	base := fmt.Sprintf("%s/projects/%s/repos/%s",
+		strings.TrimSuffix(c.URL, "/"),
+		url.PathEscape(c.Owner),
+		url.PathEscape(c.Repository),
+	)
+
+	if from == "" || from == to {
+		return fmt.Sprintf("%s/commits?until=%s", base, url.QueryEscape("refs/tags/"+to))
+	}
+
+	query := url.Values{}
+	query.Set("sourceBranch", "refs/tags/"+to)
+	query.Set("targetBranch", "refs/tags/"+from)
+
+	return fmt.Sprintf("%s/compare/commits?%s", base, query.Encode())
+}
+
+// commitTitle returns the first line of a commit message
+func commitTitle(message string) string {
+	title, _, _ := strings.Cut(strings.TrimSpace(message), "\n")
+	return strings.TrimSpace(title)
+}
+
+// shortSha returns the abbreviated commit sha
+func shortSha(sha string) string {
+	if len(sha) > 7 {
+		return sha[:7]
+	}
+	return sha
+}
@@ -0,0 +1,143 @@
+package changelog
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/drone/go-scm/scm/driver/stash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	stashTags = `{"values": [
+  {"id": "refs/tags/v1.1.0", "displayId": "v1.1.0", "type": "TAG", "latestCommit": "2222222222222222222222222222222222222222"},
+  {"id": "refs/tags/v1.0.0", "displayId": "v1.0.0", "type": "TAG", "latestCommit": "1111111111111111111111111111111111111111"}
+], "isLastPage": true}`
+
+	stashCommits = `{"values": [
+  {"id": "3333333333333333333333333333333333333333", "message": "feat: add feature\n\nLong description", "committerTimestamp": 1728000000000},
+  {"id": "2222222222222222222222222222222222222222", "message": "fix: correct bug", "committerTimestamp": 1727000000000},
+  {"id": "1111111111111111111111111111111111111111", "message": "chore: release v1.0.0", "committerTimestamp": 1726000000000}
+], "isLastPage": true}`
+)
+
+func TestCommitsSearch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/rest/api/1.0/projects/PROJ/repos/repo/tags":
+			_, _ = w.Write([]byte(stashTags))
+		case "/rest/api/1.0/projects/PROJ/repos/repo/commits":
+			_, _ = w.Write([]byte(stashCommits))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := stash.New(server.URL)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		from         string
+		to           string
+		expectedBody string
+		expectedURL  string
+	}{
+		{
+			name:         "Commits between two tags",
+			from:         "v1.0.0",
+			to:           "v1.2.0",
+			expectedBody: "* feat: add feature (3333333)\n* fix: correct bug (2222222)",
+			expectedURL:  server.URL + "/projects/PROJ/repos/repo/compare/commits?sourceBranch=refs%2Ftags%2Fv1.2.0&targetBranch=refs%2Ftags%2Fv1.0.0",
+		},
+		{
+			name:         "Unknown from tag",
+			from:         "v0.9.0",
+			to:           "v1.2.0",
+			expectedBody: "* feat: add feature (3333333)\n* fix: correct bug (2222222)\n* chore: release v1.0.0 (1111111)",
+			expectedURL:  server.URL + "/projects/PROJ/repos/repo/compare/commits?sourceBranch=refs%2Ftags%2Fv1.2.0&targetBranch=refs%2Ftags%2Fv0.9.0",
+		},
+		{
+			name:         "No from tag",
+			to:           "v1.2.0",
+			expectedBody: "* feat: add feature (3333333)\n* fix: correct bug (2222222)\n* chore: release v1.0.0 (1111111)",
+			expectedURL:  server.URL + "/projects/PROJ/repos/repo/commits?until=refs%2Ftags%2Fv1.2.0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changelog := Commits{
+				Client:     client,
+				URL:        server.URL,
+				Owner:      "PROJ",
+				Repository: "repo",
+			}
+
+			got, err := changelog.Search(tt.from, tt.to)
+			require.NoError(t, err)
+			require.Len(t, got, 1)
+
+			assert.Equal(t, tt.to, got[0].Title)
+			assert.Equal(t, tt.expectedBody, got[0].Body)
+			assert.Equal(t, tt.expectedURL, got[0].URL)
+			assert.NotEmpty(t, got[0].PublishedAt)
+		})
+	}
+}
+
+func TestCommitsSearchPagination(t *testing.T) {
+	pages := map[string]string{
+		"0": `{"values": [
+  {"id": "5555555555555555555555555555555555555555", "message": "feat: latest feature", "committerTimestamp": 1729000000000},
+  {"id": "4444444444444444444444444444444444444444", "message": "fix: latest bug", "committerTimestamp": 1728500000000}
+], "isLastPage": false, "nextPageStart": 2}`,
+		"2": `{"values": [
+  {"id": "3333333333333333333333333333333333333333", "message": "feat: add feature", "committerTimestamp": 1728000000000},
+  {"id": "2222222222222222222222222222222222222222", "message": "fix: correct bug", "committerTimestamp": 1727000000000},
+  {"id": "1111111111111111111111111111111111111111", "message": "chore: release v1.0.0", "committerTimestamp": 1726000000000}
+], "isLastPage": true}`,
+	}
+
+	requestedPages := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/rest/api/1.0/projects/PROJ/repos/repo/tags":
+			_, _ = w.Write([]byte(stashTags))
+		case "/rest/api/1.0/projects/PROJ/repos/repo/commits":
+			start := r.URL.Query().Get("start")
+			requestedPages = append(requestedPages, start)
+			page, ok := pages[start]
+			if !ok || r.URL.Query().Get("until") != "v1.2.0" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write([]byte(page))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := stash.New(server.URL)
+	require.NoError(t, err)
+
+	changelog := Commits{
+		Client:     client,
+		URL:        server.URL,
+		Owner:      "PROJ",
+		Repository: "repo",
+	}
+
+	got, err := changelog.Search("v1.0.0", "v1.2.0")
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+
+	assert.Equal(t, []string{"0", "2"}, requestedPages)
+	assert.Equal(t, "* feat: latest feature (5555555)\n* fix: latest bug (4444444)\n* feat: add feature (3333333)\n* fix: correct bug (2222222)", got[0].Body)
+}
@@ -0,0 +1,192 @@
+package changelog
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/drone/go-scm/scm"
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/result"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+// Releases retrieves changelogs from the releases of a git forge
+// supported by drone/go-scm, such as GitLab or Gitea/Forgejo
+type Releases struct {
+	// Client is the go-scm client used to query the git forge api
+	Client *scm.Client
+	// Owner is the repository owner
+	Owner string
+	// Repository is the repository name
+	Repository string
+	// VersionFilter is used to sort releases between two versions
+	VersionFilter version.Filter
+}
+
+// Search returns a list of changelogs, retrieved from the git forge releases, between two versions
+func (r Releases) Search(from, to string) (result.Changelogs, error) {
+	releases, err := r.listReleases()
+	if err != nil {
+		return nil, fmt.Errorf("listing releases: %w", err)
+	}
+
+	switch r.VersionFilter.Kind {
+	case version.SEMVERVERSIONKIND:
+		sortReleasesBySemver(&releases)
+	case "":
+		if isSemverDetected(from, to) {
+			sortReleasesBySemver(&releases)
+		}
+	default:
+		logrus.Debugf("version filter of kind %q not supported to sort releases, using api order", r.VersionFilter.Kind)
+	}
+
+	return convertReleasesToChangelog(filterReleases(releases, from, to)), nil
+}
+
+// listReleases returns every release, except drafts, ordered as returned by the api, newest first
+func (r Releases) listReleases() ([]*scm.Release, error) {
+	ctx := context.Background()
+	// Timeout api query after 30sec
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	var results []*scm.Release
+	page := 0
+	for {
+		releases, resp, err := r.Client.Releases.List(
+			ctx,
+			strings.Join([]string{r.Owner, r.Repository}, "/"),
+			scm.ReleaseListOptions{
+				Page:   page,
+				Size:   30,
+				Open:   true,
+				Closed: true,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, release := range releases {
+			if !release.Draft {
+				results = append(results, release)
+			}
+		}
+
+		// Means that we parsed all pages
+		if resp == nil || page >= resp.Page.Last {
+			break
+		}
+		page++
+	}
+
+	return results, nil
+}
+
+// filterReleases returns releases from "to" down to "from", both included.
+// If "from" can't be found, only the "to" release is returned.
+func filterReleases(releases []*scm.Release, from, to string) []*scm.Release {
+	if from == "" && to == "" {
+		return releases
+	}
+
+	var filteredReleases []*scm.Release
+
+	foundFrom := false
+	foundTo := to == ""
+
+	for _, release := range releases {
+		if release.Tag == to {
+			foundTo = true
+		}
+
+		if !foundTo {
+			continue
+		}
+
+		filteredReleases = append(filteredReleases, release)
+
+		if from != "" && release.Tag == from {
+			foundFrom = true
+			break
+		}
+	}
+
+	if len(filteredReleases) == 0 {
+		return nil
+	}
+
+	if from != "" && !foundFrom {
+		logrus.Debugf("release %q not found so only the latest release is returned", from)
+		return filteredReleases[0:1]
+	}
+
+	return filteredReleases
+}
+
+// convertReleasesToChangelog converts a list of scm.Release to result.Changelogs
+func convertReleasesToChangelog(releases []*scm.Release) result.Changelogs {
+	var changelogs result.Changelogs
+
+	for _, release := range releases {
+		publishedAt := release.Published
+		if publishedAt.IsZero() {
+			publishedAt = release.Created
+		}
+
+		title := release.Tag
+		if title == "" {
+			title = release.Title
+		}
+
+		changelog := result.Changelog{
+			Title: title,
+			Body:  release.Description,
+			URL:   release.Link,
+		}
+
+		if !publishedAt.IsZero() {
+			changelog.PublishedAt = publishedAt.String()
+		}
+
+		changelogs = append(changelogs, changelog)
+	}
+
+	return changelogs
+}
+
+// sortReleasesBySemver sorts releases by their tag name, newest first,
+// and drops releases not following semantic versioning
+func sortReleasesBySemver(releases *[]*scm.Release) {
+	var semverReleases []*scm.Release
+	versions := map[*scm.Release]*semver.Version{}
+
+	for _, release := range *releases {
+		v, err := semver.NewVersion(release.Tag)
+		if err == nil {
+			semverReleases = append(semverReleases, release)
+			versions[release] = v
+		}
+	}
+
+	sort.SliceStable(semverReleases, func(i, j int) bool {
+		return versions[semverReleases[j]].LessThan(versions[semverReleases[i]])
+	})
+
+	*releases = semverReleases
+}
+
+// isSemverDetected tries to detect if our version range is semver compliant
+func isSemverDetected(from, to string) bool {
+	if _, err := semver.NewVersion(from); err != nil {
+		return false
+	}
+
+	_, err := semver.NewVersion(to)
+	return err == nil
+}
@@ -0,0 +1,116 @@
+package changelog
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/drone/go-scm/scm/driver/gitea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+const giteaReleases = `[
+  {"tag_name": "v1.10.0", "name": "v1.10.0", "body": "Release 1.10.0", "html_url": "https://gitea.com/owner/repo/releases/tag/v1.10.0", "published_at": "2024-10-03T10:00:00Z"},
+  {"tag_name": "v1.11.0", "name": "v1.11.0", "body": "Draft release", "draft": true},
+  {"tag_name": "v1.9.0", "name": "v1.9.0", "body": "Release 1.9.0", "html_url": "https://gitea.com/owner/repo/releases/tag/v1.9.0", "published_at": "2024-09-03T10:00:00Z"},
+  {"tag_name": "v1.2.0", "name": "v1.2.0", "body": "Release 1.2.0", "html_url": "https://gitea.com/owner/repo/releases/tag/v1.2.0", "published_at": "2024-01-03T10:00:00Z"},
+  {"tag_name": "nightly", "name": "nightly", "body": "Nightly build"}
+]`
+
+func TestReleasesSearch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/repos/owner/repo/releases" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(giteaReleases))
+	}))
+	defer server.Close()
+
+	client, err := gitea.New(server.URL)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		versionFilter  version.Filter
+		from           string
+		to             string
+		expectedTitles []string
+	}{
+		{
+			name:           "All releases sorted by semver",
+			versionFilter:  version.Filter{Kind: version.SEMVERVERSIONKIND},
+			expectedTitles: []string{"v1.10.0", "v1.9.0", "v1.2.0"},
+		},
+		{
+			name:           "Releases between two versions",
+			from:           "v1.2.0",
+			to:             "v1.10.0",
+			expectedTitles: []string{"v1.10.0", "v1.9.0", "v1.2.0"},
+		},
+		{
+			name:           "Single release",
+			from:           "v1.9.0",
+			to:             "v1.9.0",
+			expectedTitles: []string{"v1.9.0"},
+		},
+		{
+			name:           "Unknown from version",
+			from:           "v1.0.0",
+			to:             "v1.10.0",
+			expectedTitles: []string{"v1.10.0"},
+		},
+		{
+			name:           "Non semver using api order",
+			versionFilter:  version.Filter{Kind: version.LATESTVERSIONKIND},
+			from:           "v1.2.0",
+			to:             "nightly",
+			expectedTitles: []string{"nightly"},
+		},
+		{
+			name: "Unknown to version",
+			from: "v1.2.0",
+			to:   "v2.0.0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changelog := Releases{
+				Client:        client,
+				Owner:         "owner",
+				Repository:    "repo",
+				VersionFilter: tt.versionFilter,
+			}
+
+			got, err := changelog.Search(tt.from, tt.to)
+			require.NoError(t, err)
+
+			var gotTitles []string
+			for _, c := range got {
+				gotTitles = append(gotTitles, c.Title)
+			}
+			assert.Equal(t, tt.expectedTitles, gotTitles)
+		})
+	}
+}
+
+func TestConvertReleasesToChangelog(t *testing.T) {
+	got := convertReleasesToChangelog([]*scm.Release{
+		{
+			Title:       "First release",
+			Description: "Initial release",
+			Link:        "https://gitlab.com/owner/repo/-/releases/v0.1.0",
+		},
+	})
+
+	require.Len(t, got, 1)
+	assert.Equal(t, "First release", got[0].Title)
+	assert.Equal(t, "Initial release", got[0].Body)
+	assert.Equal(t, "https://gitlab.com/owner/repo/-/releases/v0.1.0", got[0].URL)
+	assert.Empty(t, got[0].PublishedAt)
+}
@@ -0,0 +1,33 @@
+package branch
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
+)
+
+// Condition tests if a branch exists on an Azure DevOps repository
+func (a *AzureDevOps) Condition(source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+	if scm != nil {
+		logrus.Warningf("Condition not supported for the plugin Azure DevOps branch")
+	}
+
+	branch := source
+	if a.spec.Branch != "" {
+		branch = a.spec.Branch
+	}
+
+	branches, err := a.SearchBranches()
+	if err != nil {
+		return false, "", fmt.Errorf("looking for Azure DevOps branch: %w", err)
+	}
+
+	for _, t := range branches {
+		if t == branch {
+			return true, fmt.Sprintf("Azure DevOps branch %q found", t), nil
+		}
+	}
+
+	return false, fmt.Sprintf("no Azure DevOps branch found matching %q", branch), nil
+}
@@ -0,0 +1,53 @@
+package branch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCondition(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+
+	tests := []struct {
+		name       string
+		branch     string
+		source     string
+		wantResult bool
+	}{
+		{
+			name:       "branch from spec exists",
+			branch:     "v1",
+			wantResult: true,
+		},
+		{
+			name:       "branch from source exists",
+			source:     "main",
+			wantResult: true,
+		},
+		{
+			name:       "branch doesn't exist",
+			branch:     "v3",
+			wantResult: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := New(map[string]interface{}{
+				"url":          server.URL,
+				"organization": "org",
+				"project":      "project",
+				"repository":   "repo",
+				"branch":       tt.branch,
+			})
+			require.NoError(t, err)
+
+			gotResult, _, err := a.Condition(tt.source, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantResult, gotResult)
+		})
+	}
+}
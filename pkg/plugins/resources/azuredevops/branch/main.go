@@ -0,0 +1,154 @@
+package branch
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/resources/azuredevops/client"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/redact"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+// Spec defines settings used to interact with Azure DevOps branches
+type Spec struct {
+	client.Spec `yaml:",inline,omitempty"`
+	// [S][C] Organization specifies the Azure DevOps organization
+	Organization string `yaml:",omitempty" jsonschema:"required"`
+	// [S][C] Project specifies the Azure DevOps project
+	Project string `yaml:",omitempty" jsonschema:"required"`
+	// [S][C] Repository specifies the name of a repository for a specific project
+	Repository string `yaml:",omitempty" jsonschema:"required"`
+	// [S] VersionFilter provides parameters to specify version pattern and its type like regex, semver, or just latest.
+	//
+	// remark:
+	//   Azure DevOps returns branches sorted alphabetically, so the "latest" kind returns the last branch in alphabetical order.
+	VersionFilter version.Filter `yaml:",omitempty"`
+	// [C] Branch specifies the branch name
+	Branch string `yaml:",omitempty"`
+}
+
+// AzureDevOps contains information to interact with Azure DevOps api
+type AzureDevOps struct {
+	// spec contains inputs coming from updatecli configuration
+	spec Spec
+	// client handle the api authentication
+	client        client.Client
+	foundVersion  version.Version
+	versionFilter version.Filter
+}
+
+// New returns a new valid Azure DevOps branch object.
+func New(spec interface{}) (*AzureDevOps, error) {
+	var s Spec
+	var clientSpec client.Spec
+
+	// mapstructure.Decode cannot handle embedded fields
+	// hence we decode it in two steps
+	err := mapstructure.Decode(spec, &clientSpec)
+	if err != nil {
+		return &AzureDevOps{}, err
+	}
+
+	err = mapstructure.Decode(spec, &s)
+	if err != nil {
+		return &AzureDevOps{}, nil
+	}
+
+	err = clientSpec.Sanitize()
+	if err != nil {
+		return &AzureDevOps{}, err
+	}
+
+	s.Spec = clientSpec
+	err = s.Validate()
+
+	if err != nil {
+		return &AzureDevOps{}, err
+	}
+
+	c, err := client.New(clientSpec)
+
+	if err != nil {
+		return &AzureDevOps{}, err
+	}
+
+	newFilter, err := s.VersionFilter.Init()
+	if err != nil {
+		return &AzureDevOps{}, err
+	}
+	s.VersionFilter = newFilter
+
+	a := AzureDevOps{
+		spec:          s,
+		client:        c,
+		versionFilter: newFilter,
+	}
+
+	return &a, nil
+}
+
+// SearchBranches retrieves git branches from a remote Azure DevOps repository
+func (a *AzureDevOps) SearchBranches() (branches []string, err error) {
+	// Timeout api query after 30sec
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	return a.client.ListRefs(ctx, a.spec.Organization, a.spec.Project, a.spec.Repository, "heads/")
+}
+
+// Validate validates that a spec contains good content
+func (s Spec) Validate() error {
+	gotError := false
+	missingParameters := []string{}
+
+	err := s.Spec.Validate()
+
+	if err != nil {
+		logrus.Errorln(err)
+		gotError = true
+	}
+
+	if len(s.Organization) == 0 {
+		gotError = true
+		missingParameters = append(missingParameters, "organization")
+	}
+
+	if len(s.Project) == 0 {
+		gotError = true
+		missingParameters = append(missingParameters, "project")
+	}
+
+	if len(s.Repository) == 0 {
+		gotError = true
+		missingParameters = append(missingParameters, "repository")
+	}
+
+	if len(missingParameters) > 0 {
+		logrus.Errorf("missing parameter(s) [%s]", strings.Join(missingParameters, ","))
+	}
+
+	if gotError {
+		return fmt.Errorf("wrong azuredevops configuration")
+	}
+
+	return nil
+}
+
+// ReportConfig returns a new configuration object with only the necessary fields
+// to identify the resource without any sensitive information
+func (a *AzureDevOps) ReportConfig() interface{} {
+	return Spec{
+		Organization: a.spec.Organization,
+		Project:      a.spec.Project,
+		Repository:   a.spec.Repository,
+		Spec: client.Spec{
+			URL: redact.URL(a.spec.URL),
+		},
+		Branch:        a.spec.Branch,
+		VersionFilter: a.spec.VersionFilter,
+	}
+}
@@ -0,0 +1,48 @@
+package branch
+
+import (
+	"fmt"
+
+	"github.com/updatecli/updatecli/pkg/core/result"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+// Source returns the latest Azure DevOps branch matching the version filter
+func (a *AzureDevOps) Source(workingDir string, resultSource *result.Source) error {
+	versions, err := a.SearchBranches()
+
+	if err != nil {
+		return fmt.Errorf("search Azure DevOps branches: %w", err)
+	}
+
+	if len(versions) == 0 {
+		return fmt.Errorf("no Azure DevOps branches found")
+	}
+
+	a.foundVersion, err = a.spec.VersionFilter.Search(versions)
+
+	if err != nil {
+		switch err {
+		case version.ErrNoVersionFound:
+			return fmt.Errorf("no Azure DevOps branches found matching pattern %q", a.versionFilter.Pattern)
+		default:
+			return fmt.Errorf("no Azure DevOps branches found matching pattern %q: %w", a.versionFilter.Pattern, err)
+		}
+	}
+
+	value := a.foundVersion.GetVersion()
+
+	if len(value) == 0 {
+		return fmt.Errorf("no Azure DevOps branches found matching pattern %q", a.versionFilter.Pattern)
+	}
+
+	resultSource.Result = result.SUCCESS
+	resultSource.Information = value
+	resultSource.Description = fmt.Sprintf("Azure DevOps branch %q found matching pattern %q of kind %q",
+		value,
+		a.versionFilter.Pattern,
+		a.versionFilter.Kind,
+	)
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package branch
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/core/result"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+// newTestServer returns an Azure DevOps api stand-in serving the refs of the repository "org/project/repo"
+func newTestServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/org/project/_apis/git/repositories/repo/refs" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		assert.Equal(t, "heads/", r.URL.Query().Get("filter"))
+
+		refs := []map[string]string{}
+		for _, name := range []string{"refs/heads/main", "refs/heads/v1", "refs/heads/v2"} {
+			refs = append(refs, map[string]string{"name": name})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"value": refs, "count": len(refs)})
+	}))
+}
+
+func TestSource(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+
+	tests := []struct {
+		name          string
+		repository    string
+		versionFilter version.Filter
+		wantResult    string
+		wantErr       bool
+	}{
+		{
+			name:       "repository should not exist",
+			repository: "nonexistent",
+			wantErr:    true,
+		},
+		{
+			name:       "latest branch in alphabetical order",
+			repository: "repo",
+			wantResult: "v2",
+		},
+		{
+			name:       "branch matching semver filter",
+			repository: "repo",
+			versionFilter: version.Filter{
+				Kind:    "semver",
+				Pattern: "*",
+			},
+			wantResult: "v2",
+		},
+		{
+			name:       "no branch matching regex filter",
+			repository: "repo",
+			versionFilter: version.Filter{
+				Kind:    "regex",
+				Pattern: "^v9",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := New(map[string]interface{}{
+				"url":           server.URL,
+				"organization":  "org",
+				"project":       "project",
+				"repository":    tt.repository,
+				"versionfilter": tt.versionFilter,
+			})
+			require.NoError(t, err)
+
+			gotResult := result.Source{}
+			err = a.Source("", &gotResult)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantResult, gotResult.Information)
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/updatecli/updatecli/pkg/core/httpclient"
+)
+
+const (
+	// apiVersion is the Azure DevOps REST api version used by Updatecli
+	apiVersion = "7.1"
+	// continuationTokenHeader is the header used by Azure DevOps to paginate api responses
+	continuationTokenHeader = "x-ms-continuationtoken"
+)
+
+// ErrNotFound is returned when the Azure DevOps api returns a 404
+var ErrNotFound = errors.New("not found")
+
+// Client is an Azure DevOps REST api client.
+// The go-scm Azure driver doesn't support the api endpoints used by Updatecli,
+// such as listing tags or pull requests, hence the reason to use a dedicated client.
+type Client struct {
+	httpClient httpclient.HTTPClient
+	url        string
+	username   string
+	token      string
+}
+
+// New returns a new Azure DevOps client
+func New(s Spec) (Client, error) {
+	if err := s.Sanitize(); err != nil {
+		return Client{}, err
+	}
+
+	return Client{
+		httpClient: httpclient.NewRetryClient(),
+		url:        s.URL,
+		username:   s.Username,
+		token:      s.Token,
+	}, nil
+}
+
+// Do sends an api request to Azure DevOps.
+// path is relative to the Azure DevOps url and may contain query parameters,
+// the request body is encoded from in, and the response body is decoded into out, if not nil.
+// It returns the response headers.
+func (c Client) Do(ctx context.Context, method, path string, in, out interface{}) (http.Header, error) {
+	u, err := url.Parse(c.url + "/" + strings.TrimPrefix(path, "/"))
+	if err != nil {
+		return nil, err
+	}
+
+	query := u.Query()
+	if query.Get("api-version") == "" {
+		query.Set("api-version", apiVersion)
+	}
+	u.RawQuery = query.Encode()
+
+	var body io.Reader
+	if in != nil {
+		buf := new(bytes.Buffer)
+		if err := json.NewEncoder(buf).Encode(in); err != nil {
+			return nil, err
+		}
+		body = buf
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	if c.token != "" {
+		req.SetBasicAuth(c.username, c.token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return resp.Header, fmt.Errorf("%s %s: %w", method, u.Path, ErrNotFound)
+	}
+
+	if resp.StatusCode >= 300 {
+		apiError := struct {
+			Message string `json:"message"`
+		}{}
+		_ = json.NewDecoder(resp.Body).Decode(&apiError)
+
+		if apiError.Message != "" {
+			return resp.Header, fmt.Errorf("%s %s: %s: %s", method, u.Path, http.StatusText(resp.StatusCode), apiError.Message)
+		}
+		return resp.Header, fmt.Errorf("%s %s: %s", method, u.Path, http.StatusText(resp.StatusCode))
+	}
+
+	if out != nil {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return resp.Header, fmt.Errorf("decoding %s %s response: %w", method, u.Path, err)
+		}
+	}
+
+	return resp.Header, nil
+}
+
+// ListRefs returns the names of the git references from a repository matching the filter, such as "heads/" or "tags/".
+// The filter is removed from the returned reference names.
+func (c Client) ListRefs(ctx context.Context, organization, project, repository, filter string) ([]string, error) {
+	var refs []string
+
+	continuationToken := ""
+	for {
+		query := url.Values{}
+		query.Set("filter", filter)
+		if continuationToken != "" {
+			query.Set("continuationToken", continuationToken)
+		}
+
+		out := struct {
+			Value []struct {
+				Name string `json:"name"`
+			} `json:"value"`
+		}{}
+
+		header, err := c.Do(ctx, http.MethodGet,
+			fmt.Sprintf("%s/%s/_apis/git/repositories/%s/refs?%s",
+				url.PathEscape(organization),
+				url.PathEscape(project),
+				url.PathEscape(repository),
+				query.Encode()),
+			nil, &out)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, ref := range out.Value {
+			refs = append(refs, strings.TrimPrefix(ref.Name, "refs/"+filter))
+		}
+
+		continuationToken = header.Get(continuationTokenHeader)
+		if continuationToken == "" {
+			break
+		}
+	}
+
+	return refs, nil
+}
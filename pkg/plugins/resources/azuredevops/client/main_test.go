@@ -0,0 +1,81 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSanitize(t *testing.T) {
+	testData := []struct {
+		name        string
+		spec        Spec
+		expectedURL string
+	}{
+		{
+			name:        "Default Azure DevOps Services url",
+			spec:        Spec{},
+			expectedURL: "https://dev.azure.com",
+		},
+		{
+			name:        "Azure DevOps Server url without scheme",
+			spec:        Spec{URL: "devops.example.com/DefaultCollection/"},
+			expectedURL: "https://devops.example.com/DefaultCollection",
+		},
+		{
+			name:        "Url with http scheme",
+			spec:        Spec{URL: "http://localhost:8080"},
+			expectedURL: "http://localhost:8080",
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NoError(t, tt.spec.Sanitize())
+			assert.Equal(t, tt.expectedURL, tt.spec.URL)
+		})
+	}
+}
+
+func TestListRefs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/org/project/_apis/git/repositories/repo/refs" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		username, password, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "", username)
+		assert.Equal(t, "xxx", password)
+		assert.Equal(t, apiVersion, r.URL.Query().Get("api-version"))
+		assert.Equal(t, "tags/", r.URL.Query().Get("filter"))
+
+		w.Header().Set("Content-Type", "application/json")
+
+		refs := []map[string]string{{"name": "refs/tags/v1.0.0"}, {"name": "refs/tags/v1.1.0"}}
+		if r.URL.Query().Get("continuationToken") == "" {
+			w.Header().Set(continuationTokenHeader, "next")
+		} else {
+			refs = []map[string]string{{"name": "refs/tags/v2.0.0"}}
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]any{"value": refs, "count": len(refs)})
+	}))
+	defer server.Close()
+
+	c, err := New(Spec{URL: server.URL, Token: "xxx"})
+	require.NoError(t, err)
+
+	refs, err := c.ListRefs(context.Background(), "org", "project", "repo", "tags/")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"v1.0.0", "v1.1.0", "v2.0.0"}, refs)
+
+	_, err = c.ListRefs(context.Background(), "org", "project", "missing", "tags/")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
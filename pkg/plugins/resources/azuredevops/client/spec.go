@@ -0,0 +1,65 @@
+package client
+
+import (
+	"strings"
+)
+
+const (
+	// DefaultURL is the Azure DevOps Services url
+	DefaultURL = "https://dev.azure.com"
+)
+
+// Spec defines a specification for an "azuredevops" resource
+// parsed from an updatecli manifest file
+type Spec struct {
+	//  "url" defines the Azure DevOps url to interact with
+	//
+	//  default:
+	//    "https://dev.azure.com"
+	//
+	//  remark:
+	//    For Azure DevOps Server, the url must contain the collection such as "https://devops.example.com/DefaultCollection"
+	URL string `yaml:",omitempty"`
+	//  "username" defines the username used to authenticate with Azure DevOps
+	//
+	//  remark:
+	//    Azure DevOps ignores the username when authenticating with a personal access token
+	Username string `yaml:",omitempty"`
+	//  "token" specifies the personal access token used to authenticate with Azure DevOps
+	//
+	//  remark:
+	//    A token is a sensitive information, it's recommended to not set this value directly in the configuration file
+	//    but to use an environment variable or a SOPS file.
+	//
+	//    The value can be set to `{{ requiredEnv "AZURE_DEVOPS_TOKEN"}}` to retrieve the token from the environment variable `AZURE_DEVOPS_TOKEN`
+	//	  or `{{ .azuredevops.token }}` to retrieve the token from a SOPS file.
+	//
+	//	  For more information, about a SOPS file, please refer to the following documentation:
+	//    https://github.com/getsops/sops
+	Token string `yaml:",omitempty"`
+}
+
+// Validate validates that a spec contains good content
+func (s Spec) Validate() error {
+	return nil
+}
+
+// Sanitize parse and update if needed a spec content
+func (s *Spec) Sanitize() error {
+	err := s.Validate()
+	if err != nil {
+		return err
+	}
+
+	if len(s.URL) == 0 {
+		s.URL = DefaultURL
+	}
+
+	if !strings.HasPrefix(s.URL, "https://") && !strings.HasPrefix(s.URL, "http://") {
+		s.URL = "https://" + s.URL
+	}
+
+	s.URL = strings.TrimSuffix(s.URL, "/")
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package pullrequest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/reports"
+	utils "github.com/updatecli/updatecli/pkg/plugins/utils/action"
+)
+
+// CleanAction abandons an existing Azure DevOps pull request if its working branch doesn't bring any changes anymore
+func (a *AzureDevOps) CleanAction(report *reports.Action, closeObsolete bool) error {
+	if a.scm == nil {
+		logrus.Debugln("no Azure DevOps scm defined, nothing to clean")
+		return nil
+	}
+
+	pr, err := a.getPullRequest()
+	if err != nil {
+		return err
+	}
+
+	if pr == nil {
+		logrus.Debugln("nothing to clean")
+		return nil
+	}
+
+	// Without push, the remote working branch doesn't contain the local changes,
+	// so it can't be considered obsolete.
+	isObsolete := false
+	if closeObsolete {
+		isObsolete, err = a.scm.IsWorkingBranchObsolete()
+		if err != nil {
+			return fmt.Errorf("checking if pull request is obsolete: %w", err)
+		}
+	}
+
+	if !isObsolete {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), azureRequestTimeout)
+	defer cancel()
+
+	thread := map[string]interface{}{
+		"comments": []map[string]interface{}{
+			{
+				"content":     utils.OBSOLETEPULLREQUESTCOMMENT,
+				"commentType": "text",
+			},
+		},
+		"status": "closed",
+	}
+
+	// Not returning an error if the comment failed to be added
+	// as the main purpose of this function is to abandon the pull request
+	_, err = a.client.Do(ctx, http.MethodPost,
+		fmt.Sprintf("%s/%d/threads", a.pullRequestsPath(), pr.PullRequestID),
+		thread, nil)
+	if err != nil {
+		logrus.Errorf("Commenting Azure DevOps pull request: %s", err)
+	}
+
+	if _, err := a.updatePullRequest(pr.PullRequestID, map[string]string{"status": "abandoned"}); err != nil {
+		return fmt.Errorf("abandoning obsolete pull request: %w", err)
+	}
+
+	logrus.Infof("Azure DevOps pull request abandoned as obsolete at:\n\n\t%s\n\n", pr.Link())
+
+	report.Link = ""
+	report.Description = "Pull request abandoned as obsolete"
+
+	if a.spec.DeleteObsoleteBranch {
+		if err := a.scm.DeleteBranch(a.SourceBranch); err != nil {
+			return fmt.Errorf("deleting obsolete branch %q: %w", a.SourceBranch, err)
+		}
+		logrus.Infof("Branch %q deleted", a.SourceBranch)
+	}
+
+	return nil
+}
@@ -0,0 +1,206 @@
+package pullrequest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/reports"
+	"github.com/updatecli/updatecli/pkg/core/result"
+	utils "github.com/updatecli/updatecli/pkg/plugins/utils/action"
+)
+
+// createPullRequestInput is the Azure DevOps api payload used to create a pull request
+type createPullRequestInput struct {
+	SourceRefName string           `json:"sourceRefName"`
+	TargetRefName string           `json:"targetRefName"`
+	Title         string           `json:"title"`
+	Description   string           `json:"description"`
+	Reviewers     []identityRef    `json:"reviewers,omitempty"`
+	Labels        []labelInput     `json:"labels,omitempty"`
+	WorkItemRefs  []workItemRefDef `json:"workItemRefs,omitempty"`
+}
+
+// labelInput is an Azure DevOps pull request label
+type labelInput struct {
+	Name string `json:"name"`
+}
+
+// workItemRefDef is a reference to an Azure Boards work item
+type workItemRefDef struct {
+	ID string `json:"id"`
+}
+
+// CreateAction opens a pull request on Azure DevOps, or updates the existing one
+func (a *AzureDevOps) CreateAction(report *reports.Action, resetDescription bool) error {
+	title := report.Title
+	if len(a.spec.Title) > 0 {
+		title = a.spec.Title
+	}
+
+	existingPR, err := a.getPullRequest()
+	if err != nil {
+		return err
+	}
+
+	if existingPR != nil {
+		logrus.Debugln("Azure DevOps pull request already exist, updating it")
+
+		body := a.spec.Body
+		if body == "" {
+			previousDescription := existingPR.Description
+			if resetDescription {
+				previousDescription = ""
+			}
+
+			mergedDescription, err := reports.MergeFromMarkdown(previousDescription, report.ToActionsMarkdownString())
+			if err != nil {
+				return err
+			}
+
+			body, err = utils.GeneratePullRequestBodyMarkdown("", mergedDescription)
+			if err != nil {
+				return fmt.Errorf("generate Azure DevOps pull request body: %w", err)
+			}
+		}
+
+		pr, err := a.updatePullRequest(existingPR.PullRequestID, map[string]string{
+			"description": truncateDescription(body),
+		})
+		if err != nil {
+			return fmt.Errorf("update Azure DevOps pull request: %w", err)
+		}
+
+		report.Title = pr.Title
+		report.Link = pr.Link()
+		report.Description = pr.Description
+
+		logrus.Infof("%s Azure DevOps pull request successfully updated %q", result.SUCCESS, pr.Link())
+
+		if a.spec.AutoComplete {
+			if err := a.enableAutoComplete(pr); err != nil {
+				logrus.Errorf("Auto-complete can't be enabled: %s", err)
+			}
+		}
+
+		return nil
+	}
+
+	body := a.spec.Body
+	if body == "" {
+		body, err = utils.GeneratePullRequestBodyMarkdown("", report.ToActionsMarkdownString())
+		if err != nil {
+			logrus.Warningf("something went wrong while generating Azure DevOps pull request body: %s", err)
+		}
+	}
+
+	// Test that both sourceBranch and targetBranch exists on remote before creating a new one
+	ok, err := a.isRemoteBranchesExist()
+	if err != nil {
+		return err
+	}
+
+	/*
+		Due to the following scenario, Updatecli always tries to open a pull request
+			* A pull request has been "manually" abandoned via UI
+			* A previous Updatecli run failed during a pull request creation for example due to network issues
+
+		Therefore we always try to open a pull request, we don't consider being an error if all conditions are not met
+		such as missing remote branches.
+	*/
+	if !ok {
+		logrus.Debugln("skipping pull request creation")
+		return nil
+	}
+
+	in := createPullRequestInput{
+		SourceRefName: "refs/heads/" + a.SourceBranch,
+		TargetRefName: "refs/heads/" + a.TargetBranch,
+		Title:         title,
+		Description:   truncateDescription(body),
+	}
+
+	for _, reviewer := range a.spec.Reviewers {
+		in.Reviewers = append(in.Reviewers, identityRef{ID: reviewer})
+	}
+
+	for _, label := range a.spec.Labels {
+		in.Labels = append(in.Labels, labelInput{Name: label})
+	}
+
+	for _, workItem := range a.spec.WorkItems {
+		in.WorkItemRefs = append(in.WorkItemRefs, workItemRefDef{ID: fmt.Sprint(workItem)})
+	}
+
+	logrus.Debugf("Title:\t%q\nBody:\t%q\nSource:\t%q\nTarget:\t%q\n",
+		title,
+		body,
+		a.SourceBranch,
+		a.TargetBranch)
+
+	ctx, cancel := context.WithTimeout(context.Background(), azureRequestTimeout)
+	defer cancel()
+
+	pr := pullRequest{}
+	if _, err := a.client.Do(ctx, http.MethodPost, a.pullRequestsPath(), in, &pr); err != nil {
+		return fmt.Errorf("create Azure DevOps pull request: %w", err)
+	}
+
+	report.Title = pr.Title
+	report.Link = pr.Link()
+	report.Description = pr.Description
+
+	logrus.Infof("%s Azure DevOps pull request successfully opened %q", result.SUCCESS, pr.Link())
+
+	if a.spec.AutoComplete {
+		if err := a.enableAutoComplete(&pr); err != nil {
+			logrus.Errorf("Auto-complete can't be enabled: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// updatePullRequest updates a pull request with the given fields and returns the updated pull request
+func (a *AzureDevOps) updatePullRequest(id int, in interface{}) (*pullRequest, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), azureRequestTimeout)
+	defer cancel()
+
+	pr := pullRequest{}
+	if _, err := a.client.Do(ctx, http.MethodPatch, fmt.Sprintf("%s/%d", a.pullRequestsPath(), id), in, &pr); err != nil {
+		return nil, err
+	}
+
+	return &pr, nil
+}
+
+// enableAutoComplete sets the pull request to be completed once all its policies succeed
+func (a *AzureDevOps) enableAutoComplete(pr *pullRequest) error {
+	if pr.AutoCompleteSetBy != nil {
+		logrus.Debugf("auto-complete already enabled on Azure DevOps pull request %q", pr.Link())
+		return nil
+	}
+
+	mergeMethod := a.spec.MergeMethod
+	if mergeMethod == "" {
+		mergeMethod = "noFastForward"
+	}
+
+	in := map[string]interface{}{
+		// Auto-complete is set on behalf of the pull request author, which is the Updatecli identity
+		"autoCompleteSetBy": identityRef{ID: pr.CreatedBy.ID},
+		"completionOptions": map[string]interface{}{
+			"mergeStrategy":      mergeMethod,
+			"deleteSourceBranch": a.spec.RemoveSourceBranch,
+		},
+	}
+
+	if _, err := a.updatePullRequest(pr.PullRequestID, in); err != nil {
+		return fmt.Errorf("enable auto-complete on Azure DevOps pull request %q: %w", pr.Link(), err)
+	}
+
+	logrus.Infof("Auto-complete enabled on Azure DevOps pull request %q", pr.Link())
+
+	return nil
+}
@@ -0,0 +1,24 @@
+package pullrequest
+
+import (
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/reports"
+)
+
+// CheckActionExist verifies if an existing Azure DevOps pull request is already opened.
+func (a *AzureDevOps) CheckActionExist(report *reports.Action) error {
+	pr, err := a.getPullRequest()
+	if err != nil {
+		return err
+	}
+
+	if pr != nil {
+		logrus.Debugf("Azure DevOps pull request detected")
+
+		report.Title = pr.Title
+		report.Link = pr.Link()
+		report.Description = pr.Description
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package pullrequest
+
+import (
+	"github.com/mitchellh/mapstructure"
+	"github.com/updatecli/updatecli/pkg/plugins/resources/azuredevops/client"
+	azuredevopsscm "github.com/updatecli/updatecli/pkg/plugins/scms/azuredevops"
+)
+
+// AzureDevOps contains information to interact with Azure DevOps api
+type AzureDevOps struct {
+	// spec contains inputs coming from updatecli configuration
+	spec Spec
+	// client handle the api authentication
+	client client.Client
+	// scm allows to interact with a scm object
+	scm *azuredevopsscm.AzureDevOps
+	// SourceBranch specifies the pull request source branch.
+	SourceBranch string
+	// TargetBranch specifies the pull request target branch
+	TargetBranch string
+	// Organization specifies the Azure DevOps organization
+	Organization string
+	// Project specifies the Azure DevOps project
+	Project string
+	// Repository specifies the name of a repository for a specific project
+	Repository string
+}
+
+// New returns a new valid Azure DevOps pull request object.
+func New(spec interface{}, scm *azuredevopsscm.AzureDevOps) (AzureDevOps, error) {
+	var clientSpec client.Spec
+	var s Spec
+
+	// mapstructure.Decode cannot handle embedded fields
+	// hence we decode it in two steps
+	err := mapstructure.Decode(spec, &clientSpec)
+	if err != nil {
+		return AzureDevOps{}, err
+	}
+
+	err = mapstructure.Decode(spec, &s)
+	if err != nil {
+		return AzureDevOps{}, err
+	}
+
+	if err := s.Validate(); err != nil {
+		return AzureDevOps{}, err
+	}
+
+	if scm != nil {
+		if len(clientSpec.Token) == 0 && len(scm.Spec.Token) > 0 {
+			clientSpec.Token = scm.Spec.Token
+		}
+
+		if len(clientSpec.URL) == 0 && len(scm.Spec.URL) > 0 {
+			clientSpec.URL = scm.Spec.URL
+		}
+
+		if len(clientSpec.Username) == 0 && len(scm.Spec.Username) > 0 {
+			clientSpec.Username = scm.Spec.Username
+		}
+	}
+
+	// Sanitize modifies the clientSpec so it must be done once initialization is completed
+	err = clientSpec.Sanitize()
+	if err != nil {
+		return AzureDevOps{}, err
+	}
+
+	c, err := client.New(clientSpec)
+	if err != nil {
+		return AzureDevOps{}, err
+	}
+
+	a := AzureDevOps{
+		spec:   s,
+		client: c,
+		scm:    scm,
+	}
+
+	a.inheritFromScm()
+
+	return a, nil
+}
@@ -0,0 +1,204 @@
+package pullrequest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/core/reports"
+	azuredevopsscm "github.com/updatecli/updatecli/pkg/plugins/scms/azuredevops"
+)
+
+func TestNew(t *testing.T) {
+	scm, err := azuredevopsscm.New(map[string]interface{}{
+		"organization": "updatecli",
+		"project":      "infra",
+		"repository":   "charts",
+		"branch":       "main",
+		"token":        "xxx",
+	}, "1234")
+	require.NoError(t, err)
+
+	testData := []struct {
+		name                 string
+		spec                 map[string]interface{}
+		expectedRepository   string
+		expectedSourceBranch string
+		expectedTargetBranch string
+		wantErr              bool
+	}{
+		{
+			name:                 "Settings inherited from the scm",
+			spec:                 map[string]interface{}{},
+			expectedRepository:   "charts",
+			expectedSourceBranch: "updatecli_main_1234",
+			expectedTargetBranch: "main",
+		},
+		{
+			name: "Settings overridden by the action",
+			spec: map[string]interface{}{
+				"repository":   "website",
+				"sourcebranch": "feature",
+				"targetbranch": "develop",
+			},
+			expectedRepository:   "website",
+			expectedSourceBranch: "feature",
+			expectedTargetBranch: "develop",
+		},
+		{
+			name: "Wrong merge method",
+			spec: map[string]interface{}{
+				"mergemethod": "octopus",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := New(tt.spec, scm)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, "updatecli", a.Organization)
+			assert.Equal(t, "infra", a.Project)
+			assert.Equal(t, tt.expectedRepository, a.Repository)
+			assert.Equal(t, tt.expectedSourceBranch, a.SourceBranch)
+			assert.Equal(t, tt.expectedTargetBranch, a.TargetBranch)
+		})
+	}
+}
+
+func TestCreateAction(t *testing.T) {
+	// pullRequests contains the pull requests stored by the fake Azure DevOps server
+	pullRequests := map[int]map[string]any{}
+	var created []map[string]any
+	var autoCompleted []map[string]any
+
+	const path = "/org/project/_apis/git/repositories/repo"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == path+"/refs":
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"value": []map[string]string{{"name": "refs/heads/main"}, {"name": "refs/heads/updatecli_main"}},
+			})
+
+		case r.Method == http.MethodGet && r.URL.Path == path+"/pullrequests":
+			assert.Equal(t, "refs/heads/updatecli_main", r.URL.Query().Get("searchCriteria.sourceRefName"))
+			list := []map[string]any{}
+			for _, pr := range pullRequests {
+				list = append(list, pr)
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"value": list})
+
+		case r.Method == http.MethodPost && r.URL.Path == path+"/pullrequests":
+			in := map[string]any{}
+			_ = json.NewDecoder(r.Body).Decode(&in)
+			created = append(created, in)
+
+			pr := map[string]any{
+				"pullRequestId": 7,
+				"title":         in["title"],
+				"description":   in["description"],
+				"status":        "active",
+				"sourceRefName": in["sourceRefName"],
+				"targetRefName": in["targetRefName"],
+				"createdBy":     map[string]string{"id": "updatecli-bot"},
+				"repository":    map[string]string{"webUrl": "https://dev.azure.com/org/project/_git/repo"},
+			}
+			pullRequests[7] = pr
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(pr)
+
+		case r.Method == http.MethodPatch && strings.HasPrefix(r.URL.Path, path+"/pullrequests/"):
+			var id int
+			_, _ = fmt.Sscanf(strings.TrimPrefix(r.URL.Path, path+"/pullrequests/"), "%d", &id)
+			pr, ok := pullRequests[id]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+
+			in := map[string]any{}
+			_ = json.NewDecoder(r.Body).Decode(&in)
+			if _, ok := in["autoCompleteSetBy"]; ok {
+				autoCompleted = append(autoCompleted, in)
+			}
+			for k, v := range in {
+				pr[k] = v
+			}
+			_ = json.NewEncoder(w).Encode(pr)
+
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	a, err := New(map[string]interface{}{
+		"url":                server.URL,
+		"organization":       "org",
+		"project":            "project",
+		"repository":         "repo",
+		"sourcebranch":       "updatecli_main",
+		"targetbranch":       "main",
+		"labels":             []string{"dependencies"},
+		"reviewers":          []string{"d6245f20-2af8-44f4-9451-8107cb2767db"},
+		"workitems":          []int{42},
+		"autocomplete":       true,
+		"mergemethod":        "squash",
+		"removesourcebranch": true,
+	}, nil)
+	require.NoError(t, err)
+
+	// The pull request is created
+	report := reports.Action{Title: "Bump Golang version"}
+	require.NoError(t, a.CreateAction(&report, false))
+
+	require.Len(t, created, 1)
+	assert.Equal(t, "Bump Golang version", created[0]["title"])
+	assert.Equal(t, "refs/heads/updatecli_main", created[0]["sourceRefName"])
+	assert.Equal(t, "refs/heads/main", created[0]["targetRefName"])
+	assert.Equal(t, []any{map[string]any{"name": "dependencies"}}, created[0]["labels"])
+	assert.Equal(t, []any{map[string]any{"id": "d6245f20-2af8-44f4-9451-8107cb2767db"}}, created[0]["reviewers"])
+	assert.Equal(t, []any{map[string]any{"id": "42"}}, created[0]["workItemRefs"])
+	assert.Equal(t, "https://dev.azure.com/org/project/_git/repo/pullrequest/7", report.Link)
+
+	require.Len(t, autoCompleted, 1)
+	assert.Equal(t, map[string]any{"id": "updatecli-bot"}, autoCompleted[0]["autoCompleteSetBy"])
+	assert.Equal(t, map[string]any{
+		"mergeStrategy":      "squash",
+		"deleteSourceBranch": true,
+	}, autoCompleted[0]["completionOptions"])
+
+	// The existing pull request is updated, auto-complete is only enabled once
+	report = reports.Action{Title: "Bump Golang version"}
+	require.NoError(t, a.CreateAction(&report, false))
+	assert.Len(t, created, 1)
+	assert.Len(t, autoCompleted, 1)
+	assert.Equal(t, "https://dev.azure.com/org/project/_git/repo/pullrequest/7", report.Link)
+
+	// The pull request is detected
+	report = reports.Action{}
+	require.NoError(t, a.CheckActionExist(&report))
+	assert.Equal(t, "https://dev.azure.com/org/project/_git/repo/pullrequest/7", report.Link)
+}
+
+func TestTruncateDescription(t *testing.T) {
+	assert.Equal(t, "short", truncateDescription("short"))
+
+	got := truncateDescription(strings.Repeat("a", maxDescriptionLength+10))
+	assert.Len(t, got, maxDescriptionLength)
+	assert.True(t, strings.HasSuffix(got, "..."))
+}
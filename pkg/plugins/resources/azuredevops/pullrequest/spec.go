@@ -0,0 +1,111 @@
+package pullrequest
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/updatecli/updatecli/pkg/plugins/resources/azuredevops/client"
+)
+
+// mergeMethods contains the merge strategies accepted by the Azure DevOps api
+var mergeMethods = []string{"noFastForward", "squash", "rebase", "rebaseMerge"}
+
+// Spec defines settings used to interact with Azure DevOps pull request
+// It's a mapping of user input from a Updatecli manifest and it shouldn't modified
+type Spec struct {
+	client.Spec `yaml:",inline,omitempty"`
+	// "sourcebranch" defines the branch name used as a source to create the Azure DevOps pull request.
+	//
+	// default:
+	// 		"sourcebranch" inherits the value from the scm working branch if a scm of kind "azuredevops" is specified by the action.
+	//
+	// remark:
+	// 		unless you know what you are doing, you shouldn't set this value and rely on the scmid to provide the sane default.
+	SourceBranch string `yaml:",omitempty"`
+	// "targetbranch" defines the branch name used as a target to create the Azure DevOps pull request.
+	//
+	// default:
+	// 		"targetbranch" inherits the value from the scm branch if a scm of kind "azuredevops" is specified by the action.
+	//
+	// remark:
+	// 		unless you know what you are doing, you shouldn't set this value and rely on the scmid to provide the sane default.
+	TargetBranch string `yaml:",omitempty"`
+	// "organization" defines the Azure DevOps organization.
+	//
+	// remark:
+	// 		unless you know what you are doing, you shouldn't set this value and rely on the scmid to provide the sane default.
+	Organization string `yaml:",omitempty"`
+	// "project" defines the Azure DevOps project.
+	//
+	// remark:
+	// 		unless you know what you are doing, you shouldn't set this value and rely on the scmid to provide the sane default.
+	Project string `yaml:",omitempty"`
+	// "repository" defines the Azure DevOps repository for a specific project.
+	//
+	// remark:
+	// 		unless you know what you are doing, you shouldn't set this value and rely on the scmid to provide the sane default.
+	Repository string `yaml:",omitempty"`
+	// "title" defines the Azure DevOps pull request title.
+	//
+	// default:
+	// 		the action title, the first associated target title, or the pipeline title
+	Title string `yaml:",omitempty"`
+	// "body" defines a custom pull request body
+	//
+	// default:
+	// 	By default a pull request body is generated out of a pipeline execution.
+	//
+	// remark:
+	// 	Unless you know what you are doing, you shouldn't set this value and rely on the sane default.
+	// 	Azure DevOps limits the pull request description to 4000 characters, longer bodies are truncated.
+	Body string `yaml:",omitempty"`
+	// "labels" defines the labels, also known as tags, added to the pull request.
+	//
+	// default: empty
+	//
+	// remark:
+	// 		labels that don't exist yet are created by Azure DevOps.
+	Labels []string `yaml:",omitempty"`
+	// "reviewers" defines the reviewers added to the pull request.
+	//
+	// default: empty
+	//
+	// remark:
+	// 		reviewers only accept Azure DevOps identity IDs, for users or groups.
+	Reviewers []string `yaml:",omitempty"`
+	// "workitems" defines the IDs of the Azure Boards work items linked to the pull request.
+	//
+	// default: empty
+	WorkItems []int `yaml:",omitempty"`
+	// "autocomplete" defines if the pull request should be completed automatically
+	// once all its policies, such as required reviewers or builds, succeed.
+	//
+	// default: false
+	AutoComplete bool `yaml:",omitempty"`
+	// "mergemethod" defines the merge strategy used when the pull request is automatically completed.
+	//
+	// default: "noFastForward"
+	//
+	// remark:
+	// 		Accept "noFastForward", "squash", "rebase", or "rebaseMerge"
+	MergeMethod string `yaml:",omitempty"`
+	// "removesourcebranch" defines if the pull request source branch should be deleted once automatically completed.
+	//
+	// default: false
+	RemoveSourceBranch bool `yaml:",omitempty"`
+	// "deleteobsoletebranch" defines if the working branch should be deleted once Updatecli abandoned its pull request
+	// because the working branch doesn't bring any changes anymore.
+	//
+	// default: false
+	DeleteObsoleteBranch bool `yaml:",omitempty"`
+}
+
+// Validate validates that a spec contains good content
+func (s Spec) Validate() error {
+	if s.MergeMethod != "" && !slices.Contains(mergeMethods, s.MergeMethod) {
+		return fmt.Errorf("wrong merge method %q defined, accepting one of %q", s.MergeMethod, strings.Join(mergeMethods, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,157 @@
+package pullrequest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/result"
+)
+
+const (
+	// maxDescriptionLength is the maximum pull request description length accepted by Azure DevOps
+	maxDescriptionLength = 4000
+	// azureRequestTimeout is the timeout applied to Azure DevOps api queries
+	azureRequestTimeout = 30 * time.Second
+)
+
+// identityRef is an Azure DevOps identity reference
+type identityRef struct {
+	ID string `json:"id"`
+}
+
+// pullRequest contains the Azure DevOps pull request fields used by Updatecli
+type pullRequest struct {
+	PullRequestID     int          `json:"pullRequestId"`
+	Title             string       `json:"title"`
+	Description       string       `json:"description"`
+	Status            string       `json:"status"`
+	SourceRefName     string       `json:"sourceRefName"`
+	TargetRefName     string       `json:"targetRefName"`
+	CreatedBy         identityRef  `json:"createdBy"`
+	AutoCompleteSetBy *identityRef `json:"autoCompleteSetBy,omitempty"`
+	Repository        struct {
+		WebURL string `json:"webUrl"`
+	} `json:"repository"`
+}
+
+// Link returns the pull request web url
+func (p pullRequest) Link() string {
+	return fmt.Sprintf("%s/pullrequest/%d", p.Repository.WebURL, p.PullRequestID)
+}
+
+// pullRequestsPath returns the Azure DevOps api path of the repository pull requests
+func (a *AzureDevOps) pullRequestsPath() string {
+	return fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests",
+		url.PathEscape(a.Organization),
+		url.PathEscape(a.Project),
+		url.PathEscape(a.Repository))
+}
+
+// getPullRequest queries Azure DevOps to retrieve the active pull request from the source branch
+// to the target branch, it returns nil if none could be found.
+func (a *AzureDevOps) getPullRequest() (*pullRequest, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), azureRequestTimeout)
+	defer cancel()
+
+	query := url.Values{}
+	query.Set("searchCriteria.sourceRefName", "refs/heads/"+a.SourceBranch)
+	query.Set("searchCriteria.targetRefName", "refs/heads/"+a.TargetBranch)
+	query.Set("searchCriteria.status", "active")
+
+	out := struct {
+		Value []pullRequest `json:"value"`
+	}{}
+
+	_, err := a.client.Do(ctx, http.MethodGet, a.pullRequestsPath()+"?"+query.Encode(), nil, &out)
+	if err != nil {
+		return nil, fmt.Errorf("list Azure DevOps pull requests: %w", err)
+	}
+
+	for i := range out.Value {
+		pr := out.Value[i]
+		if pr.SourceRefName == "refs/heads/"+a.SourceBranch &&
+			pr.TargetRefName == "refs/heads/"+a.TargetBranch &&
+			pr.Status == "active" {
+
+			logrus.Infof("%s Azure DevOps pull request detected at:\n\t%s",
+				result.SUCCESS,
+				pr.Link())
+
+			return &pr, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// isRemoteBranchesExist queries Azure DevOps to know if both the pull request source branch and the target branch exist.
+func (a *AzureDevOps) isRemoteBranchesExist() (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), azureRequestTimeout)
+	defer cancel()
+
+	branches, err := a.client.ListRefs(ctx, a.Organization, a.Project, a.Repository, "heads/")
+	if err != nil {
+		return false, fmt.Errorf("list Azure DevOps branches: %w", err)
+	}
+
+	if !slices.Contains(branches, a.SourceBranch) {
+		logrus.Debugf("branch %q not found on Azure DevOps repository %s/%s/%s",
+			a.SourceBranch, a.Organization, a.Project, a.Repository)
+		return false, nil
+	}
+
+	if !slices.Contains(branches, a.TargetBranch) {
+		logrus.Debugf("branch %q not found on Azure DevOps repository %s/%s/%s",
+			a.TargetBranch, a.Organization, a.Project, a.Repository)
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// truncateDescription truncates a pull request description to the maximum length accepted by Azure DevOps
+func truncateDescription(description string) string {
+	runes := []rune(description)
+	if len(runes) <= maxDescriptionLength {
+		return description
+	}
+
+	logrus.Warningf("Azure DevOps pull request description truncated to %d characters", maxDescriptionLength)
+
+	return string(runes[:maxDescriptionLength-3]) + "..."
+}
+
+// inheritFromScm retrieve missing Azure DevOps settings from the Azure DevOps scm object.
+func (a *AzureDevOps) inheritFromScm() {
+	if a.scm != nil {
+		_, a.SourceBranch, a.TargetBranch = a.scm.GetBranches()
+		a.Organization = a.scm.Spec.Organization
+		a.Project = a.scm.Spec.Project
+		a.Repository = a.scm.Spec.Repository
+	}
+
+	if len(a.spec.SourceBranch) > 0 {
+		a.SourceBranch = a.spec.SourceBranch
+	}
+
+	if len(a.spec.TargetBranch) > 0 {
+		a.TargetBranch = a.spec.TargetBranch
+	}
+
+	if len(a.spec.Organization) > 0 {
+		a.Organization = a.spec.Organization
+	}
+
+	if len(a.spec.Project) > 0 {
+		a.Project = a.spec.Project
+	}
+
+	if len(a.spec.Repository) > 0 {
+		a.Repository = a.spec.Repository
+	}
+}
@@ -0,0 +1,33 @@
+package tag
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
+)
+
+// Condition tests if a tag exists on an Azure DevOps repository
+func (a *AzureDevOps) Condition(source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+	if scm != nil {
+		logrus.Warningf("Condition not supported for the plugin Azure DevOps tag")
+	}
+
+	tag := source
+	if a.spec.Tag != "" {
+		tag = a.spec.Tag
+	}
+
+	tags, err := a.SearchTags()
+	if err != nil {
+		return false, "", fmt.Errorf("looking for Azure DevOps tag: %w", err)
+	}
+
+	for _, t := range tags {
+		if t == tag {
+			return true, fmt.Sprintf("Azure DevOps tag %q found", t), nil
+		}
+	}
+
+	return false, fmt.Sprintf("no Azure DevOps tag found matching %q", tag), nil
+}
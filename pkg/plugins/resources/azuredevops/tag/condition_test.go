@@ -0,0 +1,53 @@
+package tag
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCondition(t *testing.T) {
+	server := newTestServer(t)
+	defer server.Close()
+
+	tests := []struct {
+		name       string
+		tag        string
+		source     string
+		wantResult bool
+	}{
+		{
+			name:       "tag from spec exists",
+			tag:        "v1.9.0",
+			wantResult: true,
+		},
+		{
+			name:       "tag from source exists",
+			source:     "v1.0.0",
+			wantResult: true,
+		},
+		{
+			name:       "tag doesn't exist",
+			tag:        "v3.0.0",
+			wantResult: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := New(map[string]interface{}{
+				"url":          server.URL,
+				"organization": "org",
+				"project":      "project",
+				"repository":   "repo",
+				"tag":          tt.tag,
+			})
+			require.NoError(t, err)
+
+			gotResult, _, err := a.Condition(tt.source, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantResult, gotResult)
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package pullrequest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/reports"
+)
+
+const (
+	// buildStatusMaxPages limits the number of build status pages retrieved for a pull request
+	buildStatusMaxPages = 20
+	// buildStatusSuccessful is the Bitbucket Cloud state of a successful build status
+	buildStatusSuccessful = "SUCCESSFUL"
+)
+
+// autoMergePullRequest merges the pull request once all its build statuses succeeded,
+// otherwise it waits for a future Updatecli run.
+func (b *Bitbucket) autoMergePullRequest(details pullRequestDetails, report *reports.Action) error {
+	// Timeout api query after 30sec
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	pullRequestPath := fmt.Sprintf("2.0/repositories/%s/%s/pullrequests/%d", b.Owner, b.Repository, details.Number)
+
+	statuses, err := b.getBuildStatuses(ctx, pullRequestPath+"/statuses?pagelen=100")
+	if err != nil {
+		return fmt.Errorf("retrieving pull request build statuses: %w", err)
+	}
+
+	if len(statuses) == 0 {
+		logrus.Infof("Bitbucket Cloud pull request %q can't be merged yet: no build status reported",
+			details.Link)
+		return nil
+	}
+
+	for _, status := range statuses {
+		if status.State != buildStatusSuccessful {
+			logrus.Infof("Bitbucket Cloud pull request %q can't be merged yet: build %q is %s",
+				details.Link, status.Name, status.State)
+			return nil
+		}
+	}
+
+	in := struct {
+		MergeStrategy     string `json:"merge_strategy,omitempty"`
+		CloseSourceBranch bool   `json:"close_source_branch"`
+	}{
+		MergeStrategy:     b.spec.MergeMethod,
+		CloseSourceBranch: b.spec.RemoveSourceBranch,
+	}
+
+	if err := b.do(ctx, http.MethodPost, pullRequestPath+"/merge", in, nil); err != nil {
+		return fmt.Errorf("merging pull request: %w", err)
+	}
+
+	logrus.Infof("Bitbucket Cloud pull request merged at:\n\n\t%s\n\n", details.Link)
+
+	report.Description = "Pull request merged"
+
+	return nil
+}
+
+// buildStatus is a Bitbucket Cloud commit build status
+type buildStatus struct {
+	Name  string `json:"name"`
+	State string `json:"state"`
+}
+
+// getBuildStatuses retrieves every build status of a pull request, following the "next" page links.
+func (b *Bitbucket) getBuildStatuses(ctx context.Context, path string) ([]buildStatus, error) {
+	var statuses []buildStatus
+
+	for page := 0; path != ""; page++ {
+		if page == buildStatusMaxPages {
+			return nil, fmt.Errorf("more than %d pages of build statuses", buildStatusMaxPages)
+		}
+
+		out := struct {
+			Values []buildStatus `json:"values"`
+			Next   string        `json:"next"`
+		}{}
+
+		if err := b.do(ctx, http.MethodGet, path, nil, &out); err != nil {
+			return nil, err
+		}
+
+		statuses = append(statuses, out.Values...)
+
+		path = ""
+		if out.Next != "" {
+			// Only follow links to the Bitbucket API, the client credentials are sent with each request
+			base := b.client.BaseURL.String()
+			if !strings.HasPrefix(out.Next, base) {
+				return nil, fmt.Errorf("unexpected next page link %q", out.Next)
+			}
+			path = strings.TrimPrefix(out.Next, base)
+		}
+	}
+
+	return statuses, nil
+}
@@ -0,0 +1,181 @@
+package pullrequest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/drone/go-scm/scm/driver/bitbucket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/core/reports"
+)
+
+func TestAutoMergePullRequest(t *testing.T) {
+	state := "INPROGRESS"
+	var merges []map[string]any
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		const path = "/2.0/repositories/owner/repo/pullrequests/1"
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == path+"/statuses":
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"values": []map[string]string{
+					{"name": "lint", "state": "SUCCESSFUL"},
+					{"name": "test", "state": state},
+				},
+			})
+
+		case r.Method == http.MethodPost && r.URL.Path == path+"/merge":
+			in := map[string]any{}
+			_ = json.NewDecoder(r.Body).Decode(&in)
+			merges = append(merges, in)
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": 1, "state": "MERGED"})
+
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c, err := bitbucket.New(server.URL)
+	require.NoError(t, err)
+
+	b := Bitbucket{
+		spec: Spec{
+			AutoMerge:          true,
+			MergeMethod:        "squash",
+			RemoveSourceBranch: true,
+		},
+		client:     c,
+		Owner:      "owner",
+		Repository: "repo",
+	}
+
+	report := reports.Action{}
+
+	// The pull request isn't merged until all build statuses succeeded
+	require.NoError(t, b.autoMergePullRequest(pullRequestDetails{Number: 1}, &report))
+	assert.Empty(t, merges)
+
+	state = "SUCCESSFUL"
+	require.NoError(t, b.autoMergePullRequest(pullRequestDetails{Number: 1}, &report))
+	require.Len(t, merges, 1)
+	assert.Equal(t, map[string]any{
+		"merge_strategy":      "squash",
+		"close_source_branch": true,
+	}, merges[0])
+	assert.Equal(t, "Pull request merged", report.Description)
+
+	// Errors are reported
+	assert.Error(t, b.autoMergePullRequest(pullRequestDetails{Number: 2}, &report))
+}
+
+func TestAutoMergePullRequestStatuses(t *testing.T) {
+	tests := []struct {
+		name           string
+		pages          [][]map[string]string
+		expectedMerged bool
+	}{
+		{
+			name:           "No build status",
+			pages:          [][]map[string]string{{}},
+			expectedMerged: false,
+		},
+		{
+			name: "Pending build status",
+			pages: [][]map[string]string{{
+				{"name": "lint", "state": "SUCCESSFUL"},
+				{"name": "test", "state": "INPROGRESS"},
+			}},
+			expectedMerged: false,
+		},
+		{
+			name: "Successful build statuses on several pages",
+			pages: [][]map[string]string{
+				{{"name": "lint", "state": "SUCCESSFUL"}},
+				{{"name": "test", "state": "SUCCESSFUL"}},
+			},
+			expectedMerged: true,
+		},
+		{
+			name: "Failed build status on the second page",
+			pages: [][]map[string]string{
+				{{"name": "lint", "state": "SUCCESSFUL"}},
+				{{"name": "test", "state": "FAILED"}},
+			},
+			expectedMerged: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			const path = "/2.0/repositories/owner/repo/pullrequests/1"
+			merged := false
+
+			var server *httptest.Server
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+
+				switch {
+				case r.Method == http.MethodGet && r.URL.Path == path+"/statuses":
+					page, err := strconv.Atoi(r.URL.Query().Get("page"))
+					if err != nil {
+						page = 1
+					}
+					if page > len(tt.pages) {
+						w.WriteHeader(http.StatusNotFound)
+						return
+					}
+
+					out := map[string]any{"values": tt.pages[page-1]}
+					if page < len(tt.pages) {
+						out["next"] = fmt.Sprintf("%s%s/statuses?page=%d", server.URL, path, page+1)
+					}
+					_ = json.NewEncoder(w).Encode(out)
+
+				case r.Method == http.MethodPost && r.URL.Path == path+"/merge":
+					merged = true
+					_ = json.NewEncoder(w).Encode(map[string]any{"id": 1, "state": "MERGED"})
+
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			c, err := bitbucket.New(server.URL)
+			require.NoError(t, err)
+
+			b := Bitbucket{
+				spec:       Spec{AutoMerge: true},
+				client:     c,
+				Owner:      "owner",
+				Repository: "repo",
+			}
+
+			report := reports.Action{}
+			require.NoError(t, b.autoMergePullRequest(pullRequestDetails{Number: 1}, &report))
+			assert.Equal(t, tt.expectedMerged, merged)
+		})
+	}
+}
+
+func TestGetReviewers(t *testing.T) {
+	b := Bitbucket{
+		spec: Spec{
+			Reviewers: []string{"557058:f0ab", "{d3b07384-d113-4ec6-a2e8-1c4f0ef0a1b2}"},
+		},
+	}
+
+	assert.Equal(t, []map[string]string{
+		{"account_id": "557058:f0ab"},
+		{"uuid": "{d3b07384-d113-4ec6-a2e8-1c4f0ef0a1b2}"},
+	}, b.getReviewers())
+}
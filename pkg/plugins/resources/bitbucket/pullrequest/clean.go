@@ -1,12 +1,92 @@
 package pullrequest
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/drone/go-scm/scm"
 	"github.com/sirupsen/logrus"
 	"github.com/updatecli/updatecli/pkg/core/reports"
+	utils "github.com/updatecli/updatecli/pkg/plugins/utils/action"
 )
 
-// CleanAction verifies if an existing action requires some operations
-func (b *Bitbucket) CleanAction(report *reports.Action) error {
-	logrus.Debugln("cleaning Bitbucket Cloud pull request is not yet supported. Feel free to open an issue to mark your interest.")
+// CleanAction declines an existing Bitbucket Cloud pull request if its working branch doesn't bring any changes anymore,
+// otherwise it merges the pull request if auto-merge is enabled and all its build statuses succeeded.
+func (b *Bitbucket) CleanAction(report *reports.Action, closeObsolete bool) error {
+	if b.scm == nil {
+		logrus.Debugln("no Bitbucket Cloud scm defined, nothing to clean")
+		return nil
+	}
+
+	exists, details, err := b.isPullRequestExist()
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		logrus.Debugln("nothing to clean")
+		return nil
+	}
+
+	// Without push, the remote working branch doesn't contain the local changes,
+	// so it can't be considered obsolete.
+	isObsolete := false
+	if closeObsolete {
+		isObsolete, err = b.scm.IsWorkingBranchObsolete()
+		if err != nil {
+			return fmt.Errorf("checking if pull request is obsolete: %w", err)
+		}
+	}
+
+	if !isObsolete {
+		if b.spec.AutoMerge {
+			return b.autoMergePullRequest(details, report)
+		}
+		return nil
+	}
+
+	repo := strings.Join([]string{b.Owner, b.Repository}, "/")
+
+	// Timeout api query after 30sec
+	ctx := context.Background()
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	// Not returning an error if the comment failed to be added
+	// as the main purpose of this function is to decline the pull request
+	_, _, err = b.client.PullRequests.CreateComment(ctx, repo, details.Number, &scm.CommentInput{
+		Body: utils.OBSOLETEPULLREQUESTCOMMENT,
+	})
+	if err != nil {
+		logrus.Errorf("Commenting Bitbucket Cloud pull request: %s", err)
+	}
+
+	// Declining a pull request isn't supported by the go-scm library
+	path := fmt.Sprintf("2.0/repositories/%s/pullrequests/%d/decline", repo, details.Number)
+	resp, err := b.client.Do(ctx, &scm.Request{Method: http.MethodPost, Path: path})
+	if err != nil {
+		return fmt.Errorf("declining obsolete pull request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.Status >= 300 {
+		return fmt.Errorf("declining obsolete pull request: %s", http.StatusText(resp.Status))
+	}
+
+	logrus.Infof("Bitbucket Cloud pull request declined as obsolete at:\n\n\t%s\n\n", details.Link)
+
+	report.Link = ""
+	report.Description = "Pull request declined as obsolete"
+
+	if b.spec.DeleteObsoleteBranch {
+		if err := b.scm.DeleteBranch(b.SourceBranch); err != nil {
+			return fmt.Errorf("deleting obsolete branch %q: %w", b.SourceBranch, err)
+		}
+		logrus.Infof("Branch %q deleted", b.SourceBranch)
+	}
+
 	return nil
 }
@@ -141,13 +141,19 @@ func (b *Bitbucket) createPullRequest(title, body string) (responseTitle string,
 		return "", "", "", err
 	}
 
+	// Reviewers can't be set using the go-scm library
+	if len(b.spec.Reviewers) > 0 {
+		return b.updatePullRequest(pr.Number, pr.Title, pr.Body)
+	}
+
 	return pr.Title, pr.Body, pr.Link, nil
 }
 
 func (b *Bitbucket) updatePullRequest(pullRequestNumber int, title, body string) (responseTitle string, responseBody string, link string, err error) {
 	type requestInput struct {
-		Title       string `json:"title"`
-		Description string `json:"description"`
+		Title       string              `json:"title"`
+		Description string              `json:"description"`
+		Reviewers   []map[string]string `json:"reviewers,omitempty"`
 		Source      struct {
 			Branch struct {
 				Name string `json:"name"`
@@ -165,6 +171,7 @@ func (b *Bitbucket) updatePullRequest(pullRequestNumber int, title, body string)
 	in.Description = body
 	in.Source.Branch.Name = b.SourceBranch
 	in.Destination.Branch.Name = b.TargetBranch
+	in.Reviewers = b.getReviewers()
 
 	buf := new(bytes.Buffer)
 	err = json.NewEncoder(buf).Encode(in)
@@ -213,6 +220,19 @@ func (b *Bitbucket) updatePullRequest(pullRequestNumber int, title, body string)
 	return pr.Title, pr.Description, pr.Links.HTML.Href, nil
 }
 
+// getReviewers returns the pull request reviewers as expected by the Bitbucket Cloud api
+func (b *Bitbucket) getReviewers() []map[string]string {
+	var reviewers []map[string]string
+	for _, reviewer := range b.spec.Reviewers {
+		if strings.HasPrefix(reviewer, "{") {
+			reviewers = append(reviewers, map[string]string{"uuid": reviewer})
+			continue
+		}
+		reviewers = append(reviewers, map[string]string{"account_id": reviewer})
+	}
+	return reviewers
+}
+
 func (b *Bitbucket) logErrorResponse(resp *scm.Response) {
 	if resp != nil {
 		if resp.Status > 400 {
@@ -23,6 +23,22 @@ type Spec struct {
 	Title string `yaml:",inline,omitempty"`
 	// Body defines the Bitbucket pullrequest body
 	Body string `yaml:",inline,omitempty"`
+	// DeleteObsoleteBranch defines if the working branch should be deleted once Updatecli closed its pullrequest
+	// because the working branch doesn't bring any changes anymore.
+	DeleteObsoleteBranch bool `yaml:",omitempty"`
+	// AutoMerge defines if the pull request should be merged by Updatecli once all its build statuses succeed.
+	// The pull request is merged by a later Updatecli run, once builds triggered by the pull request are completed.
+	// Bitbucket Cloud merge checks, such as required approvals, still apply.
+	AutoMerge bool `yaml:",omitempty"`
+	// MergeMethod defines the merge strategy used when the pull request is automatically merged,
+	// such as "merge_commit", "squash", or "fast_forward".
+	// The repository default merge strategy is used if empty.
+	MergeMethod string `yaml:",omitempty"`
+	// RemoveSourceBranch defines if the pull request source branch should be deleted once automatically merged.
+	RemoveSourceBranch bool `yaml:",omitempty"`
+	// Reviewers defines the list of Bitbucket Cloud users requested to review the pull request,
+	// identified by their account ID or by their UUID such as "{a1b2c3d4-...}".
+	Reviewers []string `yaml:",omitempty"`
 }
 
 // Bitbucket contains information to interact with Bitbucket Cloud API
@@ -1,7 +1,11 @@
 package pullrequest
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
 	"strings"
 	"time"
 
@@ -179,3 +183,42 @@ func (s *Bitbucket) inheritFromScm() {
 		s.Repository = s.spec.Repository
 	}
 }
+
+// do sends a raw api request to Bitbucket Cloud, for endpoints not supported by the go-scm library.
+// The request body is encoded from in, and the response body is decoded into out, if not nil.
+func (b *Bitbucket) do(ctx context.Context, method, path string, in, out interface{}) error {
+	req := &scm.Request{
+		Method: method,
+		Path:   path,
+	}
+
+	if in != nil {
+		buf := new(bytes.Buffer)
+		if err := json.NewEncoder(buf).Encode(in); err != nil {
+			return err
+		}
+		req.Header = map[string][]string{
+			"Content-Type": {"application/json"},
+		}
+		req.Body = buf
+	}
+
+	resp, err := b.client.Do(ctx, req)
+	b.logErrorResponse(resp)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.Status >= 300 {
+		return fmt.Errorf("%s %s: %s", method, path, http.StatusText(resp.Status))
+	}
+
+	if out != nil {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return fmt.Errorf("decoding %s %s response: %w", method, path, err)
+		}
+	}
+
+	return nil
+}
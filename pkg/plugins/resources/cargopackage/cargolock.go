@@ -0,0 +1,404 @@
+package cargopackage
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/Masterminds/semver/v3"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// cargoLockDefaultFile is the default lockfile updated by the target
+	cargoLockDefaultFile string = "Cargo.lock"
+)
+
+var (
+	// cargoManifestDependencyTables lists the Cargo.toml tables holding dependencies
+	cargoManifestDependencyTables = []string{"dependencies", "dev-dependencies", "build-dependencies"}
+	// cargoLockVersionLine matches the version line of a Cargo.lock package entry
+	cargoLockVersionLine = regexp.MustCompile(`^version\s*=\s*"[^"]*"`)
+	// cargoLockChecksumLine matches the checksum line of a Cargo.lock package entry
+	cargoLockChecksumLine = regexp.MustCompile(`^checksum\s*=\s*"[^"]*"`)
+)
+
+// cargoLock holds the Cargo.lock fields needed to update a package entry
+type cargoLock struct {
+	Packages []cargoLockPackage `toml:"package"`
+}
+
+// cargoLockPackage is a [[package]] entry of a Cargo.lock file
+type cargoLockPackage struct {
+	Name         string   `toml:"name"`
+	Version      string   `toml:"version"`
+	Source       string   `toml:"source"`
+	Checksum     string   `toml:"checksum"`
+	Dependencies []string `toml:"dependencies"`
+}
+
+// isRegistry returns true if the package is downloaded from a registry, and not from a git repository or a local path
+func (p cargoLockPackage) isRegistry() bool {
+	return strings.HasPrefix(p.Source, "registry+") || strings.HasPrefix(p.Source, "sparse+")
+}
+
+// getVersionDetails returns the checksum and the dependencies of a package version, from the registry
+func (cp *CargoPackage) getVersionDetails(version string) (string, []PackageDependency, error) {
+	data, err := cp.getPackageData()
+	if err != nil {
+		return "", nil, err
+	}
+
+	for _, v := range data.Versions {
+		if v.Num != version {
+			continue
+		}
+
+		if v.Yanked {
+			return "", nil, fmt.Errorf("version %q of cargo package %q is yanked", version, cp.spec.Package)
+		}
+
+		if v.Checksum == "" {
+			return "", nil, fmt.Errorf("no checksum found for version %q of cargo package %q", version, cp.spec.Package)
+		}
+
+		// Index files already provide the dependencies
+		if cp.registry.RootDir != "" {
+			return v.Checksum, v.Deps, nil
+		}
+
+		deps, err := cp.getDependenciesFromApi(version)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return v.Checksum, deps, nil
+	}
+
+	return "", nil, fmt.Errorf("version %q of cargo package %q not found", version, cp.spec.Package)
+}
+
+// getDependenciesFromApi returns the dependencies of a package version, from the registry api
+func (cp *CargoPackage) getDependenciesFromApi(version string) ([]PackageDependency, error) {
+	URL := fmt.Sprintf("%s/%s/%s/dependencies", cp.registry.URL, cp.spec.Package, version)
+
+	req, err := cp.newApiRequest(URL)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := cp.webClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= 400 {
+		return nil, fmt.Errorf("getting dependencies from %q: unexpected status code %d", URL, res.StatusCode)
+	}
+
+	var d struct {
+		Dependencies []PackageDependency `json:"dependencies"`
+	}
+
+	if err := json.NewDecoder(res.Body).Decode(&d); err != nil {
+		return nil, fmt.Errorf("parsing dependencies from %q: %w", URL, err)
+	}
+
+	return d.Dependencies, nil
+}
+
+// updateCargoLock updates the package entry of a Cargo.lock file with the version and checksum retrieved from the registry.
+// It returns the new Cargo.lock content and the version previously locked.
+func (cp *CargoPackage) updateCargoLock(filename, content, version, checksum string, deps []PackageDependency, dryRun bool) (string, string, error) {
+	var lock cargoLock
+	if _, err := toml.Decode(content, &lock); err != nil {
+		return "", "", fmt.Errorf("parsing %q: %w", filename, err)
+	}
+
+	var locked []cargoLockPackage
+	for _, p := range lock.Packages {
+		if p.Name == cp.spec.Package && p.isRegistry() {
+			locked = append(locked, p)
+		}
+	}
+
+	switch len(locked) {
+	case 0:
+		return "", "", fmt.Errorf("cargo package %q not found", cp.spec.Package)
+	case 1:
+	default:
+		return "", "", fmt.Errorf("several versions of cargo package %q are locked, a full dependency resolution is required", cp.spec.Package)
+	}
+
+	oldPackage := locked[0]
+
+	// Cargo.lock files prior to version 2 store checksums in a [metadata] table
+	if oldPackage.Checksum == "" {
+		return "", "", fmt.Errorf("no checksum found for cargo package %q, Cargo.lock format is not supported", cp.spec.Package)
+	}
+
+	if err := cp.checkCargoManifest(filepath.Join(filepath.Dir(filename), "Cargo.toml"), version, dryRun); err != nil {
+		return "", "", err
+	}
+
+	if err := cp.checkCargoLockDependencies(lock, oldPackage, version, deps); err != nil {
+		return "", "", err
+	}
+
+	lines := strings.Split(content, "\n")
+
+	inPackage, found := false, false
+	start := 0
+	for i := 0; i <= len(lines); i++ {
+		line := ""
+		if i < len(lines) {
+			line = strings.TrimSpace(lines[i])
+		}
+
+		if i == len(lines) || strings.HasPrefix(line, "[") {
+			if inPackage && isCargoLockPackageEntry(lines[start:i], oldPackage) {
+				updateCargoLockPackageEntry(lines[start:i], version, checksum)
+				found = true
+				break
+			}
+			inPackage = line == "[[package]]"
+			start = i
+		}
+	}
+
+	if !found {
+		return "", "", fmt.Errorf("cargo package %q entry not found", cp.spec.Package)
+	}
+
+	return strings.Join(lines, "\n"), oldPackage.Version, nil
+}
+
+// isCargoLockPackageEntry returns true if the Cargo.lock lines describe the package p
+func isCargoLockPackageEntry(lines []string, p cargoLockPackage) bool {
+	var entry struct {
+		Packages []cargoLockPackage `toml:"package"`
+	}
+
+	if _, err := toml.Decode(strings.Join(lines, "\n"), &entry); err != nil || len(entry.Packages) != 1 {
+		return false
+	}
+
+	return entry.Packages[0].Name == p.Name &&
+		entry.Packages[0].Version == p.Version &&
+		entry.Packages[0].Source == p.Source
+}
+
+// updateCargoLockPackageEntry replaces the version and the checksum of the Cargo.lock package entry lines
+func updateCargoLockPackageEntry(lines []string, version, checksum string) {
+	for i, line := range lines {
+		switch {
+		case cargoLockVersionLine.MatchString(line):
+			lines[i] = cargoLockVersionLine.ReplaceAllLiteralString(line, fmt.Sprintf("version = %q", version))
+		case cargoLockChecksumLine.MatchString(line):
+			lines[i] = cargoLockChecksumLine.ReplaceAllLiteralString(line, fmt.Sprintf("checksum = %q", checksum))
+		}
+	}
+}
+
+// checkCargoManifest ensures the version requirements of the Cargo.toml file, located next to the Cargo.lock file,
+// are satisfied by the new version.
+// In dry run mode, Cargo.toml may not be updated yet by the target requiring the package version, so the validation is skipped.
+func (cp *CargoPackage) checkCargoManifest(manifestFile, version string, dryRun bool) error {
+	var manifest map[string]any
+	if _, err := toml.DecodeFile(manifestFile, &manifest); err != nil {
+		logrus.Debugf("skipping Cargo.toml validation: %s", err)
+		return nil
+	}
+
+	var tables []any
+	for _, name := range cargoManifestDependencyTables {
+		tables = append(tables, manifest[name])
+	}
+
+	if workspace, ok := manifest["workspace"].(map[string]any); ok {
+		tables = append(tables, workspace["dependencies"])
+	}
+
+	if targets, ok := manifest["target"].(map[string]any); ok {
+		for _, target := range targets {
+			if target, ok := target.(map[string]any); ok {
+				for _, name := range cargoManifestDependencyTables {
+					tables = append(tables, target[name])
+				}
+			}
+		}
+	}
+
+	for _, table := range tables {
+		dependencies, ok := table.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		for name, dependency := range dependencies {
+			var req string
+			switch d := dependency.(type) {
+			case string:
+				req = d
+			case map[string]any:
+				if pkg, ok := d["package"].(string); ok {
+					name = pkg
+				}
+				req, _ = d["version"].(string)
+			}
+
+			if name != cp.spec.Package || req == "" {
+				continue
+			}
+
+			if !satisfiesCargoRequirement(version, req) {
+				if dryRun {
+					logrus.Infof("%q doesn't require cargo package %q version %q yet, skipping validation in dry run mode",
+						manifestFile, cp.spec.Package, version)
+					return nil
+				}
+				return fmt.Errorf("cargo package %q version %q doesn't satisfy the requirement %q of %q, Cargo.toml must be updated first",
+					cp.spec.Package, version, req, manifestFile)
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkCargoLockDependencies ensures the dependencies of the new version are the ones already locked,
+// and that the new version is compatible with the requirements of the registry packages depending on it.
+// Otherwise a full dependency resolution is required, which is out of the scope of the target.
+func (cp *CargoPackage) checkCargoLockDependencies(lock cargoLock, oldPackage cargoLockPackage, version string, deps []PackageDependency) error {
+	lockedVersions := map[string][]string{}
+	for _, p := range lock.Packages {
+		lockedVersions[p.Name] = append(lockedVersions[p.Name], p.Version)
+	}
+
+	// Dependencies are listed as "name", "name version" or "name version (source)"
+	lockedDependencies := map[string]string{}
+	for _, d := range oldPackage.Dependencies {
+		fields := strings.Fields(d)
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch {
+		case len(fields) > 1:
+			lockedDependencies[fields[0]] = fields[1]
+		case len(lockedVersions[fields[0]]) == 1:
+			lockedDependencies[fields[0]] = lockedVersions[fields[0]][0]
+		default:
+			lockedDependencies[fields[0]] = ""
+		}
+	}
+
+	newDependencies := map[string]bool{}
+	for _, d := range deps {
+		// Development dependencies are not recorded in Cargo.lock for registry packages
+		if d.Kind == "dev" {
+			continue
+		}
+
+		newDependencies[d.Crate()] = true
+
+		lockedVersion, ok := lockedDependencies[d.Crate()]
+		if !ok {
+			// Optional dependencies are only locked when their feature is enabled
+			if d.Optional {
+				continue
+			}
+			return fmt.Errorf("cargo package %q version %q requires %q not locked by %s, a full dependency resolution is required",
+				cp.spec.Package, version, d.Crate(), oldPackage.Version)
+		}
+
+		if lockedVersion != "" && !satisfiesCargoRequirement(lockedVersion, d.Req) {
+			return fmt.Errorf("cargo package %q version %q requires %q %q but version %q is locked, a full dependency resolution is required",
+				cp.spec.Package, version, d.Crate(), d.Req, lockedVersion)
+		}
+	}
+
+	for name := range lockedDependencies {
+		if !newDependencies[name] {
+			return fmt.Errorf("cargo package %q version %q doesn't depend on %q anymore, a full dependency resolution is required",
+				cp.spec.Package, version, name)
+		}
+	}
+
+	if isCargoCompatible(oldPackage.Version, version) {
+		return nil
+	}
+
+	for _, p := range lock.Packages {
+		if !p.isRegistry() {
+			continue
+		}
+
+		for _, d := range p.Dependencies {
+			if fields := strings.Fields(d); len(fields) > 0 && fields[0] == cp.spec.Package {
+				return fmt.Errorf("cargo package %q version %q is not compatible with version %q required by %q, a full dependency resolution is required",
+					cp.spec.Package, version, oldPackage.Version, p.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// satisfiesCargoRequirement returns true if version satisfies the Cargo version requirement.
+// Requirements without operator are caret requirements, such as "1.2.3" meaning "^1.2.3".
+func satisfiesCargoRequirement(version, req string) bool {
+	var constraints []string
+	for _, c := range strings.Split(req, ",") {
+		c = strings.TrimSpace(c)
+		if c != "" && c[0] >= '0' && c[0] <= '9' {
+			c = "^" + c
+		}
+		constraints = append(constraints, c)
+	}
+
+	constraint, err := semver.NewConstraint(strings.Join(constraints, ", "))
+	if err != nil {
+		logrus.Debugf("skipping version requirement %q validation: %s", req, err)
+		return true
+	}
+
+	v, err := semver.NewVersion(version)
+	if err != nil {
+		logrus.Debugf("skipping version %q validation: %s", version, err)
+		return true
+	}
+
+	return constraint.Check(v)
+}
+
+// isCargoCompatible returns true if both versions are semver compatible according to Cargo,
+// meaning they share the same left-most non-zero component
+func isCargoCompatible(oldVersion, newVersion string) bool {
+	o, err := semver.NewVersion(oldVersion)
+	if err != nil {
+		return false
+	}
+
+	n, err := semver.NewVersion(newVersion)
+	if err != nil {
+		return false
+	}
+
+	switch {
+	case o.Major() != n.Major():
+		return false
+	case o.Major() > 0:
+		return true
+	case o.Minor() != n.Minor():
+		return false
+	case o.Minor() > 0:
+		return true
+	}
+
+	return o.Patch() == n.Patch()
+}
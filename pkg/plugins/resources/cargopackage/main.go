@@ -43,6 +43,35 @@ type PackageVersion struct {
 	Num     string `json:"num,omitempty"`
 	Version string `json:"vers,omitempty"`
 	Yanked  bool   `json:"yanked"`
+	// Checksum is the sha256 checksum of the crate file, named "cksum" in index files
+	Checksum string `json:"checksum,omitempty"`
+	Cksum    string `json:"cksum,omitempty"`
+	// Deps lists the dependencies of the version, only available in index files
+	Deps []PackageDependency `json:"deps,omitempty"`
+}
+
+// PackageDependency defines a dependency of a package version
+type PackageDependency struct {
+	// Name is the dependency name, which may be renamed in index files
+	Name string `json:"name,omitempty"`
+	// Package is the name of the renamed dependency crate, in index files
+	Package string `json:"package,omitempty"`
+	// CrateID is the name of the dependency crate, in api data
+	CrateID  string `json:"crate_id,omitempty"`
+	Req      string `json:"req"`
+	Kind     string `json:"kind,omitempty"`
+	Optional bool   `json:"optional"`
+}
+
+// Crate returns the name of the dependency crate
+func (d PackageDependency) Crate() string {
+	switch {
+	case d.CrateID != "":
+		return d.CrateID
+	case d.Package != "":
+		return d.Package
+	}
+	return d.Name
 }
 
 type PackageCrate struct {
@@ -153,13 +182,11 @@ func getPackageFileDir(packageName string) (string, error) {
 	}
 }
 
-func (cp *CargoPackage) getPackageDataFromApi(name string, indexUrl string) (PackageData, error) {
-	packageUrl := fmt.Sprintf("%s/%s", indexUrl, name)
-
-	req, err := http.NewRequest("GET", packageUrl, nil)
+// newApiRequest returns a GET request to the registry api, authenticated if a token is configured
+func (cp *CargoPackage) newApiRequest(url string) (*http.Request, error) {
+	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
-		logrus.Errorf("something went wrong while getting cargo api data %q\n", err)
-		return PackageData{}, err
+		return nil, err
 	}
 
 	req.Header.Set("User-Agent", httputils.UserAgent)
@@ -172,6 +199,18 @@ func (cp *CargoPackage) getPackageDataFromApi(name string, indexUrl string) (Pac
 		req.Header.Set("Authorization", fmt.Sprintf(format, cp.registry.Auth.Token))
 	}
 
+	return req, nil
+}
+
+func (cp *CargoPackage) getPackageDataFromApi(name string, indexUrl string) (PackageData, error) {
+	packageUrl := fmt.Sprintf("%s/%s", indexUrl, name)
+
+	req, err := cp.newApiRequest(packageUrl)
+	if err != nil {
+		logrus.Errorf("something went wrong while getting cargo api data %q\n", err)
+		return PackageData{}, err
+	}
+
 	res, err := cp.webClient.Do(req)
 	if err != nil {
 		logrus.Errorf("something went wrong while getting cargo api data %q\n", err)
@@ -220,6 +259,7 @@ func (cp *CargoPackage) getPackageDataFromFS(name string, indexDir string) (Pack
 		}
 		// File index store version info in Version Field
 		packageVersion.Num = packageVersion.Version
+		packageVersion.Checksum = packageVersion.Cksum
 		pd.Versions = append(pd.Versions, packageVersion)
 	}
 	return pd, nil
@@ -241,5 +281,6 @@ func (cp *CargoPackage) ReportConfig() interface{} {
 		Registry: cp.spec.Registry,
 		Package:  cp.spec.Package,
 		Version:  cp.spec.Version,
+		File:     cp.spec.File,
 	}
 }
@@ -10,12 +10,15 @@ import (
 type Spec struct {
 	// !deprecated, please use Registry.URL
 	IndexUrl string `yaml:",omitempty" jsonschema:"-"`
-	// [S][C] Registry specifies the registry to use
+	// [S][C][T] Registry specifies the registry to use
 	Registry cargo.Registry `yaml:",omitempty"`
-	// [S][C] Package specifies the name of the package
+	// [S][C][T] Package specifies the name of the package
 	Package string `yaml:",omitempty" jsonschema:"required"`
-	// [C] Defines a specific package version
+	// [C][T] Defines a specific package version
 	Version string `yaml:",omitempty"`
+	// [T] File defines the Cargo.lock file to update, default to "Cargo.lock".
+	// The Cargo.toml file located in the same directory must already require a version matching the new one.
+	File string `yaml:",omitempty"`
 	// [S] VersionFilter provides parameters to specify version pattern and its type like regex, semver, or just latest.
 	VersionFilter version.Filter `yaml:",omitempty"`
 }
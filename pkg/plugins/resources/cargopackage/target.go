@@ -2,11 +2,81 @@ package cargopackage
 
 import (
 	"fmt"
+	"os"
+	"strings"
 
+	"github.com/sirupsen/logrus"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 	"github.com/updatecli/updatecli/pkg/core/result"
+	"github.com/updatecli/updatecli/pkg/plugins/utils"
 )
 
+// Target updates the package entry of a Cargo.lock file with the version and checksum retrieved from the registry.
+// It doesn't update Cargo.toml, which should be done first using a "toml" target.
 func (cp *CargoPackage) Target(source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) error {
-	return fmt.Errorf("Target not supported for the plugin Cargo Package")
+	version := cp.spec.Version
+	if version == "" {
+		version = source
+	}
+	if version == "" {
+		return fmt.Errorf("no version defined")
+	}
+
+	filename := cargoLockDefaultFile
+	if cp.spec.File != "" {
+		filename = strings.TrimPrefix(cp.spec.File, "file://")
+	}
+	if scm != nil {
+		// The scm holds the Cargo.lock file, not the registry index
+		filename = utils.JoinFilePathWithWorkingDirectoryPath(filename, scm.GetDirectory())
+	}
+
+	if cp.registry.RootDir == "" && cp.registry.URL == "" {
+		cp.registry.URL = cratesDefaultIndexApiUrl
+	}
+
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		return fmt.Errorf("reading %q: %w", filename, err)
+	}
+
+	checksum, deps, err := cp.getVersionDetails(version)
+	if err != nil {
+		return err
+	}
+
+	newContent, oldVersion, err := cp.updateCargoLock(filename, string(content), version, checksum, deps, dryRun)
+	if err != nil {
+		return fmt.Errorf("updating %q: %w", filename, err)
+	}
+
+	resultTarget.Information = oldVersion
+	resultTarget.NewInformation = version
+
+	if newContent == string(content) {
+		resultTarget.Result = result.SUCCESS
+		resultTarget.Description = fmt.Sprintf("%s already locks cargo package %q to version %q", filename, cp.spec.Package, version)
+		return nil
+	}
+
+	resultTarget.Changed = true
+	resultTarget.Result = result.ATTENTION
+
+	if dryRun {
+		resultTarget.Description = fmt.Sprintf("%s should update cargo package %q from %q to %q",
+			filename, cp.spec.Package, oldVersion, version)
+		return nil
+	}
+
+	if err := os.WriteFile(filename, []byte(newContent), 0o600); err != nil {
+		return fmt.Errorf("writing %q: %w", filename, err)
+	}
+
+	logrus.Debugf("%q updated\n", filename)
+
+	resultTarget.Files = append(resultTarget.Files, filename)
+	resultTarget.Description = fmt.Sprintf("%s updated cargo package %q from %q to %q",
+		filename, cp.spec.Package, oldVersion, version)
+
+	return nil
 }
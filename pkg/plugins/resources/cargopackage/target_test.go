@@ -0,0 +1,268 @@
+package cargopackage
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/core/result"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/cargo"
+)
+
+// testRegistryCrate is the "serde_json" crate data served by the test registry
+const testRegistryCrate = `{
+  "crate": {"name": "serde_json"},
+  "versions": [
+    {"num": "2.0.0", "yanked": false, "checksum": "2222222222222222222222222222222222222222222222222222222222222222"},
+    {"num": "1.1.0", "yanked": false, "checksum": "1111111111111111111111111111111111111111111111111111111111111111"},
+    {"num": "1.0.5", "yanked": true, "checksum": "0505050505050505050505050505050505050505050505050505050505050505"},
+    {"num": "1.0.0", "yanked": false, "checksum": "0000000000000000000000000000000000000000000000000000000000000000"}
+  ]
+}`
+
+// testRegistryDependencies maps the "serde_json" versions served by the test registry to their dependencies
+var testRegistryDependencies = map[string]string{
+	"2.0.0": `{"dependencies": [
+    {"crate_id": "itoa", "req": "^2.0", "kind": "normal", "optional": false},
+    {"crate_id": "serde", "req": "^1.0.100", "kind": "normal", "optional": false}
+  ]}`,
+	"1.1.0": `{"dependencies": [
+    {"crate_id": "itoa", "req": "^1.0", "kind": "normal", "optional": false},
+    {"crate_id": "indexmap", "req": "^2.0", "kind": "normal", "optional": true},
+    {"crate_id": "serde", "req": "^1.0.100", "kind": "normal", "optional": false},
+    {"crate_id": "trybuild", "req": "^1.0", "kind": "dev", "optional": false}
+  ]}`,
+	"1.0.0": `{"dependencies": [
+    {"crate_id": "itoa", "req": "^1.0", "kind": "normal", "optional": false},
+    {"crate_id": "serde", "req": "^1.0.100", "kind": "normal", "optional": false}
+  ]}`,
+}
+
+// testCargoLock is a Cargo.lock file locking "serde_json" to version 1.0.0
+const testCargoLock = `# This file is automatically @generated by Cargo.
+# It is not intended for manual editing.
+version = 4
+
+[[package]]
+name = "app"
+version = "0.1.0"
+dependencies = [
+ "serde",
+ "serde_json",
+]
+
+[[package]]
+name = "itoa"
+version = "1.0.11"
+source = "registry+https://github.com/rust-lang/crates.io-index"
+checksum = "49f1f14873335454500d59611f1cf4a4b0f786f9ac11f4312a78e4cf2566695b"
+
+[[package]]
+name = "serde"
+version = "1.0.200"
+source = "registry+https://github.com/rust-lang/crates.io-index"
+checksum = "ddc6f9cc94d67c0e21aaf7eda3a010fd3af78ebf6e096aa6e2e13c79749cce4f"
+
+[[package]]
+name = "serde_json"
+version = "1.0.0"
+source = "registry+https://github.com/rust-lang/crates.io-index"
+checksum = "0000000000000000000000000000000000000000000000000000000000000000"
+dependencies = [
+ "itoa",
+ "serde",
+]
+`
+
+// testUpdatedCargoLock is testCargoLock locking "serde_json" to version 1.1.0
+const testUpdatedCargoLock = `# This file is automatically @generated by Cargo.
+# It is not intended for manual editing.
+version = 4
+
+[[package]]
+name = "app"
+version = "0.1.0"
+dependencies = [
+ "serde",
+ "serde_json",
+]
+
+[[package]]
+name = "itoa"
+version = "1.0.11"
+source = "registry+https://github.com/rust-lang/crates.io-index"
+checksum = "49f1f14873335454500d59611f1cf4a4b0f786f9ac11f4312a78e4cf2566695b"
+
+[[package]]
+name = "serde"
+version = "1.0.200"
+source = "registry+https://github.com/rust-lang/crates.io-index"
+checksum = "ddc6f9cc94d67c0e21aaf7eda3a010fd3af78ebf6e096aa6e2e13c79749cce4f"
+
+[[package]]
+name = "serde_json"
+version = "1.1.0"
+source = "registry+https://github.com/rust-lang/crates.io-index"
+checksum = "1111111111111111111111111111111111111111111111111111111111111111"
+dependencies = [
+ "itoa",
+ "serde",
+]
+`
+
+func TestTarget(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/crates/serde_json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(testRegistryCrate))
+	})
+	for version, dependencies := range testRegistryDependencies {
+		mux.HandleFunc("/api/v1/crates/serde_json/"+version+"/dependencies", func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(dependencies))
+		})
+	}
+	registry := httptest.NewServer(mux)
+	defer registry.Close()
+
+	tests := []struct {
+		name            string
+		cargoToml       string
+		cargoLock       string
+		version         string
+		dryRun          bool
+		expectedLock    string
+		expectedChanged bool
+		expectedError   bool
+	}{
+		{
+			name:            "Update Cargo.lock",
+			cargoToml:       "[package]\nname = \"app\"\n\n[dependencies]\nserde = \"1.0\"\nserde_json = { version = \"1.1\" }\n",
+			cargoLock:       testCargoLock,
+			version:         "1.1.0",
+			expectedLock:    testUpdatedCargoLock,
+			expectedChanged: true,
+		},
+		{
+			name:            "Cargo.lock already up to date",
+			cargoToml:       "[package]\nname = \"app\"\n\n[dependencies]\nserde_json = \"1.1\"\n",
+			cargoLock:       testUpdatedCargoLock,
+			version:         "1.1.0",
+			expectedLock:    testUpdatedCargoLock,
+			expectedChanged: false,
+		},
+		{
+			name:          "Cargo.toml not updated",
+			cargoToml:     "[package]\nname = \"app\"\n\n[dependencies]\nserde_json = \"=1.0.0\"\n",
+			cargoLock:     testCargoLock,
+			version:       "1.1.0",
+			expectedError: true,
+		},
+		{
+			name:            "Cargo.toml not updated yet in dry run mode",
+			cargoToml:       "[package]\nname = \"app\"\n\n[dependencies]\nserde_json = \"=1.0.0\"\n",
+			cargoLock:       testCargoLock,
+			version:         "1.1.0",
+			dryRun:          true,
+			expectedLock:    testCargoLock,
+			expectedChanged: true,
+		},
+		{
+			name:          "Full dependency resolution required",
+			cargoToml:     "[package]\nname = \"app\"\n\n[dependencies]\nserde_json = \"2\"\n",
+			cargoLock:     testCargoLock,
+			version:       "2.0.0",
+			expectedError: true,
+		},
+		{
+			name:          "Yanked version",
+			cargoLock:     testCargoLock,
+			version:       "1.0.5",
+			expectedError: true,
+		},
+		{
+			name:          "Package not in Cargo.lock",
+			cargoLock:     "version = 4\n\n[[package]]\nname = \"app\"\nversion = \"0.1.0\"\n",
+			version:       "1.1.0",
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			cargoLockFile := filepath.Join(dir, "Cargo.lock")
+			require.NoError(t, os.WriteFile(cargoLockFile, []byte(tt.cargoLock), 0o600))
+			if tt.cargoToml != "" {
+				require.NoError(t, os.WriteFile(filepath.Join(dir, "Cargo.toml"), []byte(tt.cargoToml), 0o600))
+			}
+
+			got, err := New(Spec{
+				Package: "serde_json",
+				Registry: cargo.Registry{
+					URL: registry.URL + "/api/v1/crates",
+				},
+				File: cargoLockFile,
+			}, false)
+			require.NoError(t, err)
+			got.webClient = registry.Client()
+
+			gotResult := result.Target{}
+			err = got.Target(tt.version, nil, tt.dryRun, &gotResult)
+			if tt.expectedError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedChanged, gotResult.Changed)
+
+			gotLock, err := os.ReadFile(cargoLockFile)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedLock, string(gotLock))
+		})
+	}
+}
+
+func TestTargetIndexDir(t *testing.T) {
+	dir, err := CreateDummyIndex()
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	lockDir := t.TempDir()
+	cargoLockFile := filepath.Join(lockDir, "Cargo.lock")
+	require.NoError(t, os.WriteFile(cargoLockFile, []byte(`version = 4
+
+[[package]]
+name = "crate-test"
+version = "0.1.0"
+source = "registry+https://example.com/index"
+checksum = "0000000000000000000000000000000000000000000000000000000000000000"
+`), 0o600))
+
+	got, err := New(Spec{
+		Package: "crate-test",
+		Registry: cargo.Registry{
+			RootDir: dir,
+		},
+		File: cargoLockFile,
+	}, false)
+	require.NoError(t, err)
+
+	gotResult := result.Target{}
+	require.NoError(t, got.Target("0.2.2", nil, false, &gotResult))
+	assert.True(t, gotResult.Changed)
+	assert.Equal(t, "0.1.0", gotResult.Information)
+
+	gotLock, err := os.ReadFile(cargoLockFile)
+	require.NoError(t, err)
+	assert.Equal(t, `version = 4
+
+[[package]]
+name = "crate-test"
+version = "0.2.2"
+source = "registry+https://example.com/index"
+checksum = "b274d286f7a6aad5a7d5b5407e9db0098c94711fb3563bf2e32854a611edfb63"
+`, string(gotLock))
+}
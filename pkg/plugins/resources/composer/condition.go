@@ -0,0 +1,47 @@
+package composer
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+// Condition checks if a Composer package version is published on the repository
+func (c *Composer) Condition(source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+	if scm != nil {
+		logrus.Warningf("SCM configuration is not supported for composer condition, aborting")
+	}
+
+	versionToCheck := c.spec.Version
+	if versionToCheck == "" {
+		versionToCheck = source
+	}
+
+	if len(versionToCheck) == 0 {
+		return false, "", errors.New("no version defined")
+	}
+
+	expected, err := version.NewComposer(versionToCheck)
+	if err != nil {
+		return false, "", err
+	}
+
+	releases, err := c.getReleases()
+	if err != nil {
+		return false, "", fmt.Errorf("getting composer package releases: %w", err)
+	}
+
+	for _, r := range releases {
+		v, err := version.NewComposer(r.Version)
+		if err != nil || v.Compare(expected) != 0 {
+			continue
+		}
+
+		return true, fmt.Sprintf("composer package %q version %q available", c.spec.Name, versionToCheck), nil
+	}
+
+	return false, fmt.Sprintf("composer package %q version %q doesn't exist", c.spec.Name, versionToCheck), nil
+}
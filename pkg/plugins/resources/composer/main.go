@@ -0,0 +1,298 @@
+package composer
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/httpclient"
+	"github.com/updatecli/updatecli/pkg/core/result"
+	httputils "github.com/updatecli/updatecli/pkg/plugins/utils/http"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/redact"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+const (
+	// packagistDefaultURL is the url of the public Composer repository
+	packagistDefaultURL string = "https://repo.packagist.org/"
+	// minifiedUnset is the value used by the minified metadata format to remove a field inherited from the previous version
+	minifiedUnset string = "__unset"
+)
+
+// Composer defines a resource of kind "composer"
+type Composer struct {
+	spec Spec
+	// versionFilter holds the "valid" version.filter, that might be different from the user-specified filter (Spec.VersionFilter)
+	versionFilter version.Filter
+	foundVersion  version.Version
+	webClient     httpclient.HTTPClient
+}
+
+// release describes a published package version
+type release struct {
+	// Version is the release version
+	Version string
+	// Time is the release date, if provided by the repository
+	Time time.Time
+}
+
+// packageVersion represents a package version from the repository metadata
+type packageVersion struct {
+	Version string `json:"version"`
+	Time    string `json:"time"`
+}
+
+// repositoryResponse represents the repository root "packages.json"
+// https://getcomposer.org/doc/05-repositories.md#composer
+type repositoryResponse struct {
+	// MetadataURL is the Composer v2 url template used to retrieve a package metadata
+	MetadataURL string `json:"metadata-url"`
+	// Packages holds the packages defined directly in the repository root
+	Packages json.RawMessage `json:"packages"`
+	// Includes lists additional files defining packages, as generated by Satis
+	Includes map[string]json.RawMessage `json:"includes"`
+}
+
+// metadataResponse represents a package metadata file as returned by the metadata-url
+type metadataResponse struct {
+	Packages map[string][]map[string]any `json:"packages"`
+	// Minified is set to "composer/2.0" when every version only contains the fields updated from the previous one
+	Minified string `json:"minified"`
+}
+
+// New returns a reference to a newly initialized Composer object from a composer.Spec
+// or an error if the provided Spec triggers a validation error.
+func New(spec interface{}) (*Composer, error) {
+	newSpec := Spec{}
+
+	err := mapstructure.Decode(spec, &newSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := newSpec.Validate(); err != nil {
+		return nil, err
+	}
+
+	if newSpec.URL == "" {
+		newSpec.URL = packagistDefaultURL
+	}
+
+	newFilter := newSpec.VersionFilter
+	if newFilter.IsZero() {
+		newFilter.Kind = version.COMPOSERVERSIONKIND
+	}
+
+	newFilter, err = newFilter.Init()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Composer{
+		spec:          newSpec,
+		versionFilter: newFilter,
+		webClient:     httpclient.NewRetryClient(),
+	}, nil
+}
+
+// Changelog returns the changelog for this resource, or an empty string if not supported
+func (c *Composer) Changelog(from, to string) *result.Changelogs {
+	return nil
+}
+
+// ReportConfig returns a new configuration with only the necessary configuration fields
+// to identify the resource without any sensitive information or context specific data.
+func (c *Composer) ReportConfig() interface{} {
+	return Spec{
+		Name:          c.spec.Name,
+		Version:       c.spec.Version,
+		URL:           redact.URL(c.spec.URL),
+		VersionFilter: c.spec.VersionFilter,
+	}
+}
+
+// packageName returns the package name as used by Composer repositories, which are lowercase
+func (c *Composer) packageName() string {
+	return strings.ToLower(c.spec.Name)
+}
+
+// getReleases returns every release published on the repository, sorted oldest first.
+// Development branches, such as "dev-main" or "1.x-dev", are ignored.
+func (c *Composer) getReleases() ([]release, error) {
+	repositoryURL := strings.TrimSuffix(c.spec.URL, "/") + "/packages.json"
+
+	var repository repositoryResponse
+	found, err := c.get(repositoryURL, &repository)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving composer repository %q: %w", redact.URL(c.spec.URL), err)
+	}
+	if !found {
+		return nil, fmt.Errorf("no composer repository found at %q", redact.URL(c.spec.URL))
+	}
+
+	var versions []packageVersion
+
+	switch {
+	case repository.MetadataURL != "":
+		metadataURL, err := resolveURL(repositoryURL, strings.ReplaceAll(repository.MetadataURL, "%package%", c.packageName()))
+		if err != nil {
+			return nil, err
+		}
+
+		var metadata metadataResponse
+		found, err := c.get(metadataURL, &metadata)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving composer package %q metadata: %w", c.spec.Name, err)
+		}
+		if found {
+			versions = metadata.versions(c.packageName())
+		}
+
+	default:
+		versions = inlineVersions(repository.Packages, c.packageName())
+
+		for include := range repository.Includes {
+			includeURL, err := resolveURL(repositoryURL, include)
+			if err != nil {
+				return nil, err
+			}
+
+			var data repositoryResponse
+			found, err := c.get(includeURL, &data)
+			if err != nil {
+				return nil, fmt.Errorf("retrieving composer repository include %q: %w", include, err)
+			}
+			if found {
+				versions = append(versions, inlineVersions(data.Packages, c.packageName())...)
+			}
+		}
+	}
+
+	published := map[string]time.Time{}
+	var names []string
+	for _, v := range versions {
+		if _, ok := published[v.Version]; !ok {
+			names = append(names, v.Version)
+		}
+
+		t, err := time.Parse(time.RFC3339, v.Time)
+		if err != nil {
+			logrus.Debugf("ignoring composer package %q version %q time %q: %s", c.spec.Name, v.Version, v.Time, err)
+			published[v.Version] = published[v.Version]
+			continue
+		}
+		published[v.Version] = t
+	}
+
+	releases := []release{}
+	for _, v := range version.SortComposer(names) {
+		releases = append(releases, release{Version: v, Time: published[v]})
+	}
+
+	return releases, nil
+}
+
+// versions returns the versions of a package, expanding the minified format if needed
+func (m metadataResponse) versions(name string) []packageVersion {
+	var versions []packageVersion
+
+	current := map[string]any{}
+	for _, data := range m.Packages[name] {
+		if m.Minified == "" {
+			current = map[string]any{}
+		}
+
+		for key, value := range data {
+			if value == minifiedUnset {
+				delete(current, key)
+				continue
+			}
+			current[key] = value
+		}
+
+		v, _ := current["version"].(string)
+		t, _ := current["time"].(string)
+		versions = append(versions, packageVersion{Version: v, Time: t})
+	}
+
+	return versions
+}
+
+// inlineVersions returns the versions of a package defined in a "packages" object,
+// mapping package names to their versions
+func inlineVersions(data json.RawMessage, name string) []packageVersion {
+	// Packagist returns an empty array when no package is defined inline
+	var packages map[string]map[string]packageVersion
+	if err := json.Unmarshal(data, &packages); err != nil {
+		return nil
+	}
+
+	var versions []packageVersion
+	for packageName, packageVersions := range packages {
+		if strings.ToLower(packageName) != name {
+			continue
+		}
+		for _, v := range packageVersions {
+			versions = append(versions, v)
+		}
+	}
+
+	return versions
+}
+
+// resolveURL returns ref resolved relatively to base
+func resolveURL(base, ref string) (string, error) {
+	b, err := url.Parse(base)
+	if err != nil {
+		return "", err
+	}
+
+	r, err := url.Parse(ref)
+	if err != nil {
+		return "", err
+	}
+
+	return b.ResolveReference(r).String(), nil
+}
+
+// get queries a repository endpoint and decodes its json response into v.
+// It returns false if the endpoint returns a 404.
+func (c *Composer) get(url string, v any) (bool, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return false, err
+	}
+
+	req.Header.Set("User-Agent", httputils.UserAgent)
+	req.Header.Set("Accept", "application/json")
+
+	if c.spec.Username != "" || c.spec.Password != "" {
+		req.SetBasicAuth(c.spec.Username, c.spec.Password)
+	}
+
+	res, err := c.webClient.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		logrus.Debugf("composer endpoint %q returned %d", redact.URL(url), res.StatusCode)
+		return false, nil
+	}
+
+	if res.StatusCode >= 400 {
+		return false, fmt.Errorf("unexpected status code %d from %q", res.StatusCode, redact.URL(url))
+	}
+
+	if err := json.NewDecoder(res.Body).Decode(v); err != nil {
+		return false, fmt.Errorf("decoding composer api response: %w", err)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,239 @@
+package composer
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/core/result"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+// newTestRepository returns a Packagist stand-in serving the package "symfony/console" using the minified metadata format
+func newTestRepository(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("/packages.json", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, `{"packages": [], "metadata-url": "/p2/%package%.json"}`)
+	})
+
+	mux.HandleFunc("/p2/symfony/console.json", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{
+  "minified": "composer/2.0",
+  "packages": {
+    "symfony/console": [
+      {"name": "symfony/console", "version": "v7.0.0-RC1", "time": "2023-11-20T10:00:00+00:00"},
+      {"version": "v6.4.1", "time": "2023-12-01T14:56:37+00:00"},
+      {"version": "v6.4.0"},
+      {"version": "v5.4.32", "time": "__unset"}
+    ]
+  }
+}`)
+	})
+
+	return server
+}
+
+// newTestSatisRepository returns a Satis stand-in serving the package "acme/private" from an include file
+func newTestSatisRepository(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("/satis/packages.json", func(w http.ResponseWriter, r *http.Request) {
+		user, password, ok := r.BasicAuth()
+		if !ok || user != "token" || password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, `{"packages": {"acme/private": {"1.0.0": {"version": "1.0.0"}}}, "includes": {"include/all$1234.json": {"sha1": "1234"}}}`)
+	})
+
+	mux.HandleFunc("/satis/include/all$1234.json", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"packages": {"acme/private": {"1.1.0": {"version": "1.1.0"}, "dev-main": {"version": "dev-main"}}}}`)
+	})
+
+	return server
+}
+
+func TestSource(t *testing.T) {
+	server := newTestRepository(t)
+	satis := newTestSatisRepository(t)
+
+	tests := []struct {
+		name           string
+		spec           Spec
+		expectedResult string
+		wantErr        bool
+	}{
+		{
+			name:           "Latest stable version",
+			spec:           Spec{Name: "symfony/console", URL: server.URL},
+			expectedResult: "v6.4.1",
+		},
+		{
+			name: "Composer constraint",
+			spec: Spec{
+				Name: "Symfony/Console",
+				URL:  server.URL,
+				VersionFilter: version.Filter{
+					Kind:    "composer",
+					Pattern: "^5.4",
+				},
+			},
+			expectedResult: "v5.4.32",
+		},
+		{
+			name: "Composer constraint with stability flag",
+			spec: Spec{
+				Name: "symfony/console",
+				URL:  server.URL,
+				VersionFilter: version.Filter{
+					Kind:    "composer",
+					Pattern: "^7.0@RC",
+				},
+			},
+			expectedResult: "v7.0.0-RC1",
+		},
+		{
+			name:           "Satis repository with includes",
+			spec:           Spec{Name: "acme/private", URL: satis.URL + "/satis/", Username: "token", Password: "secret"},
+			expectedResult: "1.1.0",
+		},
+		{
+			name:    "Unauthorized",
+			spec:    Spec{Name: "acme/private", URL: satis.URL + "/satis/"},
+			wantErr: true,
+		},
+		{
+			name:    "Unknown package",
+			spec:    Spec{Name: "acme/donotexist", URL: server.URL},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := New(tt.spec)
+			require.NoError(t, err)
+
+			gotResult := result.Source{}
+			err = c.Source("", &gotResult)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, gotResult.Information)
+		})
+	}
+}
+
+func TestCondition(t *testing.T) {
+	server := newTestRepository(t)
+
+	tests := []struct {
+		name           string
+		spec           Spec
+		source         string
+		expectedResult bool
+		wantErr        bool
+	}{
+		{
+			name:           "Version from source",
+			spec:           Spec{Name: "symfony/console"},
+			source:         "v6.4.1",
+			expectedResult: true,
+		},
+		{
+			name:           "Non normalized version",
+			spec:           Spec{Name: "symfony/console", Version: "6.4.0.0"},
+			expectedResult: true,
+		},
+		{
+			name:           "Missing version",
+			spec:           Spec{Name: "symfony/console", Version: "6.3.0"},
+			expectedResult: false,
+		},
+		{
+			name:    "Invalid version",
+			spec:    Spec{Name: "symfony/console", Version: "dev-main"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.spec.URL = server.URL
+
+			c, err := New(tt.spec)
+			require.NoError(t, err)
+
+			gotResult, _, err := c.Condition(tt.source, nil)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, gotResult)
+		})
+	}
+}
+
+func TestPublishDate(t *testing.T) {
+	server := newTestRepository(t)
+
+	tests := []struct {
+		name     string
+		version  string
+		expected time.Time
+		wantErr  bool
+	}{
+		{
+			name:     "Release time",
+			version:  "v6.4.1",
+			expected: time.Date(2023, time.December, 1, 14, 56, 37, 0, time.UTC),
+		},
+		{
+			name:     "Release time inherited from the previous minified version",
+			version:  "v6.4.0",
+			expected: time.Date(2023, time.December, 1, 14, 56, 37, 0, time.UTC),
+		},
+		{
+			name:    "Release time unset",
+			version: "v5.4.32",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := New(Spec{Name: "symfony/console", URL: server.URL})
+			require.NoError(t, err)
+
+			got, err := c.PublishDate(tt.version)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, tt.expected.Equal(got))
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	_, err := New(Spec{})
+	require.ErrorIs(t, err, ErrSpecNameUndefined)
+}
@@ -0,0 +1,28 @@
+package composer
+
+import (
+	"fmt"
+	"time"
+)
+
+// PublishDate returns the release date of the package version, as defined by the repository metadata
+func (c *Composer) PublishDate(version string) (time.Time, error) {
+	releases, err := c.getReleases()
+	if err != nil {
+		return time.Time{}, fmt.Errorf("retrieving composer package %q releases: %w", c.spec.Name, err)
+	}
+
+	for _, r := range releases {
+		if r.Version != version {
+			continue
+		}
+
+		if r.Time.IsZero() {
+			break
+		}
+
+		return r.Time, nil
+	}
+
+	return time.Time{}, fmt.Errorf("no publication date found for composer package %q version %q", c.spec.Name, version)
+}
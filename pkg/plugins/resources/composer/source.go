@@ -0,0 +1,35 @@
+package composer
+
+import (
+	"fmt"
+
+	"github.com/updatecli/updatecli/pkg/core/result"
+)
+
+// Source returns the latest Composer package version
+func (c *Composer) Source(workingDir string, resultSource *result.Source) error {
+	releases, err := c.getReleases()
+	if err != nil {
+		return fmt.Errorf("get composer package releases: %w", err)
+	}
+
+	versions := []string{}
+	for _, r := range releases {
+		versions = append(versions, r.Version)
+	}
+
+	if len(versions) == 0 {
+		return fmt.Errorf("no version found for composer package %q", c.spec.Name)
+	}
+
+	c.foundVersion, err = c.versionFilter.Search(versions)
+	if err != nil {
+		return fmt.Errorf("filtering composer package %q versions: %w", c.spec.Name, err)
+	}
+
+	resultSource.Result = result.SUCCESS
+	resultSource.Information = c.foundVersion.GetVersion()
+	resultSource.Description = fmt.Sprintf("version %q found for composer package %q", c.foundVersion.GetVersion(), c.spec.Name)
+
+	return nil
+}
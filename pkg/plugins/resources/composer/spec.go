@@ -0,0 +1,53 @@
+package composer
+
+import (
+	"errors"
+
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+// Spec defines a specification for a "composer" resource
+// parsed from an updatecli manifest file
+type Spec struct {
+	// [S][C] Name specifies the Composer package name
+	//
+	// example:
+	//   * symfony/console
+	Name string `yaml:",omitempty" jsonschema:"required"`
+	// [C] Version defines a specific package version
+	//
+	// default:
+	//   When used from a condition, the default value is set to the linked source output
+	Version string `yaml:",omitempty"`
+	// [S][C] URL defines the Composer repository url, such as Packagist, Private Packagist, or a Satis repository.
+	// The repository "packages.json" is used to retrieve the package metadata,
+	// either through its "metadata-url", or the packages it defines directly or from its "includes".
+	//
+	// default:
+	//   https://repo.packagist.org/
+	URL string `yaml:",omitempty"`
+	// [S][C] Username defines the username used to authenticate on a private repository
+	Username string `yaml:",omitempty"`
+	// [S][C] Password defines the password, or token, used to authenticate on a private repository
+	Password string `yaml:",omitempty"`
+	// [S] VersionFilter provides parameters to specify version pattern and its type like regex, semver, or just latest.
+	//
+	// default:
+	//   When not specified, the version filter kind "composer" is used, which accepts
+	//   Composer version constraints such as "^5.4 || ^6.0" and returns the newest stable version
+	VersionFilter version.Filter `yaml:",omitempty"`
+}
+
+var (
+	// ErrSpecNameUndefined is returned when the package name is not defined
+	ErrSpecNameUndefined = errors.New("composer package name undefined")
+)
+
+// Validate checks if the Spec is properly defined
+func (s *Spec) Validate() error {
+	if len(s.Name) == 0 {
+		return ErrSpecNameUndefined
+	}
+
+	return nil
+}
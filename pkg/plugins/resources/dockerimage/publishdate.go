@@ -0,0 +1,51 @@
+package dockerimage
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+)
+
+// PublishDate returns the creation date of the docker image tag, as defined in its configuration.
+// For multi-architecture images, the first architecture defined in the spec is used, or linux/amd64 by default.
+func (di *DockerImage) PublishDate(version string) (time.Time, error) {
+	ref, err := di.createRef(version)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	remoteOptions := di.options
+
+	if len(di.spec.Architectures) > 0 {
+		platform, err := v1.ParsePlatform(di.spec.Architectures[0])
+		if err != nil {
+			return time.Time{}, fmt.Errorf("parsing architecture %q: %w", di.spec.Architectures[0], err)
+		}
+
+		// An architecture without os such as "amd64" refers to linux
+		if !strings.Contains(di.spec.Architectures[0], "/") {
+			platform = &v1.Platform{OS: "linux", Architecture: di.spec.Architectures[0]}
+		}
+
+		remoteOptions = append(remoteOptions, remote.WithPlatform(*platform))
+	}
+
+	image, err := remote.Image(ref, remoteOptions...)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("retrieving docker image %q: %w", ref.Name(), err)
+	}
+
+	config, err := image.ConfigFile()
+	if err != nil {
+		return time.Time{}, fmt.Errorf("retrieving docker image %q configuration: %w", ref.Name(), err)
+	}
+
+	if config.Created.IsZero() {
+		return time.Time{}, fmt.Errorf("no creation date defined for docker image %q", ref.Name())
+	}
+
+	return config.Created.Time, nil
+}
@@ -1,6 +1,11 @@
 package client
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 
 	"github.com/drone/go-scm/scm"
@@ -11,6 +16,9 @@ import (
 
 type Client *scm.Client
 
+// ErrConflict is returned by Do when the Gitea api reports a conflict, such as an already existing resource
+var ErrConflict = errors.New(http.StatusText(http.StatusConflict))
+
 func New(s Spec) (Client, error) {
 
 	client, err := gitea.New(s.URL)
@@ -34,3 +42,38 @@ func New(s Spec) (Client, error) {
 
 	return client, nil
 }
+
+// Do sends a raw api request to Gitea, for endpoints not supported by the go-scm library
+func Do(ctx context.Context, c Client, method, path string, in interface{}) error {
+	req := &scm.Request{
+		Method: method,
+		Path:   path,
+	}
+
+	if in != nil {
+		buf := new(bytes.Buffer)
+		if err := json.NewEncoder(buf).Encode(in); err != nil {
+			return err
+		}
+		req.Header = map[string][]string{
+			"Content-Type": {"application/json"},
+		}
+		req.Body = buf
+	}
+
+	resp, err := (*scm.Client)(c).Do(ctx, req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.Status == http.StatusConflict {
+		return fmt.Errorf("%s %s: %w", method, path, ErrConflict)
+	}
+
+	if resp.Status >= 300 {
+		return fmt.Errorf("%s %s: %s", method, path, http.StatusText(resp.Status))
+	}
+
+	return nil
+}
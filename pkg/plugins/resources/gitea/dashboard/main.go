@@ -0,0 +1,195 @@
+package dashboard
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/mitchellh/mapstructure"
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/resources/gitea/client"
+
+	giteascm "github.com/updatecli/updatecli/pkg/plugins/scms/gitea"
+)
+
+// giteaRequestTimeout defines the timeout of every Gitea API request
+const giteaRequestTimeout = 30 * time.Second
+
+// Gitea contains information to maintain a dependency dashboard issue on Gitea
+type Gitea struct {
+	// spec contains inputs coming from updatecli configuration
+	spec Spec
+	// client handle the api authentication
+	client client.Client
+	// Owner specifies repository owner
+	Owner string
+	// Repository specifies the name of a repository for a specific owner
+	Repository string
+}
+
+// New returns a new valid Gitea dashboard object.
+func New(spec interface{}, scm *giteascm.Gitea) (Gitea, error) {
+
+	var clientSpec client.Spec
+	var s Spec
+
+	// mapstructure.Decode cannot handle embedded fields
+	// hence we decode it in two steps
+	err := mapstructure.Decode(spec, &clientSpec)
+	if err != nil {
+		return Gitea{}, err
+	}
+
+	err = mapstructure.Decode(spec, &s)
+	if err != nil {
+		return Gitea{}, err
+	}
+
+	g := Gitea{
+		spec:       s,
+		Owner:      s.Owner,
+		Repository: s.Repository,
+	}
+
+	if scm != nil {
+		if len(clientSpec.Token) == 0 && len(scm.Spec.Token) > 0 {
+			clientSpec.Token = scm.Spec.Token
+		}
+
+		if len(clientSpec.URL) == 0 && len(scm.Spec.URL) > 0 {
+			clientSpec.URL = scm.Spec.URL
+		}
+
+		if len(clientSpec.Username) == 0 && len(scm.Spec.Username) > 0 {
+			clientSpec.Username = scm.Spec.Username
+		}
+
+		if len(g.Owner) == 0 {
+			g.Owner = scm.Spec.Owner
+		}
+
+		if len(g.Repository) == 0 {
+			g.Repository = scm.Spec.Repository
+		}
+	}
+
+	// Sanitize modifies the clientSpec so it must be done once initialization is completed
+	err = clientSpec.Sanitize()
+	if err != nil {
+		return Gitea{}, err
+	}
+
+	g.client, err = client.New(clientSpec)
+	if err != nil {
+		return Gitea{}, err
+	}
+
+	return g, nil
+}
+
+// UpdateDashboard creates or updates the open issue titled title.
+// The issue body is generated from the current issue body, if any.
+func (g *Gitea) UpdateDashboard(title string, generateBody func(currentBody string) (string, error)) (string, error) {
+	issue, err := g.findDashboardIssue(title)
+	if err != nil {
+		return "", fmt.Errorf("search Gitea dashboard issue: %w", err)
+	}
+
+	currentBody := ""
+	if issue != nil {
+		currentBody = issue.Body
+	}
+
+	body, err := generateBody(currentBody)
+	if err != nil {
+		return "", err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), giteaRequestTimeout)
+	defer cancel()
+
+	if issue == nil {
+		issue, _, err = g.client.Issues.Create(ctx, g.repo(), &scm.IssueInput{
+			Title: title,
+			Body:  body,
+		})
+		if err != nil {
+			return "", fmt.Errorf("create Gitea dashboard issue: %w", err)
+		}
+
+		link := g.issueLink(issue.Number)
+
+		logrus.Infof("Gitea dashboard issue created at %q", link)
+
+		if !g.spec.DisablePin {
+			path := fmt.Sprintf("api/v1/repos/%s/issues/%d/pin", g.repo(), issue.Number)
+			if err := client.Do(ctx, g.client, http.MethodPost, path, nil); err != nil {
+				logrus.Warningf("unable to pin Gitea dashboard issue %q: %s", link, err)
+			}
+		}
+
+		return link, nil
+	}
+
+	link := g.issueLink(issue.Number)
+
+	if issue.Body == body {
+		logrus.Infof("Gitea dashboard issue %q already up to date", link)
+		return link, nil
+	}
+
+	// The scm Gitea driver doesn't support updating issues
+	path := fmt.Sprintf("api/v1/repos/%s/issues/%d", g.repo(), issue.Number)
+	if err := client.Do(ctx, g.client, http.MethodPatch, path, map[string]string{"body": body}); err != nil {
+		return "", fmt.Errorf("update Gitea dashboard issue: %w", err)
+	}
+
+	logrus.Infof("Gitea dashboard issue %q updated", link)
+
+	return link, nil
+}
+
+// findDashboardIssue returns the open issue titled title, or nil if none
+func (g *Gitea) findDashboardIssue(title string) (*scm.Issue, error) {
+	page := 1
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), giteaRequestTimeout)
+		issues, resp, err := g.client.Issues.List(ctx, g.repo(), scm.IssueListOptions{
+			Page: page,
+			Size: 30,
+			Open: true,
+		})
+		cancel()
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, issue := range issues {
+			if issue.Title == title && !issue.Closed {
+				return issue, nil
+			}
+		}
+
+		if resp == nil || page >= resp.Page.Last {
+			break
+		}
+		page++
+	}
+
+	return nil, nil
+}
+
+// issueLink returns the issue web URL, as the scm Gitea driver doesn't provide it
+func (g *Gitea) issueLink(number int) string {
+	baseURL := strings.TrimSuffix((*scm.Client)(g.client).BaseURL.String(), "/")
+	return fmt.Sprintf("%s/%s/issues/%d", baseURL, g.repo(), number)
+}
+
+// repo returns the repository full name
+func (g *Gitea) repo() string {
+	return strings.Join([]string{g.Owner, g.Repository}, "/")
+}
@@ -0,0 +1,94 @@
+package dashboard
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/drone/go-scm/scm/driver/gitea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/plugins/resources/gitea/client"
+)
+
+func TestUpdateDashboard(t *testing.T) {
+	// issues contains the issue bodies stored by the fake Gitea server, indexed by issue number
+	issues := map[int]string{}
+	pinned := map[int]bool{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		issue := func(number int) map[string]any {
+			return map[string]any{
+				"number": number,
+				"title":  "Updatecli Dependency Dashboard",
+				"body":   issues[number],
+				"state":  "open",
+			}
+		}
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/repos/owner/repo/issues":
+			list := []map[string]any{
+				{"number": 1, "title": "Another issue", "state": "open"},
+			}
+			for number := range issues {
+				list = append(list, issue(number))
+			}
+			_ = json.NewEncoder(w).Encode(list)
+
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/repos/owner/repo/issues":
+			var in map[string]string
+			_ = json.NewDecoder(r.Body).Decode(&in)
+			issues[2] = in["body"]
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(issue(2))
+
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/repos/owner/repo/issues/2/pin":
+			pinned[2] = true
+			w.WriteHeader(http.StatusNoContent)
+
+		case r.Method == http.MethodPatch && r.URL.Path == "/api/v1/repos/owner/repo/issues/2":
+			var in map[string]string
+			data, _ := io.ReadAll(r.Body)
+			_ = json.Unmarshal(data, &in)
+			issues[2] = in["body"]
+			_ = json.NewEncoder(w).Encode(issue(2))
+
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c, err := gitea.New(server.URL)
+	require.NoError(t, err)
+
+	g := Gitea{
+		client:     client.Client(c),
+		Owner:      "owner",
+		Repository: "repo",
+	}
+
+	// The dashboard issue is created and pinned
+	link, err := g.UpdateDashboard("Updatecli Dependency Dashboard", func(current string) (string, error) {
+		assert.Empty(t, current)
+		return "first", nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, server.URL+"/owner/repo/issues/2", link)
+	assert.Equal(t, "first", issues[2])
+	assert.True(t, pinned[2])
+
+	// The existing dashboard issue is updated
+	link, err = g.UpdateDashboard("Updatecli Dependency Dashboard", func(current string) (string, error) {
+		assert.Equal(t, "first", current)
+		return "second", nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, server.URL+"/owner/repo/issues/2", link)
+	assert.Equal(t, "second", issues[2])
+}
@@ -0,0 +1,35 @@
+package dashboard
+
+import (
+	"github.com/updatecli/updatecli/pkg/plugins/resources/gitea/client"
+)
+
+// Spec defines settings used to maintain a Gitea dependency dashboard issue
+// It's a mapping of user input from a Updatecli manifest and it shouldn't modified
+type Spec struct {
+	client.Spec
+	/*
+		"owner" defines the Gitea repository owner.
+
+		remark:
+			unless you know what you are doing, you shouldn't set this value and rely on the scmid to provide the sane default.
+	*/
+	Owner string `yaml:",omitempty" jsonschema:"required"`
+	/*
+		"repository" defines the Gitea repository for a specific owner
+
+		remark:
+			unless you know what you are doing, you shouldn't set this value and rely on the scmid to provide the sane default.
+	*/
+	Repository string `yaml:",omitempty" jsonschema:"required"`
+	/*
+		"disablepin" disables pinning the dashboard issue on the repository.
+
+		default:
+			false
+
+		remark:
+			pinning issues requires Gitea 1.21 or later
+	*/
+	DisablePin bool `yaml:",omitempty"`
+}
@@ -0,0 +1,91 @@
+package pullrequest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/resources/gitea/client"
+)
+
+// mergePullRequestOption is the Gitea api payload used to merge a pullrequest
+type mergePullRequestOption struct {
+	Do                     string `json:"Do"`
+	MergeWhenChecksSucceed bool   `json:"merge_when_checks_succeed"`
+	DeleteBranchAfterMerge bool   `json:"delete_branch_after_merge"`
+}
+
+// enableAutoMerge schedules the pullrequest to be merged once all its checks succeed
+func (g *Gitea) enableAutoMerge(pr *scm.PullRequest) error {
+	mergeMethod := g.spec.MergeMethod
+	if mergeMethod == "" {
+		mergeMethod = "merge"
+	}
+
+	repo := strings.Join([]string{g.Owner, g.Repository}, "/")
+
+	// Timeout api query after 30sec
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	err := client.Do(ctx, g.client, http.MethodPost,
+		fmt.Sprintf("api/v1/repos/%s/pulls/%d/merge", repo, pr.Number),
+		mergePullRequestOption{
+			Do:                     mergeMethod,
+			MergeWhenChecksSucceed: true,
+			DeleteBranchAfterMerge: g.spec.RemoveSourceBranch,
+		})
+
+	if errors.Is(err, client.ErrConflict) {
+		logrus.Debugf("auto-merge already scheduled on Gitea pullrequest %q", pr.Link)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("schedule Gitea pullrequest auto-merge: %w", err)
+	}
+
+	logrus.Infof("Auto-merge scheduled on Gitea pullrequest %q", pr.Link)
+
+	return nil
+}
+
+// updateParticipants assigns the pullrequest and requests reviews according to the spec
+func (g *Gitea) updateParticipants(pr *scm.PullRequest) error {
+	repo := strings.Join([]string{g.Owner, g.Repository}, "/")
+
+	// Timeout api query after 30sec
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	var errs []string
+
+	if len(g.spec.Assignees) > 0 {
+		err := client.Do(ctx, g.client, http.MethodPatch,
+			fmt.Sprintf("api/v1/repos/%s/pulls/%d", repo, pr.Number),
+			map[string][]string{"assignees": g.spec.Assignees})
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("assign pullrequest: %s", err))
+		}
+	}
+
+	if len(g.spec.Reviewers) > 0 {
+		err := client.Do(ctx, g.client, http.MethodPost,
+			fmt.Sprintf("api/v1/repos/%s/pulls/%d/requested_reviewers", repo, pr.Number),
+			map[string][]string{"reviewers": g.spec.Reviewers})
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("request pullrequest reviews: %s", err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "\n"))
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package pullrequest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/drone/go-scm/scm/driver/gitea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/plugins/resources/gitea/client"
+)
+
+func TestEnableAutoMerge(t *testing.T) {
+	var requests []map[string]any
+	scheduled := false
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v1/repos/owner/repo/pulls/2/merge" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		in := map[string]any{}
+		_ = json.NewDecoder(r.Body).Decode(&in)
+		requests = append(requests, in)
+
+		if scheduled {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		scheduled = true
+	}))
+	defer server.Close()
+
+	c, err := gitea.New(server.URL)
+	require.NoError(t, err)
+
+	g := Gitea{
+		spec: Spec{
+			AutoMerge:          true,
+			MergeMethod:        "squash",
+			RemoveSourceBranch: true,
+		},
+		client:     client.Client(c),
+		Owner:      "owner",
+		Repository: "repo",
+	}
+
+	require.NoError(t, g.enableAutoMerge(&scm.PullRequest{Number: 2}))
+	require.Len(t, requests, 1)
+	assert.Equal(t, map[string]any{
+		"Do":                        "squash",
+		"merge_when_checks_succeed": true,
+		"delete_branch_after_merge": true,
+	}, requests[0])
+
+	// An already scheduled auto-merge isn't an error
+	require.NoError(t, g.enableAutoMerge(&scm.PullRequest{Number: 2}))
+
+	// Other errors are reported
+	assert.Error(t, g.enableAutoMerge(&scm.PullRequest{Number: 3}))
+}
+
+func TestUpdateParticipants(t *testing.T) {
+	var assignees, reviewers []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		in := map[string][]string{}
+		_ = json.NewDecoder(r.Body).Decode(&in)
+
+		switch {
+		case r.Method == http.MethodPatch && r.URL.Path == "/api/v1/repos/owner/repo/pulls/2":
+			assignees = in["assignees"]
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/repos/owner/repo/pulls/2/requested_reviewers":
+			reviewers = in["reviewers"]
+			w.WriteHeader(http.StatusCreated)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c, err := gitea.New(server.URL)
+	require.NoError(t, err)
+
+	g := Gitea{
+		spec: Spec{
+			Assignees: []string{"alice"},
+			Reviewers: []string{"bob", "carol"},
+		},
+		client:     client.Client(c),
+		Owner:      "owner",
+		Repository: "repo",
+	}
+
+	require.NoError(t, g.updateParticipants(&scm.PullRequest{Number: 2}))
+	assert.Equal(t, []string{"alice"}, assignees)
+	assert.Equal(t, []string{"bob", "carol"}, reviewers)
+}
@@ -1,12 +1,84 @@
 package pullrequest
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/drone/go-scm/scm"
 	"github.com/sirupsen/logrus"
 	"github.com/updatecli/updatecli/pkg/core/reports"
+	"github.com/updatecli/updatecli/pkg/plugins/resources/gitea/client"
+	utils "github.com/updatecli/updatecli/pkg/plugins/utils/action"
 )
 
-// CleanAction verifies if an existing action requires some operations
-func (g *Gitea) CleanAction(report *reports.Action) error {
-	logrus.Debugln("cleaning Gitea pull-request is not yet supported. Feel free to open an issue to mark your interest.")
+// CleanAction closes an existing Gitea pull-request if its working branch doesn't bring any changes anymore
+func (g *Gitea) CleanAction(report *reports.Action, closeObsolete bool) error {
+	if g.scm == nil {
+		logrus.Debugln("no Gitea scm defined, nothing to clean")
+		return nil
+	}
+
+	pr, err := g.getPullRequest()
+	if err != nil {
+		return err
+	}
+
+	if pr == nil {
+		logrus.Debugln("nothing to clean")
+		return nil
+	}
+
+	// Without push, the remote working branch doesn't contain the local changes,
+	// so it can't be considered obsolete.
+	isObsolete := false
+	if closeObsolete {
+		isObsolete, err = g.scm.IsWorkingBranchObsolete()
+		if err != nil {
+			return fmt.Errorf("checking if pull request is obsolete: %w", err)
+		}
+	}
+
+	if !isObsolete {
+		return nil
+	}
+
+	repo := strings.Join([]string{g.Owner, g.Repository}, "/")
+
+	// Timeout api query after 30sec
+	ctx := context.Background()
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	// Not returning an error if the comment failed to be added
+	// as the main purpose of this function is to close the pullrequest
+	_, _, err = g.client.Issues.CreateComment(ctx, repo, pr.Number, &scm.CommentInput{
+		Body: utils.OBSOLETEPULLREQUESTCOMMENT,
+	})
+	if err != nil {
+		logrus.Errorf("Commenting Gitea pull request: %s", err)
+	}
+
+	err = client.Do(ctx, g.client, http.MethodPatch,
+		fmt.Sprintf("api/v1/repos/%s/pulls/%d", repo, pr.Number),
+		map[string]string{"state": "closed"})
+	if err != nil {
+		return fmt.Errorf("closing obsolete pull request: %w", err)
+	}
+
+	logrus.Infof("Gitea pull request closed as obsolete at:\n\n\t%s\n\n", pr.Link)
+
+	report.Link = ""
+	report.Description = "Pull request closed as obsolete"
+
+	if g.spec.DeleteObsoleteBranch {
+		if err := g.scm.DeleteBranch(g.SourceBranch); err != nil {
+			return fmt.Errorf("deleting obsolete branch %q: %w", g.SourceBranch, err)
+		}
+		logrus.Infof("Branch %q deleted", g.SourceBranch)
+	}
+
 	return nil
 }
@@ -34,18 +34,25 @@ func (g *Gitea) CreateAction(report *reports.Action, resetDescription bool) erro
 	}
 
 	// Check if a pull-request is already opened then exit early if it does.
-	pullrequestTitle, pullrequestDescription, pullrequestLink, err := g.isPullRequestExist()
+	existingPR, err := g.getPullRequest()
 	if err != nil {
 		return err
 	}
 
 	// If a pullrequest already exist, we update the report with the existing pullrequest
-	if pullrequestLink != "" {
+	if existingPR != nil {
 		logrus.Debugf("Gitea pullrequest already exist, nothing to do")
 
-		report.Title = pullrequestTitle
-		report.Link = pullrequestLink
-		report.Description = pullrequestDescription
+		report.Title = existingPR.Title
+		report.Link = existingPR.Link
+		report.Description = existingPR.Body
+
+		if g.spec.AutoMerge {
+			if err := g.enableAutoMerge(existingPR); err != nil {
+				logrus.Errorf("Auto-merge can't be enabled: %s", err)
+			}
+		}
+
 		return nil
 	}
 
@@ -114,5 +121,15 @@ func (g *Gitea) CreateAction(report *reports.Action, resetDescription bool) erro
 
 	logrus.Infof("Gitea pullrequest successfully opened on %q", pr.Link)
 
+	if err := g.updateParticipants(pr); err != nil {
+		logrus.Errorf("Updating Gitea pullrequest participants: %s", err)
+	}
+
+	if g.spec.AutoMerge {
+		if err := g.enableAutoMerge(pr); err != nil {
+			logrus.Errorf("Auto-merge can't be enabled: %s", err)
+		}
+	}
+
 	return nil
 }
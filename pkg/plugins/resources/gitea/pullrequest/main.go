@@ -43,6 +43,10 @@ func New(spec interface{}, scm *giteascm.Gitea) (Gitea, error) {
 		return Gitea{}, nil
 	}
 
+	if err := s.Validate(); err != nil {
+		return Gitea{}, err
+	}
+
 	if scm != nil {
 
 		if len(clientSpec.Token) == 0 && len(scm.Spec.Token) > 0 {
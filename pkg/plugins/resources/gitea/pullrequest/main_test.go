@@ -92,6 +92,17 @@ func TestNew(t *testing.T) {
 			scm:     nil,
 			wantErr: true,
 		},
+		{
+			name: "Test wrong merge method",
+			spec: Spec{
+				Spec: giteaclient.Spec{
+					URL: "gitea.updatecli.io",
+				},
+				Owner:       "updatecli",
+				MergeMethod: "octopus",
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range testData {
@@ -1,9 +1,16 @@
 package pullrequest
 
 import (
+	"fmt"
+	"slices"
+	"strings"
+
 	"github.com/updatecli/updatecli/pkg/plugins/resources/gitea/client"
 )
 
+// mergeMethods contains the merge methods accepted by the Gitea api
+var mergeMethods = []string{"merge", "rebase", "rebase-merge", "squash", "fast-forward-only"}
+
 // Spec defines settings used to interact with Gitea pullrequest
 // It's a mapping of user input from a Updatecli manifest and it shouldn't modified
 type Spec struct {
@@ -84,4 +91,63 @@ type Spec struct {
 			"body" is useful to provide additional information when reviewing pullrequest, such as changelog url.
 	*/
 	Body string `yaml:",inline,omitempty"`
+	/*
+		"deleteobsoletebranch" defines if the working branch should be deleted once Updatecli closed its pullrequest
+		because the working branch doesn't bring any changes anymore.
+
+		default:
+			false
+	*/
+	DeleteObsoleteBranch bool `yaml:",omitempty"`
+	/*
+		"automerge" defines if the pullrequest should be merged automatically once all its checks succeed.
+
+		default:
+			false
+
+		remark:
+			if the pullrequest has no checks, Gitea merges it immediately.
+	*/
+	AutoMerge bool `yaml:",omitempty"`
+	/*
+		"mergemethod" defines the merge method used when the pullrequest is automatically merged.
+
+		default:
+			"merge"
+
+		remark:
+			Accept "merge", "rebase", "rebase-merge", "squash", or "fast-forward-only"
+			The merge method must be allowed by the repository settings
+	*/
+	MergeMethod string `yaml:",omitempty"`
+	/*
+		"removesourcebranch" defines if the pullrequest source branch should be deleted once automatically merged.
+
+		default:
+			false
+	*/
+	RemoveSourceBranch bool `yaml:",omitempty"`
+	/*
+		"assignees" defines the list of Gitea usernames assigned to the pullrequest.
+
+		default:
+			empty
+	*/
+	Assignees []string `yaml:",omitempty"`
+	/*
+		"reviewers" defines the list of Gitea usernames requested to review the pullrequest.
+
+		default:
+			empty
+	*/
+	Reviewers []string `yaml:",omitempty"`
+}
+
+// Validate validates that a spec contains good content
+func (s Spec) Validate() error {
+	if s.MergeMethod != "" && !slices.Contains(mergeMethods, s.MergeMethod) {
+		return fmt.Errorf("wrong merge method %q defined, accepting one of %q", s.MergeMethod, strings.Join(mergeMethods, ", "))
+	}
+
+	return nil
 }
@@ -12,6 +12,17 @@ import (
 
 // isPullRequestExist queries a remote Gitea instance to know if a pullrequest already exists.
 func (g *Gitea) isPullRequestExist() (title, description, link string, err error) {
+	pr, err := g.getPullRequest()
+	if err != nil || pr == nil {
+		return "", "", "", err
+	}
+
+	return pr.Title, pr.Body, pr.Link, nil
+}
+
+// getPullRequest queries a remote Gitea instance to retrieve the open pullrequest from the source branch
+// to the target branch, it returns nil if none could be found.
+func (g *Gitea) getPullRequest() (*scm.PullRequest, error) {
 	ctx := context.Background()
 
 	page := 0
@@ -36,7 +47,7 @@ func (g *Gitea) isPullRequestExist() (title, description, link string, err error
 
 		if err != nil {
 			logrus.Debugf("RC: %s\n", err)
-			return "", "", "", err
+			return nil, err
 		}
 
 		if resp.Status > 400 {
@@ -53,7 +64,7 @@ func (g *Gitea) isPullRequestExist() (title, description, link string, err error
 					result.SUCCESS,
 					p.Link)
 
-				return p.Title, p.Body, p.Link, nil
+				return p, nil
 			}
 		}
 
@@ -63,7 +74,7 @@ func (g *Gitea) isPullRequestExist() (title, description, link string, err error
 		page++
 	}
 
-	return "", "", "", nil
+	return nil, nil
 }
 
 // isRemoteBranchesExist queries a remote Gitea instance to know if both the pull-request source branch and the target branch exist.
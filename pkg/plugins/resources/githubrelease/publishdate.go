@@ -0,0 +1,36 @@
+package githubrelease
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/updatecli/updatecli/pkg/plugins/scms/github"
+)
+
+// PublishDate returns the date the GitHub release matching version was published
+func (gr *GitHubRelease) PublishDate(version string) (time.Time, error) {
+	// Drafts don't have a publication date
+	releases, err := gr.ghHandler.SearchReleases(github.ReleaseType{
+		PreRelease: true,
+		Release:    true,
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	for _, release := range releases {
+		value := release.TagName
+		switch gr.spec.Key {
+		case KeyTagHash:
+			value = release.TagCommit.Oid
+		case KeyTitle:
+			value = release.Name
+		}
+
+		if value == version && !release.PublishedAt.IsZero() {
+			return release.PublishedAt.Time, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("no published GitHub release found for %q", version)
+}
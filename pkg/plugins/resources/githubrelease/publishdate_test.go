@@ -0,0 +1,74 @@
+package githubrelease
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/plugins/scms/github"
+)
+
+func TestGitHubRelease_PublishDate(t *testing.T) {
+	published := time.Date(2024, time.October, 1, 12, 0, 0, 0, time.UTC)
+
+	releases := []github.ReleaseNode{
+		{TagName: "1.0.0", Name: "First", TagCommit: github.TagCommit{Oid: "11111111"}, PublishedAt: githubv4.DateTime{Time: published.AddDate(0, -1, 0)}},
+		{TagName: "2.0.0", Name: "Second", TagCommit: github.TagCommit{Oid: "22222222"}, PublishedAt: githubv4.DateTime{Time: published}},
+	}
+
+	tests := []struct {
+		name     string
+		key      string
+		version  string
+		expected time.Time
+		wantErr  bool
+	}{
+		{
+			name:     "Tag name",
+			version:  "2.0.0",
+			expected: published,
+		},
+		{
+			name:     "Tag hash",
+			key:      KeyTagHash,
+			version:  "11111111",
+			expected: published.AddDate(0, -1, 0),
+		},
+		{
+			name:     "Title",
+			key:      KeyTitle,
+			version:  "Second",
+			expected: published,
+		},
+		{
+			name:    "Unknown release",
+			version: "3.0.0",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gr, err := New(Spec{
+				Owner:      "owner",
+				Repository: "repository",
+				Token:      "ghp_example",
+				Key:        tt.key,
+			})
+			require.NoError(t, err)
+
+			gr.ghHandler = &mockGhHandler{releases: releases}
+
+			got, err := gr.PublishDate(tt.version)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package dashboard
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/resources/gitlab/client"
+
+	gitlabscm "github.com/updatecli/updatecli/pkg/plugins/scms/gitlab"
+	gitlabapi "gitlab.com/gitlab-org/api/client-go"
+)
+
+// Gitlab contains information to maintain a dependency dashboard issue on GitLab
+type Gitlab struct {
+	// spec contains inputs coming from updatecli configuration
+	spec Spec
+	// api allows to interact with Gitlab via API
+	api *gitlabapi.Client
+	// Owner specifies repository owner
+	Owner string
+	// Repository specifies the name of a repository for a specific owner
+	Repository string
+}
+
+// New returns a new valid GitLab dashboard object.
+func New(spec interface{}, scm *gitlabscm.Gitlab) (Gitlab, error) {
+
+	var clientSpec client.Spec
+	var s Spec
+
+	// mapstructure.Decode cannot handle embedded fields
+	// hence we decode it in two steps
+	err := mapstructure.Decode(spec, &clientSpec)
+	if err != nil {
+		return Gitlab{}, err
+	}
+
+	err = mapstructure.Decode(spec, &s)
+	if err != nil {
+		return Gitlab{}, err
+	}
+
+	g := Gitlab{
+		spec:       s,
+		Owner:      s.Owner,
+		Repository: s.Repository,
+	}
+
+	if scm != nil {
+		if len(clientSpec.Token) == 0 && len(scm.Spec.Token) > 0 {
+			clientSpec.Token = scm.Spec.Token
+		}
+
+		if len(clientSpec.URL) == 0 && len(scm.Spec.URL) > 0 {
+			clientSpec.URL = scm.Spec.URL
+		}
+
+		if len(clientSpec.TokenType) == 0 && len(scm.Spec.TokenType) > 0 {
+			clientSpec.TokenType = scm.Spec.TokenType
+		}
+
+		if len(g.Owner) == 0 {
+			g.Owner = scm.Spec.Owner
+		}
+
+		if len(g.Repository) == 0 {
+			g.Repository = scm.Spec.Repository
+		}
+	}
+
+	options := []gitlabapi.ClientOptionFunc{
+		gitlabapi.WithBaseURL(client.EnsureValidURL(clientSpec.URL)),
+	}
+
+	switch strings.ToLower(clientSpec.TokenType) {
+	case "bearer":
+		g.api, err = gitlabapi.NewOAuthClient(clientSpec.Token, options...)
+	case "private", "":
+		g.api, err = gitlabapi.NewClient(clientSpec.Token, options...)
+	default:
+		err = fmt.Errorf("unknown tokenType %q", clientSpec.TokenType)
+	}
+
+	if err != nil {
+		return Gitlab{}, err
+	}
+
+	return g, nil
+}
+
+// UpdateDashboard creates or updates the open issue titled title.
+// The issue body is generated from the current issue body, if any.
+//
+// GitLab doesn't allow pinning issues using its API, so the dashboard issue isn't pinned.
+func (g *Gitlab) UpdateDashboard(title string, generateBody func(currentBody string) (string, error)) (string, error) {
+	issue, err := g.findDashboardIssue(title)
+	if err != nil {
+		return "", fmt.Errorf("search GitLab dashboard issue: %w", err)
+	}
+
+	currentBody := ""
+	if issue != nil {
+		currentBody = issue.Description
+	}
+
+	body, err := generateBody(currentBody)
+	if err != nil {
+		return "", err
+	}
+
+	if issue == nil {
+		opts := gitlabapi.CreateIssueOptions{
+			Title:       &title,
+			Description: &body,
+		}
+
+		if len(g.spec.Labels) > 0 {
+			labels := gitlabapi.LabelOptions(g.spec.Labels)
+			opts.Labels = &labels
+		}
+
+		issue, _, err = g.api.Issues.CreateIssue(g.getPID(), &opts)
+		if err != nil {
+			return "", fmt.Errorf("create GitLab dashboard issue: %w", err)
+		}
+
+		logrus.Infof("GitLab dashboard issue created at %q", issue.WebURL)
+
+		return issue.WebURL, nil
+	}
+
+	if issue.Description == body {
+		logrus.Infof("GitLab dashboard issue %q already up to date", issue.WebURL)
+		return issue.WebURL, nil
+	}
+
+	_, _, err = g.api.Issues.UpdateIssue(g.getPID(), issue.IID, &gitlabapi.UpdateIssueOptions{
+		Description: &body,
+	})
+	if err != nil {
+		return "", fmt.Errorf("update GitLab dashboard issue: %w", err)
+	}
+
+	logrus.Infof("GitLab dashboard issue %q updated", issue.WebURL)
+
+	return issue.WebURL, nil
+}
+
+// findDashboardIssue returns the open issue titled title, or nil if none
+func (g *Gitlab) findDashboardIssue(title string) (*gitlabapi.Issue, error) {
+	state := "opened"
+	in := "title"
+
+	opts := gitlabapi.ListProjectIssuesOptions{
+		ListOptions: gitlabapi.ListOptions{
+			Page:    1,
+			PerPage: 30,
+		},
+		State:  &state,
+		Search: &title,
+		In:     &in,
+	}
+
+	for {
+		issues, resp, err := g.api.Issues.ListProjectIssues(g.getPID(), &opts)
+		if err != nil {
+			return nil, err
+		}
+
+		// The search is fuzzy so we need to look for the exact title
+		for _, issue := range issues {
+			if issue.Title == title {
+				return issue, nil
+			}
+		}
+
+		if resp == nil || resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return nil, nil
+}
+
+func (g *Gitlab) getPID() string {
+	return strings.Join([]string{
+		g.Owner,
+		g.Repository}, "/")
+}
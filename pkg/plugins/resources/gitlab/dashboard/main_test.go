@@ -0,0 +1,94 @@
+package dashboard
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateDashboard(t *testing.T) {
+	// description contains the dashboard issue description stored by the fake GitLab server
+	description := ""
+	created := false
+	var labels []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		issue := map[string]any{
+			"id":          30,
+			"iid":         3,
+			"title":       "Updatecli Dependency Dashboard",
+			"description": description,
+			"web_url":     "https://gitlab.com/owner/repo/-/issues/3",
+		}
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v4/projects/owner/repo/issues":
+			assert.Equal(t, "opened", r.URL.Query().Get("state"))
+			list := []map[string]any{
+				{"id": 10, "iid": 1, "title": "Updatecli Dependency Dashboard (old)"},
+			}
+			if created {
+				list = append(list, issue)
+			}
+			_ = json.NewEncoder(w).Encode(list)
+
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v4/projects/owner/repo/issues":
+			var in struct {
+				Description string `json:"description"`
+				Labels      string `json:"labels"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&in)
+			description = in.Description
+			labels = append(labels, in.Labels)
+			created = true
+			issue["description"] = description
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(issue)
+
+		case r.Method == http.MethodPut && r.URL.Path == "/api/v4/projects/owner/repo/issues/3":
+			var in struct {
+				Description string `json:"description"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&in)
+			description = in.Description
+			issue["description"] = description
+			_ = json.NewEncoder(w).Encode(issue)
+
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	g, err := New(map[string]interface{}{
+		"url":        server.URL,
+		"owner":      "owner",
+		"repository": "repo",
+		"labels":     []string{"dependencies"},
+	}, nil)
+	require.NoError(t, err)
+
+	// The dashboard issue is created
+	link, err := g.UpdateDashboard("Updatecli Dependency Dashboard", func(current string) (string, error) {
+		assert.Empty(t, current)
+		return "first", nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "https://gitlab.com/owner/repo/-/issues/3", link)
+	assert.Equal(t, "first", description)
+	assert.Equal(t, []string{"dependencies"}, labels)
+
+	// The existing dashboard issue is updated
+	_, err = g.UpdateDashboard("Updatecli Dependency Dashboard", func(current string) (string, error) {
+		assert.Equal(t, "first", current)
+		return "second", nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "second", description)
+}
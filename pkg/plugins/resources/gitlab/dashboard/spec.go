@@ -0,0 +1,32 @@
+package dashboard
+
+import (
+	"github.com/updatecli/updatecli/pkg/plugins/resources/gitlab/client"
+)
+
+// Spec defines settings used to maintain a GitLab dependency dashboard issue
+// It's a mapping of user input from a Updatecli manifest and it shouldn't modified
+type Spec struct {
+	client.Spec
+	/*
+		"owner" defines the GitLab repository owner.
+
+		remark:
+			unless you know what you are doing, you shouldn't set this value and rely on the scmid to provide the sane default.
+	*/
+	Owner string `yaml:",omitempty" jsonschema:"required"`
+	/*
+		"repository" defines the GitLab repository for a specific owner
+
+		remark:
+			unless you know what you are doing, you shouldn't set this value and rely on the scmid to provide the sane default.
+	*/
+	Repository string `yaml:",omitempty" jsonschema:"required"`
+	/*
+		"labels" defines labels added to the dashboard issue.
+
+		default:
+			empty
+	*/
+	Labels []string `yaml:",omitempty"`
+}
@@ -0,0 +1,40 @@
+package mergerequest
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	gitlabapi "gitlab.com/gitlab-org/api/client-go"
+)
+
+// enableAutoMerge requests GitLab to merge the merge request once its pipeline succeeds
+func (g *Gitlab) enableAutoMerge(mr *gitlabapi.BasicMergeRequest) error {
+	if mr.MergeWhenPipelineSucceeds {
+		logrus.Debugf("auto-merge already enabled on GitLab merge request %q", mr.WebURL)
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), gitlabRequestTimeout)
+	defer cancel()
+
+	opts := gitlabapi.AcceptMergeRequestOptions{
+		MergeWhenPipelineSucceeds: gitlabapi.Ptr(true),
+		Squash:                    gitlabapi.Ptr(g.spec.Squash),
+		ShouldRemoveSourceBranch:  gitlabapi.Ptr(g.spec.RemoveSourceBranch),
+	}
+
+	_, _, err := g.api.MergeRequests.AcceptMergeRequest(
+		g.getPID(),
+		mr.IID,
+		&opts,
+		gitlabapi.WithContext(ctx),
+	)
+	if err != nil {
+		return fmt.Errorf("enable auto-merge on GitLab merge request %s/%d: %w", g.getPID(), mr.IID, err)
+	}
+
+	logrus.Infof("Auto-merge enabled on GitLab merge request %q", mr.WebURL)
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package mergerequest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/plugins/resources/gitlab/client"
+	gitlabapi "gitlab.com/gitlab-org/api/client-go"
+)
+
+func TestEnableAutoMerge(t *testing.T) {
+	var requests []map[string]any
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method != http.MethodPut || r.URL.Path != "/api/v4/projects/owner/repo/merge_requests/3/merge" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		in := map[string]any{}
+		_ = json.NewDecoder(r.Body).Decode(&in)
+		requests = append(requests, in)
+
+		_ = json.NewEncoder(w).Encode(map[string]any{"iid": 3, "merge_when_pipeline_succeeds": true})
+	}))
+	defer server.Close()
+
+	api, err := getGitlabClient(client.Spec{URL: server.URL})
+	require.NoError(t, err)
+
+	g := Gitlab{
+		spec: Spec{
+			AutoMerge:          true,
+			Squash:             true,
+			RemoveSourceBranch: true,
+		},
+		api:        api,
+		Owner:      "owner",
+		Repository: "repo",
+	}
+
+	mr := gitlabapi.BasicMergeRequest{IID: 3}
+	require.NoError(t, g.enableAutoMerge(&mr))
+	require.Len(t, requests, 1)
+	assert.Equal(t, map[string]any{
+		"merge_when_pipeline_succeeds": true,
+		"squash":                       true,
+		"should_remove_source_branch":  true,
+	}, requests[0])
+
+	// Auto-merge is only requested once
+	mr.MergeWhenPipelineSucceeds = true
+	require.NoError(t, g.enableAutoMerge(&mr))
+	assert.Len(t, requests, 1)
+
+	// Errors are reported
+	mr = gitlabapi.BasicMergeRequest{IID: 4}
+	assert.Error(t, g.enableAutoMerge(&mr))
+}
@@ -1,12 +1,86 @@
 package mergerequest
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/sirupsen/logrus"
 	"github.com/updatecli/updatecli/pkg/core/reports"
+	utils "github.com/updatecli/updatecli/pkg/plugins/utils/action"
+	gitlabapi "gitlab.com/gitlab-org/api/client-go"
 )
 
-// CleanAction verifies if existing action requires some operations
-func (g *Gitlab) CleanAction(report *reports.Action) error {
-	logrus.Debugln("cleaning GitLab merge request is not yet supported. Feel free to open an issue to mark your interest.")
+// CleanAction closes an existing GitLab merge request if its working branch doesn't bring any changes anymore
+func (g *Gitlab) CleanAction(report *reports.Action, closeObsolete bool) error {
+	if g.scm == nil {
+		logrus.Debugln("no GitLab scm defined, nothing to clean")
+		return nil
+	}
+
+	mr, err := g.findExistingMR()
+	if err != nil {
+		return err
+	}
+
+	if mr == nil {
+		logrus.Debugln("nothing to clean")
+		return nil
+	}
+
+	// Without push, the remote working branch doesn't contain the local changes,
+	// so it can't be considered obsolete.
+	isObsolete := false
+	if closeObsolete {
+		isObsolete, err = g.scm.IsWorkingBranchObsolete()
+		if err != nil {
+			return fmt.Errorf("checking if merge request is obsolete: %w", err)
+		}
+	}
+
+	if !isObsolete {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), gitlabRequestTimeout)
+	defer cancel()
+
+	// Not returning an error if the comment failed to be added
+	// as the main purpose of this function is to close the merge request
+	_, _, err = g.api.Notes.CreateMergeRequestNote(
+		g.getPID(),
+		mr.IID,
+		&gitlabapi.CreateMergeRequestNoteOptions{
+			Body: gitlabapi.Ptr(utils.OBSOLETEPULLREQUESTCOMMENT),
+		},
+		gitlabapi.WithContext(ctx),
+	)
+	if err != nil {
+		logrus.Errorf("Commenting GitLab merge request: %s", err)
+	}
+
+	_, _, err = g.api.MergeRequests.UpdateMergeRequest(
+		g.getPID(),
+		mr.IID,
+		&gitlabapi.UpdateMergeRequestOptions{
+			StateEvent: gitlabapi.Ptr("close"),
+		},
+		gitlabapi.WithContext(ctx),
+	)
+	if err != nil {
+		return fmt.Errorf("closing obsolete GitLab merge request %s/%d: %w", g.getPID(), mr.IID, err)
+	}
+
+	logrus.Infof("GitLab merge request closed as obsolete at:\n\n\t%s\n\n", mr.WebURL)
+
+	report.Link = ""
+	report.Description = "Merge request closed as obsolete"
+
+	if g.spec.DeleteObsoleteBranch {
+		if err := g.scm.DeleteBranch(g.SourceBranch); err != nil {
+			return fmt.Errorf("deleting obsolete branch %q: %w", g.SourceBranch, err)
+		}
+		logrus.Infof("Branch %q deleted", g.SourceBranch)
+	}
+
 	return nil
 }
@@ -65,6 +65,12 @@ func (g *Gitlab) CreateAction(report *reports.Action, resetDescription bool) err
 			return fmt.Errorf("update GitLab merge request: %s", err.Error())
 		}
 
+		if g.spec.AutoMerge {
+			if err := g.enableAutoMerge(existingMR); err != nil {
+				logrus.Errorf("Auto-merge can't be enabled: %s", err)
+			}
+		}
+
 		return nil
 	}
 
@@ -134,5 +140,11 @@ func (g *Gitlab) CreateAction(report *reports.Action, resetDescription bool) err
 
 	logrus.Infof("GitLab mergerequest successfully opened on %q", mr.WebURL)
 
+	if g.spec.AutoMerge {
+		if err := g.enableAutoMerge(&mr.BasicMergeRequest); err != nil {
+			logrus.Errorf("Auto-merge can't be enabled: %s", err)
+		}
+	}
+
 	return nil
 }
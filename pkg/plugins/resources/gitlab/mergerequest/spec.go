@@ -105,6 +105,20 @@ type Spec struct {
 	//
 	// default: false
 	RemoveSourceBranch bool `yaml:",omitempty"`
+	// "automerge" defines if the merge request should be merged automatically once its pipeline succeeds
+	//
+	// default: false
+	//
+	// remark:
+	// 		the merge request is merged according to the "squash" and "removesourcebranch" settings.
+	// 		the merge method itself, such as merge commit or fast-forward, is defined by the project settings.
+	// 		depending on the GitLab version, a merge request without pipeline may be merged immediately.
+	AutoMerge bool `yaml:",omitempty"`
+	// "deleteobsoletebranch" defines if the working branch should be deleted once Updatecli closed its merge request
+	// because the working branch doesn't bring any changes anymore.
+	//
+	// default: false
+	DeleteObsoleteBranch bool `yaml:",omitempty"`
 	//
 	// 	"labels" defines labels for the merge request.
 	//
@@ -1,8 +1,25 @@
 package release
 
-import "github.com/updatecli/updatecli/pkg/core/result"
+import (
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/result"
+	scmChangelog "github.com/updatecli/updatecli/pkg/plugins/changelog/scm"
+)
 
-// Changelog returns the changelog for this resource, or an empty string if not supported
+// Changelog returns the description of the GitLab releases between two versions
 func (g *Gitlab) Changelog(from, to string) *result.Changelogs {
-	return nil
+	changelog := scmChangelog.Releases{
+		Client:        g.client,
+		Owner:         g.spec.Owner,
+		Repository:    g.spec.Repository,
+		VersionFilter: g.versionFilter,
+	}
+
+	releases, err := changelog.Search(from, to)
+	if err != nil {
+		logrus.Debugf("ignored error, searching GitLab releases: %s", err)
+		return nil
+	}
+
+	return &releases
 }
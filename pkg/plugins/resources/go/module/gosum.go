@@ -0,0 +1,256 @@
+package gomodule
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+	"golang.org/x/mod/sumdb/dirhash"
+)
+
+const (
+	// goSumDefaultFile is the default go.sum file updated by the target
+	goSumDefaultFile string = "go.sum"
+	// goModSuffix is the version suffix used by go.sum lines holding the go.mod checksum
+	goModSuffix string = "/go.mod"
+)
+
+// goSumLine is a go.sum entry such as "golang.org/x/mod v0.26.0/go.mod h1:..."
+type goSumLine struct {
+	Mod  module.Version
+	Hash string
+}
+
+// download retrieves a file from the first Go proxy serving it, such as "v1.0.0.mod" or "v1.0.0.zip"
+// https://go.dev/ref/mod#goproxy-protocol
+func (g *GoModule) download(file string) ([]byte, error) {
+	GOPROXY := g.goProxy()
+
+	for _, proxy := range strings.Split(GOPROXY, ",") {
+		if !isSupportedGoProxy(proxy) {
+			continue
+		}
+
+		URL, err := url.JoinPath(
+			sanitizeGoProxy(proxy),
+			sanitizeGoModuleNameForProxy(g.Spec.Module),
+			"@v", file)
+		if err != nil {
+			return nil, err
+		}
+
+		req, err := http.NewRequest("GET", URL, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := g.webClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := io.ReadAll(res.Body)
+		res.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		if res.StatusCode >= 400 {
+			logrus.Debugf("skipping proxy %q, %q returned %d", proxy, URL, res.StatusCode)
+			continue
+		}
+
+		return data, nil
+	}
+
+	return nil, fmt.Errorf("file %q for GO module %q not found on proxy %q", file, g.Spec.Module, GOPROXY)
+}
+
+// checksums returns the go.mod content, the go.mod checksum, and the module zip checksum of a module version,
+// as written in go.sum. Checksums are verified against the checksum database, like the go command does.
+func (g *GoModule) checksums(version string) (goMod []byte, goModHash, zipHash string, err error) {
+	escapedVersion, err := module.EscapeVersion(version)
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	goMod, err = g.download(escapedVersion + ".mod")
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	goModHash, err = dirhash.Hash1([]string{"go.mod"}, func(string) (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(goMod)), nil
+	})
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	zip, err := g.download(escapedVersion + ".zip")
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	zipFile, err := os.CreateTemp("", "updatecli-gomodule-*.zip")
+	if err != nil {
+		return nil, "", "", err
+	}
+	defer os.Remove(zipFile.Name())
+
+	_, err = zipFile.Write(zip)
+	zipFile.Close()
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	zipHash, err = dirhash.HashZip(zipFile.Name(), dirhash.Hash1)
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	if err := g.verifyChecksums(version, goModHash, zipHash); err != nil {
+		return nil, "", "", err
+	}
+
+	return goMod, goModHash, zipHash, nil
+}
+
+// checkModuleGraph ensures the go.mod file, next to the go.sum file, requires the module version
+// and every module required by it, so updating go.sum is enough to keep the module graph consistent.
+// In dry run mode, go.mod may not be updated yet by the target requiring the module version, so the validation is skipped.
+func (g *GoModule) checkModuleGraph(goSumFile, version string, goMod []byte, dryRun bool) error {
+	goModFile := filepath.Join(filepath.Dir(goSumFile), "go.mod")
+
+	content, err := os.ReadFile(goModFile)
+	if err != nil {
+		logrus.Debugf("skipping module graph validation: %s", err)
+		return nil
+	}
+
+	mainModule, err := modfile.ParseLax(goModFile, content, nil)
+	if err != nil {
+		return fmt.Errorf("parsing %q: %w", goModFile, err)
+	}
+
+	required := map[string]string{}
+	for _, r := range mainModule.Require {
+		required[r.Mod.Path] = r.Mod.Version
+	}
+
+	if required[g.Spec.Module] != version {
+		if dryRun {
+			logrus.Infof("%q doesn't require module %q version %q yet, skipping module graph validation in dry run mode",
+				goModFile, g.Spec.Module, version)
+			return nil
+		}
+		return fmt.Errorf("%q requires module %q version %q instead of %q, go.mod must be updated first",
+			goModFile, g.Spec.Module, required[g.Spec.Module], version)
+	}
+
+	dependency, err := modfile.ParseLax(g.Spec.Module+"@"+version+goModSuffix, goMod, nil)
+	if err != nil {
+		return fmt.Errorf("parsing go.mod of %s@%s: %w", g.Spec.Module, version, err)
+	}
+
+	for _, r := range dependency.Require {
+		current, ok := required[r.Mod.Path]
+		if !ok || semver.Compare(current, r.Mod.Version) < 0 {
+			return fmt.Errorf("module %s@%s requires %s@%s not selected by %q, the module graph must be updated using \"go mod tidy\"",
+				g.Spec.Module, version, r.Mod.Path, r.Mod.Version, goModFile)
+		}
+	}
+
+	return nil
+}
+
+// parseGoSum parses the content of a go.sum file
+func parseGoSum(content string) ([]goSumLine, error) {
+	var lines []goSumLine
+
+	for i, line := range strings.Split(content, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("malformed go.sum line %d: %q", i+1, line)
+		}
+
+		lines = append(lines, goSumLine{
+			Mod:  module.Version{Path: fields[0], Version: fields[1]},
+			Hash: fields[2],
+		})
+	}
+
+	return lines, nil
+}
+
+// updateGoSum replaces the module zip checksum of other versions by the checksums of version.
+// The go.mod checksums of other versions are kept as they may still be needed to load the module graph.
+// If go.sum only holds go.mod checksums for the module, as its packages aren't built, then only the go.mod checksum is added.
+// It returns the new go.sum content and the versions previously used.
+func (g *GoModule) updateGoSum(content, version, goModHash, zipHash string) (string, []string, error) {
+	lines, err := parseGoSum(content)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var oldVersions, oldGoModVersions []string
+	var mods []module.Version
+	hashes := map[module.Version]string{}
+
+	add := func(m module.Version, hash string) {
+		if _, ok := hashes[m]; ok {
+			return
+		}
+		hashes[m] = hash
+		mods = append(mods, m)
+	}
+
+	for _, line := range lines {
+		if line.Mod.Path == g.Spec.Module {
+			if v, ok := strings.CutSuffix(line.Mod.Version, goModSuffix); ok {
+				oldGoModVersions = append(oldGoModVersions, v)
+			} else {
+				oldVersions = append(oldVersions, line.Mod.Version)
+				continue
+			}
+		}
+		add(line.Mod, line.Hash)
+	}
+
+	if len(oldVersions) == 0 && len(oldGoModVersions) == 0 {
+		return "", nil, fmt.Errorf("module %q not found in go.sum", g.Spec.Module)
+	}
+
+	goModVersion := module.Version{Path: g.Spec.Module, Version: version + goModSuffix}
+	if hash, ok := hashes[goModVersion]; ok && hash != goModHash {
+		return "", nil, fmt.Errorf("checksum mismatch for %s %s: go.sum has %q, proxy returned %q",
+			goModVersion.Path, goModVersion.Version, hash, goModHash)
+	}
+
+	if len(oldVersions) > 0 {
+		add(module.Version{Path: g.Spec.Module, Version: version}, zipHash)
+	} else {
+		oldVersions = oldGoModVersions
+	}
+	add(goModVersion, goModHash)
+
+	module.Sort(mods)
+
+	var b strings.Builder
+	for _, m := range mods {
+		fmt.Fprintf(&b, "%s %s %s\n", m.Path, m.Version, hashes[m])
+	}
+
+	return b.String(), oldVersions, nil
+}
@@ -58,6 +58,7 @@ func (g *GoModule) ReportConfig() interface{} {
 		Proxy:         redact.URL(g.Spec.Proxy),
 		Module:        g.Spec.Module,
 		Version:       g.Spec.Version,
+		File:          g.Spec.File,
 		VersionFilter: g.Spec.VersionFilter,
 	}
 }
@@ -8,12 +8,16 @@ import (
 // parsed from an updatecli manifest file
 type Spec struct {
 	// Proxy may have the schemes https, http. file is not supported at this time. If a URL has no scheme, https is assumed
-	// [S][C] Proxy allows to override GO proxy similarly to GOPROXY environment variable.
+	// [S][C][T] Proxy allows to override GO proxy similarly to GOPROXY environment variable.
 	Proxy string `yaml:",omitempty"`
-	// [S][C] Module specifies the name of the module
+	// [S][C][T] Module specifies the name of the module
 	Module string `yaml:",omitempty" jsonschema:"required"`
-	// [C] Defines a specific package version
+	// [C][T] Defines a specific package version
 	Version string `yaml:",omitempty"`
+	// [T] File defines the go.sum file to update with the module checksums, default to "go.sum".
+	// The go.mod file located in the same directory must already require the module version.
+	// Checksums are verified using the checksum database defined by GOSUMDB, unless the module matches GONOSUMDB or GOPRIVATE.
+	File string `yaml:",omitempty"`
 	// [S] VersionFilter provides parameters to specify version pattern and its type like regex, semver, or just latest.
 	VersionFilter version.Filter `yaml:",omitempty"`
 }
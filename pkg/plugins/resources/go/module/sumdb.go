@@ -0,0 +1,198 @@
+package gomodule
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/httpclient"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/sumdb"
+	"golang.org/x/mod/sumdb/note"
+)
+
+const (
+	// goSumDBDefault is the checksum database used when GOSUMDB isn't set
+	goSumDBDefault string = "sum.golang.org"
+	// goSumDBOff is the GOSUMDB value disabling the checksum database
+	goSumDBOff string = "off"
+)
+
+// knownGoSumDB maps the checksum database names known by the go command to their verifier key
+var knownGoSumDB = map[string]string{
+	"sum.golang.org": "sum.golang.org+033de0ae+Ac4zctda0e5eza+HJyk9SxEdh+s3Ux18htTTAD8OuAn8",
+}
+
+// goSumDB implements sumdb.ClientOps, keeping the checksum database state in memory
+type goSumDB struct {
+	url       string
+	key       string
+	webClient httpclient.HTTPClient
+
+	mu     sync.Mutex
+	config map[string][]byte
+}
+
+// newGoSumDB returns the checksum database configured by GOSUMDB, or nil if it is disabled.
+// https://go.dev/ref/mod#checksum-database
+func newGoSumDB(webClient httpclient.HTTPClient) (*goSumDB, error) {
+	GOSUMDB := os.Getenv("GOSUMDB")
+	switch GOSUMDB {
+	case "":
+		GOSUMDB = goSumDBDefault
+	case goSumDBOff:
+		return nil, nil
+	case "sum.golang.google.cn":
+		GOSUMDB = "sum.golang.org https://sum.golang.google.cn"
+	}
+
+	fields := strings.Fields(GOSUMDB)
+	if len(fields) == 0 || len(fields) > 2 {
+		return nil, fmt.Errorf("invalid GOSUMDB %q", GOSUMDB)
+	}
+
+	key := fields[0]
+	if known, ok := knownGoSumDB[key]; ok {
+		key = known
+	}
+
+	verifier, err := note.NewVerifier(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid GOSUMDB key %q: %w", key, err)
+	}
+
+	URL := "https://" + verifier.Name()
+	if len(fields) == 2 {
+		u, err := url.Parse(fields[1])
+		if err != nil || u.Scheme == "" {
+			return nil, fmt.Errorf("invalid GOSUMDB url %q", fields[1])
+		}
+		URL = strings.TrimSuffix(fields[1], "/")
+	}
+
+	return &goSumDB{
+		url:       URL,
+		key:       key,
+		webClient: webClient,
+		config:    map[string][]byte{},
+	}, nil
+}
+
+// ReadRemote retrieves a lookup or tile file from the checksum database
+func (s *goSumDB) ReadRemote(path string) ([]byte, error) {
+	req, err := http.NewRequest("GET", s.url+path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.webClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s%s returned %d: %s", s.url, path, res.StatusCode, strings.TrimSpace(string(data)))
+	}
+
+	return data, nil
+}
+
+// ReadConfig returns the checksum database key, or the latest signed tree seen during this run
+func (s *goSumDB) ReadConfig(file string) ([]byte, error) {
+	if file == "key" {
+		return []byte(s.key), nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.config[file], nil
+}
+
+// WriteConfig stores the latest signed tree
+func (s *goSumDB) WriteConfig(file string, old, new []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if string(s.config[file]) != string(old) {
+		return sumdb.ErrWriteConflict
+	}
+	s.config[file] = new
+
+	return nil
+}
+
+// ReadCache always misses, as checksum database records aren't cached between runs
+func (s *goSumDB) ReadCache(file string) ([]byte, error) {
+	return nil, os.ErrNotExist
+}
+
+// WriteCache is a no-op, as checksum database records aren't cached between runs
+func (s *goSumDB) WriteCache(file string, data []byte) {}
+
+// Log prints checksum database client messages
+func (s *goSumDB) Log(msg string) {
+	logrus.Debugln(msg)
+}
+
+// SecurityError prints checksum database inconsistencies, Lookup then returns sumdb.ErrSecurity
+func (s *goSumDB) SecurityError(msg string) {
+	logrus.Errorln(msg)
+}
+
+// verifyChecksums ensures the go.mod and module zip checksums are the ones recorded by the checksum database,
+// unless the module is excluded by GONOSUMDB or GOPRIVATE, or the checksum database is disabled using GOSUMDB=off.
+func (g *GoModule) verifyChecksums(version, goModHash, zipHash string) error {
+	db, err := newGoSumDB(g.webClient)
+	if err != nil {
+		return err
+	}
+
+	if db == nil {
+		logrus.Debugf("checksum database disabled, skipping %s@%s checksums verification", g.Spec.Module, version)
+		return nil
+	}
+
+	GONOSUMDB := os.Getenv("GONOSUMDB")
+	if GONOSUMDB == "" {
+		GONOSUMDB = os.Getenv("GOPRIVATE")
+	}
+
+	client := sumdb.NewClient(db)
+	client.SetGONOSUMDB(GONOSUMDB)
+
+	for _, line := range []goSumLine{
+		{Mod: module.Version{Path: g.Spec.Module, Version: version + goModSuffix}, Hash: goModHash},
+		{Mod: module.Version{Path: g.Spec.Module, Version: version}, Hash: zipHash},
+	} {
+		lines, err := client.Lookup(line.Mod.Path, line.Mod.Version)
+		if errors.Is(err, sumdb.ErrGONOSUMDB) {
+			logrus.Debugf("module %q matches GONOSUMDB, skipping checksums verification", g.Spec.Module)
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("verifying checksums using %s: %w", db.url, err)
+		}
+
+		expected := fmt.Sprintf("%s %s %s", line.Mod.Path, line.Mod.Version, line.Hash)
+		if !slices.Contains(lines, expected) {
+			return fmt.Errorf("checksum mismatch for %s %s: proxy returned %q, checksum database has %q",
+				line.Mod.Path, line.Mod.Version, line.Hash, strings.Join(lines, ", "))
+		}
+	}
+
+	return nil
+}
@@ -2,12 +2,81 @@ package gomodule
 
 import (
 	"fmt"
+	"os"
+	"strings"
 
+	"github.com/sirupsen/logrus"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 	"github.com/updatecli/updatecli/pkg/core/result"
+	"github.com/updatecli/updatecli/pkg/plugins/utils"
 )
 
-// Target is not support for gomodule
-func (g *GoModule) Target(source string, scm scm.ScmHandler, dryRun bool, releaseTarget *result.Target) error {
-	return fmt.Errorf("Target not supported for the plugin GO module")
+// Target updates the go.sum file with the checksums of the module version, retrieved from the Go proxy
+// and verified using the checksum database.
+// It doesn't update go.mod, which should be done first using the "golang/gomod" target.
+func (g *GoModule) Target(source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) error {
+	version := g.Spec.Version
+	if version == "" {
+		version = source
+	}
+	if version == "" {
+		return fmt.Errorf("no version defined")
+	}
+
+	filename := goSumDefaultFile
+	if g.Spec.File != "" {
+		filename = strings.TrimPrefix(g.Spec.File, "file://")
+	}
+	if scm != nil {
+		filename = utils.JoinFilePathWithWorkingDirectoryPath(filename, scm.GetDirectory())
+	}
+
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		return fmt.Errorf("reading %q: %w", filename, err)
+	}
+
+	goMod, goModHash, zipHash, err := g.checksums(version)
+	if err != nil {
+		return fmt.Errorf("retrieving checksums for %s@%s: %w", g.Spec.Module, version, err)
+	}
+
+	if err := g.checkModuleGraph(filename, version, goMod, dryRun); err != nil {
+		return err
+	}
+
+	newContent, oldVersions, err := g.updateGoSum(string(content), version, goModHash, zipHash)
+	if err != nil {
+		return fmt.Errorf("updating %q: %w", filename, err)
+	}
+
+	resultTarget.Information = strings.Join(oldVersions, ", ")
+	resultTarget.NewInformation = version
+
+	if newContent == string(content) {
+		resultTarget.Result = result.SUCCESS
+		resultTarget.Description = fmt.Sprintf("%s already has checksums for module %q version %q", filename, g.Spec.Module, version)
+		return nil
+	}
+
+	resultTarget.Changed = true
+	resultTarget.Result = result.ATTENTION
+
+	if dryRun {
+		resultTarget.Description = fmt.Sprintf("%s should update checksums for module %q from %q to %q",
+			filename, g.Spec.Module, resultTarget.Information, version)
+		return nil
+	}
+
+	if err := os.WriteFile(filename, []byte(newContent), 0o600); err != nil {
+		return fmt.Errorf("writing %q: %w", filename, err)
+	}
+
+	logrus.Debugf("%q updated\n", filename)
+
+	resultTarget.Files = append(resultTarget.Files, filename)
+	resultTarget.Description = fmt.Sprintf("%s updated checksums for module %q from %q to %q",
+		filename, g.Spec.Module, resultTarget.Information, version)
+
+	return nil
 }
@@ -0,0 +1,305 @@
+package gomodule
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/core/result"
+	"golang.org/x/mod/sumdb"
+	"golang.org/x/mod/sumdb/dirhash"
+	"golang.org/x/mod/sumdb/note"
+)
+
+// testModules maps the module versions served by the test proxy to their go.mod content
+var testModules = map[string]string{
+	"v1.0.0": "module example.com/Foo\n\ngo 1.22\n\nrequire example.com/bar v1.0.0\n",
+	"v1.1.0": "module example.com/Foo\n\ngo 1.22\n\nrequire example.com/bar v1.0.0\n",
+	"v1.2.0": "module example.com/Foo\n\ngo 1.22\n\nrequire example.com/bar v1.1.0\n",
+}
+
+// newTestProxy returns a Go proxy stand-in serving the module "example.com/Foo"
+func newTestProxy(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	for version, goMod := range testModules {
+		mux.HandleFunc(fmt.Sprintf("/example.com/!foo/@v/%s.mod", version), func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(goMod))
+		})
+
+		mux.HandleFunc(fmt.Sprintf("/example.com/!foo/@v/%s.zip", version), func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write(testModuleZip(t, version))
+		})
+	}
+
+	return server
+}
+
+// testModuleZip returns the zip archive of a module version served by the test proxy
+func testModuleZip(t *testing.T, version string) []byte {
+	t.Helper()
+
+	buf := bytes.Buffer{}
+	archive := zip.NewWriter(&buf)
+	for name, content := range map[string]string{
+		"go.mod": testModules[version],
+		"foo.go": "package foo\n",
+	} {
+		f, err := archive.Create(fmt.Sprintf("example.com/!foo@%s/%s", version, name))
+		require.NoError(t, err)
+		_, err = f.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, archive.Close())
+
+	return buf.Bytes()
+}
+
+// newTestSumDB starts a checksum database stand-in recording the checksums of the test proxy modules,
+// then configures GOSUMDB to use it. If tampered is true, it records wrong module zip checksums.
+func newTestSumDB(t *testing.T, tampered bool) {
+	t.Helper()
+
+	skey, vkey, err := note.GenerateKey(rand.Reader, "sum.example.com")
+	require.NoError(t, err)
+
+	server := httptest.NewServer(sumdb.NewServer(sumdb.NewTestServer(skey, func(path, version string) ([]byte, error) {
+		goMod, ok := testModules[version]
+		if path != "example.com/Foo" || !ok {
+			return nil, fmt.Errorf("module %s@%s not found", path, version)
+		}
+
+		goModHash, err := dirhash.Hash1([]string{"go.mod"}, func(string) (io.ReadCloser, error) {
+			return io.NopCloser(strings.NewReader(goMod)), nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		zipFile := filepath.Join(t.TempDir(), "module.zip")
+		if err := os.WriteFile(zipFile, testModuleZip(t, version), 0o600); err != nil {
+			return nil, err
+		}
+		zipHash, err := dirhash.HashZip(zipFile, dirhash.Hash1)
+		if err != nil {
+			return nil, err
+		}
+		if tampered {
+			zipHash = "h1:47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU="
+		}
+
+		return []byte(fmt.Sprintf("%s %s %s\n%s %s/go.mod %s\n", path, version, zipHash, path, version, goModHash)), nil
+	})))
+	t.Cleanup(server.Close)
+
+	t.Setenv("GOSUMDB", vkey+" "+server.URL)
+	t.Setenv("GONOSUMDB", "")
+	t.Setenv("GOPRIVATE", "")
+}
+
+func TestTarget(t *testing.T) {
+	proxy := newTestProxy(t)
+	newTestSumDB(t, false)
+
+	goSum := `example.com/Foo v1.0.0 h1:xk9MndEZSryKevVXY7TAZ+88zC20T5ah4Hls+0OyDMQ=
+example.com/Foo v1.0.0/go.mod h1:LrfhMCj/qpX6SPe0VQLiCXFnrZegouklYK39yuODoB0=
+example.com/bar v1.0.0 h1:bb5u6fCz4EmC0lL9H17sRYJQvY4fvh+DdoxJ40DQ2X4=
+example.com/bar v1.0.0/go.mod h1:fa+d7iJ6AJBGnrJL37yDXTbmCIxs/c5RD2FlC9a/i3k=
+`
+
+	tests := []struct {
+		name            string
+		goMod           string
+		goSum           string
+		version         string
+		dryRun          bool
+		expectedGoSum   string
+		expectedChanged bool
+		expectedError   bool
+	}{
+		{
+			name:    "Update module checksums",
+			goMod:   "module example.com/app\n\ngo 1.22\n\nrequire (\n\texample.com/Foo v1.1.0\n\texample.com/bar v1.0.0\n)\n",
+			goSum:   goSum,
+			version: "v1.1.0",
+			expectedGoSum: `example.com/Foo v1.0.0/go.mod h1:LrfhMCj/qpX6SPe0VQLiCXFnrZegouklYK39yuODoB0=
+example.com/Foo v1.1.0 h1:hl1u/aSsLPxnbF6wxDesvmPIBuGjCIdtIhDq/3UEmT8=
+example.com/Foo v1.1.0/go.mod h1:LrfhMCj/qpX6SPe0VQLiCXFnrZegouklYK39yuODoB0=
+example.com/bar v1.0.0 h1:bb5u6fCz4EmC0lL9H17sRYJQvY4fvh+DdoxJ40DQ2X4=
+example.com/bar v1.0.0/go.mod h1:fa+d7iJ6AJBGnrJL37yDXTbmCIxs/c5RD2FlC9a/i3k=
+`,
+			expectedChanged: true,
+		},
+		{
+			name:            "Checksums already up to date",
+			goMod:           "module example.com/app\n\ngo 1.22\n\nrequire (\n\texample.com/Foo v1.0.0\n\texample.com/bar v1.0.0\n)\n",
+			goSum:           goSum,
+			version:         "v1.0.0",
+			expectedGoSum:   goSum,
+			expectedChanged: false,
+		},
+		{
+			name:          "go.mod not updated",
+			goMod:         "module example.com/app\n\ngo 1.22\n\nrequire (\n\texample.com/Foo v1.0.0\n\texample.com/bar v1.0.0\n)\n",
+			goSum:         goSum,
+			version:       "v1.1.0",
+			expectedError: true,
+		},
+		{
+			name:            "go.mod not updated yet in dry run mode",
+			goMod:           "module example.com/app\n\ngo 1.22\n\nrequire (\n\texample.com/Foo v1.0.0\n\texample.com/bar v1.0.0\n)\n",
+			goSum:           goSum,
+			version:         "v1.1.0",
+			dryRun:          true,
+			expectedGoSum:   goSum,
+			expectedChanged: true,
+		},
+		{
+			name:          "Module requires a newer transitive dependency",
+			goMod:         "module example.com/app\n\ngo 1.22\n\nrequire (\n\texample.com/Foo v1.2.0\n\texample.com/bar v1.0.0\n)\n",
+			goSum:         goSum,
+			version:       "v1.2.0",
+			expectedError: true,
+		},
+		{
+			name:          "Module not in go.sum",
+			goMod:         "module example.com/app\n\ngo 1.22\n\nrequire example.com/Foo v1.1.0\n",
+			goSum:         "example.com/bar v1.0.0/go.mod h1:fa+d7iJ6AJBGnrJL37yDXTbmCIxs/c5RD2FlC9a/i3k=\n",
+			version:       "v1.1.0",
+			expectedError: true,
+		},
+		{
+			name:          "Unknown version",
+			goMod:         "module example.com/app\n\ngo 1.22\n\nrequire example.com/Foo v9.9.9\n",
+			goSum:         goSum,
+			version:       "v9.9.9",
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			goSumFile := filepath.Join(dir, "go.sum")
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte(tt.goMod), 0o600))
+			require.NoError(t, os.WriteFile(goSumFile, []byte(tt.goSum), 0o600))
+
+			g, err := New(Spec{
+				Proxy:  proxy.URL,
+				Module: "example.com/Foo",
+				File:   goSumFile,
+			})
+			require.NoError(t, err)
+
+			gotResult := result.Target{}
+			err = g.Target(tt.version, nil, tt.dryRun, &gotResult)
+			if tt.expectedError {
+				require.Error(t, err)
+
+				// go.sum is left untouched, so "go mod tidy" can still be used to update the module graph
+				got, err := os.ReadFile(goSumFile)
+				require.NoError(t, err)
+				assert.Equal(t, tt.goSum, string(got))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedChanged, gotResult.Changed)
+
+			got, err := os.ReadFile(goSumFile)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedGoSum, string(got))
+		})
+	}
+}
+
+func TestTargetChecksumDatabase(t *testing.T) {
+	proxy := newTestProxy(t)
+
+	_, unreachableKey, err := note.GenerateKey(rand.Reader, "sum.example.com")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		tampered      bool
+		env           map[string]string
+		expectedError bool
+	}{
+		{
+			name:          "Checksum mismatch",
+			tampered:      true,
+			expectedError: true,
+		},
+		{
+			name:          "Checksum database unreachable",
+			env:           map[string]string{"GOSUMDB": unreachableKey + " http://127.0.0.1:1"},
+			expectedError: true,
+		},
+		{
+			name:          "Invalid checksum database",
+			env:           map[string]string{"GOSUMDB": "sum.example.com"},
+			expectedError: true,
+		},
+		{
+			name: "Checksum database disabled",
+			env:  map[string]string{"GOSUMDB": "off"},
+		},
+		{
+			name: "Module excluded by GONOSUMDB",
+			env: map[string]string{
+				"GOSUMDB":   unreachableKey + " http://127.0.0.1:1",
+				"GONOSUMDB": "example.com",
+			},
+		},
+		{
+			name: "Private module",
+			env: map[string]string{
+				"GOSUMDB":   unreachableKey + " http://127.0.0.1:1",
+				"GOPRIVATE": "example.com/Foo",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newTestSumDB(t, tt.tampered)
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+
+			dir := t.TempDir()
+			goSumFile := filepath.Join(dir, "go.sum")
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/app\n\ngo 1.22\n\nrequire (\n\texample.com/Foo v1.1.0\n\texample.com/bar v1.0.0\n)\n"), 0o600))
+			require.NoError(t, os.WriteFile(goSumFile, []byte("example.com/Foo v1.0.0 h1:xk9MndEZSryKevVXY7TAZ+88zC20T5ah4Hls+0OyDMQ=\nexample.com/Foo v1.0.0/go.mod h1:LrfhMCj/qpX6SPe0VQLiCXFnrZegouklYK39yuODoB0=\n"), 0o600))
+
+			g, err := New(Spec{
+				Proxy:  proxy.URL,
+				Module: "example.com/Foo",
+				File:   goSumFile,
+			})
+			require.NoError(t, err)
+
+			gotResult := result.Target{}
+			err = g.Target("v1.1.0", nil, false, &gotResult)
+			if tt.expectedError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, gotResult.Changed)
+		})
+	}
+}
@@ -13,17 +13,20 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-// GetVersions fetch all versions of a Golang module
-func (g *GoModule) versions() (v string, versions []string, err error) {
-
-	var GOPROXY string
+// goProxy returns the comma separated list of Go proxies, similarly to the GOPROXY environment variable
+func (g *GoModule) goProxy() string {
 	if g.Spec.Proxy != "" {
-		GOPROXY = g.Spec.Proxy
+		return g.Spec.Proxy
 	} else if os.Getenv("GOPROXY") != "" {
-		GOPROXY = os.Getenv("GOPROXY")
-	} else {
-		GOPROXY = goModuleDefaultProxy
+		return os.Getenv("GOPROXY")
 	}
+	return goModuleDefaultProxy
+}
+
+// GetVersions fetch all versions of a Golang module
+func (g *GoModule) versions() (v string, versions []string, err error) {
+
+	GOPROXY := g.goProxy()
 
 	for _, proxy := range strings.Split(GOPROXY, ",") {
 		if !isSupportedGoProxy(proxy) {
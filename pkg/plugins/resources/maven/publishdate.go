@@ -0,0 +1,58 @@
+package maven
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/httpclient"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/redact"
+)
+
+// PublishDate returns the date the artifact version was published,
+// based on the last modification date of its pom file on the first repository providing it.
+func (m *Maven) PublishDate(version string) (time.Time, error) {
+	client := httpclient.NewRetryClient()
+
+	for _, metadataHandler := range m.metadataHandlers {
+		pomURL := fmt.Sprintf("%s%s/%s-%s.pom",
+			strings.TrimSuffix(metadataHandler.GetMetadataURL(), "maven-metadata.xml"),
+			version,
+			m.spec.ArtifactID,
+			version,
+		)
+
+		req, err := http.NewRequest(http.MethodHead, pomURL, nil)
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		res, err := client.Do(req)
+		if err != nil {
+			logrus.Debugf("retrieving Maven artifact publication date from %s: %s", redact.URL(pomURL), err)
+			continue
+		}
+		res.Body.Close()
+
+		if res.StatusCode >= 400 {
+			logrus.Debugf("retrieving Maven artifact publication date from %s: unexpected status code %d", redact.URL(pomURL), res.StatusCode)
+			continue
+		}
+
+		lastModified := res.Header.Get("Last-Modified")
+		if lastModified == "" {
+			continue
+		}
+
+		date, err := http.ParseTime(lastModified)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("parsing Maven artifact last modification date %q: %w", lastModified, err)
+		}
+
+		return date, nil
+	}
+
+	return time.Time{}, fmt.Errorf("no publication date found for the Maven artifact %s/%s version %s", m.spec.GroupID, m.spec.ArtifactID, version)
+}
@@ -0,0 +1,38 @@
+package maven
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublishDate(t *testing.T) {
+	published := time.Date(2024, time.October, 1, 12, 0, 0, 0, time.UTC)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodHead || r.URL.Path != "/maven2/org/example/app/1.2.0/app-1.2.0.pom" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Last-Modified", published.Format(http.TimeFormat))
+	}))
+	defer server.Close()
+
+	m, err := New(Spec{
+		Repository: server.URL + "/maven2",
+		GroupID:    "org.example",
+		ArtifactID: "app",
+	})
+	require.NoError(t, err)
+
+	got, err := m.PublishDate("1.2.0")
+	require.NoError(t, err)
+	assert.True(t, published.Equal(got))
+
+	_, err = m.PublishDate("9.9.9")
+	assert.Error(t, err)
+}
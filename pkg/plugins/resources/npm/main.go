@@ -36,6 +36,10 @@ type Spec struct {
 	VersionFilter version.Filter `yaml:",omitempty"`
 	// NpmrcPath defines the path to the .npmrc file
 	NpmrcPath string `yaml:"npmrcpath,omitempty"`
+	// File defines the package-lock.json file updated by the target, default to "package-lock.json".
+	// Only lockfile version 3 is supported, and the package.json file located in the same directory
+	// must already require a version range matching the new version.
+	File string `yaml:",omitempty"`
 }
 
 type distTags struct {
@@ -56,6 +60,8 @@ type Data struct {
 	Versions        map[string]versions `json:"versions,omitempty"`
 	DistTags        distTags            `json:"dist-tags,omitempty"`
 	Repository      Repository
+	// Time maps each version to its publication date
+	Time map[string]string `json:"time,omitempty"`
 }
 
 type Repository struct {
@@ -213,24 +219,25 @@ func (n *Npm) getVersions() (v string, versions []string, err error) {
 	return n.foundVersion.GetVersion(), versions, nil
 }
 
-// Get package data from Json API
-func (n *Npm) getPackageData(packageName string) (Data, error) {
-	var d Data
-	var registry Registry
-	// We need to find the registry URL to use for the package
+// getRegistry returns the registry to use for the package
+func (n *Npm) getRegistry(packageName string) Registry {
 	if strings.HasPrefix(packageName, "@") {
 		// Scoped package
 		if scope, ok := n.rcConfig.Scopes[packageName[:strings.Index(packageName, "/")]]; ok {
 			// We found a scope registry for the package
-			registry = n.rcConfig.Registries[scope]
-		} else {
-			// We didn't find a scope for the package, we use the default registry
-			registry = n.rcConfig.Registries["default"]
+			return n.rcConfig.Registries[scope]
 		}
-	} else {
-		// Not a scoped package, using default registry
-		registry = n.rcConfig.Registries["default"]
+		// We didn't find a scope for the package, we use the default registry
+		return n.rcConfig.Registries["default"]
 	}
+	// Not a scoped package, using default registry
+	return n.rcConfig.Registries["default"]
+}
+
+// Get package data from Json API
+func (n *Npm) getPackageData(packageName string) (Data, error) {
+	var d Data
+	registry := n.getRegistry(packageName)
 
 	URL := fmt.Sprintf("%s%s", registry.Url, packageName)
 
@@ -347,5 +354,6 @@ func (n *Npm) ReportConfig() interface{} {
 		URL:           redact.URL(n.spec.URL),
 		VersionFilter: n.spec.VersionFilter,
 		NpmrcPath:     n.spec.NpmrcPath,
+		File:          n.spec.File,
 	}
 }
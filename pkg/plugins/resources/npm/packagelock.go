@@ -0,0 +1,658 @@
+package npm
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// packageLockDefaultFile is the default lockfile updated by the target
+	packageLockDefaultFile string = "package-lock.json"
+	// packageLockVersion is the only lockfileVersion supported by the target
+	packageLockVersion int = 3
+)
+
+var (
+	// lockManifestFields lists the package-lock.json entry fields copied from the package manifest
+	lockManifestFields = []string{
+		"bin",
+		"cpu",
+		"dependencies",
+		"deprecated",
+		"engines",
+		"funding",
+		"hasInstallScript",
+		"license",
+		"optionalDependencies",
+		"os",
+		"peerDependencies",
+		"peerDependenciesMeta",
+	}
+	// lockLeadingFields lists the package-lock.json entry fields always written first, in that order
+	lockLeadingFields = []string{"name", "version", "resolved", "integrity"}
+	// installScripts lists the package scripts run by npm during the installation
+	installScripts = []string{"preinstall", "install", "postinstall"}
+	// rootDependencyFields lists the package.json fields whose version ranges are recorded in package-lock.json
+	rootDependencyFields = []string{"dependencies", "devDependencies", "optionalDependencies", "peerDependencies"}
+	// lockIndentation matches the indentation of the first indented line of a JSON file
+	lockIndentation = regexp.MustCompile(`\n([ \t]+)\S`)
+)
+
+// packageManifest holds the package version manifest fields needed to update package-lock.json
+type packageManifest struct {
+	Version string `json:"version"`
+	Dist    struct {
+		Tarball   string `json:"tarball"`
+		Integrity string `json:"integrity"`
+	} `json:"dist"`
+	Dependencies         map[string]string `json:"dependencies"`
+	OptionalDependencies map[string]string `json:"optionalDependencies"`
+	PeerDependencies     map[string]string `json:"peerDependencies"`
+	PeerDependenciesMeta map[string]struct {
+		Optional bool `json:"optional"`
+	} `json:"peerDependenciesMeta"`
+}
+
+// lockPackage holds the package-lock.json "packages" entry fields needed to validate the dependency tree
+type lockPackage struct {
+	Version              string            `json:"version"`
+	Link                 bool              `json:"link"`
+	Dependencies         map[string]string `json:"dependencies"`
+	DevDependencies      map[string]string `json:"devDependencies"`
+	OptionalDependencies map[string]string `json:"optionalDependencies"`
+	PeerDependencies     map[string]string `json:"peerDependencies"`
+}
+
+// jsonObject is a JSON object preserving the order of its keys, so a JSON file can be updated
+// without reordering it
+type jsonObject struct {
+	keys   []string
+	values map[string]json.RawMessage
+}
+
+// UnmarshalJSON parses a JSON object, keeping its keys order
+func (o *jsonObject) UnmarshalJSON(data []byte) error {
+	o.keys = nil
+	o.values = map[string]json.RawMessage{}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+
+	t, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	if t != json.Delim('{') {
+		return fmt.Errorf("expected JSON object but got %v", t)
+	}
+
+	for decoder.More() {
+		t, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+
+		key, ok := t.(string)
+		if !ok {
+			return fmt.Errorf("expected string key but got: %T", t)
+		}
+
+		var value json.RawMessage
+		if err := decoder.Decode(&value); err != nil {
+			return err
+		}
+
+		o.set(key, value)
+	}
+
+	_, err = decoder.Token()
+	return err
+}
+
+// MarshalJSON returns the compact JSON object, without escaping HTML characters
+func (o jsonObject) MarshalJSON() ([]byte, error) {
+	b := bytes.Buffer{}
+	b.WriteByte('{')
+
+	for i, key := range o.keys {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+
+		k, err := marshalJSON(key)
+		if err != nil {
+			return nil, err
+		}
+
+		b.Write(k)
+		b.WriteByte(':')
+		b.Write(o.values[key])
+	}
+
+	b.WriteByte('}')
+
+	return b.Bytes(), nil
+}
+
+// get returns the value of key
+func (o jsonObject) get(key string) (json.RawMessage, bool) {
+	value, ok := o.values[key]
+	return value, ok
+}
+
+// set updates the value of key, keeping its position, or appends key if it doesn't exist
+func (o *jsonObject) set(key string, value json.RawMessage) {
+	if o.values == nil {
+		o.values = map[string]json.RawMessage{}
+	}
+	if _, ok := o.values[key]; !ok {
+		o.keys = append(o.keys, key)
+	}
+	o.values[key] = value
+}
+
+// remove deletes key
+func (o *jsonObject) remove(key string) {
+	if _, ok := o.values[key]; !ok {
+		return
+	}
+	delete(o.values, key)
+	for i, k := range o.keys {
+		if k == key {
+			o.keys = append(o.keys[:i], o.keys[i+1:]...)
+			return
+		}
+	}
+}
+
+// getObject returns the JSON object value of key
+func (o jsonObject) getObject(key string) (jsonObject, bool, error) {
+	var object jsonObject
+
+	value, ok := o.get(key)
+	if !ok {
+		return object, false, nil
+	}
+
+	if err := json.Unmarshal(value, &object); err != nil {
+		return object, true, fmt.Errorf("parsing %q: %w", key, err)
+	}
+
+	return object, true, nil
+}
+
+// setObject updates the JSON object value of key
+func (o *jsonObject) setObject(key string, object jsonObject) error {
+	value, err := object.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	o.set(key, value)
+	return nil
+}
+
+// marshalJSON returns the JSON encoding of v, without escaping HTML characters as npm doesn't
+func marshalJSON(v any) ([]byte, error) {
+	b := bytes.Buffer{}
+	encoder := json.NewEncoder(&b)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(b.Bytes(), []byte("\n")), nil
+}
+
+// getPackageManifest returns the manifest of a package version, from the registry
+func (n *Npm) getPackageManifest(packageName, packageVersion string) (json.RawMessage, error) {
+	registry := n.getRegistry(packageName)
+
+	URL := fmt.Sprintf("%s%s", registry.Url, packageName)
+
+	req, err := http.NewRequest("GET", URL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if registry.AuthToken != "" {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", registry.AuthToken))
+	}
+
+	res, err := n.webClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= 400 {
+		return nil, fmt.Errorf("getting npm package %q from %q: unexpected status code %d", packageName, URL, res.StatusCode)
+	}
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var d struct {
+		Versions map[string]json.RawMessage `json:"versions"`
+	}
+
+	if err := json.Unmarshal(data, &d); err != nil {
+		return nil, fmt.Errorf("parsing npm package %q data: %w", packageName, err)
+	}
+
+	manifest, ok := d.Versions[packageVersion]
+	if !ok {
+		return nil, fmt.Errorf("version %q of npm package %q not found", packageVersion, packageName)
+	}
+
+	return manifest, nil
+}
+
+// updatePackageLock updates the package entry of a package-lock.json file to the package version described by manifest.
+// The version ranges recorded for the root project are synchronized with the package.json file located next to it.
+// It returns the new package-lock.json content and the version previously locked.
+func (n *Npm) updatePackageLock(filename string, content []byte, rawManifest json.RawMessage, dryRun bool) ([]byte, string, error) {
+	var lock jsonObject
+	if err := json.Unmarshal(content, &lock); err != nil {
+		return nil, "", fmt.Errorf("parsing %q: %w", filename, err)
+	}
+
+	var lockfileVersion int
+	if value, ok := lock.get("lockfileVersion"); ok {
+		if err := json.Unmarshal(value, &lockfileVersion); err != nil {
+			return nil, "", fmt.Errorf("parsing lockfileVersion: %w", err)
+		}
+	}
+	if lockfileVersion != packageLockVersion {
+		return nil, "", fmt.Errorf("lockfileVersion %d is not supported, only version %d is", lockfileVersion, packageLockVersion)
+	}
+
+	packages, ok, err := lock.getObject("packages")
+	if err != nil {
+		return nil, "", err
+	}
+	if !ok {
+		return nil, "", fmt.Errorf("no packages found")
+	}
+
+	packageKey := path.Join("node_modules", n.spec.Name)
+
+	entry, ok, err := packages.getObject(packageKey)
+	if err != nil {
+		return nil, "", err
+	}
+	if !ok {
+		return nil, "", fmt.Errorf("npm package %q not found", n.spec.Name)
+	}
+
+	var oldPackage lockPackage
+	if err := json.Unmarshal(packages.values[packageKey], &oldPackage); err != nil {
+		return nil, "", fmt.Errorf("parsing %q: %w", packageKey, err)
+	}
+	if oldPackage.Link {
+		return nil, "", fmt.Errorf("npm package %q is a link to a local package", n.spec.Name)
+	}
+	if value, ok := entry.get("name"); ok && string(value) != fmt.Sprintf("%q", n.spec.Name) {
+		return nil, "", fmt.Errorf("npm package %q is an alias of %s", n.spec.Name, value)
+	}
+
+	var manifest packageManifest
+	if err := json.Unmarshal(rawManifest, &manifest); err != nil {
+		return nil, "", fmt.Errorf("parsing npm package %q manifest: %w", n.spec.Name, err)
+	}
+
+	newEntry, err := newLockEntry(n.spec.Name, entry, manifest, rawManifest)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := packages.setObject(packageKey, newEntry); err != nil {
+		return nil, "", err
+	}
+
+	if err := syncRootDependencies(filepath.Join(filepath.Dir(filename), "package.json"), &packages); err != nil {
+		return nil, "", err
+	}
+
+	if err := checkLockDependencies(packages, packageKey, manifest, dryRun); err != nil {
+		return nil, "", err
+	}
+
+	if err := lock.setObject("packages", packages); err != nil {
+		return nil, "", err
+	}
+
+	compact, err := lock.MarshalJSON()
+	if err != nil {
+		return nil, "", err
+	}
+
+	indent := "  "
+	if found := lockIndentation.FindSubmatch(content); found != nil {
+		indent = string(found[1])
+	}
+
+	newContent := bytes.Buffer{}
+	if err := json.Indent(&newContent, compact, "", indent); err != nil {
+		return nil, "", err
+	}
+	newContent.WriteString("\n")
+
+	if bytes.Contains(content, []byte("\r\n")) {
+		return bytes.ReplaceAll(newContent.Bytes(), []byte("\n"), []byte("\r\n")), oldPackage.Version, nil
+	}
+
+	return newContent.Bytes(), oldPackage.Version, nil
+}
+
+// newLockEntry returns the package-lock.json entry of the package version described by manifest.
+// Fields not coming from the manifest, such as "dev" or "optional", are kept from the current entry.
+// Fields are sorted the way npm does, leading fields first, then scalar values, then objects, both alphabetically.
+func newLockEntry(packageName string, entry jsonObject, manifest packageManifest, rawManifest json.RawMessage) (jsonObject, error) {
+	var fields jsonObject
+	if err := json.Unmarshal(rawManifest, &fields); err != nil {
+		return jsonObject{}, fmt.Errorf("parsing npm package %q manifest: %w", packageName, err)
+	}
+
+	if manifest.Dist.Tarball == "" || manifest.Dist.Integrity == "" {
+		return jsonObject{}, fmt.Errorf("npm package %q version %q has no tarball integrity", packageName, manifest.Version)
+	}
+
+	newEntry := jsonObject{}
+	for _, key := range entry.keys {
+		newEntry.set(key, entry.values[key])
+	}
+
+	for _, key := range lockManifestFields {
+		newEntry.remove(key)
+	}
+
+	for key, value := range map[string]string{
+		"version":   manifest.Version,
+		"resolved":  manifest.Dist.Tarball,
+		"integrity": manifest.Dist.Integrity,
+	} {
+		v, err := marshalJSON(value)
+		if err != nil {
+			return jsonObject{}, err
+		}
+		newEntry.set(key, v)
+	}
+
+	for _, key := range lockManifestFields {
+		value, ok := fields.get(key)
+		if !ok {
+			continue
+		}
+
+		switch key {
+		case "bin":
+			// npm normalizes a single binary to an object named after the package
+			var bin string
+			if json.Unmarshal(value, &bin) == nil {
+				v, err := marshalJSON(map[string]string{path.Base(packageName): bin})
+				if err != nil {
+					return jsonObject{}, err
+				}
+				value = v
+			}
+		case "deprecated", "license":
+			// Only messages and SPDX expressions are recorded
+			var s string
+			if json.Unmarshal(value, &s) != nil || s == "" {
+				continue
+			}
+		case "hasInstallScript":
+			var b bool
+			if json.Unmarshal(value, &b) != nil || !b {
+				continue
+			}
+		}
+
+		if isEmptyJSON(value) {
+			continue
+		}
+
+		newEntry.set(key, value)
+	}
+
+	if _, ok := newEntry.get("hasInstallScript"); !ok {
+		var scripts struct {
+			Scripts map[string]string `json:"scripts"`
+		}
+		if err := json.Unmarshal(rawManifest, &scripts); err == nil {
+			for _, script := range installScripts {
+				if _, ok := scripts.Scripts[script]; ok {
+					newEntry.set("hasInstallScript", json.RawMessage("true"))
+					break
+				}
+			}
+		}
+	}
+
+	sortLockEntry(&newEntry)
+
+	return newEntry, nil
+}
+
+// sortLockEntry sorts the fields of a package-lock.json entry the way npm does
+func sortLockEntry(entry *jsonObject) {
+	rank := func(key string) int {
+		for i, k := range lockLeadingFields {
+			if k == key {
+				return i
+			}
+		}
+		if bytes.HasPrefix(entry.values[key], []byte("{")) {
+			return len(lockLeadingFields) + 1
+		}
+		return len(lockLeadingFields)
+	}
+
+	sort.SliceStable(entry.keys, func(i, j int) bool {
+		ri, rj := rank(entry.keys[i]), rank(entry.keys[j])
+		if ri != rj {
+			return ri < rj
+		}
+		if ri < len(lockLeadingFields) {
+			return false
+		}
+		return entry.keys[i] < entry.keys[j]
+	})
+}
+
+// isEmptyJSON returns true if value is null, an empty object or an empty array
+func isEmptyJSON(value json.RawMessage) bool {
+	switch string(bytes.Join(bytes.Fields(value), nil)) {
+	case "null", "{}", "[]":
+		return true
+	}
+	return false
+}
+
+// syncRootDependencies updates the version ranges recorded for the root project in package-lock.json
+// with the ones from its package.json file, as npm would do.
+func syncRootDependencies(packageJSONFile string, packages *jsonObject) error {
+	content, err := os.ReadFile(packageJSONFile)
+	if err != nil {
+		logrus.Debugf("skipping package.json synchronization: %s", err)
+		return nil
+	}
+
+	var packageJSON map[string]json.RawMessage
+	if err := json.Unmarshal(content, &packageJSON); err != nil {
+		return fmt.Errorf("parsing %q: %w", packageJSONFile, err)
+	}
+
+	root, ok, err := packages.getObject("")
+	if err != nil || !ok {
+		return err
+	}
+
+	for _, field := range rootDependencyFields {
+		var ranges map[string]string
+		if value, ok := packageJSON[field]; ok {
+			if err := json.Unmarshal(value, &ranges); err != nil {
+				return fmt.Errorf("parsing %q %q: %w", packageJSONFile, field, err)
+			}
+		}
+
+		dependencies, ok, err := root.getObject(field)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+
+		for _, name := range dependencies.keys {
+			versionRange, ok := ranges[name]
+			if !ok {
+				continue
+			}
+
+			value, err := marshalJSON(versionRange)
+			if err != nil {
+				return err
+			}
+			dependencies.set(name, value)
+		}
+
+		if err := root.setObject(field, dependencies); err != nil {
+			return err
+		}
+	}
+
+	return packages.setObject("", root)
+}
+
+// checkLockDependencies ensures the updated package still satisfies every package depending on it,
+// and that its own dependencies are satisfied by packages already in package-lock.json.
+// Otherwise a full dependency resolution is required, which is out of the scope of the target.
+// In dry run mode, package.json may not be updated yet by the target requiring the package version,
+// so the range required by the root project isn't validated.
+func checkLockDependencies(packages jsonObject, packageKey string, manifest packageManifest, dryRun bool) error {
+	packageName := strings.TrimPrefix(packageKey, "node_modules/")
+
+	for _, key := range packages.keys {
+		var p lockPackage
+		if err := json.Unmarshal(packages.values[key], &p); err != nil {
+			return fmt.Errorf("parsing %q: %w", key, err)
+		}
+
+		dependencies := []map[string]string{p.Dependencies, p.OptionalDependencies, p.PeerDependencies}
+		// Development dependencies are only installed for the root project and workspaces
+		if !strings.Contains(key, "node_modules/") {
+			dependencies = append(dependencies, p.DevDependencies)
+		}
+
+		for _, d := range dependencies {
+			versionRange, ok := d[packageName]
+			if !ok || resolveLockPackage(packages, key, packageName) != packageKey {
+				continue
+			}
+
+			if !satisfiesRange(manifest.Version, versionRange) {
+				name := key
+				if name == "" {
+					if dryRun {
+						logrus.Infof("package.json doesn't require npm package %q version %q yet, skipping validation in dry run mode",
+							packageName, manifest.Version)
+						continue
+					}
+					name = "the root project"
+				}
+				return fmt.Errorf("npm package %q version %q doesn't satisfy the range %q required by %s, package.json must be updated first",
+					packageName, manifest.Version, versionRange, name)
+			}
+		}
+	}
+
+	check := func(dependencies map[string]string, optional func(string) bool) error {
+		for name, versionRange := range dependencies {
+			key := resolveLockPackage(packages, packageKey, name)
+			if key == "" {
+				if optional(name) {
+					continue
+				}
+				return fmt.Errorf("npm package %q version %q requires %q not found in package-lock.json, a full dependency resolution is required",
+					packageName, manifest.Version, name)
+			}
+
+			var p lockPackage
+			if err := json.Unmarshal(packages.values[key], &p); err != nil {
+				return fmt.Errorf("parsing %q: %w", key, err)
+			}
+
+			if !p.Link && !satisfiesRange(p.Version, versionRange) {
+				return fmt.Errorf("npm package %q version %q requires %q %q but version %q is locked, a full dependency resolution is required",
+					packageName, manifest.Version, name, versionRange, p.Version)
+			}
+		}
+		return nil
+	}
+
+	if err := check(manifest.Dependencies, func(string) bool { return false }); err != nil {
+		return err
+	}
+
+	// Optional dependencies may not be installed on every platform
+	if err := check(manifest.OptionalDependencies, func(string) bool { return true }); err != nil {
+		return err
+	}
+
+	return check(manifest.PeerDependencies, func(name string) bool {
+		return manifest.PeerDependenciesMeta[name].Optional
+	})
+}
+
+// resolveLockPackage returns the package-lock.json key of the package name, as required by the package located at from,
+// following the Node.js module resolution algorithm. It returns an empty string if the package isn't found.
+func resolveLockPackage(packages jsonObject, from, name string) string {
+	dir := from
+	for {
+		key := path.Join(dir, "node_modules", name)
+		if _, ok := packages.get(key); ok {
+			return key
+		}
+
+		if dir == "" {
+			return ""
+		}
+
+		i := strings.LastIndex(dir, "/node_modules/")
+		if i < 0 {
+			dir = ""
+			continue
+		}
+		dir = dir[:i]
+	}
+}
+
+// satisfiesRange returns true if version satisfies the npm version range.
+// Ranges which aren't semantic versioning ranges, such as dist-tags, urls or aliases, are considered satisfied.
+func satisfiesRange(version, versionRange string) bool {
+	constraint, err := semver.NewConstraint(versionRange)
+	if err != nil {
+		logrus.Debugf("skipping version range %q validation: %s", versionRange, err)
+		return true
+	}
+
+	v, err := semver.NewVersion(version)
+	if err != nil {
+		logrus.Debugf("skipping version %q validation: %s", version, err)
+		return true
+	}
+
+	return constraint.Check(v)
+}
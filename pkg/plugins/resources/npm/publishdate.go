@@ -0,0 +1,26 @@
+package npm
+
+import (
+	"fmt"
+	"time"
+)
+
+// PublishDate returns the date the npm package version was published to the registry
+func (n *Npm) PublishDate(version string) (time.Time, error) {
+	data, err := n.getPackageData(n.spec.Name)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	published, ok := data.Time[version]
+	if !ok {
+		return time.Time{}, fmt.Errorf("no publication date found for npm package %q version %q", n.spec.Name, version)
+	}
+
+	date, err := time.Parse(time.RFC3339, published)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parsing npm package %q version %q publication date: %w", n.spec.Name, version, err)
+	}
+
+	return date, nil
+}
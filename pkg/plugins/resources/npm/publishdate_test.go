@@ -0,0 +1,50 @@
+package npm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublishDate(t *testing.T) {
+	tests := []struct {
+		name          string
+		version       string
+		expected      time.Time
+		expectedError bool
+	}{
+		{
+			name:     "Published version",
+			version:  "0.2.0",
+			expected: time.Date(2014, time.September, 12, 20, 6, 33, 167000000, time.UTC),
+		},
+		{
+			name:          "Unknown version",
+			version:       "9.9.9",
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, err := New(Spec{
+				Name:          "axios",
+				URL:           "https://mycustomregistry.updatecli.io",
+				RegistryToken: "mytoken",
+			})
+			require.NoError(t, err)
+
+			n.webClient = GetMockClient("https://mycustomregistry.updatecli.io", "mytoken", existingPackageData, 200)
+
+			got, err := n.PublishDate(tt.version)
+			if tt.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, tt.expected.Equal(got))
+		})
+	}
+}
@@ -1,12 +1,78 @@
 package npm
 
 import (
+	"bytes"
 	"fmt"
+	"os"
+	"strings"
 
+	"github.com/sirupsen/logrus"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
 	"github.com/updatecli/updatecli/pkg/core/result"
+	"github.com/updatecli/updatecli/pkg/plugins/utils"
 )
 
+// Target updates the package entry of a package-lock.json file with the version, tarball and integrity retrieved from the registry.
+// It doesn't update package.json, which should be done first using a "json" target.
 func (n Npm) Target(source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) error {
-	return fmt.Errorf("Target not supported for the plugin Npm")
+	version := n.spec.Version
+	if version == "" {
+		version = source
+	}
+	if version == "" {
+		return fmt.Errorf("no version defined")
+	}
+
+	filename := packageLockDefaultFile
+	if n.spec.File != "" {
+		filename = strings.TrimPrefix(n.spec.File, "file://")
+	}
+	if scm != nil {
+		filename = utils.JoinFilePathWithWorkingDirectoryPath(filename, scm.GetDirectory())
+	}
+
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		return fmt.Errorf("reading %q: %w", filename, err)
+	}
+
+	manifest, err := n.getPackageManifest(n.spec.Name, version)
+	if err != nil {
+		return err
+	}
+
+	newContent, oldVersion, err := n.updatePackageLock(filename, content, manifest, dryRun)
+	if err != nil {
+		return fmt.Errorf("updating %q: %w", filename, err)
+	}
+
+	resultTarget.Information = oldVersion
+	resultTarget.NewInformation = version
+
+	if bytes.Equal(newContent, content) {
+		resultTarget.Result = result.SUCCESS
+		resultTarget.Description = fmt.Sprintf("%s already locks npm package %q to version %q", filename, n.spec.Name, version)
+		return nil
+	}
+
+	resultTarget.Changed = true
+	resultTarget.Result = result.ATTENTION
+
+	if dryRun {
+		resultTarget.Description = fmt.Sprintf("%s should update npm package %q from %q to %q",
+			filename, n.spec.Name, oldVersion, version)
+		return nil
+	}
+
+	if err := os.WriteFile(filename, newContent, 0o600); err != nil {
+		return fmt.Errorf("writing %q: %w", filename, err)
+	}
+
+	logrus.Debugf("%q updated\n", filename)
+
+	resultTarget.Files = append(resultTarget.Files, filename)
+	resultTarget.Description = fmt.Sprintf("%s updated npm package %q from %q to %q",
+		filename, n.spec.Name, oldVersion, version)
+
+	return nil
 }
@@ -0,0 +1,284 @@
+package npm
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/core/result"
+)
+
+// testRegistryPackage is the "axios" package data served by the test registry
+const testRegistryPackage = `{
+  "name": "axios",
+  "dist-tags": {"latest": "2.0.0"},
+  "versions": {
+    "1.6.0": {
+      "name": "axios",
+      "version": "1.6.0",
+      "license": "MIT",
+      "dependencies": {"follow-redirects": "^1.15.0"},
+      "dist": {
+        "tarball": "https://registry.npmjs.org/axios/-/axios-1.6.0.tgz",
+        "integrity": "sha512-axios160"
+      }
+    },
+    "1.7.2": {
+      "name": "axios",
+      "version": "1.7.2",
+      "license": "MIT",
+      "scripts": {"test": "jest", "postinstall": "node ./scripts/postinstall.js"},
+      "dependencies": {"follow-redirects": "^1.15.6"},
+      "devDependencies": {"jest": "^29.0.0"},
+      "dist": {
+        "tarball": "https://registry.npmjs.org/axios/-/axios-1.7.2.tgz",
+        "integrity": "sha512-axios172"
+      }
+    },
+    "1.8.0": {
+      "name": "axios",
+      "version": "1.8.0",
+      "license": "MIT",
+      "dependencies": {"follow-redirects": "^2.0.0"},
+      "dist": {
+        "tarball": "https://registry.npmjs.org/axios/-/axios-1.8.0.tgz",
+        "integrity": "sha512-axios180"
+      }
+    },
+    "2.0.0": {
+      "name": "axios",
+      "version": "2.0.0",
+      "license": "MIT",
+      "dist": {
+        "tarball": "https://registry.npmjs.org/axios/-/axios-2.0.0.tgz",
+        "integrity": "sha512-axios200"
+      }
+    }
+  }
+}`
+
+// testPackageLock is a package-lock.json file locking "axios" to version 1.6.0
+const testPackageLock = `{
+  "name": "app",
+  "version": "1.0.0",
+  "lockfileVersion": 3,
+  "requires": true,
+  "packages": {
+    "": {
+      "name": "app",
+      "version": "1.0.0",
+      "dependencies": {
+        "axios": "^1.6.0"
+      }
+    },
+    "node_modules/axios": {
+      "version": "1.6.0",
+      "resolved": "https://registry.npmjs.org/axios/-/axios-1.6.0.tgz",
+      "integrity": "sha512-axios160",
+      "license": "MIT",
+      "dependencies": {
+        "follow-redirects": "^1.15.0"
+      }
+    },
+    "node_modules/follow-redirects": {
+      "version": "1.15.6",
+      "resolved": "https://registry.npmjs.org/follow-redirects/-/follow-redirects-1.15.6.tgz",
+      "integrity": "sha512-followredirects",
+      "funding": [
+        {
+          "type": "individual",
+          "url": "https://github.com/sponsors/RubenVerborgh"
+        }
+      ],
+      "license": "MIT",
+      "engines": {
+        "node": ">=4.0"
+      },
+      "peerDependenciesMeta": {
+        "debug": {
+          "optional": true
+        }
+      }
+    }
+  }
+}
+`
+
+// testUpdatedPackageLock is testPackageLock locking "axios" to version 1.7.2
+const testUpdatedPackageLock = `{
+  "name": "app",
+  "version": "1.0.0",
+  "lockfileVersion": 3,
+  "requires": true,
+  "packages": {
+    "": {
+      "name": "app",
+      "version": "1.0.0",
+      "dependencies": {
+        "axios": "^1.7.0"
+      }
+    },
+    "node_modules/axios": {
+      "version": "1.7.2",
+      "resolved": "https://registry.npmjs.org/axios/-/axios-1.7.2.tgz",
+      "integrity": "sha512-axios172",
+      "hasInstallScript": true,
+      "license": "MIT",
+      "dependencies": {
+        "follow-redirects": "^1.15.6"
+      }
+    },
+    "node_modules/follow-redirects": {
+      "version": "1.15.6",
+      "resolved": "https://registry.npmjs.org/follow-redirects/-/follow-redirects-1.15.6.tgz",
+      "integrity": "sha512-followredirects",
+      "funding": [
+        {
+          "type": "individual",
+          "url": "https://github.com/sponsors/RubenVerborgh"
+        }
+      ],
+      "license": "MIT",
+      "engines": {
+        "node": ">=4.0"
+      },
+      "peerDependenciesMeta": {
+        "debug": {
+          "optional": true
+        }
+      }
+    }
+  }
+}
+`
+
+func TestTarget(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/axios", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer mytoken" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(testRegistryPackage))
+	})
+	registry := httptest.NewServer(mux)
+	defer registry.Close()
+
+	// Ignore the .npmrc file of the user running the tests
+	t.Setenv("HOME", t.TempDir())
+
+	tests := []struct {
+		name            string
+		packageName     string
+		packageJSON     string
+		packageLock     string
+		version         string
+		dryRun          bool
+		expectedLock    string
+		expectedChanged bool
+		expectedError   bool
+	}{
+		{
+			name:            "Update package-lock.json",
+			packageName:     "axios",
+			packageJSON:     `{"name": "app", "version": "1.0.0", "dependencies": {"axios": "^1.7.0"}}`,
+			packageLock:     testPackageLock,
+			version:         "1.7.2",
+			expectedLock:    testUpdatedPackageLock,
+			expectedChanged: true,
+		},
+		{
+			name:            "package-lock.json already up to date",
+			packageName:     "axios",
+			packageJSON:     `{"name": "app", "version": "1.0.0", "dependencies": {"axios": "^1.7.0"}}`,
+			packageLock:     testUpdatedPackageLock,
+			version:         "1.7.2",
+			expectedLock:    testUpdatedPackageLock,
+			expectedChanged: false,
+		},
+		{
+			name:          "package.json not updated",
+			packageName:   "axios",
+			packageJSON:   `{"name": "app", "version": "1.0.0", "dependencies": {"axios": "^1.6.0"}}`,
+			packageLock:   testPackageLock,
+			version:       "2.0.0",
+			expectedError: true,
+		},
+		{
+			name:            "package.json not updated yet in dry run mode",
+			packageName:     "axios",
+			packageJSON:     `{"name": "app", "version": "1.0.0", "dependencies": {"axios": "^1.6.0"}}`,
+			packageLock:     testPackageLock,
+			version:         "2.0.0",
+			dryRun:          true,
+			expectedLock:    testPackageLock,
+			expectedChanged: true,
+		},
+		{
+			name:          "Full dependency resolution required",
+			packageName:   "axios",
+			packageJSON:   `{"name": "app", "version": "1.0.0", "dependencies": {"axios": "^1.8.0"}}`,
+			packageLock:   testPackageLock,
+			version:       "1.8.0",
+			expectedError: true,
+		},
+		{
+			name:          "Unknown version",
+			packageName:   "axios",
+			packageJSON:   `{"name": "app", "version": "1.0.0", "dependencies": {"axios": "^1.9.0"}}`,
+			packageLock:   testPackageLock,
+			version:       "1.9.0",
+			expectedError: true,
+		},
+		{
+			name:          "Package not in package-lock.json",
+			packageName:   "axios",
+			packageJSON:   `{"name": "app", "version": "1.0.0"}`,
+			packageLock:   `{"name": "app", "lockfileVersion": 3, "packages": {"": {"name": "app"}}}`,
+			version:       "1.7.2",
+			expectedError: true,
+		},
+		{
+			name:          "Unsupported lockfile version",
+			packageName:   "axios",
+			packageJSON:   `{"name": "app", "version": "1.0.0", "dependencies": {"axios": "^1.7.0"}}`,
+			packageLock:   `{"name": "app", "lockfileVersion": 1, "dependencies": {}}`,
+			version:       "1.7.2",
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			packageLockFile := filepath.Join(dir, "package-lock.json")
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "package.json"), []byte(tt.packageJSON), 0o600))
+			require.NoError(t, os.WriteFile(packageLockFile, []byte(tt.packageLock), 0o600))
+
+			n, err := New(Spec{
+				Name:          tt.packageName,
+				URL:           registry.URL + "/",
+				RegistryToken: "mytoken",
+				File:          packageLockFile,
+			})
+			require.NoError(t, err)
+
+			gotResult := result.Target{}
+			err = n.Target(tt.version, nil, tt.dryRun, &gotResult)
+			if tt.expectedError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedChanged, gotResult.Changed)
+
+			got, err := os.ReadFile(packageLockFile)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedLock, string(got))
+		})
+	}
+}
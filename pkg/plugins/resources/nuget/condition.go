@@ -0,0 +1,40 @@
+package nuget
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
+)
+
+// Condition checks if a nuget package version is published on the feed
+func (n *Nuget) Condition(source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+	if scm != nil {
+		logrus.Warningf("SCM configuration is not supported for nuget condition, aborting")
+	}
+
+	versionToCheck := n.spec.Version
+	if versionToCheck == "" {
+		versionToCheck = source
+	}
+
+	if len(versionToCheck) == 0 {
+		return false, "", errors.New("no version defined")
+	}
+
+	_, versions, err := n.getVersions()
+	if err != nil {
+		return false, "", fmt.Errorf("getting nuget package versions: %w", err)
+	}
+
+	// NuGet versions are case insensitive
+	for _, v := range versions {
+		if strings.EqualFold(v, versionToCheck) {
+			return true, fmt.Sprintf("nuget package %q version %q available", n.spec.Package, versionToCheck), nil
+		}
+	}
+
+	return false, fmt.Sprintf("nuget package %q version %q doesn't exist", n.spec.Package, versionToCheck), nil
+}
@@ -0,0 +1,190 @@
+package nuget
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/httpclient"
+	"github.com/updatecli/updatecli/pkg/core/result"
+	httputils "github.com/updatecli/updatecli/pkg/plugins/utils/http"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/redact"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+const (
+	// nugetDefaultURL is the service index of the public NuGet gallery
+	nugetDefaultURL string = "https://api.nuget.org/v3/index.json"
+	// packageBaseAddressType is the service index resource type used to list package versions
+	packageBaseAddressType string = "PackageBaseAddress/3.0.0"
+)
+
+// Nuget defines a resource of kind "nuget"
+type Nuget struct {
+	spec Spec
+	// versionFilter holds the "valid" version.filter, that might be different from the user-specified filter (Spec.VersionFilter)
+	versionFilter version.Filter
+	foundVersion  version.Version
+	webClient     httpclient.HTTPClient
+}
+
+// serviceIndex represents a NuGet v3 service index
+// https://learn.microsoft.com/en-us/nuget/api/service-index
+type serviceIndex struct {
+	Resources []struct {
+		ID   string `json:"@id"`
+		Type string `json:"@type"`
+	} `json:"resources"`
+}
+
+// packageVersions represents the response of the package base address versions endpoint
+// https://learn.microsoft.com/en-us/nuget/api/package-base-address-resource#enumerate-package-versions
+type packageVersions struct {
+	Versions []string `json:"versions"`
+}
+
+// New returns a reference to a newly initialized Nuget object from a nuget.Spec
+// or an error if the provided Spec triggers a validation error.
+func New(spec interface{}) (*Nuget, error) {
+	newSpec := Spec{}
+
+	err := mapstructure.Decode(spec, &newSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := newSpec.Validate(); err != nil {
+		return nil, err
+	}
+
+	if newSpec.URL == "" {
+		newSpec.URL = nugetDefaultURL
+	}
+
+	newFilter := newSpec.VersionFilter
+	if newFilter.IsZero() {
+		// By default, use semantic versioning so prerelease versions are ignored
+		newFilter.Kind = version.SEMVERVERSIONKIND
+		newFilter.Pattern = "*"
+	}
+
+	newFilter, err = newFilter.Init()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Nuget{
+		spec:          newSpec,
+		versionFilter: newFilter,
+		webClient:     httpclient.NewRetryClient(),
+	}, nil
+}
+
+// Changelog returns the changelog for this resource, or an empty string if not supported
+func (n *Nuget) Changelog(from, to string) *result.Changelogs {
+	return nil
+}
+
+// ReportConfig returns a new configuration with only the necessary configuration fields
+// to identify the resource without any sensitive information or context specific data.
+func (n *Nuget) ReportConfig() interface{} {
+	return Spec{
+		Package:       n.spec.Package,
+		Version:       n.spec.Version,
+		URL:           redact.URL(n.spec.URL),
+		VersionFilter: n.spec.VersionFilter,
+	}
+}
+
+// getVersions returns the version matching the version filter and every published version
+func (n *Nuget) getVersions() (string, []string, error) {
+	baseAddress, err := n.getResourceURL(packageBaseAddressType)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var data packageVersions
+	versionsURL := fmt.Sprintf("%s/%s/index.json",
+		strings.TrimSuffix(baseAddress, "/"),
+		strings.ToLower(n.spec.Package))
+
+	found, err := n.get(versionsURL, &data)
+	if err != nil {
+		return "", nil, fmt.Errorf("retrieving nuget package %q versions: %w", n.spec.Package, err)
+	}
+
+	if !found || len(data.Versions) == 0 {
+		return "", nil, nil
+	}
+
+	n.foundVersion, err = n.versionFilter.Search(data.Versions)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return n.foundVersion.GetVersion(), data.Versions, nil
+}
+
+// getResourceURL returns the url of a resource type advertised by the feed service index
+func (n *Nuget) getResourceURL(resourceType string) (string, error) {
+	var index serviceIndex
+
+	found, err := n.get(n.spec.URL, &index)
+	if err != nil {
+		return "", fmt.Errorf("retrieving nuget service index: %w", err)
+	}
+
+	if !found {
+		return "", fmt.Errorf("nuget service index %q not found", redact.URL(n.spec.URL))
+	}
+
+	for _, r := range index.Resources {
+		if r.Type == resourceType {
+			return r.ID, nil
+		}
+	}
+
+	return "", fmt.Errorf("resource %q not advertised by the nuget service index %q", resourceType, redact.URL(n.spec.URL))
+}
+
+// get queries a NuGet api endpoint and decodes its json response into v.
+// It returns false if the endpoint returns a 404.
+func (n *Nuget) get(url string, v any) (bool, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return false, err
+	}
+
+	req.Header.Set("User-Agent", httputils.UserAgent)
+
+	switch {
+	case n.spec.Token != "":
+		req.Header.Set("Authorization", "Bearer "+n.spec.Token)
+	case n.spec.Username != "" || n.spec.Password != "":
+		req.SetBasicAuth(n.spec.Username, n.spec.Password)
+	}
+
+	res, err := n.webClient.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		logrus.Debugf("nuget endpoint %q returned %d", redact.URL(url), res.StatusCode)
+		return false, nil
+	}
+
+	if res.StatusCode >= 400 {
+		return false, fmt.Errorf("unexpected status code %d from %q", res.StatusCode, redact.URL(url))
+	}
+
+	if err := json.NewDecoder(res.Body).Decode(v); err != nil {
+		return false, fmt.Errorf("decoding nuget api response: %w", err)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,185 @@
+package nuget
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/core/result"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+// newTestFeed returns a NuGet v3 feed stand-in serving the packages "Newtonsoft.Json" and "Serilog".
+// If password is set, requests must be authenticated using basic auth.
+func newTestFeed(t *testing.T, password string) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if password != "" {
+			if _, p, ok := r.BasicAuth(); !ok || p != password {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+		}
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("/v3/index.json", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"version": "3.0.0", "resources": [{"@id": "%s/v3-flatcontainer/", "@type": "PackageBaseAddress/3.0.0"}]}`, server.URL)
+	})
+
+	mux.HandleFunc("/v3-flatcontainer/newtonsoft.json/index.json", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"versions": ["12.0.3", "13.0.1", "13.0.2-beta1", "13.0.3"]}`)
+	})
+
+	mux.HandleFunc("/v3-flatcontainer/serilog/index.json", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"versions": ["3.1.0", "3.1.1", "4.0.0-preview.1"]}`)
+	})
+
+	return server
+}
+
+func TestSource(t *testing.T) {
+	server := newTestFeed(t, "")
+
+	tests := []struct {
+		name           string
+		spec           Spec
+		expectedResult string
+		wantErr        bool
+	}{
+		{
+			name: "Latest version",
+			spec: Spec{
+				Package: "Newtonsoft.Json",
+			},
+			expectedResult: "13.0.3",
+		},
+		{
+			name: "Latest version skips prerelease",
+			spec: Spec{
+				Package: "Serilog",
+			},
+			expectedResult: "3.1.1",
+		},
+		{
+			name: "Latest version including prerelease",
+			spec: Spec{
+				Package: "Serilog",
+				VersionFilter: version.Filter{
+					Kind: "latest",
+				},
+			},
+			expectedResult: "4.0.0-preview.1",
+		},
+		{
+			name: "Semver constraint",
+			spec: Spec{
+				Package: "Newtonsoft.Json",
+				VersionFilter: version.Filter{
+					Kind:    "semver",
+					Pattern: "~12",
+				},
+			},
+			expectedResult: "12.0.3",
+		},
+		{
+			name: "Unknown package",
+			spec: Spec{
+				Package: "DoNotExist",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.spec.URL = server.URL + "/v3/index.json"
+
+			n, err := New(tt.spec)
+			require.NoError(t, err)
+
+			gotResult := result.Source{}
+			err = n.Source("", &gotResult)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, gotResult.Information)
+		})
+	}
+}
+
+func TestCondition(t *testing.T) {
+	server := newTestFeed(t, "secret")
+
+	tests := []struct {
+		name           string
+		spec           Spec
+		source         string
+		expectedResult bool
+		wantErr        bool
+	}{
+		{
+			name: "Version from source",
+			spec: Spec{
+				Package:  "Newtonsoft.Json",
+				Username: "updatecli",
+				Password: "secret",
+			},
+			source:         "13.0.1",
+			expectedResult: true,
+		},
+		{
+			name: "Version from spec",
+			spec: Spec{
+				Package:  "Newtonsoft.Json",
+				Version:  "1.0.0",
+				Username: "updatecli",
+				Password: "secret",
+			},
+			expectedResult: false,
+		},
+		{
+			name: "Wrong credentials",
+			spec: Spec{
+				Package:  "Newtonsoft.Json",
+				Version:  "13.0.1",
+				Username: "updatecli",
+				Password: "wrong",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.spec.URL = server.URL + "/v3/index.json"
+
+			n, err := New(tt.spec)
+			require.NoError(t, err)
+
+			gotResult, _, err := n.Condition(tt.source, nil)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, gotResult)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	_, err := New(Spec{})
+	require.ErrorIs(t, err, ErrSpecPackageUndefined)
+
+	_, err = New(Spec{Package: "Serilog", Token: "token", Username: "user"})
+	require.ErrorIs(t, err, ErrSpecAuthConflict)
+}
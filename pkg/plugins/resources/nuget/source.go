@@ -0,0 +1,25 @@
+package nuget
+
+import (
+	"fmt"
+
+	"github.com/updatecli/updatecli/pkg/core/result"
+)
+
+// Source returns the latest nuget package version
+func (n *Nuget) Source(workingDir string, resultSource *result.Source) error {
+	version, _, err := n.getVersions()
+	if err != nil {
+		return fmt.Errorf("get nuget package versions: %w", err)
+	}
+
+	if version == "" {
+		return fmt.Errorf("no version found for nuget package %q", n.spec.Package)
+	}
+
+	resultSource.Result = result.SUCCESS
+	resultSource.Information = version
+	resultSource.Description = fmt.Sprintf("version %q found for nuget package %q", version, n.spec.Package)
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package nuget
+
+import (
+	"errors"
+
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+// Spec defines a specification for a "nuget" resource
+// parsed from an updatecli manifest file
+type Spec struct {
+	// [S][C] Package specifies the NuGet package id
+	//
+	// example:
+	//   * Newtonsoft.Json
+	Package string `yaml:",omitempty" jsonschema:"required"`
+	// [C] Version defines a specific package version
+	//
+	// default:
+	//   When used from a condition, the default value is set to the linked source output
+	Version string `yaml:",omitempty"`
+	// [S][C] URL defines the NuGet v3 feed service index url
+	//
+	// default:
+	//   https://api.nuget.org/v3/index.json
+	URL string `yaml:",omitempty"`
+	// [S][C] Username defines the username used to authenticate on a private feed
+	Username string `yaml:",omitempty"`
+	// [S][C] Password defines the password, or personal access token, used to authenticate on a private feed
+	Password string `yaml:",omitempty"`
+	// [S][C] Token defines a bearer token used to authenticate on a private feed
+	//
+	// remark:
+	//   * "token" and "username"/"password" are mutually exclusive
+	Token string `yaml:",omitempty"`
+	// [S] VersionFilter provides parameters to specify version pattern and its type like regex, semver, or just latest.
+	//
+	// default:
+	//   semver "*", the newest stable version
+	VersionFilter version.Filter `yaml:",omitempty"`
+}
+
+var (
+	// ErrSpecPackageUndefined is returned when the package id is not defined
+	ErrSpecPackageUndefined = errors.New("nuget package undefined")
+	// ErrSpecAuthConflict is returned when both token and username/password are defined
+	ErrSpecAuthConflict = errors.New("parameter \"token\" and \"username\"/\"password\" are mutually exclusive")
+)
+
+// Validate checks if the Spec is properly defined
+func (s *Spec) Validate() error {
+	var errs []error
+
+	if len(s.Package) == 0 {
+		errs = append(errs, ErrSpecPackageUndefined)
+	}
+
+	if len(s.Token) > 0 && (len(s.Username) > 0 || len(s.Password) > 0) {
+		errs = append(errs, ErrSpecAuthConflict)
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,51 @@
+package pypi
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+// Condition checks if a Python package version is published on the package index
+func (p *Pypi) Condition(source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+	if scm != nil {
+		logrus.Warningf("SCM configuration is not supported for pypi condition, aborting")
+	}
+
+	versionToCheck := p.spec.Version
+	if versionToCheck == "" {
+		versionToCheck = source
+	}
+
+	if len(versionToCheck) == 0 {
+		return false, "", errors.New("no version defined")
+	}
+
+	expected, err := version.NewPEP440(versionToCheck)
+	if err != nil {
+		return false, "", err
+	}
+
+	releases, err := p.getReleases()
+	if err != nil {
+		return false, "", fmt.Errorf("getting pypi package releases: %w", err)
+	}
+
+	for _, r := range releases {
+		v, err := version.NewPEP440(r.Version)
+		if err != nil || v.Compare(expected) != 0 {
+			continue
+		}
+
+		if r.Yanked {
+			return false, fmt.Sprintf("pypi package %q version %q has been yanked", p.spec.Name, versionToCheck), nil
+		}
+
+		return true, fmt.Sprintf("pypi package %q version %q available", p.spec.Name, versionToCheck), nil
+	}
+
+	return false, fmt.Sprintf("pypi package %q version %q doesn't exist", p.spec.Name, versionToCheck), nil
+}
@@ -0,0 +1,202 @@
+package pypi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/httpclient"
+	"github.com/updatecli/updatecli/pkg/core/result"
+	httputils "github.com/updatecli/updatecli/pkg/plugins/utils/http"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/redact"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+const (
+	// pypiDefaultURL is the url of the public Python package index
+	pypiDefaultURL string = "https://pypi.org/"
+	// simpleAPIContentType is the content type of the PEP 691 json simple api
+	simpleAPIContentType string = "application/vnd.pypi.simple.v1+json"
+)
+
+var (
+	// nameNormalizer matches the characters replaced when normalizing a package name
+	// https://packaging.python.org/en/latest/specifications/name-normalization/
+	nameNormalizer = regexp.MustCompile(`[-_.]+`)
+)
+
+// Pypi defines a resource of kind "pypi"
+type Pypi struct {
+	spec Spec
+	// versionFilter holds the "valid" version.filter, that might be different from the user-specified filter (Spec.VersionFilter)
+	versionFilter version.Filter
+	foundVersion  version.Version
+	webClient     httpclient.HTTPClient
+}
+
+// release describes a published package version
+type release struct {
+	// Version is the release version
+	Version string
+	// Yanked is true when every file of the release has been yanked
+	Yanked bool
+}
+
+// jsonAPIResponse represents the PyPI json api response
+// https://warehouse.pypa.io/api-reference/json.html
+type jsonAPIResponse struct {
+	Releases map[string][]struct {
+		Yanked     bool      `json:"yanked"`
+		UploadTime time.Time `json:"upload_time_iso_8601"`
+	} `json:"releases"`
+}
+
+// simpleAPIResponse represents the json simple api response
+// https://peps.python.org/pep-0691/ and https://peps.python.org/pep-0700/
+type simpleAPIResponse struct {
+	Versions []string `json:"versions"`
+}
+
+// New returns a reference to a newly initialized Pypi object from a pypi.Spec
+// or an error if the provided Spec triggers a validation error.
+func New(spec interface{}) (*Pypi, error) {
+	newSpec := Spec{}
+
+	err := mapstructure.Decode(spec, &newSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := newSpec.Validate(); err != nil {
+		return nil, err
+	}
+
+	if newSpec.URL == "" {
+		newSpec.URL = pypiDefaultURL
+	}
+
+	newFilter, err := newSpec.VersionFilter.Init()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Pypi{
+		spec:          newSpec,
+		versionFilter: newFilter,
+		webClient:     httpclient.NewRetryClient(),
+	}, nil
+}
+
+// Changelog returns the changelog for this resource, or an empty string if not supported
+func (p *Pypi) Changelog(from, to string) *result.Changelogs {
+	return nil
+}
+
+// ReportConfig returns a new configuration with only the necessary configuration fields
+// to identify the resource without any sensitive information or context specific data.
+func (p *Pypi) ReportConfig() interface{} {
+	return Spec{
+		Name:          p.spec.Name,
+		Version:       p.spec.Version,
+		URL:           redact.URL(p.spec.URL),
+		Prerelease:    p.spec.Prerelease,
+		VersionFilter: p.spec.VersionFilter,
+	}
+}
+
+// normalizedName returns the package name normalized as required by the package index apis
+func (p *Pypi) normalizedName() string {
+	return strings.ToLower(nameNormalizer.ReplaceAllString(p.spec.Name, "-"))
+}
+
+// getReleases returns every release published on the package index, sorted according to PEP 440,
+// oldest first. Versions which are not PEP 440 compliant are ignored.
+func (p *Pypi) getReleases() ([]release, error) {
+	baseURL := strings.TrimSuffix(p.spec.URL, "/")
+
+	var data jsonAPIResponse
+	found, err := p.get(fmt.Sprintf("%s/pypi/%s/json", baseURL, p.normalizedName()), "application/json", &data)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving pypi package %q releases: %w", p.spec.Name, err)
+	}
+
+	yanked := map[string]bool{}
+	var versions []string
+
+	if found {
+		for v, files := range data.Releases {
+			versions = append(versions, v)
+			// A release without files can't be installed
+			yanked[v] = len(files) == 0
+			for _, f := range files {
+				if f.Yanked {
+					yanked[v] = true
+				} else {
+					yanked[v] = false
+					break
+				}
+			}
+		}
+	} else {
+		logrus.Debugf("json api not available for pypi package %q, falling back to the simple api", p.spec.Name)
+
+		var simple simpleAPIResponse
+		found, err = p.get(fmt.Sprintf("%s/simple/%s/", baseURL, p.normalizedName()), simpleAPIContentType, &simple)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving pypi package %q releases: %w", p.spec.Name, err)
+		}
+		if !found {
+			return nil, nil
+		}
+		versions = simple.Versions
+	}
+
+	releases := []release{}
+	for _, v := range version.SortPEP440(versions) {
+		releases = append(releases, release{Version: v, Yanked: yanked[v]})
+	}
+
+	return releases, nil
+}
+
+// get queries a package index api endpoint and decodes its json response into v.
+// It returns false if the endpoint returns a 404.
+func (p *Pypi) get(url, accept string, v any) (bool, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return false, err
+	}
+
+	req.Header.Set("User-Agent", httputils.UserAgent)
+	req.Header.Set("Accept", accept)
+
+	if p.spec.Username != "" || p.spec.Password != "" {
+		req.SetBasicAuth(p.spec.Username, p.spec.Password)
+	}
+
+	res, err := p.webClient.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		logrus.Debugf("pypi endpoint %q returned %d", redact.URL(url), res.StatusCode)
+		return false, nil
+	}
+
+	if res.StatusCode >= 400 {
+		return false, fmt.Errorf("unexpected status code %d from %q", res.StatusCode, redact.URL(url))
+	}
+
+	if err := json.NewDecoder(res.Body).Decode(v); err != nil {
+		return false, fmt.Errorf("decoding pypi api response: %w", err)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,222 @@
+package pypi
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/core/result"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+// newTestIndex returns a package index stand-in serving the package "requests" through the json api
+// and the package "private_pkg" through the simple api only.
+func newTestIndex(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("/pypi/requests/json", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{
+  "info": {"name": "requests", "version": "2.31.0"},
+  "releases": {
+    "2.9.2": [{"yanked": false}],
+    "2.30.0": [{"yanked": false}],
+    "2.31.0": [
+      {"yanked": false, "upload_time_iso_8601": "2023-05-22T15:12:44.175Z"},
+      {"yanked": false, "upload_time_iso_8601": "2023-05-22T15:12:42.313Z"}
+    ],
+    "2.32.0": [{"yanked": true}],
+    "3.0.0rc1": [{"yanked": false}],
+    "3.0.0.dev0": []
+  }
+}`)
+	})
+
+	mux.HandleFunc("/simple/private-pkg/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept") != simpleAPIContentType {
+			w.WriteHeader(http.StatusNotAcceptable)
+			return
+		}
+		w.Header().Set("Content-Type", simpleAPIContentType)
+		fmt.Fprint(w, `{"meta": {"api-version": "1.1"}, "name": "private-pkg", "versions": ["1.0", "1.0.post1", "1.1b1"], "files": []}`)
+	})
+
+	return server
+}
+
+func TestSource(t *testing.T) {
+	server := newTestIndex(t)
+
+	tests := []struct {
+		name           string
+		spec           Spec
+		expectedResult string
+		wantErr        bool
+	}{
+		{
+			name:           "Latest version ignores yanked and pre-releases",
+			spec:           Spec{Name: "requests"},
+			expectedResult: "2.31.0",
+		},
+		{
+			name:           "Latest version with pre-releases",
+			spec:           Spec{Name: "requests", Prerelease: true},
+			expectedResult: "3.0.0rc1",
+		},
+		{
+			name: "Semver constraint",
+			spec: Spec{
+				Name: "requests",
+				VersionFilter: version.Filter{
+					Kind:    "semver",
+					Pattern: "~2.30",
+				},
+			},
+			expectedResult: "2.30.0",
+		},
+		{
+			name:           "Simple api fallback",
+			spec:           Spec{Name: "Private_Pkg"},
+			expectedResult: "1.0.post1",
+		},
+		{
+			name:    "Unknown package",
+			spec:    Spec{Name: "donotexist"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.spec.URL = server.URL
+
+			p, err := New(tt.spec)
+			require.NoError(t, err)
+
+			gotResult := result.Source{}
+			err = p.Source("", &gotResult)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, gotResult.Information)
+		})
+	}
+}
+
+func TestCondition(t *testing.T) {
+	server := newTestIndex(t)
+
+	tests := []struct {
+		name           string
+		spec           Spec
+		source         string
+		expectedResult bool
+		wantErr        bool
+	}{
+		{
+			name:           "Version from source",
+			spec:           Spec{Name: "requests"},
+			source:         "2.31.0",
+			expectedResult: true,
+		},
+		{
+			name:           "Non normalized version",
+			spec:           Spec{Name: "requests", Version: "3.0.0-RC1"},
+			expectedResult: true,
+		},
+		{
+			name:           "Yanked version",
+			spec:           Spec{Name: "requests", Version: "2.32.0"},
+			expectedResult: false,
+		},
+		{
+			name:           "Missing version",
+			spec:           Spec{Name: "requests", Version: "1.0.0"},
+			expectedResult: false,
+		},
+		{
+			name:    "Invalid version",
+			spec:    Spec{Name: "requests", Version: "latest"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.spec.URL = server.URL
+
+			p, err := New(tt.spec)
+			require.NoError(t, err)
+
+			gotResult, _, err := p.Condition(tt.source, nil)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, gotResult)
+		})
+	}
+}
+
+func TestPublishDate(t *testing.T) {
+	server := newTestIndex(t)
+
+	tests := []struct {
+		name     string
+		spec     Spec
+		version  string
+		expected time.Time
+		wantErr  bool
+	}{
+		{
+			name:     "Oldest file upload time",
+			spec:     Spec{Name: "requests"},
+			version:  "2.31.0",
+			expected: time.Date(2023, time.May, 22, 15, 12, 42, 313000000, time.UTC),
+		},
+		{
+			name:    "Version without upload time",
+			spec:    Spec{Name: "requests"},
+			version: "2.30.0",
+			wantErr: true,
+		},
+		{
+			name:    "Package without json api",
+			spec:    Spec{Name: "private_pkg"},
+			version: "1.0",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.spec.URL = server.URL
+
+			p, err := New(tt.spec)
+			require.NoError(t, err)
+
+			got, err := p.PublishDate(tt.version)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, tt.expected.Equal(got))
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	_, err := New(Spec{})
+	require.ErrorIs(t, err, ErrSpecNameUndefined)
+}
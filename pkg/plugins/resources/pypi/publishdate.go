@@ -0,0 +1,33 @@
+package pypi
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// PublishDate returns the date the first file of the package version was uploaded to the package index.
+// It requires the package index to provide the PyPI json api.
+func (p *Pypi) PublishDate(version string) (time.Time, error) {
+	var data jsonAPIResponse
+	found, err := p.get(fmt.Sprintf("%s/pypi/%s/json", strings.TrimSuffix(p.spec.URL, "/"), p.normalizedName()), "application/json", &data)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("retrieving pypi package %q releases: %w", p.spec.Name, err)
+	}
+	if !found {
+		return time.Time{}, fmt.Errorf("no json api available to retrieve pypi package %q publication dates", p.spec.Name)
+	}
+
+	var published time.Time
+	for _, f := range data.Releases[version] {
+		if published.IsZero() || (!f.UploadTime.IsZero() && f.UploadTime.Before(published)) {
+			published = f.UploadTime
+		}
+	}
+
+	if published.IsZero() {
+		return time.Time{}, fmt.Errorf("no publication date found for pypi package %q version %q", p.spec.Name, version)
+	}
+
+	return published, nil
+}
@@ -0,0 +1,47 @@
+package pypi
+
+import (
+	"fmt"
+
+	"github.com/updatecli/updatecli/pkg/core/result"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+// Source returns the latest Python package version
+func (p *Pypi) Source(workingDir string, resultSource *result.Source) error {
+	releases, err := p.getReleases()
+	if err != nil {
+		return fmt.Errorf("get pypi package releases: %w", err)
+	}
+
+	versions := []string{}
+	for _, r := range releases {
+		if r.Yanked {
+			continue
+		}
+
+		if !p.spec.Prerelease {
+			v, err := version.NewPEP440(r.Version)
+			if err != nil || v.IsPrerelease() {
+				continue
+			}
+		}
+
+		versions = append(versions, r.Version)
+	}
+
+	if len(versions) == 0 {
+		return fmt.Errorf("no version found for pypi package %q", p.spec.Name)
+	}
+
+	p.foundVersion, err = p.versionFilter.Search(versions)
+	if err != nil {
+		return fmt.Errorf("filtering pypi package %q versions: %w", p.spec.Name, err)
+	}
+
+	resultSource.Result = result.SUCCESS
+	resultSource.Information = p.foundVersion.GetVersion()
+	resultSource.Description = fmt.Sprintf("version %q found for pypi package %q", p.foundVersion.GetVersion(), p.spec.Name)
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package pypi
+
+import (
+	"errors"
+
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+// Spec defines a specification for a "pypi" resource
+// parsed from an updatecli manifest file
+type Spec struct {
+	// [S][C] Name specifies the Python package name
+	//
+	// example:
+	//   * requests
+	Name string `yaml:",omitempty" jsonschema:"required"`
+	// [C] Version defines a specific package version
+	//
+	// default:
+	//   When used from a condition, the default value is set to the linked source output
+	Version string `yaml:",omitempty"`
+	// [S][C] URL defines the Python package index url.
+	// The JSON api "<url>/pypi/<name>/json" is used when available,
+	// otherwise Updatecli falls back to the simple api "<url>/simple/<name>/"
+	//
+	// default:
+	//   https://pypi.org/
+	URL string `yaml:",omitempty"`
+	// [S][C] Username defines the username used to authenticate on a private index
+	Username string `yaml:",omitempty"`
+	// [S][C] Password defines the password, or token, used to authenticate on a private index
+	Password string `yaml:",omitempty"`
+	// [S] Prerelease allows pre-releases and development releases, such as "2.0rc1" or "2.0.dev1", to be returned
+	//
+	// default:
+	//   false
+	Prerelease bool `yaml:",omitempty"`
+	// [S] VersionFilter provides parameters to specify version pattern and its type like regex, semver, or just latest.
+	//
+	// default:
+	//   When not specified, the newest version according to PEP 440 ordering is returned
+	VersionFilter version.Filter `yaml:",omitempty"`
+}
+
+var (
+	// ErrSpecNameUndefined is returned when the package name is not defined
+	ErrSpecNameUndefined = errors.New("pypi package name undefined")
+)
+
+// Validate checks if the Spec is properly defined
+func (s *Spec) Validate() error {
+	if len(s.Name) == 0 {
+		return ErrSpecNameUndefined
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package rubygems
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+// Condition checks if a gem version is published on the gem server
+func (r *RubyGems) Condition(source string, scm scm.ScmHandler) (pass bool, message string, err error) {
+	if scm != nil {
+		logrus.Warningf("SCM configuration is not supported for rubygems condition, aborting")
+	}
+
+	versionToCheck := r.spec.Version
+	if versionToCheck == "" {
+		versionToCheck = source
+	}
+
+	if len(versionToCheck) == 0 {
+		return false, "", errors.New("no version defined")
+	}
+
+	expected, err := version.NewRubyGems(versionToCheck)
+	if err != nil {
+		return false, "", err
+	}
+
+	releases, err := r.getReleases()
+	if err != nil {
+		return false, "", fmt.Errorf("getting gem releases: %w", err)
+	}
+
+	for _, rel := range releases {
+		v, err := version.NewRubyGems(rel.Version)
+		if err != nil || v.Compare(expected) != 0 {
+			continue
+		}
+
+		return true, fmt.Sprintf("gem %q version %q available", r.spec.Name, versionToCheck), nil
+	}
+
+	return false, fmt.Sprintf("gem %q version %q doesn't exist", r.spec.Name, versionToCheck), nil
+}
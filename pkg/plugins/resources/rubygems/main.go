@@ -0,0 +1,213 @@
+package rubygems
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/httpclient"
+	"github.com/updatecli/updatecli/pkg/core/result"
+	httputils "github.com/updatecli/updatecli/pkg/plugins/utils/http"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/redact"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+const (
+	// rubygemsDefaultURL is the url of the public gem server
+	rubygemsDefaultURL string = "https://rubygems.org/"
+)
+
+// RubyGems defines a resource of kind "rubygems"
+type RubyGems struct {
+	spec Spec
+	// versionFilter holds the "valid" version.filter, that might be different from the user-specified filter (Spec.VersionFilter)
+	versionFilter version.Filter
+	foundVersion  version.Version
+	webClient     httpclient.HTTPClient
+}
+
+// release describes a published gem version
+type release struct {
+	// Version is the release version
+	Version string
+	// Time is the release date, if provided by the gem server
+	Time time.Time
+}
+
+// versionResponse represents a gem version as returned by the RubyGems API
+// https://guides.rubygems.org/rubygems-org-api/#gem-version-methods
+type versionResponse struct {
+	Number    string    `json:"number"`
+	Platform  string    `json:"platform"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// New returns a reference to a newly initialized RubyGems object from a rubygems.Spec
+// or an error if the provided Spec triggers a validation error.
+func New(spec interface{}) (*RubyGems, error) {
+	newSpec := Spec{}
+
+	err := mapstructure.Decode(spec, &newSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := newSpec.Validate(); err != nil {
+		return nil, err
+	}
+
+	if newSpec.URL == "" {
+		newSpec.URL = rubygemsDefaultURL
+	}
+
+	newFilter := newSpec.VersionFilter
+	if newFilter.IsZero() {
+		newFilter.Kind = version.RUBYGEMSVERSIONKIND
+	}
+
+	newFilter, err = newFilter.Init()
+	if err != nil {
+		return nil, err
+	}
+
+	return &RubyGems{
+		spec:          newSpec,
+		versionFilter: newFilter,
+		webClient:     httpclient.NewRetryClient(),
+	}, nil
+}
+
+// Changelog returns the changelog for this resource, or an empty string if not supported
+func (r *RubyGems) Changelog(from, to string) *result.Changelogs {
+	return nil
+}
+
+// ReportConfig returns a new configuration with only the necessary configuration fields
+// to identify the resource without any sensitive information or context specific data.
+func (r *RubyGems) ReportConfig() interface{} {
+	return Spec{
+		Name:          r.spec.Name,
+		Version:       r.spec.Version,
+		URL:           redact.URL(r.spec.URL),
+		VersionFilter: r.spec.VersionFilter,
+	}
+}
+
+// getReleases returns every release published on the gem server, sorted oldest first.
+// A version published for several platforms is only returned once.
+func (r *RubyGems) getReleases() ([]release, error) {
+	baseURL := strings.TrimSuffix(r.spec.URL, "/")
+
+	var versions []versionResponse
+	found, err := r.get(fmt.Sprintf("%s/api/v1/versions/%s.json", baseURL, url.PathEscape(r.spec.Name)), func(body io.Reader) error {
+		return json.NewDecoder(body).Decode(&versions)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("retrieving gem %q versions: %w", r.spec.Name, err)
+	}
+
+	// Private gem servers, such as Gemstash, only provide the compact index used by Bundler
+	if !found {
+		logrus.Debugf("gem %q versions not found from the RubyGems API, trying the compact index", r.spec.Name)
+
+		found, err = r.get(fmt.Sprintf("%s/info/%s", baseURL, url.PathEscape(r.spec.Name)), func(body io.Reader) error {
+			versions, err = parseCompactIndex(body)
+			return err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("retrieving gem %q compact index: %w", r.spec.Name, err)
+		}
+	}
+
+	if !found {
+		return nil, fmt.Errorf("gem %q not found on %q", r.spec.Name, redact.URL(r.spec.URL))
+	}
+
+	published := map[string]time.Time{}
+	var names []string
+	for _, v := range versions {
+		t, ok := published[v.Number]
+		if !ok {
+			names = append(names, v.Number)
+		}
+
+		// Keep the oldest publication date when a version is published for several platforms
+		if t.IsZero() || (!v.CreatedAt.IsZero() && v.CreatedAt.Before(t)) {
+			published[v.Number] = v.CreatedAt
+		}
+	}
+
+	releases := []release{}
+	for _, v := range version.SortRubyGems(names) {
+		releases = append(releases, release{Version: v, Time: published[v]})
+	}
+
+	return releases, nil
+}
+
+// parseCompactIndex parses a compact index "info" file, where each line starts with
+// a version, optionally followed by its platform, such as "1.16.0-x86_64-linux deps|checksum:..."
+// https://guides.rubygems.org/rubygems-org-compact-index-api/
+func parseCompactIndex(body io.Reader) ([]versionResponse, error) {
+	var versions []versionResponse
+
+	scanner := bufio.NewScanner(body)
+	// Dependency lists can be long
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line == "---" {
+			continue
+		}
+
+		number, _, _ := strings.Cut(line, " ")
+		number, platform, _ := strings.Cut(number, "-")
+		versions = append(versions, versionResponse{Number: number, Platform: platform})
+	}
+
+	return versions, scanner.Err()
+}
+
+// get queries a gem server endpoint and decodes its response using decode.
+// It returns false if the endpoint returns a 404.
+func (r *RubyGems) get(url string, decode func(io.Reader) error) (bool, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return false, err
+	}
+
+	req.Header.Set("User-Agent", httputils.UserAgent)
+
+	if r.spec.Username != "" || r.spec.Password != "" {
+		req.SetBasicAuth(r.spec.Username, r.spec.Password)
+	}
+
+	res, err := r.webClient.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		logrus.Debugf("gem server endpoint %q returned %d", redact.URL(url), res.StatusCode)
+		return false, nil
+	}
+
+	if res.StatusCode >= 400 {
+		return false, fmt.Errorf("unexpected status code %d from %q", res.StatusCode, redact.URL(url))
+	}
+
+	if err := decode(res.Body); err != nil {
+		return false, fmt.Errorf("decoding gem server response: %w", err)
+	}
+
+	return true, nil
+}
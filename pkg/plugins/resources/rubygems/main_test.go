@@ -0,0 +1,236 @@
+package rubygems
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/core/result"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+// newTestServer returns a rubygems.org stand-in serving the gem "nokogiri" published for several platforms
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("/api/v1/versions/nokogiri.json", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[
+  {"number": "1.17.0.rc1", "platform": "ruby", "prerelease": true, "created_at": "2024-11-28T10:00:00.000Z"},
+  {"number": "1.16.8", "platform": "x86_64-linux", "prerelease": false, "created_at": "2024-12-03T09:00:00.000Z"},
+  {"number": "1.16.8", "platform": "ruby", "prerelease": false, "created_at": "2024-12-02T17:30:12.000Z"},
+  {"number": "1.16.10", "platform": "ruby", "prerelease": false, "created_at": "2024-12-20T08:00:00.000Z"},
+  {"number": "1.15.7", "platform": "ruby", "prerelease": false, "created_at": "2024-11-13T15:11:03.000Z"}
+]`)
+	})
+
+	return server
+}
+
+// newTestCompactIndexServer returns a private gem server stand-in only providing the compact index
+func newTestCompactIndexServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("/private/info/acme", func(w http.ResponseWriter, r *http.Request) {
+		user, password, ok := r.BasicAuth()
+		if !ok || user != "token" || password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, `---
+1.0.0 |checksum:aaaa
+1.1.0 rack:>= 2.0|checksum:bbbb,ruby:>= 3.0
+1.1.0-x86_64-linux rack:>= 2.0|checksum:cccc
+2.0.0.pre.1 |checksum:dddd
+`)
+	})
+
+	return server
+}
+
+func TestSource(t *testing.T) {
+	server := newTestServer(t)
+	private := newTestCompactIndexServer(t)
+
+	tests := []struct {
+		name           string
+		spec           Spec
+		expectedResult string
+		wantErr        bool
+	}{
+		{
+			name:           "Latest version which isn't a pre-release",
+			spec:           Spec{Name: "nokogiri", URL: server.URL},
+			expectedResult: "1.16.10",
+		},
+		{
+			name: "Pessimistic requirement",
+			spec: Spec{
+				Name: "nokogiri",
+				URL:  server.URL,
+				VersionFilter: version.Filter{
+					Kind:    "rubygems",
+					Pattern: "~> 1.15.0",
+				},
+			},
+			expectedResult: "1.15.7",
+		},
+		{
+			name: "Pre-release requirement",
+			spec: Spec{
+				Name: "nokogiri",
+				URL:  server.URL,
+				VersionFilter: version.Filter{
+					Kind:    "rubygems",
+					Pattern: ">= 1.17.0.a",
+				},
+			},
+			expectedResult: "1.17.0.rc1",
+		},
+		{
+			name:           "Private gem server compact index",
+			spec:           Spec{Name: "acme", URL: private.URL + "/private/", Username: "token", Password: "secret"},
+			expectedResult: "1.1.0",
+		},
+		{
+			name:    "Unauthorized",
+			spec:    Spec{Name: "acme", URL: private.URL + "/private/"},
+			wantErr: true,
+		},
+		{
+			name:    "Unknown gem",
+			spec:    Spec{Name: "donotexist", URL: server.URL},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := New(tt.spec)
+			require.NoError(t, err)
+
+			gotResult := result.Source{}
+			err = r.Source("", &gotResult)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, gotResult.Information)
+		})
+	}
+}
+
+func TestCondition(t *testing.T) {
+	server := newTestServer(t)
+
+	tests := []struct {
+		name           string
+		spec           Spec
+		source         string
+		expectedResult bool
+		wantErr        bool
+	}{
+		{
+			name:           "Version from source",
+			spec:           Spec{Name: "nokogiri"},
+			source:         "1.16.8",
+			expectedResult: true,
+		},
+		{
+			name:           "Non canonical version",
+			spec:           Spec{Name: "nokogiri", Version: "1.16.10.0"},
+			expectedResult: true,
+		},
+		{
+			name:           "Missing version",
+			spec:           Spec{Name: "nokogiri", Version: "1.16.9"},
+			expectedResult: false,
+		},
+		{
+			name:    "Invalid version",
+			spec:    Spec{Name: "nokogiri", Version: "latest"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.spec.URL = server.URL
+
+			r, err := New(tt.spec)
+			require.NoError(t, err)
+
+			gotResult, _, err := r.Condition(tt.source, nil)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, gotResult)
+		})
+	}
+}
+
+func TestPublishDate(t *testing.T) {
+	server := newTestServer(t)
+	private := newTestCompactIndexServer(t)
+
+	tests := []struct {
+		name     string
+		spec     Spec
+		version  string
+		expected time.Time
+		wantErr  bool
+	}{
+		{
+			name:     "Oldest platform release time",
+			spec:     Spec{Name: "nokogiri", URL: server.URL},
+			version:  "1.16.8",
+			expected: time.Date(2024, time.December, 2, 17, 30, 12, 0, time.UTC),
+		},
+		{
+			name:    "Unknown version",
+			spec:    Spec{Name: "nokogiri", URL: server.URL},
+			version: "1.16.9",
+			wantErr: true,
+		},
+		{
+			name:    "Compact index without release time",
+			spec:    Spec{Name: "acme", URL: private.URL + "/private/", Username: "token", Password: "secret"},
+			version: "1.1.0",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := New(tt.spec)
+			require.NoError(t, err)
+
+			got, err := r.PublishDate(tt.version)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, tt.expected.Equal(got))
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	_, err := New(Spec{})
+	require.ErrorIs(t, err, ErrSpecNameUndefined)
+}
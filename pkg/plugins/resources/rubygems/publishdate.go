@@ -0,0 +1,29 @@
+package rubygems
+
+import (
+	"fmt"
+	"time"
+)
+
+// PublishDate returns the release date of the gem version, as returned by the RubyGems API
+func (r *RubyGems) PublishDate(version string) (time.Time, error) {
+	releases, err := r.getReleases()
+	if err != nil {
+		return time.Time{}, fmt.Errorf("retrieving gem %q releases: %w", r.spec.Name, err)
+	}
+
+	for _, rel := range releases {
+		if rel.Version != version {
+			continue
+		}
+
+		// The compact index doesn't provide publication dates
+		if rel.Time.IsZero() {
+			break
+		}
+
+		return rel.Time, nil
+	}
+
+	return time.Time{}, fmt.Errorf("no publication date found for gem %q version %q", r.spec.Name, version)
+}
@@ -0,0 +1,35 @@
+package rubygems
+
+import (
+	"fmt"
+
+	"github.com/updatecli/updatecli/pkg/core/result"
+)
+
+// Source returns the latest gem version
+func (r *RubyGems) Source(workingDir string, resultSource *result.Source) error {
+	releases, err := r.getReleases()
+	if err != nil {
+		return fmt.Errorf("get gem releases: %w", err)
+	}
+
+	versions := []string{}
+	for _, rel := range releases {
+		versions = append(versions, rel.Version)
+	}
+
+	if len(versions) == 0 {
+		return fmt.Errorf("no version found for gem %q", r.spec.Name)
+	}
+
+	r.foundVersion, err = r.versionFilter.Search(versions)
+	if err != nil {
+		return fmt.Errorf("filtering gem %q versions: %w", r.spec.Name, err)
+	}
+
+	resultSource.Result = result.SUCCESS
+	resultSource.Information = r.foundVersion.GetVersion()
+	resultSource.Description = fmt.Sprintf("version %q found for gem %q", r.foundVersion.GetVersion(), r.spec.Name)
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package rubygems
+
+import (
+	"errors"
+
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+// Spec defines a specification for a "rubygems" resource
+// parsed from an updatecli manifest file
+type Spec struct {
+	// [S][C] Name specifies the gem name
+	//
+	// example:
+	//   * rails
+	Name string `yaml:",omitempty" jsonschema:"required"`
+	// [C] Version defines a specific gem version
+	//
+	// default:
+	//   When used from a condition, the default value is set to the linked source output
+	Version string `yaml:",omitempty"`
+	// [S][C] URL defines the gem server url, such as rubygems.org or a private gem server.
+	// The versions are retrieved from the RubyGems API "/api/v1/versions/<name>.json",
+	// or from the compact index "/info/<name>" when the gem server doesn't provide the API.
+	//
+	// default:
+	//   https://rubygems.org/
+	URL string `yaml:",omitempty"`
+	// [S][C] Username defines the username used to authenticate on a private gem server
+	Username string `yaml:",omitempty"`
+	// [S][C] Password defines the password, or token, used to authenticate on a private gem server
+	Password string `yaml:",omitempty"`
+	// [S] VersionFilter provides parameters to specify version pattern and its type like regex, semver, or just latest.
+	//
+	// default:
+	//   When not specified, the version filter kind "rubygems" is used, which accepts
+	//   gem requirements such as "~> 7.1" and returns the newest version which isn't a pre-release
+	VersionFilter version.Filter `yaml:",omitempty"`
+}
+
+var (
+	// ErrSpecNameUndefined is returned when the gem name is not defined
+	ErrSpecNameUndefined = errors.New("gem name undefined")
+)
+
+// Validate checks if the Spec is properly defined
+func (s *Spec) Validate() error {
+	if len(s.Name) == 0 {
+		return ErrSpecNameUndefined
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package pullrequest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/reports"
+)
+
+// pullRequestMergeability is the Bitbucket Server api response describing if a pullrequest can be merged
+type pullRequestMergeability struct {
+	CanMerge   bool `json:"canMerge"`
+	Conflicted bool `json:"conflicted"`
+	Vetoes     []struct {
+		SummaryMessage string `json:"summaryMessage"`
+	} `json:"vetoes"`
+}
+
+// autoMergePullRequest merges the pullrequest if Bitbucket Server reports it can be merged,
+// otherwise it waits for a future Updatecli run.
+// Bitbucket Server merge checks, such as required builds or approvals, decide when a pullrequest can be merged.
+func (s *Stash) autoMergePullRequest(pr *scm.PullRequest, report *reports.Action) error {
+	// Timeout api query after 30sec
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	mergePath := s.pullRequestPath(pr.Number) + "/merge"
+
+	mergeability := pullRequestMergeability{}
+	if err := s.do(ctx, http.MethodGet, mergePath, nil, &mergeability); err != nil {
+		return fmt.Errorf("checking if pull request can be merged: %w", err)
+	}
+
+	if !mergeability.CanMerge {
+		reasons := []string{}
+		if mergeability.Conflicted {
+			reasons = append(reasons, "conflicts with the target branch")
+		}
+		for _, veto := range mergeability.Vetoes {
+			reasons = append(reasons, veto.SummaryMessage)
+		}
+		logrus.Infof("Bitbucket Server pull request %q can't be merged yet: %s", pr.Link, strings.Join(reasons, ", "))
+		return nil
+	}
+
+	version, err := s.getPullRequestVersion(ctx, pr.Number)
+	if err != nil {
+		return err
+	}
+
+	var in interface{}
+	if s.spec.MergeMethod != "" {
+		in = map[string]string{"strategyId": s.spec.MergeMethod}
+	}
+
+	if err := s.do(ctx, http.MethodPost, fmt.Sprintf("%s?version=%d", mergePath, version), in, nil); err != nil {
+		return fmt.Errorf("merging pull request: %w", err)
+	}
+
+	logrus.Infof("Bitbucket Server pull request merged at:\n\n\t%s\n\n", pr.Link)
+
+	report.Description = "Pull request merged"
+
+	if s.spec.RemoveSourceBranch {
+		if err := s.scm.DeleteBranch(s.SourceBranch); err != nil {
+			return fmt.Errorf("deleting source branch %q: %w", s.SourceBranch, err)
+		}
+		logrus.Infof("Branch %q deleted", s.SourceBranch)
+	}
+
+	return nil
+}
+
+// addReviewers requests reviews on the pullrequest
+func (s *Stash) addReviewers(pr *scm.PullRequest) error {
+	// Timeout api query after 30sec
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	var errs []string
+	for _, reviewer := range s.spec.Reviewers {
+		participant := map[string]interface{}{
+			"user": map[string]string{"name": reviewer},
+			"role": "REVIEWER",
+		}
+
+		if err := s.do(ctx, http.MethodPost, s.pullRequestPath(pr.Number)+"/participants", participant, nil); err != nil {
+			errs = append(errs, fmt.Sprintf("adding reviewer %q: %s", reviewer, err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "\n"))
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package pullrequest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/drone/go-scm/scm"
+	"github.com/drone/go-scm/scm/driver/stash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/core/reports"
+	"github.com/updatecli/updatecli/pkg/plugins/resources/stash/client"
+)
+
+func TestAutoMergePullRequest(t *testing.T) {
+	canMerge := false
+	var merges []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		const path = "/rest/api/1.0/projects/owner/repos/repo/pull-requests/1"
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == path:
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": 1, "version": 4})
+
+		case r.Method == http.MethodGet && r.URL.Path == path+"/merge":
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"canMerge":   canMerge,
+				"conflicted": false,
+				"vetoes":     []map[string]string{{"summaryMessage": "Not all required builds are successful yet"}},
+			})
+
+		case r.Method == http.MethodPost && r.URL.Path == path+"/merge":
+			in := map[string]string{}
+			_ = json.NewDecoder(r.Body).Decode(&in)
+			assert.Equal(t, "4", r.URL.Query().Get("version"))
+			merges = append(merges, in["strategyId"])
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": 1, "state": "MERGED"})
+
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c, err := stash.New(server.URL)
+	require.NoError(t, err)
+
+	s := Stash{
+		spec: Spec{
+			AutoMerge:   true,
+			MergeMethod: "squash",
+		},
+		client:     client.Client(c),
+		Owner:      "owner",
+		Repository: "repo",
+	}
+
+	report := reports.Action{}
+
+	// The pullrequest isn't merged until Bitbucket Server reports it can be merged
+	require.NoError(t, s.autoMergePullRequest(&scm.PullRequest{Number: 1}, &report))
+	assert.Empty(t, merges)
+
+	canMerge = true
+	require.NoError(t, s.autoMergePullRequest(&scm.PullRequest{Number: 1}, &report))
+	assert.Equal(t, []string{"squash"}, merges)
+	assert.Equal(t, "Pull request merged", report.Description)
+
+	// Errors are reported
+	assert.Error(t, s.autoMergePullRequest(&scm.PullRequest{Number: 2}, &report))
+}
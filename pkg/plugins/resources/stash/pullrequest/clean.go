@@ -1,12 +1,97 @@
 package pullrequest
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/drone/go-scm/scm"
 	"github.com/sirupsen/logrus"
 	"github.com/updatecli/updatecli/pkg/core/reports"
+	utils "github.com/updatecli/updatecli/pkg/plugins/utils/action"
 )
 
-// CleanAction verifies if an existing action requires some operations
-func (s *Stash) CleanAction(report *reports.Action) error {
-	logrus.Debugln("cleaning Stash pull-request is not yet supported. Feel free to open an issue to mark your interest.")
+// CleanAction declines an existing Bitbucket Server pullrequest if its working branch doesn't bring any changes anymore,
+// otherwise it merges the pullrequest if auto-merge is enabled and the pullrequest can be merged.
+func (s *Stash) CleanAction(report *reports.Action, closeObsolete bool) error {
+	if s.scm == nil {
+		logrus.Debugln("no Bitbucket Server scm defined, nothing to clean")
+		return nil
+	}
+
+	pr, err := s.getPullRequest()
+	if err != nil {
+		return err
+	}
+
+	if pr == nil {
+		logrus.Debugln("nothing to clean")
+		return nil
+	}
+
+	// Without push, the remote working branch doesn't contain the local changes,
+	// so it can't be considered obsolete.
+	isObsolete := false
+	if closeObsolete {
+		isObsolete, err = s.scm.IsWorkingBranchObsolete()
+		if err != nil {
+			return fmt.Errorf("checking if pull request is obsolete: %w", err)
+		}
+	}
+
+	if !isObsolete {
+		if s.spec.AutoMerge {
+			return s.autoMergePullRequest(pr, report)
+		}
+		return nil
+	}
+
+	repo := strings.Join([]string{s.Owner, s.Repository}, "/")
+
+	// Timeout api query after 30sec
+	ctx := context.Background()
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	// Not returning an error if the comment failed to be added
+	// as the main purpose of this function is to decline the pullrequest
+	_, _, err = s.client.PullRequests.CreateComment(ctx, repo, pr.Number, &scm.CommentInput{
+		Body: utils.OBSOLETEPULLREQUESTCOMMENT,
+	})
+	if err != nil {
+		logrus.Errorf("Commenting Bitbucket Server pull request: %s", err)
+	}
+
+	if err := s.declinePullRequest(ctx, pr.Number); err != nil {
+		return fmt.Errorf("declining obsolete pull request: %w", err)
+	}
+
+	logrus.Infof("Bitbucket Server pull request declined as obsolete at:\n\n\t%s\n\n", pr.Link)
+
+	report.Link = ""
+	report.Description = "Pull request declined as obsolete"
+
+	if s.spec.DeleteObsoleteBranch {
+		if err := s.scm.DeleteBranch(s.SourceBranch); err != nil {
+			return fmt.Errorf("deleting obsolete branch %q: %w", s.SourceBranch, err)
+		}
+		logrus.Infof("Branch %q deleted", s.SourceBranch)
+	}
+
 	return nil
 }
+
+// declinePullRequest declines a pullrequest.
+// The Bitbucket Server api requires the current pullrequest version which isn't exposed by the go-scm library.
+func (s *Stash) declinePullRequest(ctx context.Context, number int) error {
+	version, err := s.getPullRequestVersion(ctx, number)
+	if err != nil {
+		return err
+	}
+
+	return s.do(ctx, http.MethodPost,
+		fmt.Sprintf("%s/decline?version=%d", s.pullRequestPath(number), version),
+		nil, nil)
+}
@@ -110,5 +110,9 @@ func (s *Stash) CreateAction(report *reports.Action, resetDescription bool) erro
 
 	logrus.Infof("Bitbucket pullrequest successfully opened on %q", pr.Link)
 
+	if err := s.addReviewers(pr); err != nil {
+		logrus.Errorf("Adding Bitbucket pullrequest reviewers: %s", err)
+	}
+
 	return nil
 }
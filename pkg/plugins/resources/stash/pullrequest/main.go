@@ -22,6 +22,21 @@ type Spec struct {
 	Title string `yaml:",inline,omitempty"`
 	// Body defines the Bitbucket pullrequest body
 	Body string `yaml:",inline,omitempty"`
+	// DeleteObsoleteBranch defines if the working branch should be deleted once Updatecli closed its pullrequest
+	// because the working branch doesn't bring any changes anymore.
+	DeleteObsoleteBranch bool `yaml:",omitempty"`
+	// AutoMerge defines if the pullrequest should be merged by Updatecli once Bitbucket Server reports it can be merged.
+	// The pullrequest is merged by a later Updatecli run, when the repository merge checks,
+	// such as required builds or approvals, are satisfied.
+	AutoMerge bool `yaml:",omitempty"`
+	// MergeMethod defines the merge strategy used when the pullrequest is automatically merged,
+	// such as "no-ff", "ff", "ff-only", "squash", "squash-ff-only", "rebase-no-ff", or "rebase-ff-only".
+	// The repository default merge strategy is used if empty.
+	MergeMethod string `yaml:",omitempty"`
+	// RemoveSourceBranch defines if the pullrequest source branch should be deleted once automatically merged.
+	RemoveSourceBranch bool `yaml:",omitempty"`
+	// Reviewers defines the list of Bitbucket Server usernames requested to review the pullrequest.
+	Reviewers []string `yaml:",omitempty"`
 }
 
 // Stash contains information to interact with Bitbucket Server API
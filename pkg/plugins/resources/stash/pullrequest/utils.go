@@ -1,7 +1,11 @@
 package pullrequest
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
 	"strings"
 	"time"
 
@@ -12,6 +16,17 @@ import (
 
 // isPullRequestExist queries a remote Bitbucket instance to know if a pullrequest already exists.
 func (s *Stash) isPullRequestExist() (title, description, link string, err error) {
+	pr, err := s.getPullRequest()
+	if err != nil || pr == nil {
+		return "", "", "", err
+	}
+
+	return pr.Title, pr.Body, pr.Link, nil
+}
+
+// getPullRequest queries a remote Bitbucket instance to retrieve the open pullrequest from the source branch
+// to the target branch, it returns nil if none could be found.
+func (s *Stash) getPullRequest() (*scm.PullRequest, error) {
 	ctx := context.Background()
 	// Timeout api query after 30sec
 	ctx, cancelList := context.WithTimeout(ctx, 30*time.Second)
@@ -34,7 +49,7 @@ func (s *Stash) isPullRequestExist() (title, description, link string, err error
 
 	if err != nil {
 		logrus.Debugf("RC: %d\nBody:\n%s", resp.Status, resp.Body)
-		return "", "", "", err
+		return nil, err
 	}
 
 	if resp.Status > 400 {
@@ -51,10 +66,10 @@ func (s *Stash) isPullRequestExist() (title, description, link string, err error
 				result.SUCCESS,
 				p.Link)
 
-			return p.Title, p.Body, p.Link, nil
+			return p, nil
 		}
 	}
-	return "", "", "", nil
+	return nil, nil
 }
 
 // isRemoteBranchesExist queries a remote Bitbucket instance to know if both the pull-request source branch and the target branch exist.
@@ -169,3 +184,60 @@ func (s *Stash) inheritFromScm() {
 		s.Repository = s.spec.Repository
 	}
 }
+
+// pullRequestPath returns the Bitbucket Server api path of a pullrequest
+func (s *Stash) pullRequestPath(number int) string {
+	return fmt.Sprintf("rest/api/1.0/projects/%s/repos/%s/pull-requests/%d", s.Owner, s.Repository, number)
+}
+
+// getPullRequestVersion returns the current version of a pullrequest which is required
+// by the Bitbucket Server api to modify it.
+func (s *Stash) getPullRequestVersion(ctx context.Context, number int) (int, error) {
+	pr := struct {
+		Version int `json:"version"`
+	}{}
+
+	if err := s.do(ctx, http.MethodGet, s.pullRequestPath(number), nil, &pr); err != nil {
+		return 0, err
+	}
+
+	return pr.Version, nil
+}
+
+// do sends a raw api request to Bitbucket Server, for endpoints not supported by the go-scm library.
+// The request body is encoded from in, and the response body is decoded into out, if not nil.
+func (s *Stash) do(ctx context.Context, method, path string, in, out interface{}) error {
+	req := &scm.Request{
+		Method: method,
+		Path:   path,
+	}
+
+	if in != nil {
+		buf := new(bytes.Buffer)
+		if err := json.NewEncoder(buf).Encode(in); err != nil {
+			return err
+		}
+		req.Header = map[string][]string{
+			"Content-Type": {"application/json"},
+		}
+		req.Body = buf
+	}
+
+	resp, err := (*scm.Client)(s.client).Do(ctx, req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.Status >= 300 {
+		return fmt.Errorf("%s %s: %s", method, path, http.StatusText(resp.Status))
+	}
+
+	if out != nil {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return fmt.Errorf("decoding %s %s response: %w", method, path, err)
+		}
+	}
+
+	return nil
+}
@@ -1,8 +1,25 @@
 package tag
 
-import "github.com/updatecli/updatecli/pkg/core/result"
+import (
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/core/result"
+	scmChangelog "github.com/updatecli/updatecli/pkg/plugins/changelog/scm"
+)
 
-// Changelog returns the changelog for this resource, or an empty string if not supported
+// Changelog returns the list of commits between two Bitbucket Server tags
 func (g *Stash) Changelog(from, to string) *result.Changelogs {
-	return nil
+	changelog := scmChangelog.Commits{
+		Client:     g.client,
+		URL:        g.spec.URL,
+		Owner:      g.spec.Owner,
+		Repository: g.spec.Repository,
+	}
+
+	commits, err := changelog.Search(from, to)
+	if err != nil {
+		logrus.Debugf("ignored error, searching Bitbucket Server commits: %s", err)
+		return nil
+	}
+
+	return &commits
 }
@@ -2,6 +2,7 @@ package xml
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/beevik/etree"
 	"github.com/updatecli/updatecli/pkg/core/pipeline/scm"
@@ -10,9 +11,9 @@ import (
 // Condition checks that a specific xml path contains the correct value at the specified path
 func (x *XML) Condition(source string, scm scm.ScmHandler) (pass bool, message string, err error) {
 
-	resourceFile := x.spec.File
+	rootDir := ""
 	if scm != nil {
-		resourceFile = joinPathWithWorkingDirectoryPath(x.spec.File, scm.GetDirectory())
+		rootDir = scm.GetDirectory()
 	}
 
 	value := source
@@ -20,41 +21,62 @@ func (x *XML) Condition(source string, scm scm.ScmHandler) (pass bool, message s
 		value = x.spec.Value
 	}
 
-	// Test at runtime if a file exist
-	if !x.contentRetriever.FileExists(resourceFile) {
-		return false, "", fmt.Errorf("file %q does not exist", resourceFile)
-	}
-
-	if err := x.Read(resourceFile); err != nil {
+	resourceFiles, err := x.resourceFiles(rootDir)
+	if err != nil {
 		return false, "", err
 	}
 
-	doc := etree.NewDocument()
+	pass = true
+	messages := []string{}
 
-	if err := doc.ReadFromString(x.currentContent); err != nil {
-		return false, "", err
-	}
+	for _, resourceFile := range resourceFiles {
+		// Test at runtime if a file exist
+		if !x.contentRetriever.FileExists(resourceFile) {
+			return false, "", fmt.Errorf("file %q does not exist", resourceFile)
+		}
 
-	elem := doc.FindElement(x.spec.Path)
+		if err := x.Read(resourceFile); err != nil {
+			return false, "", err
+		}
 
-	if elem == nil {
-		return false, fmt.Sprintf("nothing found in path %q from file %q",
-			x.spec.Path,
-			resourceFile,
-		), nil
-	}
+		doc := etree.NewDocument()
+
+		if err := doc.ReadFromString(x.currentContent); err != nil {
+			return false, "", err
+		}
+
+		elems, err := x.findElements(doc, x.spec.Multiple)
+		if err != nil {
+			return false, "", err
+		}
+
+		if len(elems) == 0 {
+			pass = false
+			messages = append(messages, fmt.Sprintf("nothing found in path %q from file %q",
+				x.spec.Path,
+				resourceFile,
+			))
+			continue
+		}
+
+		for _, elem := range elems {
+			if value == elem.Text() {
+				messages = append(messages, fmt.Sprintf("Path %q, from file %q, is correctly set to %s",
+					x.spec.Path,
+					resourceFile,
+					value))
+				continue
+			}
 
-	if value == elem.Text() {
-		return true, fmt.Sprintf("Path %q, from file %q, is correctly set to %s",
-			x.spec.Path,
-			resourceFile,
-			value), nil
+			pass = false
+			messages = append(messages, fmt.Sprintf("Path %q, from file %q, is incorrectly set to %q and should be %q",
+				x.spec.Path,
+				resourceFile,
+				elem.Text(),
+				value,
+			))
+		}
 	}
 
-	return false, fmt.Sprintf("Path %q, from file %q, is incorrectly set to %q and should be %q",
-		x.spec.Path,
-		resourceFile,
-		elem.Text(),
-		value,
-	), nil
+	return pass, strings.Join(messages, "\n"), nil
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/mitchellh/mapstructure"
 	"github.com/sirupsen/logrus"
 	"github.com/updatecli/updatecli/pkg/core/text"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
 )
 
 var (
@@ -19,6 +20,10 @@ type XML struct {
 	spec             Spec
 	contentRetriever text.TextRetriever
 	currentContent   string
+	// Holds both parsed version and original version (to allow retrieving metadata such as changelog)
+	foundVersion version.Version
+	// Holds the "valid" version.filter, that might be different than the user-specified filter (Spec.VersionFilter)
+	versionFilter version.Filter
 }
 
 func New(spec interface{}) (*XML, error) {
@@ -31,10 +36,19 @@ func New(spec interface{}) (*XML, error) {
 	}
 
 	newSpec.File = strings.TrimPrefix(newSpec.File, "file://")
+	for i := range newSpec.Files {
+		newSpec.Files[i] = strings.TrimPrefix(newSpec.Files[i], "file://")
+	}
+
+	newFilter, err := newSpec.VersionFilter.Init()
+	if err != nil {
+		return nil, err
+	}
 
 	x := XML{
 		spec:             newSpec,
 		contentRetriever: &text.Text{},
+		versionFilter:    newFilter,
 	}
 
 	err = x.Validate()
@@ -73,8 +87,12 @@ func (x *XML) Validate() (err error) {
 // to identify the resource without any sensitive information or context specific data.
 func (x *XML) ReportConfig() interface{} {
 	return Spec{
-		File:  x.spec.File,
-		Path:  x.spec.Path,
-		Value: x.spec.Value,
+		File:          x.spec.File,
+		Files:         x.spec.Files,
+		Path:          x.spec.Path,
+		Value:         x.spec.Value,
+		Multiple:      x.spec.Multiple,
+		VersionFilter: x.spec.VersionFilter,
+		Namespaces:    x.spec.Namespaces,
 	}
 }
@@ -4,14 +4,15 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/beevik/etree"
+	"github.com/sirupsen/logrus"
 	"github.com/updatecli/updatecli/pkg/core/result"
 )
 
 // Source returns a value from a xml file
 func (x *XML) Source(workingDir string, resultSource *result.Source) error {
-
 	// By the default workingdir is set to the current working directory
 	// it would be better to have it empty by default but it must be changed in the
 	// source core codebase.
@@ -20,44 +21,109 @@ func (x *XML) Source(workingDir string, resultSource *result.Source) error {
 		return errors.New("fail getting current working directory")
 	}
 
-	resourceFile := x.spec.File
 	// To merge File path with current working dire, unless file is an http url
+	rootDir := ""
 	if workingDir != currentWorkingDirectory {
-		resourceFile = joinPathWithWorkingDirectoryPath(x.spec.File, workingDir)
+		rootDir = workingDir
 	}
 
-	// Test at runtime if a file exist
-	if !x.contentRetriever.FileExists(resourceFile) {
-		return fmt.Errorf("file %q does not exist", resourceFile)
+	resourceFiles, err := x.resourceFiles(rootDir)
+	if err != nil {
+		return err
 	}
 
-	if err := x.Read(resourceFile); err != nil {
-		return fmt.Errorf("reading file: %w", err)
+	if len(resourceFiles) > 1 && x.spec.VersionFilter.IsZero() {
+		return errors.New("source only supports one file, unless versionfilter is specified")
 	}
 
-	doc := etree.NewDocument()
+	// A version filter is applied on every node matching the path, across every file
+	values := []string{}
+	valueFiles := map[string]string{}
 
-	if err := doc.ReadFromString(x.currentContent); err != nil {
-		return fmt.Errorf("loading document: %w", err)
-	}
+	for _, resourceFile := range resourceFiles {
+		fileValues, err := x.sourceValues(resourceFile)
+		if err != nil {
+			return err
+		}
 
-	elem := doc.FindElement(x.spec.Path)
+		if len(fileValues) == 0 {
+			if len(resourceFiles) == 1 {
+				return fmt.Errorf("cannot find value for path %q from file %q",
+					x.spec.Path,
+					resourceFile,
+				)
+			}
+			logrus.Debugf("no value found for path %q from file %q", x.spec.Path, resourceFile)
+			continue
+		}
 
-	if elem == nil {
-		return fmt.Errorf("cannot find value for path %q from file %q",
+		for _, value := range fileValues {
+			if _, found := valueFiles[value]; !found {
+				valueFiles[value] = resourceFile
+			}
+		}
+		values = append(values, fileValues...)
+	}
+
+	if len(values) == 0 {
+		return fmt.Errorf("cannot find value for path %q from files %q",
 			x.spec.Path,
-			resourceFile,
+			strings.Join(resourceFiles, ", "),
 		)
 	}
 
-	queryResult := elem.Text()
+	queryResult := values[0]
+
+	if !x.spec.VersionFilter.IsZero() {
+		var err error
+		x.foundVersion, err = x.versionFilter.Search(values)
+		if err != nil {
+			return fmt.Errorf("filtering result: %w", err)
+		}
+		queryResult = x.foundVersion.GetVersion()
+	}
+
+	resultFile, found := valueFiles[queryResult]
+	if !found {
+		resultFile = strings.Join(resourceFiles, ", ")
+	}
 
 	resultSource.Result = result.SUCCESS
 	resultSource.Information = queryResult
 	resultSource.Description = fmt.Sprintf("value %q found at path %q in the xml file %q",
 		queryResult,
 		x.spec.Path,
-		resourceFile)
+		resultFile)
 
 	return nil
 }
+
+// sourceValues returns the text of the nodes matching the path in resourceFile.
+// Every matching node is returned when a version filter is specified, only the first one otherwise.
+func (x *XML) sourceValues(resourceFile string) ([]string, error) {
+	// Test at runtime if a file exist
+	if !x.contentRetriever.FileExists(resourceFile) {
+		return nil, fmt.Errorf("file %q does not exist", resourceFile)
+	}
+
+	if err := x.Read(resourceFile); err != nil {
+		return nil, fmt.Errorf("reading file: %w", err)
+	}
+
+	doc := etree.NewDocument()
+	if err := doc.ReadFromString(x.currentContent); err != nil {
+		return nil, fmt.Errorf("loading document: %w", err)
+	}
+
+	elems, err := x.findElements(doc, !x.spec.VersionFilter.IsZero())
+	if err != nil {
+		return nil, err
+	}
+
+	values := []string{}
+	for _, elem := range elems {
+		values = append(values, elem.Text())
+	}
+
+	return values, nil
+}
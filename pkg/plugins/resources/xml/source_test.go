@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/updatecli/updatecli/pkg/core/result"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
 )
 
 func TestSource(t *testing.T) {
@@ -43,6 +44,78 @@ func TestSource(t *testing.T) {
 			wantErr:          true,
 			expectedErrorMsg: "cannot find value for path \"doNotExist\" from file \"testdata/data_1.xml\"",
 		},
+		{
+			name: "scenario 3 - namespace",
+			spec: Spec{
+				File: "testdata/pom.xml",
+				Path: "/m:project/m:version",
+				Namespaces: map[string]string{
+					"m": "http://maven.apache.org/POM/4.0.0",
+				},
+			},
+			expectedResult: "1.0.0",
+		},
+		{
+			name: "scenario 4 - versionfilter",
+			spec: Spec{
+				File: "testdata/pom.xml",
+				Path: "//m:dependency[m:artifactId='junit']/m:version",
+				Namespaces: map[string]string{
+					"m": "http://maven.apache.org/POM/4.0.0",
+				},
+				VersionFilter: version.Filter{
+					Kind:    "semver",
+					Pattern: "~4",
+				},
+			},
+			expectedResult: "4.13.2",
+		},
+		{
+			name: "scenario 5 - multiple files",
+			spec: Spec{
+				Files: []string{"testdata/dotnet/**/*.csproj"},
+				Path:  "//SerilogVersion",
+			},
+			wantErr:          true,
+			expectedErrorMsg: "source only supports one file, unless versionfilter is specified",
+		},
+		{
+			name: "scenario 5.1 - multiple files with versionfilter",
+			spec: Spec{
+				Files: []string{"testdata/dotnet/**/*.csproj"},
+				Path:  "//SerilogVersion",
+				VersionFilter: version.Filter{
+					Kind:    "semver",
+					Pattern: "~3.0",
+				},
+			},
+			expectedResult: "3.0.0",
+		},
+		{
+			name: "scenario 5.2 - multiple files with latest versionfilter",
+			spec: Spec{
+				Files: []string{"testdata/dotnet/**/*.csproj"},
+				Path:  "//SerilogVersion",
+				VersionFilter: version.Filter{
+					Kind:    "semver",
+					Pattern: "*",
+				},
+			},
+			expectedResult: "3.1.1",
+		},
+		{
+			name: "scenario 5.3 - multiple files without matching value",
+			spec: Spec{
+				Files: []string{"testdata/dotnet/**/*.csproj"},
+				Path:  "//DoNotExist",
+				VersionFilter: version.Filter{
+					Kind:    "semver",
+					Pattern: "*",
+				},
+			},
+			wantErr:          true,
+			expectedErrorMsg: "cannot find value for path \"//DoNotExist\" from files",
+		},
 		{
 			name: "scenario 3",
 			spec: Spec{
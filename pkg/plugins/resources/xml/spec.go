@@ -1,6 +1,10 @@
 package xml
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
 
 /*
 "xml" defines the specification for manipulating "xml" files.
@@ -16,9 +20,27 @@ type Spec struct {
 			* target
 
 		remark:
+			* "file" and "files" are mutually exclusive
 			* scheme "https://", "http://", and "file://" are supported in path for source and condition
 	*/
 	File string `yaml:",omitempty"`
+	/*
+		"files" defines the list of xml files path to interact with.
+
+		compatible:
+			* source
+			* condition
+			* target
+
+		remark:
+			* "file" and "files" are mutually exclusive
+			* glob patterns are accepted, "**" matches any number of directories
+			* when used from a source matching more than one file, "versionfilter" is required
+
+		example:
+			* files: ["*.csproj", "Directory.Packages.props"]
+	*/
+	Files []string `yaml:",omitempty"`
 	/*
 		"path" defines the xpath query used for retrieving value from a XML document
 
@@ -31,6 +53,7 @@ type Spec struct {
 			* path: "/project/parent/version"
 			* path: "//breakfast_menu/food[0]/name"
 			* path: "//book[@category='WEB']/title"
+			* path: "/m:project/m:version" with the namespace prefix "m" defined in "namespaces"
 	*/
 	Path string `yaml:",omitempty"`
 	/*
@@ -43,9 +66,41 @@ type Spec struct {
 
 		default:
 			when used from a condition or a target, the default value is set to linked source output
-
 	*/
 	Value string `yaml:",omitempty"`
+	/*
+		"multiple" allows to interact with every node matched by the xpath query instead of only the first one.
+
+		compatible:
+			* condition
+			* target
+
+		default:
+			false
+	*/
+	Multiple bool `yaml:",omitempty"`
+	/*
+		"versionfilter" provides parameters to specify version pattern and its type like regex, semver, or just latest.
+		When set, the source returns the newest version across every node value matched by the xpath query, in every file.
+
+		compatible:
+			* source
+	*/
+	VersionFilter version.Filter `yaml:",omitempty"`
+	/*
+		"namespaces" maps a namespace prefix, used in the xpath query, to a namespace URI.
+		It allows to query documents using a default namespace, or a prefix different from the one used in the query.
+
+		compatible:
+			* source
+			* condition
+			* target
+
+		example:
+			namespaces:
+				m: "http://maven.apache.org/POM/4.0.0"
+	*/
+	Namespaces map[string]string `yaml:",omitempty"`
 }
 
 var (
@@ -53,14 +108,22 @@ var (
 	ErrSpecFileUndefined = errors.New("xml file not specified")
 	// ErrSpecPathUndefined is returned when the path is not defined
 	ErrSpecPathUndefined = errors.New("xml path undefined")
+	// ErrSpecFileAndFilesDefined is returned when both file and files are defined
+	ErrSpecFileAndFilesDefined = errors.New("parameter \"file\" and \"files\" are mutually exclusive")
 )
 
 func (s *Spec) Validate() (errs []error) {
-	if len(s.File) == 0 {
-		errs = append(errs, errors.New(""))
+	if len(s.File) == 0 && len(s.Files) == 0 {
+		errs = append(errs, ErrSpecFileUndefined)
+	}
+
+	if len(s.File) > 0 && len(s.Files) > 0 {
+		errs = append(errs, ErrSpecFileAndFilesDefined)
 	}
+
 	if len(s.Path) == 0 {
 		errs = append(errs, ErrSpecPathUndefined)
 	}
+
 	return errs
 }
@@ -2,6 +2,7 @@ package xml
 
 import (
 	"fmt"
+	"path/filepath"
 	"strings"
 
 	"github.com/beevik/etree"
@@ -13,9 +14,10 @@ import (
 // Target updates a scm repository based on the modified yaml file.
 func (x *XML) Target(source string, scm scm.ScmHandler, dryRun bool, resultTarget *result.Target) (err error) {
 
-	if strings.HasPrefix(x.spec.File, "https://") ||
-		strings.HasPrefix(x.spec.File, "http://") {
-		return fmt.Errorf("URL scheme is not supported for XML target: %q", x.spec.File)
+	for _, file := range append([]string{x.spec.File}, x.spec.Files...) {
+		if isURL(file) {
+			return fmt.Errorf("URL scheme is not supported for XML target: %q", file)
+		}
 	}
 
 	value := source
@@ -25,60 +27,95 @@ func (x *XML) Target(source string, scm scm.ScmHandler, dryRun bool, resultTarge
 
 	resultTarget.NewInformation = value
 
-	resourceFile := x.spec.File
+	rootDir := ""
 	if scm != nil {
-		resourceFile = joinPathWithWorkingDirectoryPath(x.spec.File, scm.GetDirectory())
-	}
-
-	// Test at runtime if a file exist
-	if !x.contentRetriever.FileExists(resourceFile) {
-		return fmt.Errorf("file %q does not exist", resourceFile)
+		rootDir = scm.GetDirectory()
 	}
 
-	if err := x.Read(resourceFile); err != nil {
+	resourceFiles, err := x.resourceFiles(rootDir)
+	if err != nil {
 		return err
 	}
 
-	doc := etree.NewDocument()
+	resultTarget.Result = result.SUCCESS
 
-	if err := doc.ReadFromString(x.currentContent); err != nil {
-		return err
-	}
-
-	elem := doc.FindElement(x.spec.Path)
-	if elem == nil {
-		return fmt.Errorf("nothing found at path %q from file %q", x.spec.Path, resourceFile)
-	}
+	informationSet := false
+	descriptions := []string{}
 
-	resultTarget.Information = elem.Text()
-	resultTarget.NewInformation = value
+	for _, resourceFile := range resourceFiles {
+		// Test at runtime if a file exist
+		if !x.contentRetriever.FileExists(resourceFile) {
+			return fmt.Errorf("file %q does not exist", resourceFile)
+		}
 
-	if elem.Text() == value {
-		resultTarget.Result = result.SUCCESS
-		resultTarget.Description = fmt.Sprintf("path %q already set to %q in file %q",
-			x.spec.Path,
-			value,
-			resourceFile)
-		return nil
-	}
-	resultTarget.Result = result.ATTENTION
-	resultTarget.Changed = true
+		if err := x.Read(resourceFile); err != nil {
+			return err
+		}
 
-	resultTarget.Description = fmt.Sprintf("path %q updated from %q to %q in file %q",
-		x.spec.Path,
-		elem.Text(),
-		value,
-		resourceFile)
+		doc := etree.NewDocument()
 
-	if !dryRun {
-		elem.SetText(value)
+		if err := doc.ReadFromString(x.currentContent); err != nil {
+			return err
+		}
 
-		if err := doc.WriteToFile(resourceFile); err != nil {
+		elems, err := x.findElements(doc, x.spec.Multiple)
+		if err != nil {
 			return err
 		}
+
+		if len(elems) == 0 {
+			return fmt.Errorf("nothing found at path %q from file %q", x.spec.Path, resourceFile)
+		}
+
+		fileChanged := false
+		for _, elem := range elems {
+			if !informationSet {
+				resultTarget.Information = elem.Text()
+				informationSet = true
+			}
+
+			if elem.Text() == value {
+				descriptions = append(descriptions, fmt.Sprintf("path %q already set to %q in file %q",
+					x.spec.Path,
+					value,
+					resourceFile))
+				continue
+			}
+
+			fileChanged = true
+			descriptions = append(descriptions, fmt.Sprintf("path %q updated from %q to %q in file %q",
+				x.spec.Path,
+				elem.Text(),
+				value,
+				resourceFile))
+
+			elem.SetText(value)
+		}
+
+		if !fileChanged {
+			continue
+		}
+
+		resultTarget.Result = result.ATTENTION
+		resultTarget.Changed = true
+
+		if !dryRun {
+			if err := doc.WriteToFile(resourceFile); err != nil {
+				return err
+			}
+		}
+
+		relativeFile := resourceFile
+		if rootDir != "" {
+			if f, err := filepath.Rel(rootDir, resourceFile); err == nil {
+				relativeFile = f
+			}
+		}
+
+		resultTarget.Files = append(resultTarget.Files, relativeFile)
 	}
 
-	resultTarget.Files = append(resultTarget.Files, x.spec.File)
+	resultTarget.Description = strings.Join(descriptions, "\n")
 
 	return nil
 }
@@ -16,6 +16,7 @@ func TestTarget(t *testing.T) {
 		spec             Spec
 		expectedResult   bool
 		expectedErrorMsg error
+		expectedFiles    []string
 		wantErr          bool
 	}{
 		{
@@ -87,6 +88,40 @@ func TestTarget(t *testing.T) {
 			expectedResult:   false,
 			expectedErrorMsg: errors.New("URL scheme is not supported for XML target: \"https://raw.githubusercontent.com/updatecli/updatecli/main/pkg/plugins/resources/xml/testdata/data_2.xml\""),
 		},
+		{
+			name: "Test 8 - multiple nodes across files",
+			spec: Spec{
+				Files:    []string{"testdata/dotnet/**/*.csproj"},
+				Path:     "//SerilogVersion",
+				Value:    "3.1.1",
+				Multiple: true,
+			},
+			expectedResult: true,
+			expectedFiles:  []string{"testdata/dotnet/src/worker/worker.csproj"},
+		},
+		{
+			name: "Test 9 - multiple nodes already up to date",
+			spec: Spec{
+				Files:    []string{"testdata/dotnet/src/api/api.csproj"},
+				Path:     "//PackageReference[@Include='Serilog']",
+				Value:    "",
+				Multiple: true,
+			},
+			expectedResult: false,
+		},
+		{
+			name: "Test 10 - namespace",
+			spec: Spec{
+				File:  "testdata/pom.xml",
+				Path:  "/m:project/m:version",
+				Value: "1.1.0",
+				Namespaces: map[string]string{
+					"m": "http://maven.apache.org/POM/4.0.0",
+				},
+			},
+			expectedResult: true,
+			expectedFiles:  []string{"testdata/pom.xml"},
+		},
 	}
 
 	for _, tt := range testData {
@@ -106,6 +141,9 @@ func TestTarget(t *testing.T) {
 			}
 
 			assert.Equal(t, tt.expectedResult, gotResult.Changed)
+			if tt.expectedFiles != nil {
+				assert.Equal(t, tt.expectedFiles, gotResult.Files)
+			}
 		})
 	}
 
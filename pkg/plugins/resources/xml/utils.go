@@ -1,8 +1,13 @@
 package xml
 
 import (
+	"fmt"
+	"io/fs"
 	"path/filepath"
+	"regexp"
 	"strings"
+
+	"github.com/beevik/etree"
 )
 
 // joinPathwithworkingDirectoryPath To merge File path with current working dire, unless file is an http url
@@ -16,3 +21,226 @@ func joinPathWithWorkingDirectoryPath(fileName, workingDir string) string {
 
 	return filepath.Join(workingDir, fileName)
 }
+
+// isURL returns true if fileName is an http(s) url
+func isURL(fileName string) bool {
+	return strings.HasPrefix(fileName, "https://") ||
+		strings.HasPrefix(fileName, "http://")
+}
+
+// resourceFiles returns every file path defined by the spec, relative to workingDir,
+// with glob patterns expanded.
+func (x *XML) resourceFiles(workingDir string) ([]string, error) {
+	patterns := x.spec.Files
+	if len(x.spec.File) > 0 {
+		patterns = []string{x.spec.File}
+	}
+
+	files := []string{}
+	for _, pattern := range patterns {
+		if isURL(pattern) || !strings.ContainsAny(pattern, "*?[") {
+			files = append(files, joinPathWithWorkingDirectoryPath(pattern, workingDir))
+			continue
+		}
+
+		matches, err := expandGlob(joinPathWithWorkingDirectoryPath(pattern, workingDir))
+		if err != nil {
+			return nil, fmt.Errorf("expanding glob pattern %q: %w", pattern, err)
+		}
+
+		if len(matches) == 0 {
+			return nil, fmt.Errorf("no file matching %q", pattern)
+		}
+
+		files = append(files, matches...)
+	}
+
+	return files, nil
+}
+
+// expandGlob returns every file matching pattern.
+// In addition to filepath.Match syntax, "**" matches any number of directories.
+func expandGlob(pattern string) ([]string, error) {
+	if !strings.Contains(pattern, "**") {
+		return filepath.Glob(pattern)
+	}
+
+	// Walk from the longest directory prefix without any glob character
+	root := pattern[:strings.IndexAny(pattern, "*?[")]
+	root = filepath.Dir(root + "x")
+
+	re, err := globToRegexp(filepath.ToSlash(pattern))
+	if err != nil {
+		return nil, err
+	}
+
+	matches := []string{}
+	err = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() && re.MatchString(filepath.ToSlash(path)) {
+			matches = append(matches, path)
+		}
+		return nil
+	})
+
+	return matches, err
+}
+
+// globToRegexp converts a glob pattern supporting "**" into a regular expression
+func globToRegexp(pattern string) (*regexp.Regexp, error) {
+	var b strings.Builder
+	b.WriteString("^")
+
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		switch c {
+		case '*':
+			if i+1 < len(pattern) && pattern[i+1] == '*' {
+				i++
+				// "**/" matches zero or more directories
+				if i+1 < len(pattern) && pattern[i+1] == '/' {
+					i++
+					b.WriteString("(?:.*/)?")
+					continue
+				}
+				b.WriteString(".*")
+				continue
+			}
+			b.WriteString("[^/]*")
+		case '?':
+			b.WriteString("[^/]")
+		case '[':
+			end := strings.IndexByte(pattern[i:], ']')
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated character class in %q", pattern)
+			}
+			class := pattern[i+1 : i+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			b.WriteString("[" + class + "]")
+			i += end
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+
+	b.WriteString("$")
+	return regexp.Compile(b.String())
+}
+
+// resolveNamespaces rewrites an xpath query so namespace prefixes defined in namespaces
+// match elements by namespace URI instead of by the prefix used in the document.
+//
+// A location step such as "m:project" becomes "project[namespace-uri()='uri']",
+// and prefixes used inside predicates are removed.
+func resolveNamespaces(path string, namespaces map[string]string) string {
+	if len(namespaces) == 0 {
+		return path
+	}
+
+	var b strings.Builder
+	depth := 0
+	var quote byte
+
+	for i := 0; i < len(path); {
+		c := path[i]
+
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+			b.WriteByte(c)
+			i++
+			continue
+		case c == '\'' || c == '"':
+			quote = c
+			b.WriteByte(c)
+			i++
+			continue
+		case c == '[':
+			depth++
+			b.WriteByte(c)
+			i++
+			continue
+		case c == ']':
+			depth--
+			b.WriteByte(c)
+			i++
+			continue
+		}
+
+		prefix, name, n := readQualifiedName(path[i:])
+		if n == 0 {
+			b.WriteByte(c)
+			i++
+			continue
+		}
+
+		uri, ok := namespaces[prefix]
+		if prefix == "" {
+			ok = false
+		}
+		switch {
+		case !ok:
+			b.WriteString(path[i : i+n])
+		case depth == 0:
+			fmt.Fprintf(&b, "%s[namespace-uri()='%s']", name, uri)
+		default:
+			b.WriteString(name)
+		}
+		i += n
+	}
+
+	return b.String()
+}
+
+// readQualifiedName reads a name, optionally prefixed like "prefix:name", at the beginning of s.
+// It returns the number of bytes read, or 0 if s doesn't start with a name.
+func readQualifiedName(s string) (prefix, name string, n int) {
+	isNameChar := func(c byte) bool {
+		return c == '_' || c == '-' || c == '.' ||
+			('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9')
+	}
+
+	i := 0
+	for i < len(s) && isNameChar(s[i]) {
+		i++
+	}
+	if i == 0 || i >= len(s) || s[i] != ':' {
+		return "", s[:i], i
+	}
+
+	j := i + 1
+	for j < len(s) && (isNameChar(s[j]) || s[j] == '*') {
+		j++
+	}
+	if j == i+1 {
+		return "", s[:i], i
+	}
+
+	return s[:i], s[i+1 : j], j
+}
+
+// findElements returns the elements matching the spec path from doc.
+// Unless multiple is set, only the first matching element is returned.
+func (x *XML) findElements(doc *etree.Document, multiple bool) ([]*etree.Element, error) {
+	path, err := etree.CompilePath(resolveNamespaces(x.spec.Path, x.spec.Namespaces))
+	if err != nil {
+		return nil, fmt.Errorf("compiling xpath %q: %w", x.spec.Path, err)
+	}
+
+	if multiple {
+		return doc.FindElementsPath(path), nil
+	}
+
+	elem := doc.FindElementPath(path)
+	if elem == nil {
+		return nil, nil
+	}
+
+	return []*etree.Element{elem}, nil
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestJoinPathWithWorkingDirectoryPath(t *testing.T) {
@@ -61,3 +62,83 @@ func TestJoinPathWithWorkingDirectoryPath(t *testing.T) {
 		})
 	}
 }
+
+func TestResolveNamespaces(t *testing.T) {
+	namespaces := map[string]string{
+		"m": "http://maven.apache.org/POM/4.0.0",
+	}
+
+	testData := []struct {
+		name           string
+		path           string
+		expectedResult string
+	}{
+		{
+			name:           "No prefix",
+			path:           "/project/version",
+			expectedResult: "/project/version",
+		},
+		{
+			name:           "Prefixed steps",
+			path:           "/m:project/m:version",
+			expectedResult: "/project[namespace-uri()='http://maven.apache.org/POM/4.0.0']/version[namespace-uri()='http://maven.apache.org/POM/4.0.0']",
+		},
+		{
+			name:           "Prefixed predicate",
+			path:           "//m:dependency[m:artifactId='junit:core']/m:version",
+			expectedResult: "//dependency[namespace-uri()='http://maven.apache.org/POM/4.0.0'][artifactId='junit:core']/version[namespace-uri()='http://maven.apache.org/POM/4.0.0']",
+		},
+		{
+			name:           "Unknown prefix",
+			path:           "/x:project/m:version",
+			expectedResult: "/x:project/version[namespace-uri()='http://maven.apache.org/POM/4.0.0']",
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedResult, resolveNamespaces(tt.path, namespaces))
+		})
+	}
+}
+
+func TestExpandGlob(t *testing.T) {
+	testData := []struct {
+		name           string
+		pattern        string
+		expectedResult []string
+	}{
+		{
+			name:    "Recursive pattern",
+			pattern: "testdata/dotnet/**/*.csproj",
+			expectedResult: []string{
+				"testdata/dotnet/src/api/api.csproj",
+				"testdata/dotnet/src/worker/worker.csproj",
+			},
+		},
+		{
+			name:    "Single level pattern",
+			pattern: "testdata/dotnet/src/*/api.csproj",
+			expectedResult: []string{
+				"testdata/dotnet/src/api/api.csproj",
+			},
+		},
+		{
+			name:           "No match",
+			pattern:        "testdata/**/*.props",
+			expectedResult: []string{},
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			gotResult, err := expandGlob(tt.pattern)
+			require.NoError(t, err)
+			if len(tt.expectedResult) == 0 {
+				assert.Empty(t, gotResult)
+				return
+			}
+			assert.Equal(t, tt.expectedResult, gotResult)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package azuredevops
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	testData := []struct {
+		name                  string
+		spec                  map[string]interface{}
+		expectedURL           string
+		expectedWorkingBranch string
+		wantErr               bool
+	}{
+		{
+			name: "Azure DevOps Services repository",
+			spec: map[string]interface{}{
+				"organization": "updatecli",
+				"project":      "my project",
+				"repository":   "website",
+				"branch":       "main",
+			},
+			expectedURL:           "https://dev.azure.com/updatecli/my%20project/_git/website",
+			expectedWorkingBranch: "updatecli_main_1234",
+		},
+		{
+			name: "Azure DevOps Server repository",
+			spec: map[string]interface{}{
+				"url":           "devops.example.com/DefaultCollection",
+				"organization":  "updatecli",
+				"project":       "infra",
+				"repository":    "charts",
+				"branch":        "master",
+				"workingbranch": false,
+				"force":         false,
+			},
+			expectedURL:           "https://devops.example.com/DefaultCollection/updatecli/infra/_git/charts",
+			expectedWorkingBranch: "master",
+		},
+		{
+			name: "Missing project",
+			spec: map[string]interface{}{
+				"organization": "updatecli",
+				"repository":   "website",
+			},
+			wantErr: true,
+		},
+		{
+			name: "Invalid branch",
+			spec: map[string]interface{}{
+				"organization": "updatecli",
+				"project":      "infra",
+				"repository":   "charts",
+				"branch":       []string{"main"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			g, err := New(tt.spec, "1234")
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Nil(t, g)
+				return
+			}
+			require.NoError(t, err)
+
+			_, workingBranch, _ := g.GetBranches()
+			assert.Equal(t, tt.expectedURL, g.GetURL())
+			assert.Equal(t, tt.expectedWorkingBranch, workingBranch)
+		})
+	}
+}
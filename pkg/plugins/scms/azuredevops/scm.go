@@ -0,0 +1,209 @@
+package azuredevops
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/gitgeneric"
+)
+
+func (g *AzureDevOps) GetBranches() (sourceBranch, workingBranch, targetBranch string) {
+
+	sourceBranch = g.Spec.Branch
+	workingBranch = g.Spec.Branch
+	targetBranch = g.Spec.Branch
+
+	if len(g.pipelineID) > 0 && g.workingBranch {
+		workingBranch = g.nativeGitHandler.SanitizeBranchName(
+			strings.Join([]string{g.workingBranchPrefix, targetBranch, g.pipelineID}, g.workingBranchSeparator))
+	}
+
+	return sourceBranch, workingBranch, targetBranch
+}
+
+// GetURL returns an "Azure DevOps" git URL
+func (g *AzureDevOps) GetURL() string {
+	URL := fmt.Sprintf("%v/%v/%v/_git/%v",
+		g.Spec.URL,
+		url.PathEscape(g.Spec.Organization),
+		url.PathEscape(g.Spec.Project),
+		url.PathEscape(g.Spec.Repository))
+
+	return URL
+}
+
+// getUsername returns the username used for git operations.
+// Azure DevOps ignores the username when authenticating with a personal access token
+// but git requires one.
+func (g *AzureDevOps) getUsername() string {
+	if g.Spec.Username == "" && g.Spec.Token != "" {
+		return "updatecli"
+	}
+	return g.Spec.Username
+}
+
+// GetDirectory returns the local git repository path.
+func (g *AzureDevOps) GetDirectory() (directory string) {
+	return g.Spec.Directory
+}
+
+// Clean deletes the Azure DevOps working directory.
+func (g *AzureDevOps) Clean() error {
+	err := os.RemoveAll(g.Spec.Directory)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// Clone run `git clone`.
+func (g *AzureDevOps) Clone() (string, error) {
+
+	g.setDirectory()
+
+	err := g.nativeGitHandler.Clone(
+		g.getUsername(),
+		g.Spec.Token,
+		g.GetURL(),
+		g.GetDirectory(),
+		g.Spec.Submodules,
+	)
+
+	if err != nil {
+		logrus.Errorf("failed cloning Azure DevOps repository %q", g.GetURL())
+		return "", err
+	}
+
+	return g.Spec.Directory, nil
+}
+
+// Commit run `git commit`.
+func (g *AzureDevOps) Commit(message string) error {
+
+	// Generate the conventional commit message
+	commitMessage, err := g.Spec.CommitMessage.Generate(message)
+	if err != nil {
+		return err
+	}
+
+	err = g.nativeGitHandler.Commit(g.Spec.User, g.Spec.Email, commitMessage, g.GetDirectory(), g.Spec.GPG.SigningKey, g.Spec.GPG.Passphrase)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// Checkout create and then uses a temporary git branch.
+func (g *AzureDevOps) Checkout() error {
+	sourceBranch, workingBranch, _ := g.GetBranches()
+
+	err := g.nativeGitHandler.Checkout(
+		g.getUsername(),
+		g.Spec.Token,
+		sourceBranch,
+		workingBranch,
+		g.Spec.Directory,
+		g.force)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// Add run `git add`.
+func (g *AzureDevOps) Add(files []string) error {
+
+	err := g.nativeGitHandler.Add(files, g.Spec.Directory)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// IsRemoteBranchUpToDate checks if the branch reference name is published on
+// on the default remote
+func (g *AzureDevOps) IsRemoteBranchUpToDate() (bool, error) {
+	sourceBranch, workingBranch, _ := g.GetBranches()
+
+	return g.nativeGitHandler.IsLocalBranchPublished(
+		sourceBranch,
+		workingBranch,
+		g.getUsername(),
+		g.Spec.Token,
+		g.GetDirectory())
+}
+
+// Push run `git push` to the corresponding Azure DevOps remote branch if not already created.
+func (g *AzureDevOps) Push() (bool, error) {
+
+	return g.nativeGitHandler.Push(
+		g.getUsername(),
+		g.Spec.Token,
+		g.GetDirectory(),
+		g.force,
+	)
+}
+
+// PushTag push tags
+func (g *AzureDevOps) PushTag(tag string) error {
+
+	err := g.nativeGitHandler.PushTag(
+		tag,
+		g.getUsername(),
+		g.Spec.Token,
+		g.GetDirectory(),
+		g.force)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// PushBranch push branch
+func (g *AzureDevOps) PushBranch(branch string) error {
+
+	err := g.nativeGitHandler.PushTag(
+		branch,
+		g.getUsername(),
+		g.Spec.Token,
+		g.GetDirectory(),
+		g.force)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (g *AzureDevOps) GetChangedFiles(workingDir string) ([]string, error) {
+	return g.nativeGitHandler.GetChangedFiles(workingDir)
+}
+
+// IsWorkingBranchObsolete checks if the working branch doesn't bring any changes to the target branch anymore
+func (g *AzureDevOps) IsWorkingBranchObsolete() (bool, error) {
+	_, workingBranch, targetBranch := g.GetBranches()
+
+	if workingBranch == targetBranch {
+		return false, nil
+	}
+
+	return g.nativeGitHandler.IsBranchObsolete(targetBranch, workingBranch, g.GetDirectory())
+}
+
+// DeleteBranch deletes a branch from the remote git repository
+func (g *AzureDevOps) DeleteBranch(branch string) error {
+	return g.nativeGitHandler.DeleteBranch(
+		branch,
+		g.getUsername(),
+		g.Spec.Token,
+		g.GetDirectory())
+}
+
+// GetRebaseStrategy returns the strategy applied to the working branch when its base branch moved
+func (g *AzureDevOps) GetRebaseStrategy() string {
+	return gitgeneric.GetRebaseStrategy(g.Spec.RebaseStrategy, g.force)
+}
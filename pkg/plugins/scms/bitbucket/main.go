@@ -63,6 +63,21 @@ type Spec struct {
 	//    When force is set to true, Updatecli also recreate the working branches that
 	//    diverged from their base branch.
 	Force *bool `yaml:",omitempty"`
+	//  "rebasestrategy" defines how Updatecli handles the working branch when its base branch moved.
+	//
+	//  compatible:
+	//    * scm
+	//
+	//  default:
+	//    "auto" if force is set to true, "never" otherwise
+	//
+	//  remark:
+	//    Accepted values are:
+	//      * "never": the working branch is kept as it is, conflicts must be resolved manually.
+	//      * "auto": the working branch is recreated from its base branch, only if it is behind the base branch.
+	//      * "recreate": the working branch is recreated from its base branch on every run, then targets are re-applied.
+	//    "auto" and "recreate" require force to be set to true.
+	RebaseStrategy string `yaml:",omitempty"`
 	//	"gpg" specifies the GPG key and passphrased used for commit signing
 	//
 	//	compatible:
@@ -230,12 +245,18 @@ If you know what you are doing, please set the force option to true in your conf
 		}
 	}
 
+	if err := gitgeneric.ValidateRebaseStrategy(s.RebaseStrategy, force); err != nil {
+		return nil, err
+	}
+
 	c, err := client.New(clientSpec)
 	if err != nil {
 		return &Bitbucket{}, err
 	}
 
-	nativeGitHandler := gitgeneric.GoGit{}
+	nativeGitHandler := gitgeneric.GoGit{
+		RebaseStrategy: s.RebaseStrategy,
+	}
 	g := Bitbucket{
 		Spec:                   s,
 		client:                 c,
@@ -7,6 +7,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 	"github.com/updatecli/updatecli/pkg/plugins/resources/bitbucket/client"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/gitgeneric"
 )
 
 func (b *Bitbucket) GetBranches() (sourceBranch, workingBranch, targetBranch string) {
@@ -178,3 +179,28 @@ func (b *Bitbucket) PushBranch(branch string) error {
 func (b *Bitbucket) GetChangedFiles(workingDir string) ([]string, error) {
 	return b.nativeGitHandler.GetChangedFiles(workingDir)
 }
+
+// IsWorkingBranchObsolete checks if the working branch doesn't bring any changes to the target branch anymore
+func (b *Bitbucket) IsWorkingBranchObsolete() (bool, error) {
+	_, workingBranch, targetBranch := b.GetBranches()
+
+	if workingBranch == targetBranch {
+		return false, nil
+	}
+
+	return b.nativeGitHandler.IsBranchObsolete(targetBranch, workingBranch, b.GetDirectory())
+}
+
+// DeleteBranch deletes a branch from the remote git repository
+func (b *Bitbucket) DeleteBranch(branch string) error {
+	return b.nativeGitHandler.DeleteBranch(
+		branch,
+		b.GetUsername(),
+		b.GetPassword(),
+		b.GetDirectory())
+}
+
+// GetRebaseStrategy returns the strategy applied to the working branch when its base branch moved
+func (b *Bitbucket) GetRebaseStrategy() string {
+	return gitgeneric.GetRebaseStrategy(b.Spec.RebaseStrategy, b.force)
+}
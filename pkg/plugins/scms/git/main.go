@@ -28,7 +28,7 @@ type Spec struct {
 	//
 	//	remarks:
 	//		when using the ssh protocol, the user must have the right to clone the repository
-	//		based on its local ssh configuration
+	//		based on its local ssh configuration, or the "ssh" settings
 	URL string `yaml:",omitempty" jsonschema:"required"`
 	//	"username" specifies the username when using the HTTP protocol
 	//
@@ -40,6 +40,14 @@ type Spec struct {
 	//	compatible:
 	//	  * scm
 	Password string `yaml:",omitempty"`
+	//	"ssh" specifies the settings used to authenticate when using the ssh protocol
+	//
+	//	compatible:
+	//	  * scm
+	//
+	//	default:
+	//	  the ssh agent and the default known_hosts files are used
+	SSH SSHSpec `yaml:",omitempty"`
 	// 	"branch" defines the git branch to work on.
 	//
 	// 	compatible:
@@ -121,6 +129,21 @@ type Spec struct {
 	//    When force is set to true, Updatecli also recreate the working branches that
 	//    diverged from their base branch.
 	Force bool `yaml:",omitempty"`
+	//	"rebasestrategy" defines how Updatecli handles the working branch when its base branch moved.
+	//
+	//	compatible:
+	//	  * scm
+	//
+	//	default:
+	//	  "auto" if force is set to true, "never" otherwise
+	//
+	//	remark:
+	//	  Accepted values are:
+	//	    * "never": the working branch is kept as it is, conflicts must be resolved manually.
+	//	    * "auto": the working branch is recreated from its base branch, only if it is behind the base branch.
+	//	    * "recreate": the working branch is recreated from its base branch on every run, then targets are re-applied.
+	//	  "auto" and "recreate" require force to be set to true.
+	RebaseStrategy string `yaml:",omitempty"`
 	//	"commitMessage" is used to generate the final commit message.
 	//
 	//	compatible:
@@ -215,7 +238,24 @@ If you know what you are doing, please set the workingBranch option to false in
 		workingBranch = *s.WorkingBranch
 	}
 
-	nativeGitHandler := gitgeneric.GoGit{}
+	if err := gitgeneric.ValidateRebaseStrategy(s.RebaseStrategy, s.Force); err != nil {
+		return nil, err
+	}
+
+	nativeGitHandler := gitgeneric.GoGit{
+		RebaseStrategy: s.RebaseStrategy,
+	}
+
+	if !s.SSH.isZero() {
+		if !gitgeneric.IsSSHURL(s.URL) {
+			logrus.Warningf("ssh settings are ignored as the git url %q doesn't use the ssh protocol", s.URL)
+		} else {
+			nativeGitHandler.SSHAuth, err = gitgeneric.NewSSHAuth(s.URL, s.SSH.PrivateKey, s.SSH.Passphrase, s.SSH.KnownHosts)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
 
 	return &Git{
 		spec:                   s,
@@ -250,6 +290,9 @@ func (gs *Spec) Merge(child interface{}) error {
 	if childGHSpec.Force {
 		gs.Force = childGHSpec.Force
 	}
+	if childGHSpec.RebaseStrategy != "" {
+		gs.RebaseStrategy = childGHSpec.RebaseStrategy
+	}
 	if childGHSpec.GPG != (sign.GPGSpec{}) {
 		gs.GPG = childGHSpec.GPG
 	}
@@ -262,6 +305,9 @@ func (gs *Spec) Merge(child interface{}) error {
 	if childGHSpec.Username != "" {
 		gs.Username = childGHSpec.Username
 	}
+	if !childGHSpec.SSH.isZero() {
+		gs.SSH = childGHSpec.SSH
+	}
 	if childGHSpec.Submodules != nil {
 		gs.Submodules = childGHSpec.Submodules
 	}
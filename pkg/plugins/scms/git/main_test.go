@@ -94,6 +94,9 @@ func TestMerge(t *testing.T) {
 				GPG: sign.GPGSpec{
 					SigningKey: "mine",
 				},
+				SSH: SSHSpec{
+					KnownHosts: []string{"/etc/ssh/ssh_known_hosts"},
+				},
 				Force: false,
 				CommitMessage: commit.Commit{
 					Title: "Bye",
@@ -109,6 +112,9 @@ func TestMerge(t *testing.T) {
 				GPG: sign.GPGSpec{
 					SigningKey: "theirs",
 				},
+				SSH: SSHSpec{
+					PrivateKey: "theirs",
+				},
 				Force: true,
 				CommitMessage: commit.Commit{
 					Title: "Hello There",
@@ -124,6 +130,9 @@ func TestMerge(t *testing.T) {
 				GPG: sign.GPGSpec{
 					SigningKey: "theirs",
 				},
+				SSH: SSHSpec{
+					PrivateKey: "theirs",
+				},
 				Force: true,
 				CommitMessage: commit.Commit{
 					Title: "Hello There",
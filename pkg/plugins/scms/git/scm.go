@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/gitgeneric"
 )
 
 func (g *Git) GetBranches() (sourceBranch, workingBranch, targetBranch string) {
@@ -165,3 +166,8 @@ func (g *Git) PushTag(tag string) error {
 func (g *Git) GetChangedFiles(workingDir string) ([]string, error) {
 	return g.nativeGitHandler.GetChangedFiles(workingDir)
 }
+
+// GetRebaseStrategy returns the strategy applied to the working branch when its base branch moved
+func (g *Git) GetRebaseStrategy() string {
+	return gitgeneric.GetRebaseStrategy(g.spec.RebaseStrategy, g.spec.Force)
+}
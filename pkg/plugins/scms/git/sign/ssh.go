@@ -0,0 +1,124 @@
+package sign
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/base64"
+	"io"
+
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	// sshSigMagic is the preamble of ssh signatures as defined by the openssh PROTOCOL.sshsig
+	sshSigMagic = "SSHSIG"
+	// sshSigNamespace is the namespace used by git for commit signatures
+	sshSigNamespace = "git"
+	// sshSigHashAlgorithm is the hash algorithm used by ssh-keygen to sign messages
+	sshSigHashAlgorithm = "sha512"
+	// sshSigLineLength is the armored signature line length used by ssh-keygen
+	sshSigLineLength = 70
+)
+
+// SSHSigner signs git objects using an ssh key, like git does when "gpg.format" is set to "ssh"
+type SSHSigner struct {
+	signer ssh.Signer
+}
+
+// sshSignedData is the blob signed by the ssh key
+type sshSignedData struct {
+	Namespace     string
+	Reserved      string
+	HashAlgorithm string
+	Hash          []byte
+}
+
+// sshSignature is the ssh signature blob, once armored it can be verified using "ssh-keygen -Y verify"
+type sshSignature struct {
+	Version       uint32
+	PublicKey     []byte
+	Namespace     string
+	Reserved      string
+	HashAlgorithm string
+	Signature     []byte
+}
+
+// NewSSHSigner returns a signer based on a PEM encoded ssh private key
+func NewSSHSigner(privateKey string, passphrase string) (*SSHSigner, error) {
+	var signer ssh.Signer
+	var err error
+
+	switch passphrase {
+	case "":
+		signer, err = ssh.ParsePrivateKey([]byte(privateKey))
+	default:
+		signer, err = ssh.ParsePrivateKeyWithPassphrase([]byte(privateKey), []byte(passphrase))
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &SSHSigner{signer: signer}, nil
+}
+
+// PublicKey returns the public key matching the signing key
+func (s *SSHSigner) PublicKey() ssh.PublicKey {
+	return s.signer.PublicKey()
+}
+
+// Sign returns the armored ssh signature of message
+func (s *SSHSigner) Sign(message io.Reader) ([]byte, error) {
+	h := sha512.New()
+	if _, err := io.Copy(h, message); err != nil {
+		return nil, err
+	}
+
+	signedData := append([]byte(sshSigMagic), ssh.Marshal(sshSignedData{
+		Namespace:     sshSigNamespace,
+		HashAlgorithm: sshSigHashAlgorithm,
+		Hash:          h.Sum(nil),
+	})...)
+
+	var signature *ssh.Signature
+	var err error
+
+	// ssh-rsa signatures rely on sha1 which isn't accepted anymore by ssh-keygen
+	algorithmSigner, ok := s.signer.(ssh.AlgorithmSigner)
+	if ok && s.signer.PublicKey().Type() == ssh.KeyAlgoRSA {
+		signature, err = algorithmSigner.SignWithAlgorithm(rand.Reader, signedData, ssh.KeyAlgoRSASHA512)
+	} else {
+		signature, err = s.signer.Sign(rand.Reader, signedData)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	blob := append([]byte(sshSigMagic), ssh.Marshal(sshSignature{
+		Version:       1,
+		PublicKey:     s.signer.PublicKey().Marshal(),
+		Namespace:     sshSigNamespace,
+		HashAlgorithm: sshSigHashAlgorithm,
+		Signature:     ssh.Marshal(signature),
+	})...)
+
+	return armorSSHSignature(blob), nil
+}
+
+// armorSSHSignature encodes an ssh signature blob the same way ssh-keygen does
+func armorSSHSignature(blob []byte) []byte {
+	encoded := base64.StdEncoding.EncodeToString(blob)
+
+	b := bytes.Buffer{}
+	b.WriteString("-----BEGIN SSH SIGNATURE-----\n")
+	for len(encoded) > sshSigLineLength {
+		b.WriteString(encoded[:sshSigLineLength] + "\n")
+		encoded = encoded[sshSigLineLength:]
+	}
+	b.WriteString(encoded + "\n")
+	b.WriteString("-----END SSH SIGNATURE-----\n")
+
+	return b.Bytes()
+}
@@ -0,0 +1,36 @@
+package git
+
+// SSHSpec defines the settings used to authenticate with a git remote over the ssh protocol.
+type SSHSpec struct {
+	//	"privatekey" specifies the PEM encoded ssh private key used to authenticate.
+	//
+	//	compatible:
+	//	  * scm
+	//
+	//	default:
+	//	  the keys available from the ssh agent, using the SSH_AUTH_SOCK environment variable
+	//
+	//	remark:
+	//	  It's recommended to retrieve the private key from an environment variable such as:
+	//	    privatekey: '{{ requiredEnv "SSH_PRIVATE_KEY" }}'
+	PrivateKey string `yaml:",omitempty"`
+	//	"passphrase" specifies the passphrase used to decrypt the ssh private key.
+	//
+	//	compatible:
+	//	  * scm
+	Passphrase string `yaml:",omitempty"`
+	//	"knownhosts" specifies the known_hosts files used to validate the git remote host key.
+	//
+	//	compatible:
+	//	  * scm
+	//
+	//	default:
+	//	  the files specified by the SSH_KNOWN_HOSTS environment variable,
+	//	  otherwise ~/.ssh/known_hosts and /etc/ssh/ssh_known_hosts
+	KnownHosts []string `yaml:",omitempty"`
+}
+
+// isZero returns true if no ssh setting is defined
+func (s SSHSpec) isZero() bool {
+	return s.PrivateKey == "" && s.Passphrase == "" && len(s.KnownHosts) == 0
+}
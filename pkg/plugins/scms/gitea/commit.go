@@ -0,0 +1,122 @@
+package gitea
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/resources/gitea/client"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/gitgeneric"
+)
+
+// changeFileOperation is a file operation sent to the Gitea ChangeFiles api
+type changeFileOperation struct {
+	Operation string `json:"operation"`
+	Path      string `json:"path"`
+	Content   string `json:"content,omitempty"`
+	SHA       string `json:"sha,omitempty"`
+}
+
+// changeFilesOptions is the Gitea ChangeFiles api request body
+type changeFilesOptions struct {
+	Branch    string                `json:"branch"`
+	NewBranch string                `json:"new_branch,omitempty"`
+	Message   string                `json:"message"`
+	Files     []changeFileOperation `json:"files"`
+}
+
+// createCommit replays the local changes on the remote working branch using the Gitea ChangeFiles api.
+// Commits created that way are signed by Gitea, if the instance is configured to do so.
+func (g *Gitea) createCommit(commitMessage string) error {
+	sourceBranch, workingBranch, _ := g.GetBranches()
+	workingDir := g.GetDirectory()
+
+	changes, err := gitgeneric.GetFileChanges(workingDir)
+	if err != nil {
+		return err
+	}
+
+	if len(changes) == 0 {
+		logrus.Debugln("no changed file, nothing to commit")
+		return nil
+	}
+
+	files := getChangeFileOperations(changes)
+
+	if g.nativeGitHandler.IsForceReset() {
+		// Ensure that locally reset branch is pushed to remote branch
+		// before continuing with commit creation as that will otherwise
+		// be lost.
+		logrus.Debugf("local branch %q was reset, pushing to remote to ensure correct state", workingBranch)
+		if _, err = g.Push(); err != nil {
+			return fmt.Errorf("failed to push branch %q before creating commit: %w", workingBranch, err)
+		}
+	}
+
+	ctx := context.Background()
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	repository := strings.Join([]string{g.Spec.Owner, g.Spec.Repository}, "/")
+
+	input := changeFilesOptions{
+		Branch:  workingBranch,
+		Message: commitMessage,
+		Files:   files,
+	}
+
+	_, resp, err := g.client.Git.FindBranch(ctx, repository, workingBranch)
+	switch {
+	case resp != nil && resp.Status == http.StatusNotFound:
+		logrus.Debugf("Branch %s does not exist, creating it from branch %q", workingBranch, sourceBranch)
+		input.Branch = sourceBranch
+		input.NewBranch = workingBranch
+	case err != nil:
+		return fmt.Errorf("search branch %q: %w", workingBranch, err)
+	}
+
+	path := fmt.Sprintf("api/v1/repos/%s/contents", repository)
+	if err := client.Do(ctx, g.client, http.MethodPost, path, input); err != nil {
+		return fmt.Errorf("create commit: %w", err)
+	}
+
+	logrus.Debugf("commit created on branch %q", workingBranch)
+
+	return nil
+}
+
+// getChangeFileOperations converts local file changes to Gitea ChangeFiles operations
+func getChangeFileOperations(changes []gitgeneric.FileChange) []changeFileOperation {
+	files := make([]changeFileOperation, 0, len(changes))
+
+	for _, change := range changes {
+		if change.Deleted {
+			files = append(files, changeFileOperation{
+				Operation: "delete",
+				Path:      change.Path,
+				SHA:       change.Hash,
+			})
+			continue
+		}
+
+		content := base64.StdEncoding.EncodeToString(change.Content)
+
+		operation := "update"
+		if change.Hash == "" {
+			operation = "create"
+		}
+
+		files = append(files, changeFileOperation{
+			Operation: operation,
+			Path:      change.Path,
+			Content:   content,
+			SHA:       change.Hash,
+		})
+	}
+
+	return files
+}
@@ -63,6 +63,21 @@ type Spec struct {
 	//    When force is set to true, Updatecli also recreates the working branches that
 	//    diverged from their base branch.
 	Force *bool `yaml:",omitempty"`
+	//  "rebasestrategy" defines how Updatecli handles the working branch when its base branch moved.
+	//
+	//  compatible:
+	//    * scm
+	//
+	//  default:
+	//    "auto" if force is set to true, "never" otherwise
+	//
+	//  remark:
+	//    Accepted values are:
+	//      * "never": the working branch is kept as it is, conflicts must be resolved manually.
+	//      * "auto": the working branch is recreated from its base branch, only if it is behind the base branch.
+	//      * "recreate": the working branch is recreated from its base branch on every run, then targets are re-applied.
+	//    "auto" and "recreate" require force to be set to true.
+	RebaseStrategy string `yaml:",omitempty"`
 	//	"gpg" specifies the GPG key and passphrased used for commit signing
 	//
 	//	compatible:
@@ -141,6 +156,19 @@ type Spec struct {
 	//
 	//  default: true
 	WorkingBranch *bool `yaml:",omitempty"`
+	//  "commitUsingApi" defines if Updatecli should use the Gitea API to create the commit.
+	//  When set to `true`, commits are created by Gitea, and signed if the Gitea instance has commit signing
+	//  configured, so they show as verified without distributing a GPG key.
+	//
+	//  compatible:
+	//    * scm
+	//
+	//  default: false
+	//
+	//  remark:
+	//    The commit author is the user owning the token, the "user", "email" and "gpg" settings are ignored.
+	//    It requires Gitea 1.20 or later.
+	CommitUsingAPI *bool `yaml:",omitempty"`
 }
 
 // Gitea contains information to interact with Gitea api
@@ -155,6 +183,7 @@ type Gitea struct {
 	workingBranchPrefix    string
 	workingBranchSeparator string
 	force                  bool
+	commitUsingApi         bool
 }
 
 // New returns a new valid Gitea object.
@@ -219,6 +248,11 @@ func New(spec interface{}, pipelineID string) (*Gitea, error) {
 		force = *s.Force
 	}
 
+	commitUsingApi := false
+	if s.CommitUsingAPI != nil {
+		commitUsingApi = *s.CommitUsingAPI
+	}
+
 	if force {
 		if !workingBranch && s.Force == nil {
 			errorMsg := fmt.Sprintf(`
@@ -239,6 +273,10 @@ If you know what you are doing, please set the force option to true in your conf
 		}
 	}
 
+	if err := gitgeneric.ValidateRebaseStrategy(s.RebaseStrategy, force); err != nil {
+		return nil, err
+	}
+
 	if len(s.Branch) == 0 {
 		logrus.Warningf("no git branch specified, fallback to %q", "main")
 		s.Branch = "main"
@@ -250,7 +288,9 @@ If you know what you are doing, please set the force option to true in your conf
 		return &Gitea{}, err
 	}
 
-	nativeGitHandler := gitgeneric.GoGit{}
+	nativeGitHandler := gitgeneric.GoGit{
+		RebaseStrategy: s.RebaseStrategy,
+	}
 	g := Gitea{
 		Spec:                   s,
 		client:                 c,
@@ -260,6 +300,7 @@ If you know what you are doing, please set the force option to true in your conf
 		workingBranchPrefix:    workingBranchPrefix,
 		workingBranchSeparator: workingBranchSeparator,
 		force:                  force,
+		commitUsingApi:         commitUsingApi,
 	}
 
 	g.setDirectory()
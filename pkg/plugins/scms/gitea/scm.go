@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/gitgeneric"
 )
 
 func (g *Gitea) GetBranches() (sourceBranch, workingBranch, targetBranch string) {
@@ -76,6 +77,24 @@ func (g *Gitea) Commit(message string) error {
 		return err
 	}
 
+	if g.commitUsingApi {
+		_, workingBranch, _ := g.GetBranches()
+
+		if err := g.createCommit(commitMessage); err != nil {
+			return err
+		}
+
+		// Sync the local working branch with the commit created remotely
+		return g.nativeGitHandler.Pull(
+			g.Spec.Username,
+			g.Spec.Token,
+			g.GetDirectory(),
+			workingBranch,
+			true,
+			true,
+		)
+	}
+
 	err = g.nativeGitHandler.Commit(g.Spec.User, g.Spec.Email, commitMessage, g.GetDirectory(), g.Spec.GPG.SigningKey, g.Spec.GPG.Passphrase)
 	if err != nil {
 		return err
@@ -169,3 +188,28 @@ func (g *Gitea) PushBranch(branch string) error {
 func (g *Gitea) GetChangedFiles(workingDir string) ([]string, error) {
 	return g.nativeGitHandler.GetChangedFiles(workingDir)
 }
+
+// IsWorkingBranchObsolete checks if the working branch doesn't bring any changes to the target branch anymore
+func (g *Gitea) IsWorkingBranchObsolete() (bool, error) {
+	_, workingBranch, targetBranch := g.GetBranches()
+
+	if workingBranch == targetBranch {
+		return false, nil
+	}
+
+	return g.nativeGitHandler.IsBranchObsolete(targetBranch, workingBranch, g.GetDirectory())
+}
+
+// DeleteBranch deletes a branch from the remote git repository
+func (g *Gitea) DeleteBranch(branch string) error {
+	return g.nativeGitHandler.DeleteBranch(
+		branch,
+		g.Spec.Username,
+		g.Spec.Token,
+		g.GetDirectory())
+}
+
+// GetRebaseStrategy returns the strategy applied to the working branch when its base branch moved
+func (g *Gitea) GetRebaseStrategy() string {
+	return gitgeneric.GetRebaseStrategy(g.Spec.RebaseStrategy, g.force)
+}
@@ -0,0 +1,276 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/sirupsen/logrus"
+)
+
+// DashboardSpec specifies the configuration of an action of kind "github/dashboard"
+type DashboardSpec struct {
+	// labels specifies repository labels added to the dashboard issue.
+	//
+	// default:
+	//   empty
+	//
+	// remark:
+	//   Labels must already exist on the repository
+	Labels []string `yaml:",omitempty"`
+	// disablepin disables pinning the dashboard issue on the repository.
+	//
+	// default:
+	//   false
+	DisablePin bool `yaml:",omitempty"`
+}
+
+// Dashboard maintains a dependency dashboard issue on a GitHub repository
+type Dashboard struct {
+	spec DashboardSpec
+	gh   *Github
+}
+
+// dashboardIssueApi contains the GitHub issue fields used by the dependency dashboard
+type dashboardIssueApi struct {
+	ID     string
+	Title  string
+	Body   string
+	Url    string
+	Number int
+}
+
+// NewDashboard returns a new dependency dashboard action
+func NewDashboard(spec DashboardSpec, gh *Github) (Dashboard, error) {
+	return Dashboard{
+		spec: spec,
+		gh:   gh,
+	}, nil
+}
+
+// UpdateDashboard creates or updates the open issue titled title.
+// The issue body is generated from the current issue body, if any.
+func (d *Dashboard) UpdateDashboard(title string, generateBody func(currentBody string) (string, error)) (string, error) {
+	issue, err := d.findDashboardIssue(title)
+	if err != nil {
+		return "", fmt.Errorf("find GitHub dashboard issue: %w", err)
+	}
+
+	currentBody := ""
+	if issue != nil {
+		currentBody = issue.Body
+	}
+
+	body, err := generateBody(currentBody)
+	if err != nil {
+		return "", err
+	}
+
+	if issue == nil {
+		issue, err = d.createDashboardIssue(title, body)
+		if err != nil {
+			return "", fmt.Errorf("create GitHub dashboard issue: %w", err)
+		}
+
+		logrus.Infof("GitHub dashboard issue created at %q", issue.Url)
+
+		if !d.spec.DisablePin {
+			if err := d.pinDashboardIssue(issue.ID); err != nil {
+				logrus.Warningf("unable to pin GitHub dashboard issue %q: %s", issue.Url, err)
+			}
+		}
+
+		return issue.Url, nil
+	}
+
+	if issue.Body == body {
+		logrus.Infof("GitHub dashboard issue %q already up to date", issue.Url)
+		return issue.Url, nil
+	}
+
+	if err := d.updateDashboardIssue(issue.ID, body); err != nil {
+		return "", fmt.Errorf("update GitHub dashboard issue: %w", err)
+	}
+
+	logrus.Infof("GitHub dashboard issue %q updated", issue.Url)
+
+	return issue.Url, nil
+}
+
+// findDashboardIssue returns the oldest open issue titled title, created by the authenticated user, or nil if none.
+// Issues are listed from the repository as the search index is eventually consistent,
+// so an issue created by a previous run may not be returned by a search yet.
+func (d *Dashboard) findDashboardIssue(title string) (*dashboardIssueApi, error) {
+	login, err := d.getViewerLogin()
+	if err != nil {
+		return nil, fmt.Errorf("query authenticated user: %w", err)
+	}
+
+	/*
+		query($owner: String!, $name: String!, $filterBy: IssueFilters, $orderBy: IssueOrder, $after: String) {
+			repository(owner: $owner, name: $name) {
+				issues(first: 100, after: $after, states: OPEN, filterBy: $filterBy, orderBy: $orderBy) {
+					nodes {
+						id
+						title
+						body
+						url
+						number
+					}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+				}
+			}
+		}
+	*/
+	var query struct {
+		Repository struct {
+			Issues struct {
+				Nodes    []dashboardIssueApi
+				PageInfo struct {
+					HasNextPage bool
+					EndCursor   string
+				}
+			} `graphql:"issues(first: 100, after: $after, states: OPEN, filterBy: $filterBy, orderBy: $orderBy)"`
+		} `graphql:"repository(owner: $owner, name: $name)"`
+	}
+
+	variables := map[string]interface{}{
+		"owner": githubv4.String(d.gh.Spec.Owner),
+		"name":  githubv4.String(d.gh.Spec.Repository),
+		"filterBy": githubv4.IssueFilters{
+			CreatedBy: githubv4.NewString(githubv4.String(login)),
+		},
+		"orderBy": githubv4.IssueOrder{
+			Field:     githubv4.IssueOrderFieldCreatedAt,
+			Direction: githubv4.OrderDirectionAsc,
+		},
+		"after": (*githubv4.String)(nil),
+	}
+
+	for {
+		if err := d.gh.client.Query(context.Background(), &query, variables); err != nil {
+			return nil, err
+		}
+
+		for i := range query.Repository.Issues.Nodes {
+			if query.Repository.Issues.Nodes[i].Title == title {
+				return &query.Repository.Issues.Nodes[i], nil
+			}
+		}
+
+		if !query.Repository.Issues.PageInfo.HasNextPage {
+			return nil, nil
+		}
+
+		variables["after"] = githubv4.NewString(githubv4.String(query.Repository.Issues.PageInfo.EndCursor))
+	}
+}
+
+// getViewerLogin returns the login of the user authenticated by the GitHub token
+func (d *Dashboard) getViewerLogin() (string, error) {
+	/*
+		query {
+			viewer {
+				login
+			}
+		}
+	*/
+	var query struct {
+		Viewer struct {
+			Login string
+		}
+	}
+
+	if err := d.gh.client.Query(context.Background(), &query, nil); err != nil {
+		return "", err
+	}
+
+	return query.Viewer.Login, nil
+}
+
+// createDashboardIssue opens a new issue
+func (d *Dashboard) createDashboardIssue(title, body string) (*dashboardIssueApi, error) {
+	_, workingBranch, _ := d.gh.GetBranches()
+
+	repository, err := d.gh.queryRepository(d.gh.Spec.Branch, workingBranch)
+	if err != nil {
+		return nil, fmt.Errorf("query repository: %w", err)
+	}
+
+	input := githubv4.CreateIssueInput{
+		RepositoryID: githubv4.ID(repository.ID),
+		Title:        githubv4.String(title),
+		Body:         githubv4.NewString(githubv4.String(body)),
+	}
+
+	if len(d.spec.Labels) > 0 {
+		repositoryLabels, err := d.gh.getRepositoryLabels()
+		if err != nil {
+			return nil, fmt.Errorf("fetch repository labels: %w", err)
+		}
+
+		labelsID := []githubv4.ID{}
+		for _, l := range d.spec.Labels {
+			found := false
+			for _, repoLabel := range repositoryLabels {
+				if l == repoLabel.Name {
+					labelsID = append(labelsID, githubv4.NewID(repoLabel.ID))
+					found = true
+					break
+				}
+			}
+			if !found {
+				logrus.Warningf("label %q not found on repository %s/%s", l, d.gh.Spec.Owner, d.gh.Spec.Repository)
+			}
+		}
+		input.LabelIDs = &labelsID
+	}
+
+	var mutation struct {
+		CreateIssue struct {
+			Issue dashboardIssueApi
+		} `graphql:"createIssue(input: $input)"`
+	}
+
+	if err := d.gh.client.Mutate(context.Background(), &mutation, input, nil); err != nil {
+		return nil, err
+	}
+
+	return &mutation.CreateIssue.Issue, nil
+}
+
+// updateDashboardIssue updates an issue body
+func (d *Dashboard) updateDashboardIssue(id, body string) error {
+	var mutation struct {
+		UpdateIssue struct {
+			Issue dashboardIssueApi
+		} `graphql:"updateIssue(input: $input)"`
+	}
+
+	input := githubv4.UpdateIssueInput{
+		ID:   githubv4.ID(id),
+		Body: githubv4.NewString(githubv4.String(body)),
+	}
+
+	return d.gh.client.Mutate(context.Background(), &mutation, input, nil)
+}
+
+// pinDashboardIssue pins an issue on the repository
+func (d *Dashboard) pinDashboardIssue(id string) error {
+	var mutation struct {
+		PinIssue struct {
+			Issue struct {
+				ID string
+			}
+		} `graphql:"pinIssue(input: $input)"`
+	}
+
+	input := githubv4.PinIssueInput{
+		IssueID: githubv4.ID(id),
+	}
+
+	return d.gh.client.Mutate(context.Background(), &mutation, input, nil)
+}
@@ -0,0 +1,167 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/shurcooL/githubv4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// dashboardIssue is an issue stored by the fake GitHub server
+type dashboardIssue struct {
+	title  string
+	body   string
+	author string
+}
+
+func TestUpdateDashboard(t *testing.T) {
+	// issues contains the issues stored by the fake GitHub server, indexed by issue id
+	issues := map[string]*dashboardIssue{
+		"I_1": {title: "Another issue", author: "updatecli-bot"},
+		// An issue with the dashboard title, opened by someone else, must not be adopted
+		"I_3": {title: "Updatecli Dependency Dashboard", body: "not ours", author: "someone"},
+	}
+	pinned := map[string]bool{}
+	var createdLabels []string
+
+	issue := func(id string) map[string]any {
+		return map[string]any{
+			"id":     id,
+			"title":  issues[id].title,
+			"body":   issues[id].body,
+			"url":    "https://github.com/owner/repo/issues/" + strings.TrimPrefix(id, "I_"),
+			"number": 2,
+		}
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var in struct {
+			Query     string                     `json:"query"`
+			Variables map[string]json.RawMessage `json:"variables"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&in))
+
+		var input struct {
+			Title    string   `json:"title"`
+			Body     string   `json:"body"`
+			ID       string   `json:"id"`
+			IssueID  string   `json:"issueId"`
+			LabelIDs []string `json:"labelIds"`
+		}
+		if raw, ok := in.Variables["input"]; ok {
+			require.NoError(t, json.Unmarshal(raw, &input))
+		}
+
+		var data any
+		switch {
+		case strings.Contains(in.Query, "viewer{"):
+			data = map[string]any{"viewer": map[string]any{"login": "updatecli-bot"}}
+
+		case strings.Contains(in.Query, "issues("):
+			var filterBy struct {
+				CreatedBy string `json:"createdBy"`
+			}
+			require.NoError(t, json.Unmarshal(in.Variables["filterBy"], &filterBy))
+
+			ids := []string{}
+			for id := range issues {
+				if issues[id].author == filterBy.CreatedBy {
+					ids = append(ids, id)
+				}
+			}
+			sort.Strings(ids)
+
+			nodes := []any{}
+			for _, id := range ids {
+				nodes = append(nodes, issue(id))
+			}
+			data = map[string]any{"repository": map[string]any{"issues": map[string]any{
+				"nodes":    nodes,
+				"pageInfo": map[string]any{"hasNextPage": false, "endCursor": ""},
+			}}}
+
+		case strings.Contains(in.Query, "labels("):
+			data = map[string]any{
+				"rateLimit": map[string]any{"cost": 1, "remaining": 5000},
+				"repository": map[string]any{"labels": map[string]any{
+					"totalCount": 1,
+					"pageInfo":   map[string]any{"hasNextPage": false},
+					"edges": []any{
+						map[string]any{"node": map[string]any{"id": "LA_1", "name": "dependencies"}},
+					},
+				}},
+			}
+
+		case strings.Contains(in.Query, "createIssue("):
+			issues["I_2"] = &dashboardIssue{title: input.Title, body: input.Body, author: "updatecli-bot"}
+			createdLabels = input.LabelIDs
+			data = map[string]any{"createIssue": map[string]any{"issue": issue("I_2")}}
+
+		case strings.Contains(in.Query, "updateIssue("):
+			issues[input.ID].body = input.Body
+			data = map[string]any{"updateIssue": map[string]any{"issue": issue(input.ID)}}
+
+		case strings.Contains(in.Query, "pinIssue("):
+			pinned[input.IssueID] = true
+			data = map[string]any{"pinIssue": map[string]any{"issue": map[string]any{"id": input.IssueID}}}
+
+		case strings.Contains(in.Query, "repository("):
+			data = map[string]any{"repository": map[string]any{
+				"id":    "R_1",
+				"name":  "repo",
+				"owner": map[string]any{"login": "owner"},
+			}}
+
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": data}))
+	}))
+	defer server.Close()
+
+	gh := Github{
+		Spec: Spec{
+			Owner:      "owner",
+			Repository: "repo",
+			Branch:     "main",
+		},
+		client: githubv4.NewEnterpriseClient(server.URL, server.Client()),
+	}
+
+	d, err := NewDashboard(DashboardSpec{Labels: []string{"dependencies"}}, &gh)
+	require.NoError(t, err)
+
+	// The dashboard issue is created, labeled and pinned
+	link, err := d.UpdateDashboard("Updatecli Dependency Dashboard", func(current string) (string, error) {
+		assert.Empty(t, current)
+		return "first", nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "https://github.com/owner/repo/issues/2", link)
+	assert.Equal(t, "first", issues["I_2"].body)
+	assert.Equal(t, []string{"LA_1"}, createdLabels)
+	assert.True(t, pinned["I_2"])
+
+	// The dashboard issue created by the previous run is updated
+	d, err = NewDashboard(DashboardSpec{Labels: []string{"dependencies"}}, &gh)
+	require.NoError(t, err)
+
+	link, err = d.UpdateDashboard("Updatecli Dependency Dashboard", func(current string) (string, error) {
+		assert.Equal(t, "first", current)
+		return "second", nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "https://github.com/owner/repo/issues/2", link)
+	assert.Equal(t, "second", issues["I_2"].body)
+	assert.Equal(t, "not ours", issues["I_3"].body)
+	assert.Len(t, issues, 3)
+}
@@ -153,6 +153,21 @@ type Spec struct {
 	//    When force is set to true, Updatecli also recreates the working branches that
 	//    diverged from their base branch.
 	Force *bool `yaml:",omitempty"`
+	//  "rebasestrategy" defines how Updatecli handles the working branch when its base branch moved.
+	//
+	//  compatible:
+	//    * scm
+	//
+	//  default:
+	//    "auto" if force is set to true, "never" otherwise
+	//
+	//  remark:
+	//    Accepted values are:
+	//      * "never": the working branch is kept as it is, conflicts must be resolved manually.
+	//      * "auto": the working branch is recreated from its base branch, only if it is behind the base branch.
+	//      * "recreate": the working branch is recreated from its base branch on every run, then targets are re-applied.
+	//    "auto" and "recreate" require force to be set to true.
+	RebaseStrategy string `yaml:",omitempty"`
 	//	"commitMessage" is used to generate the final commit message.
 	//
 	//	compatible:
@@ -258,7 +273,9 @@ func New(s Spec, pipelineID string) (*Github, error) {
 
 	httpClient := oauth2.NewClient(clientContext, src)
 
-	nativeGitHandler := gitgeneric.GoGit{}
+	nativeGitHandler := gitgeneric.GoGit{
+		RebaseStrategy: s.RebaseStrategy,
+	}
 
 	// By default, we create a working branch but if for some reason we don't want to create it
 	// Then we also need to update the force safeguard to avoid force pushing on the main branch.
@@ -307,6 +324,10 @@ If you know what you are doing, please set the force option to true in your conf
 		}
 	}
 
+	if err := gitgeneric.ValidateRebaseStrategy(s.RebaseStrategy, force); err != nil {
+		return nil, err
+	}
+
 	g := Github{
 		force:                  force,
 		Spec:                   s,
@@ -381,6 +402,9 @@ func (gs *Spec) Merge(child interface{}) error {
 	if childGHSpec.Force != nil {
 		gs.Force = childGHSpec.Force
 	}
+	if childGHSpec.RebaseStrategy != "" {
+		gs.RebaseStrategy = childGHSpec.RebaseStrategy
+	}
 	if childGHSpec.GPG != (sign.GPGSpec{}) {
 		gs.GPG = childGHSpec.GPG
 	}
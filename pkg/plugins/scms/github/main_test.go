@@ -212,6 +212,33 @@ func TestNew(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:       "Validation Error (rebase strategy without force)",
+			pipelineID: "12345",
+			spec: Spec{
+				Branch:         "main",
+				Repository:     "updatecli",
+				Owner:          "updatecli",
+				Directory:      "/tmp/updatecli",
+				Token:          "superSecretTOkenOfJoe",
+				Force:          boolPointer(false),
+				RebaseStrategy: "recreate",
+			},
+			wantErr: true,
+		},
+		{
+			name:       "Validation Error (unknown rebase strategy)",
+			pipelineID: "12345",
+			spec: Spec{
+				Branch:         "main",
+				Repository:     "updatecli",
+				Owner:          "updatecli",
+				Directory:      "/tmp/updatecli",
+				Token:          "superSecretTOkenOfJoe",
+				RebaseStrategy: "rebase",
+			},
+			wantErr: true,
+		},
 		{
 			name:       "Validation Error (missing token)",
 			pipelineID: "12345",
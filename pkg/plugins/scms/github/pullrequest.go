@@ -151,6 +151,16 @@ type ActionSpec struct {
 	// remark:
 	//   * Please note that contrary to reviewers, assignees only accept GitHub usernames
 	Assignees []string `yaml:",omitempty"`
+
+	// deleteobsoletebranch allows to delete the working branch once Updatecli closed its pull request
+	// because the working branch doesn't bring any changes anymore.
+	//
+	// compatible:
+	//   * action
+	//
+	// default:
+	//   false
+	DeleteObsoleteBranch bool `yaml:",omitempty"`
 }
 
 type PullRequest struct {
@@ -201,7 +211,7 @@ func NewAction(spec ActionSpec, gh *Github) (PullRequest, error) {
 }
 
 // CleanAction verifies if an existing action requires some cleanup such as closing a pullrequest with no changes.
-func (p *PullRequest) CleanAction(report *reports.Action) error {
+func (p *PullRequest) CleanAction(report *reports.Action, closeObsolete bool) error {
 
 	repository, err := p.gh.queryRepository("", "")
 	if err != nil {
@@ -225,7 +235,7 @@ func (p *PullRequest) CleanAction(report *reports.Action) error {
 		logrus.Debugf("No changed file detected at pull request:\n\t%s", p.remotePullRequest.Url)
 		// Not returning an error if the comment failed to be added
 		// as the main purpose of this function is to close the pullrequest
-		err = p.closePullRequest()
+		err = p.closePullRequest("Pull request closed as no changed file detected")
 		if err != nil {
 			return fmt.Errorf("closing pull request: %w", err)
 		}
@@ -236,6 +246,40 @@ func (p *PullRequest) CleanAction(report *reports.Action) error {
 		return nil
 	}
 
+	// The working branch may still contain changes which are already part of the target branch,
+	// for example when the update was manually applied, or rolled back upstream.
+	// Without push, the remote working branch doesn't contain the local changes,
+	// so it can't be considered obsolete.
+	isObsolete := false
+	if closeObsolete {
+		isObsolete, err = p.gh.IsWorkingBranchObsolete()
+		if err != nil {
+			return fmt.Errorf("checking if pull request is obsolete: %w", err)
+		}
+	}
+
+	if !isObsolete {
+		return nil
+	}
+
+	logrus.Debugf("Pull request obsolete:\n\t%s", p.remotePullRequest.Url)
+
+	err = p.closePullRequest(utils.OBSOLETEPULLREQUESTCOMMENT)
+	if err != nil {
+		return fmt.Errorf("closing obsolete pull request: %w", err)
+	}
+
+	report.Link = ""
+	report.Description = "Pull request closed as obsolete"
+
+	if p.spec.DeleteObsoleteBranch {
+		_, workingBranch, _ := p.gh.GetBranches()
+		if err := p.gh.DeleteBranch(workingBranch); err != nil {
+			return fmt.Errorf("deleting obsolete branch %q: %w", workingBranch, err)
+		}
+		logrus.Infof("Branch %q deleted", workingBranch)
+	}
+
 	return nil
 }
 
@@ -329,8 +373,9 @@ func (p *PullRequest) CreateAction(report *reports.Action, resetDescription bool
 	return nil
 }
 
-// closePullRequest closes an existing Pull Request using GitHub graphql api.
-func (p *PullRequest) closePullRequest() error {
+// closePullRequest closes an existing Pull Request using GitHub graphql api,
+// then adds a comment explaining why.
+func (p *PullRequest) closePullRequest(msg string) error {
 
 	// https://docs.github.com/en/graphql/reference/input-objects#closepullrequestinput
 	/*
@@ -366,8 +411,7 @@ func (p *PullRequest) closePullRequest() error {
 		return err
 	}
 
-	msg := "Pull request closed as no changed file detected"
-	logrus.Infof("%s at:\n\n\t%s\n\n", msg, mutation.UpdatePullRequest.PullRequest.Url)
+	logrus.Infof("Pull request closed at:\n\n\t%s\n\n", mutation.UpdatePullRequest.PullRequest.Url)
 	err = p.addComment(msg)
 	if err != nil {
 		logrus.Errorf("Commenting pull-request: %s", err.Error())
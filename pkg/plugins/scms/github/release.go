@@ -33,6 +33,7 @@ query getLatestRelease($owner: String!, $repository: String!, $before: String, $
           }
           isDraft
           isPrerelease
+          publishedAt
         }
         cursor
       }
@@ -61,6 +62,7 @@ type ReleaseNode struct {
 	IsDraft      bool
 	IsLatest     bool
 	IsPrerelease bool
+	PublishedAt  githubv4.DateTime
 }
 type TagCommit struct {
 	Oid string
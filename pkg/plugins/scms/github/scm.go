@@ -10,6 +10,7 @@ import (
 	"github.com/shurcooL/githubv4"
 	"github.com/sirupsen/logrus"
 	"github.com/updatecli/updatecli/pkg/plugins/utils"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/gitgeneric"
 )
 
 func (g *Github) GetBranches() (sourceBranch, workingBranch, targetBranch string) {
@@ -320,3 +321,28 @@ func (g *Github) PushBranch(branch string) error {
 func (g *Github) GetChangedFiles(workingDir string) ([]string, error) {
 	return g.nativeGitHandler.GetChangedFiles(workingDir)
 }
+
+// IsWorkingBranchObsolete checks if the working branch doesn't bring any changes to the target branch anymore
+func (g *Github) IsWorkingBranchObsolete() (bool, error) {
+	_, workingBranch, targetBranch := g.GetBranches()
+
+	if workingBranch == targetBranch {
+		return false, nil
+	}
+
+	return g.nativeGitHandler.IsBranchObsolete(targetBranch, workingBranch, g.GetDirectory())
+}
+
+// DeleteBranch deletes a branch from the remote git repository
+func (g *Github) DeleteBranch(branch string) error {
+	return g.nativeGitHandler.DeleteBranch(
+		branch,
+		g.Spec.Username,
+		g.Spec.Token,
+		g.GetDirectory())
+}
+
+// GetRebaseStrategy returns the strategy applied to the working branch when its base branch moved
+func (g *Github) GetRebaseStrategy() string {
+	return gitgeneric.GetRebaseStrategy(g.Spec.RebaseStrategy, g.force)
+}
@@ -0,0 +1,127 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/gitgeneric"
+)
+
+// commitAction is a file operation sent to the GitLab commits api
+type commitAction struct {
+	Action   string `json:"action"`
+	FilePath string `json:"file_path"`
+	Content  string `json:"content,omitempty"`
+	Encoding string `json:"encoding,omitempty"`
+}
+
+// commitInput is the GitLab commits api request body
+type commitInput struct {
+	Branch        string         `json:"branch"`
+	StartBranch   string         `json:"start_branch,omitempty"`
+	CommitMessage string         `json:"commit_message"`
+	Actions       []commitAction `json:"actions"`
+}
+
+// commitOutput contains the GitLab commits api response fields used by Updatecli
+type commitOutput struct {
+	ID     string `json:"id"`
+	WebURL string `json:"web_url"`
+}
+
+// createCommit replays the local changes on the remote working branch using the GitLab commits api.
+// Commits created that way are signed by GitLab.
+func (g *Gitlab) createCommit(commitMessage string) error {
+	sourceBranch, workingBranch, _ := g.GetBranches()
+	workingDir := g.GetDirectory()
+
+	changes, err := gitgeneric.GetFileChanges(workingDir)
+	if err != nil {
+		return err
+	}
+
+	if len(changes) == 0 {
+		logrus.Debugln("no changed file, nothing to commit")
+		return nil
+	}
+
+	actions := getCommitActions(changes)
+
+	if g.nativeGitHandler.IsForceReset() {
+		// Ensure that locally reset branch is pushed to remote branch
+		// before continuing with commit creation as that will otherwise
+		// be lost.
+		logrus.Debugf("local branch %q was reset, pushing to remote to ensure correct state", workingBranch)
+		if _, err = g.Push(); err != nil {
+			return fmt.Errorf("failed to push branch %q before creating commit: %w", workingBranch, err)
+		}
+	}
+
+	ctx := context.Background()
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	repository := strings.Join([]string{g.Spec.Owner, g.Spec.Repository}, "/")
+
+	input := commitInput{
+		Branch:        workingBranch,
+		CommitMessage: commitMessage,
+		Actions:       actions,
+	}
+
+	_, resp, err := g.client.Git.FindBranch(ctx, repository, workingBranch)
+	switch {
+	case resp != nil && resp.Status == http.StatusNotFound:
+		logrus.Debugf("Branch %s does not exist, creating it from branch %q", workingBranch, sourceBranch)
+		input.StartBranch = sourceBranch
+	case err != nil:
+		return fmt.Errorf("search branch %q: %w", workingBranch, err)
+	}
+
+	output := commitOutput{}
+	path := fmt.Sprintf("api/v4/projects/%s/repository/commits", encode(repository))
+
+	if err := g.do(ctx, http.MethodPost, path, input, &output); err != nil {
+		return fmt.Errorf("create commit: %w", err)
+	}
+
+	logrus.Debugf("commit created: %s", output.WebURL)
+
+	return nil
+}
+
+// getCommitActions converts local file changes to GitLab commit actions
+func getCommitActions(changes []gitgeneric.FileChange) []commitAction {
+	actions := make([]commitAction, 0, len(changes))
+
+	for _, change := range changes {
+		if change.Deleted {
+			actions = append(actions, commitAction{
+				Action:   "delete",
+				FilePath: change.Path,
+			})
+			continue
+		}
+
+		content := base64.StdEncoding.EncodeToString(change.Content)
+
+		action := "update"
+		if change.Hash == "" {
+			action = "create"
+		}
+
+		actions = append(actions, commitAction{
+			Action:   action,
+			FilePath: change.Path,
+			Content:  content,
+			Encoding: "base64",
+		})
+	}
+
+	return actions
+}
@@ -0,0 +1,155 @@
+package gitlab
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/drone/go-scm/scm/driver/gitlab"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/gitgeneric"
+)
+
+// newTestWorkingDir creates a git repository with staged changes
+// updating "README.md", deleting "go.mod" and creating "new.txt"
+func newTestWorkingDir(t *testing.T) string {
+	dir := t.TempDir()
+
+	repo, err := git.PlainInit(dir, false)
+	require.NoError(t, err)
+	w, err := repo.Worktree()
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("# test\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte("go 1.22.0\n"), 0600))
+	_, err = w.Add(".")
+	require.NoError(t, err)
+	_, err = w.Commit("init", &git.CommitOptions{
+		Author: &object.Signature{Name: "updatecli", Email: "bot@updatecli.io", When: time.Now()},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("readme"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "new.txt"), []byte("new"), 0600))
+	_, err = w.Add("README.md")
+	require.NoError(t, err)
+	_, err = w.Add("new.txt")
+	require.NoError(t, err)
+	_, err = w.Remove("go.mod")
+	require.NoError(t, err)
+
+	// Untracked files and unstaged changes are not part of the commit
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "untracked.txt"), []byte("untracked"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("readme, not staged"), 0600))
+
+	return dir
+}
+
+func TestCreateCommit(t *testing.T) {
+	tests := []struct {
+		name           string
+		branchStatus   int
+		commitStatus   int
+		expectedCommit *commitInput
+		expectedError  bool
+	}{
+		{
+			name:         "Working branch exists",
+			branchStatus: http.StatusOK,
+			commitStatus: http.StatusCreated,
+			expectedCommit: &commitInput{
+				Branch:        "updatecli_main_1234",
+				CommitMessage: "chore: update files",
+			},
+		},
+		{
+			name:         "Working branch created from the source branch",
+			branchStatus: http.StatusNotFound,
+			commitStatus: http.StatusCreated,
+			expectedCommit: &commitInput{
+				Branch:        "updatecli_main_1234",
+				StartBranch:   "main",
+				CommitMessage: "chore: update files",
+			},
+		},
+		{
+			name:          "Branch api error",
+			branchStatus:  http.StatusForbidden,
+			commitStatus:  http.StatusCreated,
+			expectedError: true,
+		},
+		{
+			name:          "Commit api error",
+			branchStatus:  http.StatusOK,
+			commitStatus:  http.StatusBadRequest,
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotCommit *commitInput
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+
+				switch {
+				case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/repository/branches/updatecli_main_1234"):
+					w.WriteHeader(tt.branchStatus)
+					_, _ = w.Write([]byte(`{"name": "updatecli_main_1234", "commit": {"id": "abc"}}`))
+
+				case r.Method == http.MethodPost && r.URL.EscapedPath() == "/api/v4/projects/owner%2Frepo/repository/commits":
+					gotCommit = &commitInput{}
+					require.NoError(t, json.NewDecoder(r.Body).Decode(gotCommit))
+					w.WriteHeader(tt.commitStatus)
+					_, _ = w.Write([]byte(`{"id": "def", "web_url": "https://gitlab.com/owner/repo/-/commit/def"}`))
+
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			c, err := gitlab.New(server.URL)
+			require.NoError(t, err)
+			c.Client = server.Client()
+
+			g := Gitlab{
+				Spec: Spec{
+					Owner:      "owner",
+					Repository: "repo",
+					Branch:     "main",
+					Directory:  newTestWorkingDir(t),
+				},
+				client:                 c,
+				pipelineID:             "1234",
+				nativeGitHandler:       &gitgeneric.GoGit{},
+				workingBranch:          true,
+				workingBranchPrefix:    "updatecli",
+				workingBranchSeparator: "_",
+			}
+
+			err = g.createCommit("chore: update files")
+			if tt.expectedError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			tt.expectedCommit.Actions = []commitAction{
+				{Action: "update", FilePath: "README.md", Content: "cmVhZG1l", Encoding: "base64"},
+				{Action: "delete", FilePath: "go.mod"},
+				{Action: "create", FilePath: "new.txt", Content: "bmV3", Encoding: "base64"},
+			}
+			assert.Equal(t, tt.expectedCommit, gotCommit)
+		})
+	}
+}
@@ -67,6 +67,21 @@ type Spec struct {
 	//    When force is set to true, Updatecli also recreates the working branches that
 	//    diverged from their base branch.
 	Force *bool `yaml:",omitempty"`
+	//  "rebasestrategy" defines how Updatecli handles the working branch when its base branch moved.
+	//
+	//  compatible:
+	//    * scm
+	//
+	//  default:
+	//    "auto" if force is set to true, "never" otherwise
+	//
+	//  remark:
+	//    Accepted values are:
+	//      * "never": the working branch is kept as it is, conflicts must be resolved manually.
+	//      * "auto": the working branch is recreated from its base branch, only if it is behind the base branch.
+	//      * "recreate": the working branch is recreated from its base branch on every run, then targets are re-applied.
+	//    "auto" and "recreate" require force to be set to true.
+	RebaseStrategy string `yaml:",omitempty"`
 	//  "gpg" specifies the GPG key and passphrased used for commit signing.
 	//
 	//  compatible:
@@ -146,6 +161,18 @@ type Spec struct {
 	//
 	//  default: true
 	WorkingBranch *bool `yaml:",omitempty"`
+	//  "commitUsingApi" defines if Updatecli should use the GitLab commits API to create the commit.
+	//  When set to `true`, commits are created and signed by GitLab so they show as verified
+	//  without distributing a GPG key.
+	//
+	//  compatible:
+	//    * scm
+	//
+	//  default: false
+	//
+	//  remark:
+	//    The commit author is the user owning the token, the "user", "email" and "gpg" settings are ignored.
+	CommitUsingAPI *bool `yaml:",omitempty"`
 }
 
 // Gitlab contains information to interact with GitLab api
@@ -162,6 +189,7 @@ type Gitlab struct {
 	workingBranch          bool
 	workingBranchPrefix    string
 	workingBranchSeparator string
+	commitUsingApi         bool
 }
 
 // New returns a new valid GitLab object.
@@ -218,6 +246,11 @@ func New(spec interface{}, pipelineID string) (*Gitlab, error) {
 		force = *s.Force
 	}
 
+	commitUsingApi := false
+	if s.CommitUsingAPI != nil {
+		commitUsingApi = *s.CommitUsingAPI
+	}
+
 	if force {
 		if !workingBranch && s.Force == nil {
 			errorMsg := fmt.Sprintf(`
@@ -238,13 +271,19 @@ If you know what you are doing, please set the force option to true in your conf
 		}
 	}
 
+	if err := gitgeneric.ValidateRebaseStrategy(s.RebaseStrategy, force); err != nil {
+		return nil, err
+	}
+
 	c, err := client.New(clientSpec)
 
 	if err != nil {
 		return &Gitlab{}, err
 	}
 
-	nativeGitHandler := gitgeneric.GoGit{}
+	nativeGitHandler := gitgeneric.GoGit{
+		RebaseStrategy: s.RebaseStrategy,
+	}
 	g := Gitlab{
 		force:                  force,
 		Spec:                   s,
@@ -254,6 +293,7 @@ If you know what you are doing, please set the force option to true in your conf
 		workingBranch:          workingBranch,
 		workingBranchPrefix:    workingBranchPrefix,
 		workingBranchSeparator: workingBranchSeparator,
+		commitUsingApi:         commitUsingApi,
 	}
 
 	g.setDirectory()
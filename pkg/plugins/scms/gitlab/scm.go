@@ -7,6 +7,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 	"github.com/updatecli/updatecli/pkg/plugins/resources/gitlab/client"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/gitgeneric"
 )
 
 // GetBranches returns the source, working and target branches.
@@ -79,6 +80,24 @@ func (g *Gitlab) Commit(message string) error {
 		return err
 	}
 
+	if g.commitUsingApi {
+		_, workingBranch, _ := g.GetBranches()
+
+		if err := g.createCommit(commitMessage); err != nil {
+			return err
+		}
+
+		// Sync the local working branch with the commit created remotely
+		return g.nativeGitHandler.Pull(
+			g.Spec.Username,
+			g.Spec.Token,
+			g.GetDirectory(),
+			workingBranch,
+			true,
+			true,
+		)
+	}
+
 	err = g.nativeGitHandler.Commit(
 		g.Spec.User,
 		g.Spec.Email,
@@ -182,3 +201,28 @@ func (g *Gitlab) PushBranch(branch string) error {
 func (g *Gitlab) GetChangedFiles(workingDir string) ([]string, error) {
 	return g.nativeGitHandler.GetChangedFiles(workingDir)
 }
+
+// IsWorkingBranchObsolete checks if the working branch doesn't bring any changes to the target branch anymore
+func (g *Gitlab) IsWorkingBranchObsolete() (bool, error) {
+	_, workingBranch, targetBranch := g.GetBranches()
+
+	if workingBranch == targetBranch {
+		return false, nil
+	}
+
+	return g.nativeGitHandler.IsBranchObsolete(targetBranch, workingBranch, g.GetDirectory())
+}
+
+// DeleteBranch deletes a branch from the remote git repository
+func (g *Gitlab) DeleteBranch(branch string) error {
+	return g.nativeGitHandler.DeleteBranch(
+		branch,
+		g.Spec.Username,
+		g.Spec.Token,
+		g.GetDirectory())
+}
+
+// GetRebaseStrategy returns the strategy applied to the working branch when its base branch moved
+func (g *Gitlab) GetRebaseStrategy() string {
+	return gitgeneric.GetRebaseStrategy(g.Spec.RebaseStrategy, g.force)
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/gitgeneric"
 )
 
 func (s *Stash) GetBranches() (sourceBranch, workingBranch, targetBranch string) {
@@ -161,3 +162,28 @@ func (s *Stash) PushBranch(branch string) error {
 func (s *Stash) GetChangedFiles(workingDir string) ([]string, error) {
 	return s.nativeGitHandler.GetChangedFiles(workingDir)
 }
+
+// IsWorkingBranchObsolete checks if the working branch doesn't bring any changes to the target branch anymore
+func (s *Stash) IsWorkingBranchObsolete() (bool, error) {
+	_, workingBranch, targetBranch := s.GetBranches()
+
+	if workingBranch == targetBranch {
+		return false, nil
+	}
+
+	return s.nativeGitHandler.IsBranchObsolete(targetBranch, workingBranch, s.GetDirectory())
+}
+
+// DeleteBranch deletes a branch from the remote git repository
+func (s *Stash) DeleteBranch(branch string) error {
+	return s.nativeGitHandler.DeleteBranch(
+		branch,
+		s.Spec.Username,
+		s.Spec.Token,
+		s.GetDirectory())
+}
+
+// GetRebaseStrategy returns the strategy applied to the working branch when its base branch moved
+func (s *Stash) GetRebaseStrategy() string {
+	return gitgeneric.GetRebaseStrategy(s.Spec.RebaseStrategy, s.force)
+}
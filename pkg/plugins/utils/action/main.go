@@ -71,6 +71,13 @@ Feel free to report any issues at [github.com/updatecli/updatecli](https://githu
 If you find this tool useful, do not hesitate to star [our GitHub repository](https://github.com/updatecli/updatecli/stargazers) as a sign of appreciation, and/or to tell us directly on our [chat](https://matrix.to/#/#Updatecli_community:gitter.im)!
 `
 
+// OBSOLETEPULLREQUESTCOMMENT is the comment added to a pull request closed by Updatecli
+// because its working branch doesn't bring any changes anymore
+const OBSOLETEPULLREQUESTCOMMENT = `Closing this pull request as its changes are already part of the target branch, or are not needed anymore.
+
+This usually happens when the update was applied manually, or when the updated version was rolled back upstream.
+Updatecli will open a new pull request if an update is detected again.`
+
 // GeneratePullRequestBody generates the Pull Request's body based on PULLREQUESTBODYTEMPLATE
 func GeneratePullRequestBody(Description, Report string) (string, error) {
 	return generatePullRequestBodyFromTemplate(Description, Report, PULLREQUESTBODYTEMPLATE)
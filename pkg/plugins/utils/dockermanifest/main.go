@@ -0,0 +1,105 @@
+package dockermanifest
+
+import (
+	"bytes"
+	"text/template"
+
+	"github.com/sirupsen/logrus"
+	"github.com/updatecli/updatecli/pkg/plugins/resources/dockerimage"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/docker"
+	"github.com/updatecli/updatecli/pkg/plugins/utils/version"
+)
+
+// Params contains the parameters used to generate an Updatecli manifest updating a Docker image
+// referenced in a yaml file, such as a GitHub workflow or a GitLab CI configuration.
+type Params struct {
+	// ActionID defines the optional action identifier
+	ActionID string
+	// ImageName defines the Docker image name without tag nor digest
+	ImageName string
+	// ImageTag defines the Docker image tag currently used
+	ImageTag string
+	// TargetID defines the target identifier
+	TargetID string
+	// TargetFile defines the yaml file to update
+	TargetFile string
+	// TargetKey defines the yaml key to update
+	TargetKey string
+	// TargetPrefix defines the prefix added to the source output, such as "alpine:"
+	TargetPrefix string
+	// TagFilter defines the dockerimage source tag filter
+	TagFilter string
+	// VersionFilterKind defines the dockerimage source version filter kind
+	VersionFilterKind string
+	// VersionFilterPattern defines the dockerimage source version filter pattern
+	VersionFilterPattern string
+	// ScmID defines the optional scm identifier
+	ScmID string
+
+	// latest is set when the latest image tag can be retrieved from the current tag
+	latest bool
+}
+
+// NewParams returns the parameters to retrieve the latest tag for the image imageName:imageTag.
+// If filter is not empty, it takes precedence over the version filter guessed from imageTag.
+func NewParams(imageName, imageTag string, filter, defaultFilter version.Filter, credentials map[string]docker.InlineKeyChain) Params {
+	p := Params{
+		ImageName:            imageName,
+		ImageTag:             imageTag,
+		TagFilter:            "*",
+		VersionFilterKind:    defaultFilter.Kind,
+		VersionFilterPattern: defaultFilter.Pattern,
+	}
+
+	sourceSpec := dockerimage.NewDockerImageSpecFromImage(imageName, imageTag, credentials)
+	if sourceSpec != nil {
+		p.latest = true
+		p.TagFilter = sourceSpec.TagFilter
+		p.VersionFilterKind = sourceSpec.VersionFilter.Kind
+		p.VersionFilterPattern = sourceSpec.VersionFilter.Pattern
+	}
+
+	// If a versionfilter is specified in the manifest then we want to be sure that it takes precedence
+	if !filter.IsZero() {
+		var err error
+		p.TagFilter = ""
+		p.VersionFilterKind = defaultFilter.Kind
+		p.VersionFilterPattern, err = defaultFilter.GreaterThanPattern(imageTag)
+		if err != nil {
+			logrus.Debugf("building version filter pattern: %s", err)
+			p.VersionFilterPattern = "*"
+		}
+	}
+
+	return p
+}
+
+// Generate returns the Updatecli manifest updating the Docker image tag and/or pinning its digest.
+// It returns nil if neither the tag nor the digest can be updated.
+func Generate(p Params, digest bool) ([]byte, error) {
+	var manifestTemplate string
+
+	switch {
+	case digest && p.latest:
+		manifestTemplate = manifestTemplateDockerDigestAndLatest
+	case digest && !p.latest:
+		manifestTemplate = manifestTemplateDockerDigest
+	case !digest && p.latest:
+		manifestTemplate = manifestTemplateDockerLatest
+	default:
+		logrus.Infoln("No source spec detected")
+		return nil, nil
+	}
+
+	tmpl, err := template.New("manifest").Parse(manifestTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := bytes.Buffer{}
+	if err := tmpl.Execute(&manifest, p); err != nil {
+		return nil, err
+	}
+
+	return manifest.Bytes(), nil
+}
@@ -1,7 +1,7 @@
-package githubaction
+package dockermanifest
 
 const (
-	// manifestTemplate is the Go template used to generate Docker compose manifests
+	// manifestTemplateDockerLatest is the Go template used to update a Docker image tag
 	manifestTemplateDockerLatest string = `name: 'deps: bump Docker image "{{ .ImageName }}"'
 {{- if .ActionID }}
 actions:
@@ -20,7 +20,7 @@ sources:
         kind: '{{ .VersionFilterKind }}'
         pattern: '{{ .VersionFilterPattern }}'
 targets:
-  workflow:
+  {{ .TargetID }}:
     name: 'deps: bump Docker image "{{ .ImageName }}" to {{ "{{" }} source "image" {{ "}}" }}'
     kind: 'yaml'
 {{- if .ScmID }}
@@ -33,6 +33,7 @@ targets:
     transformers:
       - addprefix: '{{ .TargetPrefix }}'
 `
+	// manifestTemplateDockerDigestAndLatest is the Go template used to update a Docker image tag and pin its digest
 	manifestTemplateDockerDigestAndLatest string = `name: 'deps: bump Docker image digest for "{{ .ImageName }}"'
 {{- if .ActionID }}
 actions:
@@ -60,7 +61,7 @@ sources:
     dependson:
       - 'image'
 targets:
-  workflow:
+  {{ .TargetID }}:
     name: 'deps: bump Docker image digest for "{{ .ImageName }}" to {{ "{{" }} source "digest" {{ "}}" }}'
     kind: 'yaml'
 {{- if .ScmID }}
@@ -73,6 +74,7 @@ targets:
     transformers:
       - addprefix: '{{ .TargetPrefix }}'
 `
+	// manifestTemplateDockerDigest is the Go template used to pin the digest of the current Docker image tag
 	manifestTemplateDockerDigest string = `name: 'deps: bump Docker image digest "{{ .ImageName }}"'
 {{- if .ActionID }}
 actions:
@@ -88,7 +90,7 @@ sources:
       image: '{{ .ImageName }}'
       tag: '{{ .ImageTag }}'
 targets:
-  workflow:
+  {{ .TargetID }}:
     name: 'deps: bump Docker image Docker image "{{ .ImageName }}" digest to {{ "{{" }} source "digest" {{ "}}" }}'
     kind: 'yaml'
 {{- if .ScmID }}
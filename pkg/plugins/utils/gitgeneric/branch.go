@@ -9,7 +9,7 @@ import (
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/config"
 	"github.com/go-git/go-git/v5/plumbing"
-	transportHttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/sirupsen/logrus"
 )
 
@@ -141,10 +141,7 @@ func (g GoGit) NewBranch(branch, workingDir string) (bool, error) {
 // PushBranch publish a single branch created locally
 func (g GoGit) PushBranch(branch string, username string, password string, workingDir string, force bool) error {
 
-	auth := transportHttp.BasicAuth{
-		Username: username, // anything except an empty string
-		Password: password,
-	}
+	auth := g.getAuth(username, password)
 
 	r, err := git.PlainOpen(workingDir)
 
@@ -165,7 +162,7 @@ func (g GoGit) PushBranch(branch string, username string, password string, worki
 		RemoteName: "origin",
 		Progress:   os.Stdout,
 		RefSpecs:   []config.RefSpec{refspec},
-		Auth:       &auth,
+		Auth:       auth,
 	}
 
 	err = r.Push(po)
@@ -229,6 +226,12 @@ func resetNewBranchToBaseBranch(newBranch, basedBranch plumbing.ReferenceName, g
 
 	logrus.Warningf("branch %q diverged from %q, resetting it to %q", newBranch, basedBranch, basedBranch)
 
+	return resetBranchToBaseBranch(newBranch, basedBranch, gitRepositoryPath)
+}
+
+// resetBranchToBaseBranch resets the checked out new branch to the latest commit of the based branch.
+// It returns false if the new branch was already pointing to that commit.
+func resetBranchToBaseBranch(newBranch, basedBranch plumbing.ReferenceName, gitRepositoryPath string) (bool, error) {
 	repository, err := git.PlainOpen(gitRepositoryPath)
 	if err != nil {
 		logrus.Errorf("opening %q git directory: %s", gitRepositoryPath, err)
@@ -240,6 +243,19 @@ func resetNewBranchToBaseBranch(newBranch, basedBranch plumbing.ReferenceName, g
 		return false, fmt.Errorf("reference %q - %s", basedBranch, err)
 	}
 
+	head, err := repository.Head()
+	if err != nil {
+		return false, fmt.Errorf("getting HEAD: %s", err)
+	}
+
+	if head.Name() != newBranch {
+		return false, fmt.Errorf("branch %q must be checked out to be reset, got %q", newBranch, head.Name())
+	}
+
+	if head.Hash() == ref.Hash() {
+		return false, nil
+	}
+
 	worktree, err := repository.Worktree()
 	if err != nil {
 		return false, fmt.Errorf("loading work tree: %s", err)
@@ -254,3 +270,141 @@ func resetNewBranchToBaseBranch(newBranch, basedBranch plumbing.ReferenceName, g
 	}
 	return true, nil
 }
+
+// IsBranchObsolete checks if the changes made on workingBranch, since it forked from baseBranch,
+// are already part of baseBranch. It means that merging workingBranch wouldn't change anything anymore,
+// for example because baseBranch was updated manually or because workingBranch reverted its own changes.
+func (g GoGit) IsBranchObsolete(baseBranch, workingBranch, workingDir string) (bool, error) {
+	r, err := git.PlainOpen(workingDir)
+	if err != nil {
+		return false, fmt.Errorf("opening %q git directory: %s", workingDir, err)
+	}
+
+	baseCommit, err := branchCommit(r, baseBranch)
+	if err != nil {
+		return false, err
+	}
+
+	workingCommit, err := branchCommit(r, workingBranch)
+	if err != nil {
+		return false, err
+	}
+
+	if baseCommit.Hash == workingCommit.Hash {
+		logrus.Debugf("branches %q and %q point to the same commit", baseBranch, workingBranch)
+		return true, nil
+	}
+
+	baseTree, err := baseCommit.Tree()
+	if err != nil {
+		return false, fmt.Errorf("getting tree for branch %q: %s", baseBranch, err)
+	}
+
+	workingTree, err := workingCommit.Tree()
+	if err != nil {
+		return false, fmt.Errorf("getting tree for branch %q: %s", workingBranch, err)
+	}
+
+	mergeBases, err := workingCommit.MergeBase(baseCommit)
+	if err != nil {
+		return false, fmt.Errorf("searching common ancestor of %q and %q: %s", baseBranch, workingBranch, err)
+	}
+
+	// Without common ancestor, we can only compare the full content of both branches
+	if len(mergeBases) == 0 {
+		return baseTree.Hash == workingTree.Hash, nil
+	}
+
+	forkTree, err := mergeBases[0].Tree()
+	if err != nil {
+		return false, fmt.Errorf("getting tree for commit %q: %s", mergeBases[0].Hash, err)
+	}
+
+	changes, err := object.DiffTree(forkTree, workingTree)
+	if err != nil {
+		return false, fmt.Errorf("comparing branch %q with its common ancestor: %s", workingBranch, err)
+	}
+
+	for _, change := range changes {
+		// A file removed from the working branch has an empty destination
+		name := change.To.Name
+		if name == "" {
+			name = change.From.Name
+		}
+
+		baseEntry, err := baseTree.FindEntry(name)
+		switch err {
+		case nil:
+			if change.To.Name == "" || baseEntry.Hash != change.To.TreeEntry.Hash {
+				logrus.Debugf("file %q differs between branches %q and %q", name, baseBranch, workingBranch)
+				return false, nil
+			}
+		case object.ErrEntryNotFound, object.ErrDirectoryNotFound:
+			if change.To.Name != "" {
+				logrus.Debugf("file %q only exists on branch %q", name, workingBranch)
+				return false, nil
+			}
+		default:
+			return false, fmt.Errorf("searching file %q on branch %q: %s", name, baseBranch, err)
+		}
+	}
+
+	logrus.Debugf("all changes from branch %q are already part of %q", workingBranch, baseBranch)
+
+	return true, nil
+}
+
+// DeleteBranch deletes a branch from the remote git repository and from the local one
+func (g GoGit) DeleteBranch(branch, username, password, workingDir string) error {
+
+	auth := g.getAuth(username, password)
+
+	r, err := git.PlainOpen(workingDir)
+	if err != nil {
+		return fmt.Errorf("opening %q git directory: %s", workingDir, err)
+	}
+
+	logrus.Debugf("Deleting git branch: %q", branch)
+
+	po := &git.PushOptions{
+		RemoteName: DefaultRemoteReferenceName,
+		RefSpecs:   []config.RefSpec{config.RefSpec(":" + plumbing.NewBranchReferenceName(branch).String())},
+		Auth:       auth,
+	}
+
+	err = r.Push(po)
+	if err != nil && err != git.NoErrAlreadyUpToDate {
+		return fmt.Errorf("deleting branch %q from remote origin: %s", branch, err)
+	}
+
+	// Removing the local references so the branch is recreated from scratch the next time it's needed
+	for _, ref := range []plumbing.ReferenceName{
+		plumbing.NewBranchReferenceName(branch),
+		plumbing.NewRemoteReferenceName(DefaultRemoteReferenceName, branch),
+	} {
+		if err := r.Storer.RemoveReference(ref); err != nil {
+			return fmt.Errorf("removing reference %q: %s", ref, err)
+		}
+	}
+
+	return nil
+}
+
+// branchCommit returns the latest commit of a local branch,
+// or of the remote one if the branch doesn't exist locally.
+func branchCommit(r *git.Repository, branch string) (*object.Commit, error) {
+	ref, err := r.Reference(plumbing.NewBranchReferenceName(branch), true)
+	if err == plumbing.ErrReferenceNotFound {
+		ref, err = r.Reference(plumbing.NewRemoteReferenceName(DefaultRemoteReferenceName, branch), true)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reference %q - %s", branch, err)
+	}
+
+	commit, err := r.CommitObject(ref.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("getting commit object for branch %q: %s", branch, err)
+	}
+
+	return commit, nil
+}
@@ -4,6 +4,14 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // Test that we can correctly retrieve a list of tags from a remote git repository
@@ -32,3 +40,203 @@ func TestBranchIntegration(t *testing.T) {
 	}
 	os.Remove(workingDir)
 }
+
+// testRepository is a local git repository used to test branch operations
+type testRepository struct {
+	t    *testing.T
+	dir  string
+	repo *git.Repository
+}
+
+func newTestRepository(t *testing.T) testRepository {
+	dir := t.TempDir()
+
+	repo, err := git.PlainInitWithOptions(dir, &git.PlainInitOptions{
+		InitOptions: git.InitOptions{DefaultBranch: plumbing.NewBranchReferenceName("main")},
+	})
+	require.NoError(t, err)
+
+	r := testRepository{t: t, dir: dir, repo: repo}
+	r.commit(map[string]string{"go.mod": "go 1.22.0\n", "README.md": "# test\n"})
+
+	return r
+}
+
+// checkout switches to branch, creating it from the current HEAD if it doesn't exist
+func (r testRepository) checkout(branch string) {
+	w, err := r.repo.Worktree()
+	require.NoError(r.t, err)
+
+	_, err = r.repo.Reference(plumbing.NewBranchReferenceName(branch), false)
+	require.NoError(r.t, w.Checkout(&git.CheckoutOptions{
+		Branch: plumbing.NewBranchReferenceName(branch),
+		Create: err == plumbing.ErrReferenceNotFound,
+	}))
+}
+
+// commit writes files, removing the ones with an empty content, then commits them
+func (r testRepository) commit(files map[string]string) {
+	w, err := r.repo.Worktree()
+	require.NoError(r.t, err)
+
+	for name, content := range files {
+		if content == "" {
+			_, err = w.Remove(name)
+			require.NoError(r.t, err)
+			continue
+		}
+		require.NoError(r.t, os.WriteFile(filepath.Join(r.dir, name), []byte(content), 0600))
+		_, err = w.Add(name)
+		require.NoError(r.t, err)
+	}
+
+	_, err = w.Commit("test", &git.CommitOptions{
+		Author:            &object.Signature{Name: "updatecli", Email: "bot@updatecli.io", When: time.Now()},
+		AllowEmptyCommits: true,
+	})
+	require.NoError(r.t, err)
+}
+
+// addRemote creates a bare repository used as the "origin" remote, then pushes the main branch to it
+func (r testRepository) addRemote() {
+	remoteDir := r.t.TempDir()
+	_, err := git.PlainInitWithOptions(remoteDir, &git.PlainInitOptions{
+		InitOptions: git.InitOptions{DefaultBranch: plumbing.NewBranchReferenceName("main")},
+		Bare:        true,
+	})
+	require.NoError(r.t, err)
+
+	_, err = r.repo.CreateRemote(&config.RemoteConfig{
+		Name: DefaultRemoteReferenceName,
+		URLs: []string{remoteDir},
+	})
+	require.NoError(r.t, err)
+
+	require.NoError(r.t, GoGit{}.PushBranch("main", "", "", r.dir, false))
+}
+
+// head returns the commit hash of a branch
+func (r testRepository) head(branch string) string {
+	ref, err := r.repo.Reference(plumbing.NewBranchReferenceName(branch), true)
+	require.NoError(r.t, err)
+	return ref.Hash().String()
+}
+
+func TestIsBranchObsolete(t *testing.T) {
+	testData := []struct {
+		name     string
+		setup    func(r testRepository)
+		expected bool
+	}{
+		{
+			name: "Working branch without commit",
+			setup: func(r testRepository) {
+				r.checkout("updatecli_main_1234")
+			},
+			expected: true,
+		},
+		{
+			name: "Working branch with pending changes",
+			setup: func(r testRepository) {
+				r.checkout("updatecli_main_1234")
+				r.commit(map[string]string{"go.mod": "go 1.22.1\n"})
+			},
+			expected: false,
+		},
+		{
+			name: "Base branch manually updated",
+			setup: func(r testRepository) {
+				r.checkout("updatecli_main_1234")
+				r.commit(map[string]string{"go.mod": "go 1.22.1\n"})
+				r.checkout("main")
+				r.commit(map[string]string{"go.mod": "go 1.22.1\n", "README.md": "# updated\n"})
+			},
+			expected: true,
+		},
+		{
+			name: "Base branch updated to a different version",
+			setup: func(r testRepository) {
+				r.checkout("updatecli_main_1234")
+				r.commit(map[string]string{"go.mod": "go 1.22.1\n"})
+				r.checkout("main")
+				r.commit(map[string]string{"go.mod": "go 1.22.2\n"})
+			},
+			expected: false,
+		},
+		{
+			name: "Working branch changes rolled back",
+			setup: func(r testRepository) {
+				r.checkout("updatecli_main_1234")
+				r.commit(map[string]string{"go.mod": "go 1.22.1\n"})
+				r.commit(map[string]string{"go.mod": "go 1.22.0\n"})
+			},
+			expected: true,
+		},
+		{
+			name: "File removed from the working branch only",
+			setup: func(r testRepository) {
+				r.checkout("updatecli_main_1234")
+				r.commit(map[string]string{"README.md": ""})
+			},
+			expected: false,
+		},
+		{
+			name: "File removed from both branches",
+			setup: func(r testRepository) {
+				r.checkout("updatecli_main_1234")
+				r.commit(map[string]string{"README.md": ""})
+				r.checkout("main")
+				r.commit(map[string]string{"README.md": ""})
+			},
+			expected: true,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newTestRepository(t)
+			tt.setup(r)
+
+			got, err := GoGit{}.IsBranchObsolete("main", "updatecli_main_1234", r.dir)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+
+	t.Run("Unknown branch", func(t *testing.T) {
+		r := newTestRepository(t)
+		_, err := GoGit{}.IsBranchObsolete("main", "doNotExist", r.dir)
+		require.Error(t, err)
+	})
+}
+
+func TestDeleteBranch(t *testing.T) {
+	remoteDir := t.TempDir()
+	_, err := git.PlainInit(remoteDir, true)
+	require.NoError(t, err)
+
+	r := newTestRepository(t)
+	_, err = r.repo.CreateRemote(&config.RemoteConfig{
+		Name: DefaultRemoteReferenceName,
+		URLs: []string{remoteDir},
+	})
+	require.NoError(t, err)
+
+	r.checkout("updatecli_main_1234")
+	r.commit(map[string]string{"go.mod": "go 1.22.1\n"})
+
+	require.NoError(t, GoGit{}.PushBranch("updatecli_main_1234", "", "", r.dir, false))
+
+	remote, err := git.PlainOpen(remoteDir)
+	require.NoError(t, err)
+	_, err = remote.Reference(plumbing.NewBranchReferenceName("updatecli_main_1234"), false)
+	require.NoError(t, err)
+
+	require.NoError(t, GoGit{}.DeleteBranch("updatecli_main_1234", "", "", r.dir))
+
+	_, err = remote.Reference(plumbing.NewBranchReferenceName("updatecli_main_1234"), false)
+	assert.ErrorIs(t, err, plumbing.ErrReferenceNotFound)
+
+	_, err = r.repo.Reference(plumbing.NewBranchReferenceName("updatecli_main_1234"), false)
+	assert.ErrorIs(t, err, plumbing.ErrReferenceNotFound)
+}
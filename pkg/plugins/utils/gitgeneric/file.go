@@ -1,11 +1,14 @@
 package gitgeneric
 
 import (
+	"errors"
 	"fmt"
 	"io"
+	"sort"
 
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/format/index"
 	"github.com/go-git/go-git/v5/plumbing/object"
 )
 
@@ -81,3 +84,114 @@ func resolve(obj object.Object, path string) (*object.Blob, error) {
 		return nil, object.ErrUnsupportedObject
 	}
 }
+
+// FileChange describes a file staged in the index which differs from HEAD
+type FileChange struct {
+	// Path is the file path relative to the repository root
+	Path string
+	// Deleted is true if the file is removed from the index
+	Deleted bool
+	// Hash is the blob hash of the file in HEAD, it is empty for new files
+	Hash string
+	// Content is the file content staged in the index, it is empty for deleted files
+	Content []byte
+}
+
+// GetFileChanges returns the staged files which differ from HEAD, sorted by path.
+// It is used to replay local changes through a git provider api.
+// Untracked files and unstaged changes are ignored, as they wouldn't be part of a git commit,
+// so file contents are read from the index rather than from the worktree.
+func GetFileChanges(workingDir string) ([]FileChange, error) {
+	repo, err := git.PlainOpen(workingDir)
+	if err != nil {
+		return nil, fmt.Errorf("opening %q git directory: %w", workingDir, err)
+	}
+
+	worktree, err := repo.Worktree()
+	if err != nil {
+		return nil, fmt.Errorf("opening %q git worktree: %w", workingDir, err)
+	}
+
+	status, err := worktree.Status()
+	if err != nil {
+		return nil, fmt.Errorf("getting git status: %w", err)
+	}
+
+	head, err := repo.Head()
+	if err != nil {
+		return nil, fmt.Errorf("getting HEAD: %w", err)
+	}
+
+	commit, err := repo.CommitObject(head.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("getting HEAD commit: %w", err)
+	}
+
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, fmt.Errorf("getting HEAD tree: %w", err)
+	}
+
+	idx, err := repo.Storer.Index()
+	if err != nil {
+		return nil, fmt.Errorf("getting git index: %w", err)
+	}
+
+	changes := []FileChange{}
+	for path, fileStatus := range status {
+		if fileStatus.Staging == git.Unmodified || fileStatus.Staging == git.Untracked {
+			continue
+		}
+
+		change := FileChange{Path: path}
+
+		file, err := tree.File(path)
+		switch {
+		case err == nil:
+			change.Hash = file.Hash.String()
+		case !errors.Is(err, object.ErrFileNotFound):
+			return nil, fmt.Errorf("get file %q from HEAD: %w", path, err)
+		}
+
+		entry, err := idx.Entry(path)
+		switch {
+		case errors.Is(err, index.ErrEntryNotFound):
+			// A file which is neither in HEAD nor in the index has nothing to replay
+			if change.Hash == "" {
+				continue
+			}
+			change.Deleted = true
+		case err != nil:
+			return nil, fmt.Errorf("get file %q from index: %w", path, err)
+		default:
+			change.Content, err = readBlob(repo, entry.Hash)
+			if err != nil {
+				return nil, fmt.Errorf("read file %q from index: %w", path, err)
+			}
+		}
+
+		changes = append(changes, change)
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+
+	return changes, nil
+}
+
+// readBlob returns the content of the blob identified by hash
+func readBlob(repo *git.Repository, hash plumbing.Hash) ([]byte, error) {
+	blob, err := repo.BlobObject(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := blob.Reader()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	return io.ReadAll(r)
+}
@@ -2,8 +2,11 @@ package gitgeneric
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/stretchr/testify/require"
 )
 
@@ -56,3 +59,42 @@ func TestReadFileFromBranch(t *testing.T) {
 		})
 	}
 }
+
+func TestGetFileChanges(t *testing.T) {
+	r := newTestRepository(t)
+
+	commit, err := r.repo.CommitObject(plumbing.NewHash(r.head("main")))
+	require.NoError(t, err)
+	tree, err := commit.Tree()
+	require.NoError(t, err)
+	readme, err := tree.File("README.md")
+	require.NoError(t, err)
+	goMod, err := tree.File("go.mod")
+	require.NoError(t, err)
+
+	w, err := r.repo.Worktree()
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(r.dir, "README.md"), []byte("# updated\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(r.dir, "new.txt"), []byte("new\n"), 0600))
+	_, err = w.Add("README.md")
+	require.NoError(t, err)
+	_, err = w.Add("new.txt")
+	require.NoError(t, err)
+	_, err = w.Remove("go.mod")
+	require.NoError(t, err)
+
+	// Untracked files and unstaged changes are not part of the commit
+	require.NoError(t, os.WriteFile(filepath.Join(r.dir, "untracked.txt"), []byte("untracked\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(r.dir, "new.txt"), []byte("new, not staged\n"), 0600))
+
+	changes, err := GetFileChanges(r.dir)
+	require.NoError(t, err)
+
+	// Staged content is returned, not the worktree one
+	require.Equal(t, []FileChange{
+		{Path: "README.md", Hash: readme.Hash.String(), Content: []byte("# updated\n")},
+		{Path: "go.mod", Deleted: true, Hash: goMod.Hash.String()},
+		{Path: "new.txt", Content: []byte("new\n")},
+	}, changes)
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/go-git/go-git/v5/config"
 	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport"
 	transportHttp "github.com/go-git/go-git/v5/plumbing/transport/http"
 )
 
@@ -34,6 +35,7 @@ type GitHandler interface {
 	GetChangedFiles(workingDir string) ([]string, error)
 	GetLatestCommitHash(workingDir string) (string, error)
 	IsSimilarBranch(a, b, workingDir string) (bool, error)
+	IsBranchObsolete(baseBranch, workingBranch, workingDir string) (bool, error)
 	IsLocalBranchPublished(baseBranch, workingBranch, username, password, workingDir string) (bool, error)
 	NewTag(tag, message, workingDir string) (bool, error)
 	NewBranch(branch, workingDir string) (bool, error)
@@ -41,6 +43,7 @@ type GitHandler interface {
 	Push(username string, password string, workingDir string, force bool) (bool, error)
 	PushTag(tag string, username string, password string, workingDir string, force bool) error
 	PushBranch(branch string, username string, password string, workingDir string, force bool) error
+	DeleteBranch(branch, username, password, workingDir string) error
 	RemoteURLs(workingDir string) (map[string]string, error)
 	SanitizeBranchName(branch string) string
 	Tags(workingDir string) (tags []string, err error)
@@ -54,6 +57,15 @@ type GitHandler interface {
 type GoGit struct {
 	// ForceReset is set to true if the branch has been reset to the latest commit of the based branch.
 	ForceReset bool
+	// RebaseStrategy defines when the working branch is recreated from its based branch.
+	// Accepted values are "never", "auto", and "recreate"; by default "auto" is used if force reset is allowed.
+	RebaseStrategy string
+	// recreatedBranches contains the working branches already recreated during the current run
+	// so the commits made by previous targets are kept.
+	recreatedBranches map[string]bool
+	// SSHAuth is the authentication method used to interact with git remotes over ssh.
+	// When set, it takes precedence over username/password.
+	SSHAuth transport.AuthMethod
 }
 
 /*
@@ -84,10 +96,7 @@ func (g GoGit) IsLocalBranchPublished(baseBranch, workingBranch, username, passw
 
 	logrus.Debugln("Checking if local changes have been done that should be published")
 
-	auth := transportHttp.BasicAuth{
-		Username: username, // anything excepted an empty string
-		Password: password,
-	}
+	auth := g.getAuth(username, password)
 
 	// Check if base branch and working branch have the same reference
 	matching, err := g.IsSimilarBranch(baseBranch, workingBranch, workingDir)
@@ -107,10 +116,8 @@ func (g GoGit) IsLocalBranchPublished(baseBranch, workingBranch, username, passw
 		return false, fmt.Errorf("remote %q - %s", DefaultRemoteReferenceName, err)
 	}
 
-	listOptions := git.ListOptions{}
-
-	if !isAuthEmpty(&auth) {
-		listOptions.Auth = &auth
+	listOptions := git.ListOptions{
+		Auth: auth,
 	}
 	remoteRefs, err := rem.List(&listOptions)
 	if err != nil {
@@ -284,10 +291,7 @@ func (g *GoGit) Checkout(username, password, basedBranch, newBranch, gitReposito
 		return fmt.Errorf("opening %q git worktree: %w", gitRepositoryPath, err)
 	}
 
-	auth := transportHttp.BasicAuth{
-		Username: username, // anything except an empty string
-		Password: password,
-	}
+	auth := g.getAuth(username, password)
 
 	// Checkout source branch without creating it yet
 	// If newBranch already exist, use it
@@ -330,6 +334,9 @@ func (g *GoGit) Checkout(username, password, basedBranch, newBranch, gitReposito
 
 		logrus.Debugf("branch %q successfully created", newBranch)
 
+		// A newly created branch is already based on the latest commit of the based branch
+		g.markBranchRecreated(newBranch)
+
 	case nil:
 		// Means that a local branch named remoteBranch already exist
 		// so we want to be sure that the local branch is
@@ -346,10 +353,7 @@ func (g *GoGit) Checkout(username, password, basedBranch, newBranch, gitReposito
 		pullOptions := git.PullOptions{
 			Force:    true,
 			Progress: &b,
-		}
-
-		if !isAuthEmpty(&auth) {
-			pullOptions.Auth = &auth
+			Auth:     auth,
 		}
 
 		err = worktree.Pull(&pullOptions)
@@ -364,7 +368,8 @@ func (g *GoGit) Checkout(username, password, basedBranch, newBranch, gitReposito
 			return err
 		}
 
-		if forceReset {
+		switch GetRebaseStrategy(g.RebaseStrategy, forceReset) {
+		case RebaseStrategyAuto:
 			logrus.Debugf("Checking if branch %q diverged from %q:", newBranch, basedBranch)
 			// If the newBranch diverged from the basedBranch, we need to reset it
 			resetBranch, err := resetNewBranchToBaseBranch(
@@ -381,6 +386,28 @@ func (g *GoGit) Checkout(username, password, basedBranch, newBranch, gitReposito
 			} else {
 				logrus.Debugf("\tall good,branch %q is ahead of %q", newBranch, basedBranch)
 			}
+
+		case RebaseStrategyRecreate:
+			// The working branch is only recreated once per run,
+			// otherwise we would lose the changes made by previous targets
+			if newBranch == basedBranch || g.recreatedBranches[newBranch] {
+				break
+			}
+
+			logrus.Debugf("Recreating branch %q from %q", newBranch, basedBranch)
+			resetBranch, err := resetBranchToBaseBranch(
+				plumbing.NewBranchReferenceName(newBranch),
+				plumbing.NewBranchReferenceName(basedBranch),
+				gitRepositoryPath,
+			)
+			if err != nil {
+				return err
+			}
+			g.ForceReset = g.ForceReset || resetBranch
+			g.markBranchRecreated(newBranch)
+
+		case RebaseStrategyNever:
+			logrus.Debugf("Keeping branch %q as it is, regardless of %q", newBranch, basedBranch)
 		}
 
 	default:
@@ -390,6 +417,14 @@ func (g *GoGit) Checkout(username, password, basedBranch, newBranch, gitReposito
 	return nil
 }
 
+// markBranchRecreated records that a working branch is based on the latest commit of its based branch
+func (g *GoGit) markBranchRecreated(branch string) {
+	if g.recreatedBranches == nil {
+		g.recreatedBranches = map[string]bool{}
+	}
+	g.recreatedBranches[branch] = true
+}
+
 func (g *GoGit) IsForceReset() bool {
 	// If ForceReset is set to true, it means that the branch has been reset
 	// to the latest commit of the based branch.
@@ -430,7 +465,13 @@ func (g GoGit) Commit(user, email, message, workingDir string, signingKey string
 
 	logrus.Debugf("status: %q\n", status)
 
-	if len(signingKey) > 0 {
+	if sign.IsSSHKey(signingKey) {
+		signer, err := sign.NewSSHSigner(signingKey, passphrase)
+		if err != nil {
+			return fmt.Errorf("getting commit ssh sign key: %w", err)
+		}
+		commitOptions.Signer = signer
+	} else if len(signingKey) > 0 {
 		key, err := sign.GetCommitSignKey(signingKey, passphrase)
 		if err != nil {
 			return fmt.Errorf("getting commit sign key: %w", err)
@@ -457,10 +498,7 @@ func (g GoGit) Clone(username, password, URL, workingDir string, withSubmodules
 
 	var repo *git.Repository
 
-	auth := transportHttp.BasicAuth{
-		Username: username, // anything except an empty string
-		Password: password,
-	}
+	auth := g.getAuth(username, password)
 
 	submodule := git.DefaultSubmoduleRecursionDepth
 	if withSubmodules != nil && !*withSubmodules {
@@ -472,10 +510,7 @@ func (g GoGit) Clone(username, password, URL, workingDir string, withSubmodules
 		URL:               URL,
 		Progress:          &b,
 		RecurseSubmodules: submodule,
-	}
-
-	if !isAuthEmpty(&auth) {
-		cloneOptions.Auth = &auth
+		Auth:              auth,
 	}
 
 	b.WriteString(fmt.Sprintf("cloning git repository: %s in %s\n", URL, workingDir))
@@ -510,10 +545,7 @@ func (g GoGit) Clone(username, password, URL, workingDir string, withSubmodules
 		pullOptions := git.PullOptions{
 			Force:    true,
 			Progress: &b,
-		}
-
-		if !isAuthEmpty(&auth) {
-			pullOptions.Auth = &auth
+			Auth:     auth,
 		}
 
 		err = w.Pull(&pullOptions)
@@ -555,9 +587,7 @@ func (g GoGit) Clone(username, password, URL, workingDir string, withSubmodules
 			Progress: &b,
 			RefSpecs: []config.RefSpec{"refs/*:refs/*"},
 			Force:    true,
-		}
-		if !isAuthEmpty(&auth) {
-			fetchOptions.Auth = &auth
+			Auth:     auth,
 		}
 
 		err := r.Fetch(&fetchOptions)
@@ -584,10 +614,7 @@ func (g GoGit) Push(username string, password string, workingDir string, force b
 
 	logrus.Debugf("stage: git-push\n\n")
 
-	auth := transportHttp.BasicAuth{
-		Username: username, // anything excepted an empty string
-		Password: password,
-	}
+	auth := g.getAuth(username, password)
 
 	r, err := git.PlainOpen(workingDir)
 	if err != nil {
@@ -619,15 +646,11 @@ func (g GoGit) Push(username string, password string, workingDir string, force b
 	b := bytes.Buffer{}
 
 	pushOptions := git.PushOptions{
-		Auth:     &auth,
+		Auth:     auth,
 		Progress: &b,
 		RefSpecs: []config.RefSpec{refspec},
 	}
 
-	if !isAuthEmpty(&auth) {
-		pushOptions.Auth = &auth
-	}
-
 	// Only push one branch at a time
 	err = r.Push(&pushOptions)
 	if b.String() != "" {
@@ -652,7 +675,7 @@ func (g GoGit) Push(username string, password string, workingDir string, force b
 			localBranch))
 
 		pushOptions = git.PushOptions{
-			Auth:     &auth,
+			Auth:     auth,
 			Progress: &b,
 			RefSpecs: []config.RefSpec{refspec},
 		}
@@ -854,10 +877,7 @@ func (g GoGit) NewTag(tag, message, workingDir string) (bool, error) {
 // PushTag publish a single tag created locally
 func (g GoGit) PushTag(tag string, username string, password string, workingDir string, force bool) error {
 
-	auth := transportHttp.BasicAuth{
-		Username: username, // anything except an empty string
-		Password: password,
-	}
+	auth := g.getAuth(username, password)
 
 	r, err := git.PlainOpen(workingDir)
 
@@ -879,10 +899,7 @@ func (g GoGit) PushTag(tag string, username string, password string, workingDir
 		RemoteName: DefaultRemoteReferenceName,
 		Progress:   &b,
 		RefSpecs:   []config.RefSpec{refspec},
-	}
-
-	if !isAuthEmpty(&auth) {
-		po.Auth = &auth
+		Auth:       auth,
 	}
 
 	err = r.Push(po)
@@ -922,9 +939,21 @@ func (g GoGit) RemoteURLs(workingDir string) (map[string]string, error) {
 	return remoteList, nil
 }
 
-// isAuthEmpty return true if no username/password are defined
-func isAuthEmpty(auth *transportHttp.BasicAuth) bool {
-	return auth.Username == "" && auth.Password == ""
+// getAuth returns the authentication method used to interact with git remotes.
+// The ssh authentication method takes precedence over username/password, and nil is returned if none are defined.
+func (g GoGit) getAuth(username, password string) transport.AuthMethod {
+	if g.SSHAuth != nil {
+		return g.SSHAuth
+	}
+
+	if username == "" && password == "" {
+		return nil
+	}
+
+	return &transportHttp.BasicAuth{
+		Username: username, // anything except an empty string
+		Password: password,
+	}
 }
 
 // Pull run `git pull` on the local HEAD.
@@ -947,10 +976,7 @@ func (g GoGit) Pull(username, password, gitRepositoryPath, branch string, single
 		return fmt.Errorf("opening %q git worktree: %w", gitRepositoryPath, err)
 	}
 
-	auth := transportHttp.BasicAuth{
-		Username: username, // anything except an empty string
-		Password: password,
-	}
+	auth := g.getAuth(username, password)
 
 	b := bytes.Buffer{}
 
@@ -958,16 +984,13 @@ func (g GoGit) Pull(username, password, gitRepositoryPath, branch string, single
 		Force:        forceReset,
 		Progress:     &b,
 		SingleBranch: singleBranch,
+		Auth:         auth,
 	}
 
 	if branch != "" {
 		pullOptions.ReferenceName = plumbing.NewBranchReferenceName(branch)
 	}
 
-	if !isAuthEmpty(&auth) {
-		pullOptions.Auth = &auth
-	}
-
 	err = worktree.Pull(&pullOptions)
 	if b.String() != "" {
 		logrus.Debugln(b.String())
@@ -330,3 +330,104 @@ func TestLatestCommitHash(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckoutRebaseStrategy(t *testing.T) {
+	const workingBranch = "updatecli_main_1234"
+
+	testData := []struct {
+		name          string
+		strategy      string
+		forceReset    bool
+		baseMoved     bool
+		expectedReset bool
+	}{
+		{
+			name:          "Default strategy without force keeps the working branch",
+			baseMoved:     true,
+			expectedReset: false,
+		},
+		{
+			name:          "Default strategy with force recreates a working branch behind its base",
+			forceReset:    true,
+			baseMoved:     true,
+			expectedReset: true,
+		},
+		{
+			name:          "Auto strategy keeps an up to date working branch",
+			strategy:      RebaseStrategyAuto,
+			expectedReset: false,
+		},
+		{
+			name:          "Auto strategy recreates a working branch behind its base",
+			strategy:      RebaseStrategyAuto,
+			baseMoved:     true,
+			expectedReset: true,
+		},
+		{
+			name:          "Never strategy keeps a working branch behind its base",
+			strategy:      RebaseStrategyNever,
+			forceReset:    true,
+			baseMoved:     true,
+			expectedReset: false,
+		},
+		{
+			name:          "Recreate strategy recreates an up to date working branch",
+			strategy:      RebaseStrategyRecreate,
+			expectedReset: true,
+		},
+	}
+
+	for _, tt := range testData {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newTestRepository(t)
+			r.addRemote()
+
+			r.checkout(workingBranch)
+			r.commit(map[string]string{"go.mod": "go 1.22.1\n"})
+			require.NoError(t, GoGit{}.PushBranch(workingBranch, "", "", r.dir, false))
+			workingHead := r.head(workingBranch)
+
+			if tt.baseMoved {
+				r.checkout("main")
+				r.commit(map[string]string{"README.md": "# updated\n"})
+				require.NoError(t, GoGit{}.PushBranch("main", "", "", r.dir, false))
+			}
+
+			g := GoGit{RebaseStrategy: tt.strategy}
+			require.NoError(t, g.Checkout("", "", "main", workingBranch, r.dir, tt.forceReset))
+
+			assert.Equal(t, tt.expectedReset, g.IsForceReset())
+			if tt.expectedReset {
+				assert.Equal(t, r.head("main"), r.head(workingBranch))
+			} else {
+				assert.Equal(t, workingHead, r.head(workingBranch))
+			}
+		})
+	}
+
+	t.Run("Recreate strategy only recreates the working branch once", func(t *testing.T) {
+		r := newTestRepository(t)
+		r.addRemote()
+
+		g := GoGit{RebaseStrategy: RebaseStrategyRecreate}
+		require.NoError(t, g.Checkout("", "", "main", workingBranch, r.dir, true))
+		r.commit(map[string]string{"go.mod": "go 1.22.1\n"})
+		workingHead := r.head(workingBranch)
+
+		require.NoError(t, g.Checkout("", "", "main", workingBranch, r.dir, true))
+		assert.Equal(t, workingHead, r.head(workingBranch))
+	})
+}
+
+func TestValidateRebaseStrategy(t *testing.T) {
+	for _, strategy := range []string{"", RebaseStrategyNever, RebaseStrategyAuto, RebaseStrategyRecreate} {
+		assert.NoError(t, ValidateRebaseStrategy(strategy, true))
+	}
+	assert.NoError(t, ValidateRebaseStrategy(RebaseStrategyNever, false))
+	assert.EqualError(t, ValidateRebaseStrategy(RebaseStrategyRecreate, false), `rebase strategy "recreate" requires the scm option "force" to be set to true`)
+	assert.EqualError(t, ValidateRebaseStrategy("rebase", true), `unsupported rebase strategy "rebase", accepted values are [never, auto, recreate]`)
+
+	assert.Equal(t, RebaseStrategyAuto, GetRebaseStrategy("", true))
+	assert.Equal(t, RebaseStrategyNever, GetRebaseStrategy("", false))
+	assert.Equal(t, RebaseStrategyRecreate, GetRebaseStrategy(RebaseStrategyRecreate, false))
+}
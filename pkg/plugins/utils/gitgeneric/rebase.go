@@ -0,0 +1,57 @@
+package gitgeneric
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// RebaseStrategyNever keeps the working branch as it is, even if its base branch moved
+	RebaseStrategyNever = "never"
+	// RebaseStrategyAuto recreates the working branch from its base branch, only if the working branch
+	// is behind its base branch, which is when conflicts may happen
+	RebaseStrategyAuto = "auto"
+	// RebaseStrategyRecreate recreates the working branch from its base branch on every run,
+	// before re-applying targets
+	RebaseStrategyRecreate = "recreate"
+)
+
+// RebaseStrategies contains the list of supported rebase strategies
+var RebaseStrategies = []string{
+	RebaseStrategyNever,
+	RebaseStrategyAuto,
+	RebaseStrategyRecreate,
+}
+
+// ValidateRebaseStrategy returns an error if the rebase strategy isn't supported,
+// or if it recreates working branches while force push isn't allowed.
+// An empty strategy is valid and means that the default strategy is used.
+func ValidateRebaseStrategy(strategy string, force bool) error {
+	switch strategy {
+	case "", RebaseStrategyNever:
+		return nil
+	case RebaseStrategyAuto, RebaseStrategyRecreate:
+		if !force {
+			return fmt.Errorf("rebase strategy %q requires the scm option \"force\" to be set to true", strategy)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("unsupported rebase strategy %q, accepted values are [%s]",
+		strategy, strings.Join(RebaseStrategies, ", "))
+}
+
+// GetRebaseStrategy returns the rebase strategy to apply.
+// Without explicit strategy, the working branch is only recreated when force push is allowed,
+// which is how Updatecli behaved before the rebase strategy was configurable.
+func GetRebaseStrategy(strategy string, force bool) string {
+	if strategy != "" {
+		return strategy
+	}
+
+	if force {
+		return RebaseStrategyAuto
+	}
+
+	return RebaseStrategyNever
+}
@@ -0,0 +1,56 @@
+package gitgeneric
+
+import (
+	"fmt"
+
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	gitssh "github.com/go-git/go-git/v5/plumbing/transport/ssh"
+)
+
+// DefaultSSHUser is the ssh user used when none is specified by the git url
+const DefaultSSHUser = "git"
+
+// IsSSHURL returns true if URL uses the ssh protocol, such as "git@github.com:updatecli/updatecli.git"
+func IsSSHURL(URL string) bool {
+	endpoint, err := transport.NewEndpoint(URL)
+	if err != nil {
+		return false
+	}
+	return endpoint.Protocol == "ssh"
+}
+
+// NewSSHAuth returns the authentication method used to interact with git remotes over ssh.
+// The ssh user is retrieved from URL, the private key is used if specified, otherwise the ssh agent is used.
+// Remote host keys are validated against the knownHosts files, or the default known_hosts files if none are specified.
+func NewSSHAuth(URL, privateKey, passphrase string, knownHosts []string) (transport.AuthMethod, error) {
+	user := DefaultSSHUser
+	if endpoint, err := transport.NewEndpoint(URL); err == nil && endpoint.User != "" {
+		user = endpoint.User
+	}
+
+	helper := gitssh.HostKeyCallbackHelper{}
+	if len(knownHosts) > 0 {
+		callback, err := gitssh.NewKnownHostsCallback(knownHosts...)
+		if err != nil {
+			return nil, fmt.Errorf("loading ssh known hosts: %w", err)
+		}
+		helper.HostKeyCallback = callback
+	}
+
+	if privateKey == "" {
+		auth, err := gitssh.NewSSHAgentAuth(user)
+		if err != nil {
+			return nil, fmt.Errorf("connecting to ssh agent: %w", err)
+		}
+		auth.HostKeyCallbackHelper = helper
+		return auth, nil
+	}
+
+	auth, err := gitssh.NewPublicKeys(user, []byte(privateKey), passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("loading ssh private key: %w", err)
+	}
+	auth.HostKeyCallbackHelper = helper
+
+	return auth, nil
+}
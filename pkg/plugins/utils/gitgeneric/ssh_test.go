@@ -0,0 +1,98 @@
+package gitgeneric
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	gitssh "github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+func TestIsSSHURL(t *testing.T) {
+	testCases := []struct {
+		url      string
+		expected bool
+	}{
+		{url: "git@github.com:updatecli/updatecli.git", expected: true},
+		{url: "ssh://git@github.com/updatecli/updatecli.git", expected: true},
+		{url: "https://github.com/updatecli/updatecli.git", expected: false},
+		{url: "file:///tmp/updatecli", expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.url, func(t *testing.T) {
+			assert.Equal(t, tc.expected, IsSSHURL(tc.url))
+		})
+	}
+}
+
+func TestNewSSHAuth(t *testing.T) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	block, err := ssh.MarshalPrivateKey(key, "")
+	require.NoError(t, err)
+	privateKey := string(pem.EncodeToMemory(block))
+
+	knownHosts := filepath.Join(t.TempDir(), "known_hosts")
+	require.NoError(t, os.WriteFile(knownHosts, []byte(
+		"github.com ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIOMqqnkVzrm0SdG6UOoqKLsabgH5C9okWi0dh2l9GKJl\n"), 0600))
+
+	testCases := []struct {
+		name         string
+		url          string
+		knownHosts   []string
+		expectedUser string
+		wantErr      bool
+	}{
+		{
+			name:         "user from url",
+			url:          "ssh://updatecli@github.com/updatecli/updatecli.git",
+			expectedUser: "updatecli",
+		},
+		{
+			name:         "scp like url",
+			url:          "git@github.com:updatecli/updatecli.git",
+			knownHosts:   []string{knownHosts},
+			expectedUser: "git",
+		},
+		{
+			name:       "missing known_hosts file",
+			url:        "git@github.com:updatecli/updatecli.git",
+			knownHosts: []string{filepath.Join(t.TempDir(), "doNotExist")},
+			wantErr:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			auth, err := NewSSHAuth(tc.url, privateKey, "", tc.knownHosts)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			publicKeys, ok := auth.(*gitssh.PublicKeys)
+			require.True(t, ok)
+			assert.Equal(t, tc.expectedUser, publicKeys.User)
+			assert.Equal(t, len(tc.knownHosts) > 0, publicKeys.HostKeyCallback != nil)
+
+			// The ssh authentication takes precedence over username/password
+			assert.Equal(t, auth, GoGit{SSHAuth: auth}.getAuth("user", "password"))
+		})
+	}
+
+	_, err = NewSSHAuth("git@github.com:updatecli/updatecli.git", "not a key", "", nil)
+	require.Error(t, err)
+}
+
+func TestGetAuth(t *testing.T) {
+	assert.Nil(t, GoGit{}.getAuth("", ""))
+	assert.NotNil(t, GoGit{}.getAuth("user", "password"))
+}
@@ -0,0 +1,133 @@
+package version
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	// calverRegex matches calendar versions such as "2024.10.1", "24.04", "v2024-10-01", or "2024.10.1-rc1".
+	// The first segment must be a two or four digits year.
+	calverRegex = regexp.MustCompile(`^v?([0-9]{2}|[0-9]{4})((?:[._-][0-9]+)*)(?:[._-]?([a-zA-Z][0-9a-zA-Z.-]*))?$`)
+	// calverOperators lists the supported calver constraint operators
+	calverOperators = []string{">=", "<=", "!=", "==", "=", ">", "<"}
+)
+
+// CalVer represents a calendar version
+// https://calver.org/
+type CalVer struct {
+	// Segments holds the numeric segments, starting with the year
+	Segments []int
+	// Modifier holds the optional trailing modifier such as "rc1" or "beta"
+	Modifier string
+	// original holds the string the version was parsed from
+	original string
+}
+
+// NewCalVer parses a calendar version
+func NewCalVer(version string) (CalVer, error) {
+	m := calverRegex.FindStringSubmatch(strings.TrimSpace(version))
+	if m == nil {
+		return CalVer{}, fmt.Errorf("invalid calendar version %q", version)
+	}
+
+	v := CalVer{
+		Modifier: m[3],
+		original: version,
+	}
+
+	fields := []string{m[1]}
+	fields = append(fields, strings.FieldsFunc(m[2], func(r rune) bool {
+		return r == '.' || r == '-' || r == '_'
+	})...)
+
+	for _, f := range fields {
+		n, err := strconv.Atoi(f)
+		if err != nil {
+			return CalVer{}, fmt.Errorf("invalid calendar version %q: %w", version, err)
+		}
+		v.Segments = append(v.Segments, n)
+	}
+
+	return v, nil
+}
+
+// String returns the original version
+func (v CalVer) String() string {
+	return v.original
+}
+
+// Compare returns -1, 0, or 1 depending on whether v is older, equal, or newer than o.
+// Segments are compared numerically, and a version without modifier
+// is newer than the same version with a modifier.
+func (v CalVer) Compare(o CalVer) int {
+	for i := 0; i < len(v.Segments) || i < len(o.Segments); i++ {
+		if c := compareInt(segment(v.Segments, i), segment(o.Segments, i)); c != 0 {
+			return c
+		}
+	}
+
+	switch {
+	case v.Modifier == o.Modifier:
+		return 0
+	case v.Modifier == "":
+		return 1
+	case o.Modifier == "":
+		return -1
+	}
+
+	return compareLocal(strings.ToLower(v.Modifier), strings.ToLower(o.Modifier))
+}
+
+// HasPrefix returns true if the segments of prefix are the first segments of v,
+// so "2024.10" matches "2024.10.2"
+func (v CalVer) HasPrefix(prefix CalVer) bool {
+	if len(prefix.Segments) > len(v.Segments) {
+		return false
+	}
+
+	for i, n := range prefix.Segments {
+		if v.Segments[i] != n {
+			return false
+		}
+	}
+
+	return prefix.Modifier == "" || strings.EqualFold(prefix.Modifier, v.Modifier)
+}
+
+// CalVerConstraint is a list of comparisons such as ">=2024.01, <2025".
+// A version without operator, such as "2024.10", is used as a prefix.
+type CalVerConstraint struct {
+	constraints []operatorConstraint[CalVer]
+	// modifier is true if a comparison references a version with a modifier,
+	// otherwise versions with a modifier such as "2024.10.1-rc1" are excluded
+	modifier bool
+}
+
+// NewCalVerConstraint parses a calendar version constraint.
+// An empty constraint or "*" matches any version without modifier.
+func NewCalVerConstraint(constraint string) (CalVerConstraint, error) {
+	c, err := parseOperatorConstraints(CALVERVERSIONKIND, constraint, calverOperators, NewCalVer)
+	if err != nil {
+		return CalVerConstraint{}, err
+	}
+
+	result := CalVerConstraint{constraints: c}
+	for i := range c {
+		if c[i].version.Modifier != "" && c[i].operator != "!=" {
+			result.modifier = true
+		}
+	}
+
+	return result, nil
+}
+
+// Check returns true if v satisfies every comparison
+func (c CalVerConstraint) Check(v CalVer) bool {
+	if v.Modifier != "" && !c.modifier {
+		return false
+	}
+	return checkAll(c.constraints, v)
+}
@@ -0,0 +1,95 @@
+package version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCalVer(t *testing.T) {
+	tests := []struct {
+		version          string
+		expectedSegments []int
+		expectedModifier string
+		expectedErr      bool
+	}{
+		{version: "2024.10.1", expectedSegments: []int{2024, 10, 1}},
+		{version: "24.04", expectedSegments: []int{24, 4}},
+		{version: "v2024-10-01", expectedSegments: []int{2024, 10, 1}},
+		{version: "2024.10.1-rc1", expectedSegments: []int{2024, 10, 1}, expectedModifier: "rc1"},
+		{version: "2024.10b2", expectedSegments: []int{2024, 10}, expectedModifier: "b2"},
+		{version: "123.1", expectedErr: true},
+		{version: "latest", expectedErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			v, err := NewCalVer(tt.version)
+			if tt.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedSegments, v.Segments)
+			assert.Equal(t, tt.expectedModifier, v.Modifier)
+		})
+	}
+}
+
+func TestCompareCalVer(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{a: "2024.10", b: "2024.10.0", expected: 0},
+		{a: "2024.9", b: "2024.10", expected: -1},
+		{a: "2024.10.1-rc1", b: "2024.10.1", expected: -1},
+		{a: "2024.10.1-rc1", b: "2024.10.1-rc2", expected: -1},
+		{a: "v2024.10.1", b: "2024.10.1", expected: 0},
+	}
+
+	for _, tt := range tests {
+		a, err := NewCalVer(tt.a)
+		require.NoError(t, err)
+		b, err := NewCalVer(tt.b)
+		require.NoError(t, err)
+		assert.Equal(t, tt.expected, a.Compare(b), "%s vs %s", tt.a, tt.b)
+		assert.Equal(t, -tt.expected, b.Compare(a), "%s vs %s", tt.b, tt.a)
+	}
+}
+
+func TestCalVerConstraint(t *testing.T) {
+	tests := []struct {
+		constraint  string
+		version     string
+		expected    bool
+		expectedErr bool
+	}{
+		{constraint: "*", version: "2024.10.1", expected: true},
+		{constraint: "2024", version: "2024.10.1", expected: true},
+		{constraint: "2024.10", version: "2024.1", expected: false},
+		{constraint: ">=2024.01, <2025", version: "2024.12.31", expected: true},
+		{constraint: ">=2024.01 <2025", version: "2025.1", expected: false},
+		{constraint: "!=2024.10.1", version: "2024.10.1", expected: false},
+		{constraint: ">=2024.10", version: "2024.10.1-rc1", expected: false},
+		{constraint: ">=2024.10.1-rc1", version: "2024.10.1-rc2", expected: true},
+		{constraint: ">=next", expectedErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.constraint+" "+tt.version, func(t *testing.T) {
+			c, err := NewCalVerConstraint(tt.constraint)
+			if tt.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			v, err := NewCalVer(tt.version)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, c.Check(v))
+		})
+	}
+}
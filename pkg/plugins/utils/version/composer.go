@@ -0,0 +1,381 @@
+package version
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	// composerDev is the stability of development versions such as "1.0.0-dev"
+	composerDev int = iota
+	// composerAlpha is the stability of alpha versions such as "1.0.0-alpha1"
+	composerAlpha
+	// composerBeta is the stability of beta versions such as "1.0.0-beta1"
+	composerBeta
+	// composerRC is the stability of release candidates such as "1.0.0-RC1"
+	composerRC
+	// composerStable is the stability of releases
+	composerStable
+	// composerPatch is the stability of patch versions such as "1.0.0-p1", which sort after the release
+	composerPatch
+)
+
+var (
+	// composerRegex is the regular expression used to parse versions, following the Composer VersionParser
+	// https://github.com/composer/semver/blob/main/src/VersionParser.php
+	composerRegex = regexp.MustCompile(`(?i)^\s*v?([0-9]+)(?:\.([0-9]+))?(?:\.([0-9]+))?(?:\.([0-9]+))?` +
+		`(?:[._-]?(stable|beta|b|rc|alpha|a|patch|pl|p)((?:[.-]?[0-9]+)*))?` +
+		`([.-]?dev)?\s*$`)
+	// composerStabilities maps stability names, as used in versions and stability flags, to their stability
+	composerStabilities = map[string]int{
+		"dev":    composerDev,
+		"alpha":  composerAlpha,
+		"a":      composerAlpha,
+		"beta":   composerBeta,
+		"b":      composerBeta,
+		"rc":     composerRC,
+		"stable": composerStable,
+		"patch":  composerPatch,
+		"pl":     composerPatch,
+		"p":      composerPatch,
+	}
+	// composerOperatorSpaceRegex matches the spaces allowed between an operator and a version, such as ">= 1.0"
+	composerOperatorSpaceRegex = regexp.MustCompile(`([<>=!~^]+)\s+`)
+	// composerHyphenRangeRegex matches a hyphenated version range such as "1.0 - 2.0"
+	composerHyphenRangeRegex = regexp.MustCompile(`^(\S+)\s+-\s+(\S+)$`)
+	// composerOperators lists the supported comparison operators, longest first
+	composerOperators = []string{">=", "<=", "<>", "!=", "==", "<", ">", "="}
+)
+
+// Composer represents a PHP package version as defined by Composer
+// https://getcomposer.org/doc/articles/versions.md
+type Composer struct {
+	// Segments holds the major, minor, patch, and build numbers
+	Segments [4]int
+	// Stability is the version stability, from development versions to patch versions
+	Stability int
+	// StabilityNumbers holds the numbers following the stability, such as 1 in "1.0.0-beta1"
+	StabilityNumbers []int
+	// original holds the string the version was parsed from
+	original string
+}
+
+// NewComposer parses a Composer version such as "v1.2.3", "1.2.3.4", or "1.2.0-RC1".
+// Branch names such as "dev-main" or "1.x-dev" are not versions.
+func NewComposer(version string) (Composer, error) {
+	v, _, err := parseComposer(version)
+	return v, err
+}
+
+// parseComposer parses a Composer version and returns the number of segments defined in version
+func parseComposer(version string) (Composer, int, error) {
+	m := composerRegex.FindStringSubmatch(version)
+	if m == nil {
+		return Composer{}, 0, fmt.Errorf("invalid composer version %q", version)
+	}
+
+	v := Composer{
+		Stability: composerStable,
+		original:  version,
+	}
+
+	given := 0
+	for i := 0; i < 4; i++ {
+		if m[i+1] == "" {
+			break
+		}
+		n, err := strconv.Atoi(m[i+1])
+		if err != nil {
+			return Composer{}, 0, fmt.Errorf("invalid composer version %q: %w", version, err)
+		}
+		v.Segments[i] = n
+		given++
+	}
+
+	if m[5] != "" {
+		v.Stability = composerStabilities[strings.ToLower(m[5])]
+		for _, s := range strings.FieldsFunc(m[6], func(r rune) bool { return r == '.' || r == '-' }) {
+			n, err := strconv.Atoi(s)
+			if err != nil {
+				return Composer{}, 0, fmt.Errorf("invalid composer version %q: %w", version, err)
+			}
+			v.StabilityNumbers = append(v.StabilityNumbers, n)
+		}
+	}
+
+	if m[7] != "" {
+		v.Stability = composerDev
+	}
+
+	return v, given, nil
+}
+
+// String returns the original version
+func (v Composer) String() string {
+	return v.original
+}
+
+// IsPrerelease returns true for development, alpha, beta, and release candidate versions
+func (v Composer) IsPrerelease() bool {
+	return v.Stability < composerStable
+}
+
+// Compare returns -1, 0, or 1 depending on whether v is older, equal, or newer than o
+func (v Composer) Compare(o Composer) int {
+	for i := range v.Segments {
+		if c := compareInt(v.Segments[i], o.Segments[i]); c != 0 {
+			return c
+		}
+	}
+
+	if c := compareInt(v.Stability, o.Stability); c != 0 {
+		return c
+	}
+
+	for i := 0; i < len(v.StabilityNumbers) || i < len(o.StabilityNumbers); i++ {
+		if c := compareInt(segment(v.StabilityNumbers, i), segment(o.StabilityNumbers, i)); c != 0 {
+			return c
+		}
+	}
+
+	return 0
+}
+
+// isExplicitStable returns true if v is a release without stability suffix
+func (v Composer) isExplicitStable() bool {
+	return v.Stability == composerStable && len(v.StabilityNumbers) == 0
+}
+
+// bump returns the development version preceding the increment of the segment at position,
+// such as "2.0.0.0-dev" for "1.2.3" and position 0
+func (v Composer) bump(position int) Composer {
+	b := Composer{Stability: composerDev}
+	copy(b.Segments[:position], v.Segments[:position])
+	b.Segments[position] = v.Segments[position] + 1
+	b.original = fmt.Sprintf("%d.%d.%d.%d-dev", b.Segments[0], b.Segments[1], b.Segments[2], b.Segments[3])
+	return b
+}
+
+// lowerBound returns the development version of v if v doesn't define a stability,
+// so "^1.2" also matches "1.2.0-beta1" when pre-releases are accepted
+func (v Composer) lowerBound() Composer {
+	if v.isExplicitStable() {
+		v.Stability = composerDev
+	}
+	return v
+}
+
+// SortComposer returns the valid Composer versions from versions, oldest first.
+// Invalid versions, such as branch names, are ignored.
+func SortComposer(versions []string) []string {
+	parsed := []Composer{}
+	for _, s := range versions {
+		v, err := NewComposer(s)
+		if err != nil {
+			continue
+		}
+		parsed = append(parsed, v)
+	}
+
+	slices.SortStableFunc(parsed, func(a, b Composer) int {
+		return a.Compare(b)
+	})
+
+	sorted := make([]string, len(parsed))
+	for i := range parsed {
+		sorted[i] = parsed[i].String()
+	}
+
+	return sorted
+}
+
+// ComposerConstraint is a Composer version constraint such as "^1.2 || ~2.0.3", ">=1.0 <2.0", or "1.2.*@beta"
+// https://getcomposer.org/doc/articles/versions.md#writing-version-constraints
+type ComposerConstraint struct {
+	// alternatives holds the "||" separated constraints, a version must satisfy every comparison of one of them
+	alternatives [][]operatorConstraint[Composer]
+	// stability is the minimum accepted stability.
+	// Only releases are accepted, unless the constraint uses a stability flag such as "@beta" or references a pre-release
+	stability int
+}
+
+// NewComposerConstraint parses a Composer version constraint.
+// An empty constraint or "*" matches any release.
+func NewComposerConstraint(constraint string) (ComposerConstraint, error) {
+	c := ComposerConstraint{stability: composerStable}
+
+	constraint = strings.TrimSpace(constraint)
+	if constraint == "" || constraint == "*" {
+		return c, nil
+	}
+
+	invalid := &ErrIncorrectVersionConstraint{Kind: COMPOSERVERSIONKIND, Constraint: constraint}
+
+	for _, alternative := range strings.Split(strings.ReplaceAll(constraint, "||", "|"), "|") {
+		alternative = strings.TrimSpace(alternative)
+		if alternative == "" {
+			return ComposerConstraint{}, invalid
+		}
+
+		var comparisons []operatorConstraint[Composer]
+
+		if m := composerHyphenRangeRegex.FindStringSubmatch(alternative); m != nil {
+			lower, _, err := parseComposer(m[1])
+			if err != nil {
+				return ComposerConstraint{}, invalid
+			}
+			upper, given, err := parseComposer(m[2])
+			if err != nil {
+				return ComposerConstraint{}, invalid
+			}
+			c.allowStability(lower, upper)
+
+			comparisons = append(comparisons, operatorConstraint[Composer]{operator: ">=", version: lower.lowerBound()})
+			// "1.0 - 2.0" means ">=1.0 <2.1" while "1.0.0 - 2.1.0" means ">=1.0.0 <=2.1.0"
+			if given < 3 && upper.isExplicitStable() {
+				comparisons = append(comparisons, operatorConstraint[Composer]{operator: "<", version: upper.bump(given - 1)})
+			} else {
+				comparisons = append(comparisons, operatorConstraint[Composer]{operator: "<=", version: upper})
+			}
+
+			c.alternatives = append(c.alternatives, comparisons)
+			continue
+		}
+
+		alternative = composerOperatorSpaceRegex.ReplaceAllString(alternative, "$1")
+		for _, term := range strings.FieldsFunc(alternative, func(r rune) bool {
+			return r == ',' || r == ' ' || r == '\t'
+		}) {
+			termComparisons, err := c.parseTerm(term)
+			if err != nil {
+				return ComposerConstraint{}, invalid
+			}
+			comparisons = append(comparisons, termComparisons...)
+		}
+
+		c.alternatives = append(c.alternatives, comparisons)
+	}
+
+	return c, nil
+}
+
+// parseTerm parses a single constraint such as "^1.2", "~1.2.3", "1.2.*", ">=1.0", or "1.0.0@beta"
+func (c *ComposerConstraint) parseTerm(term string) ([]operatorConstraint[Composer], error) {
+	term, flag, hasFlag := strings.Cut(term, "@")
+	if hasFlag {
+		stability, ok := composerStabilities[strings.ToLower(flag)]
+		if !ok {
+			return nil, fmt.Errorf("unknown stability flag %q", flag)
+		}
+		c.stability = min(c.stability, stability)
+	}
+
+	switch {
+	case term == "" || term == "*" || strings.EqualFold(term, "x"):
+		return nil, nil
+
+	case strings.HasSuffix(term, ".*") || strings.HasSuffix(strings.ToLower(term), ".x"):
+		prefix, given, err := parseComposer(term[:len(term)-2])
+		if err != nil || !prefix.isExplicitStable() {
+			return nil, fmt.Errorf("invalid wildcard constraint %q", term)
+		}
+		return []operatorConstraint[Composer]{
+			{operator: ">=", version: prefix.lowerBound()},
+			{operator: "<", version: prefix.bump(given - 1)},
+		}, nil
+
+	case strings.HasPrefix(term, "^"):
+		v, given, err := parseComposer(term[1:])
+		if err != nil {
+			return nil, err
+		}
+		c.allowStability(v)
+
+		// The upper bound is the next version of the first non-zero segment
+		position := 2
+		switch {
+		case v.Segments[0] != 0 || given < 2:
+			position = 0
+		case v.Segments[1] != 0 || given < 3:
+			position = 1
+		}
+
+		return []operatorConstraint[Composer]{
+			{operator: ">=", version: v.lowerBound()},
+			{operator: "<", version: v.bump(position)},
+		}, nil
+
+	case strings.HasPrefix(term, "~"):
+		v, given, err := parseComposer(term[1:])
+		if err != nil {
+			return nil, err
+		}
+		c.allowStability(v)
+
+		// "~1.2" means ">=1.2 <2.0" while "~1.2.3" means ">=1.2.3 <1.3.0"
+		return []operatorConstraint[Composer]{
+			{operator: ">=", version: v.lowerBound()},
+			{operator: "<", version: v.bump(max(1, given-1) - 1)},
+		}, nil
+	}
+
+	operator := "=="
+	for _, op := range composerOperators {
+		if strings.HasPrefix(term, op) {
+			operator = op
+			term = strings.TrimPrefix(term, op)
+			break
+		}
+	}
+
+	v, err := NewComposer(term)
+	if err != nil {
+		return nil, err
+	}
+
+	if operator == "<>" {
+		operator = "!="
+	}
+
+	if operator != "!=" {
+		c.allowStability(v)
+	}
+
+	if operator == ">=" || operator == "<" {
+		// ">=1.0" also matches "1.0.0-beta1" when pre-releases are accepted, while "<2.0" doesn't match "2.0.0-beta1"
+		v = v.lowerBound()
+	}
+
+	return []operatorConstraint[Composer]{{operator: operator, version: v}}, nil
+}
+
+// allowStability lowers the minimum accepted stability when the constraint explicitly references a pre-release
+func (c *ComposerConstraint) allowStability(versions ...Composer) {
+	for _, v := range versions {
+		if v.IsPrerelease() {
+			c.stability = min(c.stability, v.Stability)
+		}
+	}
+}
+
+// Check returns true if v satisfies one of the constraint alternatives
+func (c ComposerConstraint) Check(v Composer) bool {
+	if v.Stability < c.stability {
+		return false
+	}
+
+	if len(c.alternatives) == 0 {
+		return true
+	}
+
+	for _, comparisons := range c.alternatives {
+		if checkAll(comparisons, v) {
+			return true
+		}
+	}
+
+	return false
+}
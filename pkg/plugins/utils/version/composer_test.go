@@ -0,0 +1,148 @@
+package version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewComposer(t *testing.T) {
+	tests := []struct {
+		version            string
+		expectedPrerelease bool
+		expectedErr        bool
+	}{
+		{version: "1.0"},
+		{version: "v6.4.1"},
+		{version: "1.2.3.4"},
+		{version: "1.0.0-p1"},
+		{version: "2.0.0-RC1", expectedPrerelease: true},
+		{version: "2.0.0beta.2", expectedPrerelease: true},
+		{version: "1.0.x-dev", expectedErr: true},
+		{version: "1.0-dev", expectedPrerelease: true},
+		{version: "dev-main", expectedErr: true},
+		{version: "latest", expectedErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			v, err := NewComposer(tt.version)
+			if tt.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedPrerelease, v.IsPrerelease())
+			assert.Equal(t, tt.version, v.String())
+		})
+	}
+}
+
+func TestSortComposer(t *testing.T) {
+	expected := []string{
+		"1.0.0-dev",
+		"1.0.0-alpha1",
+		"1.0.0-beta1",
+		"1.0.0-beta2",
+		"1.0.0-RC1",
+		"v1.0.0",
+		"1.0.0-p1",
+		"1.0.1",
+		"1.10.0",
+		"2.0",
+	}
+
+	reversed := make([]string, 0, len(expected)+1)
+	for i := len(expected) - 1; i >= 0; i-- {
+		reversed = append(reversed, expected[i])
+	}
+	reversed = append(reversed, "dev-main")
+
+	assert.Equal(t, expected, SortComposer(reversed))
+}
+
+func TestCompareComposer(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{a: "1", b: "1.0.0.0", expected: 0},
+		{a: "v1.0", b: "1.0", expected: 0},
+		{a: "1.0-b1", b: "1.0-beta1", expected: 0},
+		{a: "1.0-RC", b: "1.0-RC1", expected: -1},
+		{a: "1.0-RC2", b: "1.0-RC10", expected: -1},
+		{a: "1.9", b: "1.10", expected: -1},
+		{a: "1.0.0.1", b: "1.0.1", expected: -1},
+	}
+
+	for _, tt := range tests {
+		a, err := NewComposer(tt.a)
+		require.NoError(t, err)
+		b, err := NewComposer(tt.b)
+		require.NoError(t, err)
+		assert.Equal(t, tt.expected, a.Compare(b), "%s vs %s", tt.a, tt.b)
+		assert.Equal(t, -tt.expected, b.Compare(a), "%s vs %s", tt.b, tt.a)
+	}
+}
+
+func TestComposerConstraint(t *testing.T) {
+	tests := []struct {
+		constraint  string
+		version     string
+		expected    bool
+		expectedErr bool
+	}{
+		{constraint: "*", version: "1.0", expected: true},
+		{constraint: "*", version: "1.1-RC1", expected: false},
+		{constraint: "*@beta", version: "1.1-beta1", expected: true},
+		{constraint: "*@beta", version: "1.1-alpha1", expected: false},
+		{constraint: "1.2.3", version: "1.2.3.0", expected: true},
+		{constraint: "1.2.3", version: "1.2.4", expected: false},
+		{constraint: "^1.2.3", version: "1.9.0", expected: true},
+		{constraint: "^1.2.3", version: "2.0.0", expected: false},
+		{constraint: "^1.2.3", version: "1.2.2", expected: false},
+		{constraint: "^0.3", version: "0.3.9", expected: true},
+		{constraint: "^0.3", version: "0.4.0", expected: false},
+		{constraint: "^0.0.3", version: "0.0.4", expected: false},
+		{constraint: "~1.2", version: "1.9", expected: true},
+		{constraint: "~1.2", version: "2.0", expected: false},
+		{constraint: "~1.2.3", version: "1.2.9", expected: true},
+		{constraint: "~1.2.3", version: "1.3.0", expected: false},
+		{constraint: "1.2.*", version: "1.2.7", expected: true},
+		{constraint: "1.2.x", version: "1.3.0", expected: false},
+		{constraint: ">=1.0 <2.0", version: "1.5", expected: true},
+		{constraint: ">= 1.0, < 2.0", version: "2.0", expected: false},
+		{constraint: ">=1.0 <2.0", version: "2.0.0-beta1", expected: false},
+		{constraint: "^5.4 || ^6.0", version: "6.4.1", expected: true},
+		{constraint: "^5.4|^6.0", version: "7.0.0", expected: false},
+		{constraint: "1.0 - 2.0", version: "2.0.9", expected: true},
+		{constraint: "1.0 - 2.0", version: "2.1.0", expected: false},
+		{constraint: "1.0.0 - 2.1.0", version: "2.1.0", expected: true},
+		{constraint: "1.0.0 - 2.1.0", version: "2.1.1", expected: false},
+		{constraint: "!=1.5", version: "1.5.0", expected: false},
+		{constraint: "<>1.5", version: "1.6", expected: true},
+		{constraint: ">=2.0.0-beta1", version: "2.0.0-beta2", expected: true},
+		{constraint: ">=2.0.0-beta1", version: "2.0.0-alpha1", expected: false},
+		{constraint: "^1.2@dev", version: "1.3.0-dev", expected: true},
+		{constraint: "dev-main", expectedErr: true},
+		{constraint: "^1.0 ||", expectedErr: true},
+		{constraint: "^1.0@unknown", expectedErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.constraint+" "+tt.version, func(t *testing.T) {
+			c, err := NewComposerConstraint(tt.constraint)
+			if tt.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			v, err := NewComposer(tt.version)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, c.Check(v))
+		})
+	}
+}
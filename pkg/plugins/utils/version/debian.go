@@ -0,0 +1,174 @@
+package version
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// debianOperators lists the supported debian constraint operators
+var debianOperators = []string{"<<", "<=", "=", ">=", ">>", "<", ">", "!="}
+
+// Debian represents a Debian package version such as "1:2.30-1ubuntu1"
+// https://www.debian.org/doc/debian-policy/ch-controlfields.html#version
+type Debian struct {
+	// Epoch is the version epoch, 0 if not specified
+	Epoch int
+	// Upstream is the upstream version
+	Upstream string
+	// Revision is the debian revision, empty if not specified
+	Revision string
+	// original holds the string the version was parsed from
+	original string
+}
+
+// NewDebian parses a Debian package version
+func NewDebian(version string) (Debian, error) {
+	v := Debian{original: version}
+
+	s := strings.TrimSpace(version)
+
+	if epoch, rest, found := strings.Cut(s, ":"); found {
+		e, err := strconv.Atoi(epoch)
+		if err != nil || e < 0 {
+			return Debian{}, fmt.Errorf("invalid debian version %q: wrong epoch", version)
+		}
+		v.Epoch = e
+		s = rest
+	}
+
+	if i := strings.LastIndex(s, "-"); i >= 0 {
+		v.Revision = s[i+1:]
+		s = s[:i]
+	}
+
+	v.Upstream = s
+
+	if v.Upstream == "" || v.Upstream[0] < '0' || v.Upstream[0] > '9' {
+		return Debian{}, fmt.Errorf("invalid debian version %q: upstream version must start with a digit", version)
+	}
+
+	for _, c := range v.Upstream + v.Revision {
+		if !isDebianVersionChar(c) {
+			return Debian{}, fmt.Errorf("invalid debian version %q: unexpected character %q", version, c)
+		}
+	}
+
+	return v, nil
+}
+
+// String returns the original version
+func (v Debian) String() string {
+	return v.original
+}
+
+// Compare returns -1, 0, or 1 depending on whether v is older, equal, or newer than o,
+// following the dpkg ordering rules.
+func (v Debian) Compare(o Debian) int {
+	if c := compareInt(v.Epoch, o.Epoch); c != 0 {
+		return c
+	}
+
+	if c := compareDebianString(v.Upstream, o.Upstream); c != 0 {
+		return c
+	}
+
+	return compareDebianString(v.Revision, o.Revision)
+}
+
+// DebianConstraint is a list of comparisons using the debian relationship operators such as ">= 1.2, << 2.0"
+type DebianConstraint struct {
+	constraints []operatorConstraint[Debian]
+}
+
+// NewDebianConstraint parses a debian version constraint.
+// An empty constraint or "*" matches any version.
+func NewDebianConstraint(constraint string) (DebianConstraint, error) {
+	c, err := parseOperatorConstraints(DEBIANVERSIONKIND, constraint, debianOperators, NewDebian)
+	if err != nil {
+		return DebianConstraint{}, err
+	}
+
+	return DebianConstraint{constraints: c}, nil
+}
+
+// Check returns true if v satisfies every comparison
+func (c DebianConstraint) Check(v Debian) bool {
+	return checkAll(c.constraints, v)
+}
+
+// compareDebianString implements the dpkg verrevcmp algorithm.
+// Non digit parts are compared character by character where "~" sorts before anything,
+// even the end of the string, and letters sort before non letters.
+// Digit parts are compared numerically.
+func compareDebianString(a, b string) int {
+	for a != "" || b != "" {
+		for (a != "" && !isDigit(a[0])) || (b != "" && !isDigit(b[0])) {
+			ac, bc := 0, 0
+			if a != "" {
+				ac = debianCharOrder(a[0])
+			}
+			if b != "" {
+				bc = debianCharOrder(b[0])
+			}
+
+			if ac != bc {
+				return compareInt(ac, bc)
+			}
+
+			if a != "" {
+				a = a[1:]
+			}
+			if b != "" {
+				b = b[1:]
+			}
+		}
+
+		a = strings.TrimLeft(a, "0")
+		b = strings.TrimLeft(b, "0")
+
+		ai, bi := 0, 0
+		for ai < len(a) && isDigit(a[ai]) {
+			ai++
+		}
+		for bi < len(b) && isDigit(b[bi]) {
+			bi++
+		}
+
+		// Numbers without leading zeros are compared by length then lexically
+		if c := compareInt(ai, bi); c != 0 {
+			return c
+		}
+		if c := strings.Compare(a[:ai], b[:bi]); c != 0 {
+			return c
+		}
+
+		a, b = a[ai:], b[bi:]
+	}
+
+	return 0
+}
+
+// debianCharOrder returns the weight of a character in a non digit part
+func debianCharOrder(c byte) int {
+	switch {
+	case isDigit(c):
+		return 0
+	case (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z'):
+		return int(c)
+	case c == '~':
+		return -1
+	}
+	return int(c) + 256
+}
+
+// isDebianVersionChar returns true for characters allowed in the upstream version and the debian revision
+func isDebianVersionChar(c rune) bool {
+	return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') ||
+		strings.ContainsRune(".+~-:", c)
+}
+
+// isDigit returns true if c is an ASCII digit
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
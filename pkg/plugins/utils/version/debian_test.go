@@ -0,0 +1,101 @@
+package version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDebian(t *testing.T) {
+	tests := []struct {
+		version          string
+		expectedEpoch    int
+		expectedUpstream string
+		expectedRevision string
+		expectedErr      bool
+	}{
+		{version: "1.0", expectedUpstream: "1.0"},
+		{version: "1:2.39.2-1", expectedEpoch: 1, expectedUpstream: "2.39.2", expectedRevision: "1"},
+		{version: "2.30-1ubuntu1.2", expectedUpstream: "2.30", expectedRevision: "1ubuntu1.2"},
+		{version: "1.2-3-4", expectedUpstream: "1.2-3", expectedRevision: "4"},
+		{version: "a1.0", expectedErr: true},
+		{version: "x:1.0", expectedErr: true},
+		{version: "1.0_1", expectedErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			v, err := NewDebian(tt.version)
+			if tt.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedEpoch, v.Epoch)
+			assert.Equal(t, tt.expectedUpstream, v.Upstream)
+			assert.Equal(t, tt.expectedRevision, v.Revision)
+		})
+	}
+}
+
+func TestCompareDebian(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{a: "1.0", b: "1.0", expected: 0},
+		{a: "1.0", b: "1.00", expected: 0},
+		{a: "1.0~rc1", b: "1.0", expected: -1},
+		{a: "1.0~~", b: "1.0~", expected: -1},
+		{a: "1.0", b: "1.0a", expected: -1},
+		{a: "1.0a", b: "1.0+", expected: -1},
+		{a: "1.0-1", b: "1.0-1ubuntu1", expected: -1},
+		{a: "1.9", b: "1.10", expected: -1},
+		{a: "9.0", b: "1:1.0", expected: -1},
+	}
+
+	for _, tt := range tests {
+		a, err := NewDebian(tt.a)
+		require.NoError(t, err)
+		b, err := NewDebian(tt.b)
+		require.NoError(t, err)
+		assert.Equal(t, tt.expected, a.Compare(b), "%s vs %s", tt.a, tt.b)
+		assert.Equal(t, -tt.expected, b.Compare(a), "%s vs %s", tt.b, tt.a)
+	}
+}
+
+func TestDebianConstraint(t *testing.T) {
+	tests := []struct {
+		constraint  string
+		version     string
+		expected    bool
+		expectedErr bool
+	}{
+		{constraint: "*", version: "1.0", expected: true},
+		{constraint: ">= 1.0", version: "1.0-1", expected: true},
+		{constraint: ">> 1.0", version: "1.0", expected: false},
+		{constraint: "<< 1.0", version: "1.0~rc1", expected: true},
+		{constraint: ">= 1.0, << 2.0", version: "2.0", expected: false},
+		{constraint: ">= 1.0 << 2.0", version: "1.5", expected: true},
+		{constraint: "= 1.0", version: "1.0", expected: true},
+		{constraint: "!= 1.0", version: "1.0", expected: false},
+		{constraint: ">= latest", expectedErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.constraint+" "+tt.version, func(t *testing.T) {
+			c, err := NewDebianConstraint(tt.constraint)
+			if tt.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			v, err := NewDebian(tt.version)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, c.Check(v))
+		})
+	}
+}
@@ -52,3 +52,13 @@ type ErrIncorrectSemVerConstraint struct {
 func (e *ErrIncorrectSemVerConstraint) Error() string {
 	return fmt.Sprintf("wrong semantic versioning constraint %q", e.SemVerConstraint)
 }
+
+// ErrIncorrectVersionConstraint returns when a version constraint can't be parsed
+type ErrIncorrectVersionConstraint struct {
+	Kind       string
+	Constraint string
+}
+
+func (e *ErrIncorrectVersionConstraint) Error() string {
+	return fmt.Sprintf("wrong %s version constraint %q", e.Kind, e.Constraint)
+}
@@ -26,6 +26,18 @@ const (
 	TIMEVERSIONKIND string = "time"
 	// LEXSORTVERSIONKIND uses the go sort package to find the latest version
 	LEXVERSIONKIND string = "lex"
+	// PEP440VERSIONKIND represents Python package versions as defined by PEP 440
+	PEP440VERSIONKIND string = "pep440"
+	// MAVENVERSIONKIND represents Maven artifact versions
+	MAVENVERSIONKIND string = "maven"
+	// DEBIANVERSIONKIND represents Debian package versions
+	DEBIANVERSIONKIND string = "debian"
+	// CALVERVERSIONKIND represents calendar versions such as 2024.10.1
+	CALVERVERSIONKIND string = "calver"
+	// COMPOSERVERSIONKIND represents PHP package versions as defined by Composer
+	COMPOSERVERSIONKIND string = "composer"
+	// RUBYGEMSVERSIONKIND represents Ruby gem versions as defined by RubyGems
+	RUBYGEMSVERSIONKIND string = "rubygems"
 )
 
 // SupportedKind holds a list of supported version kind
@@ -37,16 +49,28 @@ var SupportedKind []string = []string{
 	REGEXTIMEVERSIONKIND,
 	TIMEVERSIONKIND,
 	LEXVERSIONKIND,
+	PEP440VERSIONKIND,
+	MAVENVERSIONKIND,
+	DEBIANVERSIONKIND,
+	CALVERVERSIONKIND,
+	COMPOSERVERSIONKIND,
+	RUBYGEMSVERSIONKIND,
 }
 
 // Filter defines parameters to apply different kind of version matching based on a list of versions
 type Filter struct {
-	// specifies the version kind such as semver, regex, or latest
+	// specifies the version kind such as semver, regex, latest, pep440, maven, debian, calver, composer, or rubygems
 	Kind string `yaml:",omitempty"`
 	// specifies the version pattern according the version kind
 	// for semver, it is a semver constraint
 	// for regex, it is a regex pattern
 	// for time, it is a date format
+	// for pep440, it is a PEP 440 version specifier such as ">=1.4, !=1.5.*, <2"
+	// for maven, it is a Maven version range such as "[1.0,2.0)"
+	// for debian, it is a list of comparisons such as ">= 1:2.30, << 1:3"
+	// for calver, it is a list of comparisons such as ">=2024.01, <2025", or a prefix such as "2024.10"
+	// for composer, it is a Composer version constraint such as "^5.4 || ^6.0"
+	// for rubygems, it is a gem requirement such as "~> 7.1" or ">= 1.0, < 2"
 	Pattern string `yaml:",omitempty"`
 	// strict enforce strict versioning rule.
 	// Only used for semantic versioning at this time
@@ -70,7 +94,7 @@ func (f Filter) Init() (Filter, error) {
 			f.Pattern = "2006-01-02"
 		case REGEXVERSIONKIND:
 			f.Pattern = ".*"
-		case SEMVERVERSIONKIND:
+		case SEMVERVERSIONKIND, PEP440VERSIONKIND, MAVENVERSIONKIND, DEBIANVERSIONKIND, CALVERVERSIONKIND, COMPOSERVERSIONKIND, RUBYGEMSVERSIONKIND:
 			f.Pattern = "*"
 		case LATESTVERSIONKIND:
 			f.Pattern = LATESTVERSIONKIND
@@ -238,6 +262,54 @@ func (f *Filter) Search(versions []string) (Version, error) {
 		foundVersion.OriginalVersion = foundVersion.ParsedVersion
 		return foundVersion, nil
 
+	case PEP440VERSIONKIND:
+		c, err := NewPEP440Constraint(f.Pattern)
+		if err != nil {
+			return foundVersion, err
+		}
+
+		return searchOrdered(versions, NewPEP440, c.Check, f.Pattern)
+
+	case MAVENVERSIONKIND:
+		c, err := NewMavenConstraint(f.Pattern)
+		if err != nil {
+			return foundVersion, err
+		}
+
+		return searchOrdered(versions, NewMaven, c.Check, f.Pattern)
+
+	case DEBIANVERSIONKIND:
+		c, err := NewDebianConstraint(f.Pattern)
+		if err != nil {
+			return foundVersion, err
+		}
+
+		return searchOrdered(versions, NewDebian, c.Check, f.Pattern)
+
+	case CALVERVERSIONKIND:
+		c, err := NewCalVerConstraint(f.Pattern)
+		if err != nil {
+			return foundVersion, err
+		}
+
+		return searchOrdered(versions, NewCalVer, c.Check, f.Pattern)
+
+	case COMPOSERVERSIONKIND:
+		c, err := NewComposerConstraint(f.Pattern)
+		if err != nil {
+			return foundVersion, err
+		}
+
+		return searchOrdered(versions, NewComposer, c.Check, f.Pattern)
+
+	case RUBYGEMSVERSIONKIND:
+		c, err := NewRubyGemsConstraint(f.Pattern)
+		if err != nil {
+			return foundVersion, err
+		}
+
+		return searchOrdered(versions, NewRubyGems, c.Check, f.Pattern)
+
 	default:
 		return foundVersion, &ErrUnsupportedVersionKindPattern{Pattern: f.Pattern, Kind: f.Kind}
 	}
@@ -390,6 +462,105 @@ func (f *Filter) GreaterThanPattern(version string) (string, error) {
 		default:
 			return f.Pattern, nil
 		}
+
+	case PEP440VERSIONKIND:
+		v, err := NewPEP440(version)
+		if err != nil {
+			return "", err
+		}
+
+		switch f.Pattern {
+		case "", "*", "major":
+			return ">=" + version, nil
+
+		case "minor":
+			return fmt.Sprintf(">=%s, ==%s.*", version, pep440Prefix(v, 1)), nil
+
+		case "patch":
+			return fmt.Sprintf(">=%s, ==%s.*", version, pep440Prefix(v, 2)), nil
+
+		default:
+			return f.Pattern, nil
+		}
+
+	case MAVENVERSIONKIND:
+		switch f.Pattern {
+		case "", "*":
+			if _, err := NewMaven(version); err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("[%s,)", version), nil
+
+		default:
+			return f.Pattern, nil
+		}
+
+	case DEBIANVERSIONKIND:
+		switch f.Pattern {
+		case "", "*":
+			if _, err := NewDebian(version); err != nil {
+				return "", err
+			}
+			return ">= " + version, nil
+
+		default:
+			return f.Pattern, nil
+		}
+
+	case CALVERVERSIONKIND:
+		switch f.Pattern {
+		case "", "*":
+			if _, err := NewCalVer(version); err != nil {
+				return "", err
+			}
+			return ">=" + version, nil
+
+		default:
+			return f.Pattern, nil
+		}
+
+	case COMPOSERVERSIONKIND:
+		v, err := NewComposer(version)
+		if err != nil {
+			return "", err
+		}
+
+		switch f.Pattern {
+		case "", "*", "major":
+			return ">=" + version, nil
+
+		case "minor":
+			return fmt.Sprintf(">=%s <%d", version, v.Segments[0]+1), nil
+
+		case "patch":
+			return fmt.Sprintf(">=%s <%d.%d", version, v.Segments[0], v.Segments[1]+1), nil
+
+		default:
+			return f.Pattern, nil
+		}
+
+	case RUBYGEMSVERSIONKIND:
+		v, err := NewRubyGems(version)
+		if err != nil {
+			return "", err
+		}
+
+		release := v.releaseNumbers()
+		major, minor := segment(release, 0), segment(release, 1)
+
+		switch f.Pattern {
+		case "", "*", "major":
+			return ">= " + version, nil
+
+		case "minor":
+			return fmt.Sprintf(">= %s, < %d", version, major+1), nil
+
+		case "patch":
+			return fmt.Sprintf(">= %s, < %d.%d", version, major, minor+1), nil
+
+		default:
+			return f.Pattern, nil
+		}
 	}
 	return "", &ErrUnsupportedVersionKind{Kind: f.Kind}
 }
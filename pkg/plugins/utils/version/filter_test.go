@@ -219,6 +219,135 @@ func TestSearch(t *testing.T) {
 				ParsedVersion:   "v1.2",
 				OriginalVersion: "v1.2",
 			},
+		}, {
+			name: "Passing case with pep440 and specifier",
+			filter: Filter{
+				Kind:    PEP440VERSIONKIND,
+				Pattern: "~=2.31",
+			},
+			versions: []string{"2.30.0", "2.31.0", "2.32.3", "3.0.0rc1", "3.0.0"},
+			want: Version{
+				ParsedVersion:   "2.32.3",
+				OriginalVersion: "2.32.3",
+			},
+		},
+		{
+			name: "Passing case with pep440 ignoring prereleases",
+			filter: Filter{
+				Kind: PEP440VERSIONKIND,
+			},
+			versions: []string{"1.0", "1.10", "1.9", "2.0rc1", "2.0.dev1"},
+			want: Version{
+				ParsedVersion:   "1.10",
+				OriginalVersion: "1.10",
+			},
+		},
+		{
+			name: "Failing case with pep440 and wrong specifier",
+			filter: Filter{
+				Kind:    PEP440VERSIONKIND,
+				Pattern: ">=1.*",
+			},
+			versions: []string{"1.0"},
+			wantErr:  &ErrIncorrectVersionConstraint{Kind: PEP440VERSIONKIND, Constraint: ">=1.*"},
+		},
+		{
+			name: "Passing case with composer and constraint",
+			filter: Filter{
+				Kind:    COMPOSERVERSIONKIND,
+				Pattern: "^5.4 || ^6.0",
+			},
+			versions: []string{"v5.4.31", "v6.4.1", "v6.4.x-dev", "v7.0.0", "v6.5.0-RC1"},
+			want: Version{
+				ParsedVersion:   "v6.4.1",
+				OriginalVersion: "v6.4.1",
+			},
+		},
+		{
+			name: "Failing case with composer and wrong constraint",
+			filter: Filter{
+				Kind:    COMPOSERVERSIONKIND,
+				Pattern: "dev-main",
+			},
+			versions: []string{"1.0"},
+			wantErr:  &ErrIncorrectVersionConstraint{Kind: COMPOSERVERSIONKIND, Constraint: "dev-main"},
+		},
+		{
+			name: "Passing case with rubygems and pessimistic requirement",
+			filter: Filter{
+				Kind:    RUBYGEMSVERSIONKIND,
+				Pattern: "~> 7.1.0",
+			},
+			versions: []string{"7.0.8", "7.1.0", "7.1.3.4", "7.1.4.rc1", "7.2.0"},
+			want: Version{
+				ParsedVersion:   "7.1.3.4",
+				OriginalVersion: "7.1.3.4",
+			},
+		},
+		{
+			name: "Failing case with rubygems and wrong requirement",
+			filter: Filter{
+				Kind:    RUBYGEMSVERSIONKIND,
+				Pattern: "~> latest",
+			},
+			versions: []string{"1.0"},
+			wantErr:  &ErrIncorrectVersionConstraint{Kind: RUBYGEMSVERSIONKIND, Constraint: "~> latest"},
+		},
+		{
+			name: "Passing case with maven and range",
+			filter: Filter{
+				Kind:    MAVENVERSIONKIND,
+				Pattern: "[5.0,6.0)",
+			},
+			versions: []string{"5.3.9", "5.10.0", "6.0.0-M1", "6.0.0", "5.11.0-SNAPSHOT"},
+			want: Version{
+				ParsedVersion:   "5.10.0",
+				OriginalVersion: "5.10.0",
+			},
+		},
+		{
+			name: "Passing case with maven qualifiers",
+			filter: Filter{
+				Kind: MAVENVERSIONKIND,
+			},
+			versions: []string{"6.4.4.Final", "6.5.0.CR1", "6.4.10.Final"},
+			want: Version{
+				ParsedVersion:   "6.4.10.Final",
+				OriginalVersion: "6.4.10.Final",
+			},
+		},
+		{
+			name: "Passing case with debian",
+			filter: Filter{
+				Kind:    DEBIANVERSIONKIND,
+				Pattern: "<< 1:2.40",
+			},
+			versions: []string{"1:2.39.2-1", "1:2.39.2-1.1", "2.45.0-1", "1:2.40~rc1-1", "1:2.40.0-1"},
+			want: Version{
+				ParsedVersion:   "1:2.40~rc1-1",
+				OriginalVersion: "1:2.40~rc1-1",
+			},
+		},
+		{
+			name: "Passing case with calver prefix",
+			filter: Filter{
+				Kind:    CALVERVERSIONKIND,
+				Pattern: "2024.10",
+			},
+			versions: []string{"2024.9.3", "2024.10.1", "2024.10.2", "2024.10.3-rc1", "2024.11.0"},
+			want: Version{
+				ParsedVersion:   "2024.10.2",
+				OriginalVersion: "2024.10.2",
+			},
+		},
+		{
+			name: "Failing case with calver",
+			filter: Filter{
+				Kind:    CALVERVERSIONKIND,
+				Pattern: ">=2025",
+			},
+			versions: []string{"2024.9.3", "2024.10.1"},
+			wantErr:  &ErrNoVersionFoundForPattern{Pattern: ">=2025"},
 		},
 	}
 	for _, tt := range tests {
@@ -473,6 +602,108 @@ func TestGreaterThanPattern(t *testing.T) {
 			version: "1.0 - 2.0 !!!",
 			want:    "",
 			wantErr: &ErrIncorrectSemVerConstraint{SemVerConstraint: "1.0 - 2.0 !!!"},
+		}, {
+			name: "Default pep440 pattern",
+			filter: Filter{
+				Kind:    PEP440VERSIONKIND,
+				Pattern: "*",
+			},
+			version: "2.31.0", want: ">=2.31.0",
+		},
+		{
+			name: "Minor pep440 pattern",
+			filter: Filter{
+				Kind:    PEP440VERSIONKIND,
+				Pattern: "minor",
+			},
+			version: "2.31.0", want: ">=2.31.0, ==2.*",
+		},
+		{
+			name: "Patch pep440 pattern",
+			filter: Filter{
+				Kind:    PEP440VERSIONKIND,
+				Pattern: "patch",
+			},
+			version: "1!2.31", want: ">=1!2.31, ==1!2.31.*",
+		},
+		{
+			name: "Custom pep440 pattern",
+			filter: Filter{
+				Kind:    PEP440VERSIONKIND,
+				Pattern: "<3",
+			},
+			version: "2.31.0", want: "<3",
+		},
+		{
+			name: "Default composer pattern",
+			filter: Filter{
+				Kind:    COMPOSERVERSIONKIND,
+				Pattern: "*",
+			},
+			version: "v6.4.1", want: ">=v6.4.1",
+		},
+		{
+			name: "Minor composer pattern",
+			filter: Filter{
+				Kind:    COMPOSERVERSIONKIND,
+				Pattern: "minor",
+			},
+			version: "6.4.1", want: ">=6.4.1 <7",
+		},
+		{
+			name: "Patch composer pattern",
+			filter: Filter{
+				Kind:    COMPOSERVERSIONKIND,
+				Pattern: "patch",
+			},
+			version: "6.4.1", want: ">=6.4.1 <6.5",
+		},
+		{
+			name: "Default rubygems pattern",
+			filter: Filter{
+				Kind:    RUBYGEMSVERSIONKIND,
+				Pattern: "*",
+			},
+			version: "7.1.3", want: ">= 7.1.3",
+		},
+		{
+			name: "Minor rubygems pattern",
+			filter: Filter{
+				Kind:    RUBYGEMSVERSIONKIND,
+				Pattern: "minor",
+			},
+			version: "7.1.3", want: ">= 7.1.3, < 8",
+		},
+		{
+			name: "Patch rubygems pattern",
+			filter: Filter{
+				Kind:    RUBYGEMSVERSIONKIND,
+				Pattern: "patch",
+			},
+			version: "7.1.3.rc1", want: ">= 7.1.3.rc1, < 7.2",
+		},
+		{
+			name: "Default maven pattern",
+			filter: Filter{
+				Kind: MAVENVERSIONKIND,
+			},
+			version: "5.10.0", want: "[5.10.0,)",
+		},
+		{
+			name: "Default debian pattern",
+			filter: Filter{
+				Kind:    DEBIANVERSIONKIND,
+				Pattern: "*",
+			},
+			version: "1:2.39.2-1", want: ">= 1:2.39.2-1",
+		},
+		{
+			name: "Default calver pattern",
+			filter: Filter{
+				Kind:    CALVERVERSIONKIND,
+				Pattern: "*",
+			},
+			version: "2024.10.1", want: ">=2024.10.1",
 		},
 	}
 	for _, tt := range tests {
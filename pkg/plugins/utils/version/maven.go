@@ -0,0 +1,441 @@
+package version
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+var (
+	// mavenQualifiers lists the well known qualifiers, oldest first.
+	// The empty qualifier represents a release.
+	mavenQualifiers = []string{"alpha", "beta", "milestone", "rc", "snapshot", "", "sp"}
+	// mavenQualifierAliases maps qualifiers to their canonical name
+	mavenQualifierAliases = map[string]string{
+		"ga":      "",
+		"final":   "",
+		"release": "",
+		"cr":      "rc",
+	}
+	// mavenReleaseQualifier is the comparable value of the release qualifier
+	mavenReleaseQualifier = comparableMavenQualifier("")
+)
+
+// Maven represents a Maven artifact version, ordered following the Maven ComparableVersion rules
+// https://maven.apache.org/pom.html#version-order-specification
+type Maven struct {
+	items    *mavenList
+	original string
+}
+
+// mavenItem is a parsed version component
+type mavenItem interface {
+	// compare compares the item with another one, or with a missing item if other is nil
+	compare(other mavenItem) int
+	// isNull returns true if the item is equivalent to a missing item
+	isNull() bool
+}
+
+// mavenInt is a numeric component, stored without leading zeros to support any size
+type mavenInt string
+
+// mavenString is a qualifier component
+type mavenString string
+
+// mavenList is a list of components, a new list starts after each "-"
+type mavenList struct {
+	items []mavenItem
+}
+
+// NewMaven parses a Maven version.
+// Versions must start with a digit, such as "1.0", "1.0-SNAPSHOT", or "5.4.0.Final".
+func NewMaven(version string) (Maven, error) {
+	if version == "" || version[0] < '0' || version[0] > '9' {
+		return Maven{}, fmt.Errorf("invalid maven version %q", version)
+	}
+
+	return Maven{
+		items:    parseMavenVersion(version),
+		original: version,
+	}, nil
+}
+
+// String returns the original version
+func (v Maven) String() string {
+	return v.original
+}
+
+// IsPrerelease returns true for versions using a qualifier older than a release,
+// such as alpha, beta, milestone, rc, or snapshot
+func (v Maven) IsPrerelease() bool {
+	return v.items.hasPrereleaseQualifier()
+}
+
+// Compare returns -1, 0, or 1 depending on whether v is older, equal, or newer than o
+func (v Maven) Compare(o Maven) int {
+	return sign(v.items.compare(o.items))
+}
+
+// parseMavenVersion splits a version into components, following the Maven ComparableVersion parser
+func parseMavenVersion(version string) *mavenList {
+	version = strings.ToLower(version)
+
+	root := &mavenList{}
+	list := root
+	stack := []*mavenList{root}
+
+	newList := func() {
+		l := &mavenList{}
+		list.items = append(list.items, l)
+		list = l
+		stack = append(stack, l)
+	}
+
+	isDigit := false
+	start := 0
+
+	for i := 0; i < len(version); i++ {
+		c := version[i]
+
+		switch {
+		case c == '.' || c == '-':
+			if i == start {
+				list.items = append(list.items, mavenInt(""))
+			} else {
+				list.items = append(list.items, parseMavenItem(isDigit, version[start:i]))
+			}
+			start = i + 1
+
+			if c == '-' {
+				newList()
+			}
+
+		case c >= '0' && c <= '9':
+			if !isDigit && i > start {
+				list.items = append(list.items, newMavenString(version[start:i], true))
+				start = i
+				newList()
+			}
+			isDigit = true
+
+		default:
+			if isDigit && i > start {
+				list.items = append(list.items, parseMavenItem(true, version[start:i]))
+				start = i
+				newList()
+			}
+			isDigit = false
+		}
+	}
+
+	if len(version) > start {
+		list.items = append(list.items, parseMavenItem(isDigit, version[start:]))
+	}
+
+	for i := len(stack) - 1; i >= 0; i-- {
+		stack[i].normalize()
+	}
+
+	return root
+}
+
+func parseMavenItem(isDigit bool, s string) mavenItem {
+	if isDigit {
+		return mavenInt(strings.TrimLeft(s, "0"))
+	}
+	return newMavenString(s, false)
+}
+
+func newMavenString(s string, followedByDigit bool) mavenString {
+	if followedByDigit && len(s) == 1 {
+		switch s {
+		case "a":
+			s = "alpha"
+		case "b":
+			s = "beta"
+		case "m":
+			s = "milestone"
+		}
+	}
+
+	if alias, ok := mavenQualifierAliases[s]; ok {
+		s = alias
+	}
+
+	return mavenString(s)
+}
+
+// comparableMavenQualifier returns a string used to order qualifiers.
+// Unknown qualifiers are considered newer than the well known ones, and are ordered lexically.
+func comparableMavenQualifier(q string) string {
+	i := slices.Index(mavenQualifiers, q)
+	if i < 0 {
+		return fmt.Sprintf("%d-%s", len(mavenQualifiers), q)
+	}
+	return fmt.Sprint(i)
+}
+
+func (i mavenInt) isNull() bool {
+	return i == ""
+}
+
+func (i mavenInt) compare(other mavenItem) int {
+	switch o := other.(type) {
+	case nil:
+		if i.isNull() {
+			return 0
+		}
+		return 1
+	case mavenInt:
+		if len(i) != len(o) {
+			return len(i) - len(o)
+		}
+		return strings.Compare(string(i), string(o))
+	case mavenString:
+		// 1.1 > 1-sp
+		return 1
+	case *mavenList:
+		// 1.1 > 1-1
+		return 1
+	}
+	return 0
+}
+
+func (s mavenString) isNull() bool {
+	return s == ""
+}
+
+func (s mavenString) compare(other mavenItem) int {
+	switch o := other.(type) {
+	case nil:
+		// 1-rc < 1, 1-sp > 1
+		return strings.Compare(comparableMavenQualifier(string(s)), mavenReleaseQualifier)
+	case mavenInt:
+		return -1
+	case mavenString:
+		return strings.Compare(comparableMavenQualifier(string(s)), comparableMavenQualifier(string(o)))
+	case *mavenList:
+		return -1
+	}
+	return 0
+}
+
+// hasPrereleaseQualifier returns true if any qualifier of the list, or its sublists, sorts before a release
+func (l *mavenList) hasPrereleaseQualifier() bool {
+	for _, item := range l.items {
+		switch i := item.(type) {
+		case mavenString:
+			if i.compare(nil) < 0 {
+				return true
+			}
+		case *mavenList:
+			if i.hasPrereleaseQualifier() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (l *mavenList) isNull() bool {
+	return len(l.items) == 0
+}
+
+// normalize removes trailing null items, so 1.0.0 == 1
+func (l *mavenList) normalize() {
+	for i := len(l.items) - 1; i >= 0; i-- {
+		item := l.items[i]
+		if item.isNull() {
+			l.items = append(l.items[:i], l.items[i+1:]...)
+			continue
+		}
+		if _, ok := item.(*mavenList); !ok {
+			break
+		}
+	}
+}
+
+func (l *mavenList) compare(other mavenItem) int {
+	switch o := other.(type) {
+	case nil:
+		if len(l.items) == 0 {
+			return 0
+		}
+		return l.items[0].compare(nil)
+	case mavenInt:
+		return -1
+	case mavenString:
+		return 1
+	case *mavenList:
+		for i := 0; i < len(l.items) || i < len(o.items); i++ {
+			var left, right mavenItem
+			if i < len(l.items) {
+				left = l.items[i]
+			}
+			if i < len(o.items) {
+				right = o.items[i]
+			}
+
+			var result int
+			switch {
+			case left == nil && right == nil:
+				result = 0
+			case left == nil:
+				result = -right.compare(nil)
+			default:
+				result = left.compare(right)
+			}
+
+			if result != 0 {
+				return result
+			}
+		}
+	}
+	return 0
+}
+
+// MavenConstraint is a Maven version range, such as "[1.0,2.0)" or "(,1.0],[1.2,)"
+// https://maven.apache.org/enforcer/enforcer-rules/versionRanges.html
+type MavenConstraint struct {
+	ranges []mavenRange
+	// prerelease is true if the constraint references a pre-release version,
+	// otherwise pre-releases and snapshots are excluded
+	prerelease bool
+}
+
+// mavenRange is a single version range, a nil bound is unbounded
+type mavenRange struct {
+	lower          *Maven
+	upper          *Maven
+	lowerInclusive bool
+	upperInclusive bool
+}
+
+// NewMavenConstraint parses a Maven version range.
+// A version without brackets, such as "1.0", is considered as a minimum version,
+// and an empty constraint or "*" matches any release.
+// Pre-releases are only considered if the constraint references one.
+func NewMavenConstraint(constraint string) (MavenConstraint, error) {
+	c := MavenConstraint{}
+
+	constraint = strings.ReplaceAll(constraint, " ", "")
+	if constraint == "" || constraint == "*" {
+		return c, nil
+	}
+
+	invalid := &ErrIncorrectVersionConstraint{Kind: MAVENVERSIONKIND, Constraint: constraint}
+
+	parse := func(s string) (*Maven, error) {
+		if s == "" {
+			return nil, nil
+		}
+		v, err := NewMaven(s)
+		if err != nil {
+			return nil, invalid
+		}
+		if v.IsPrerelease() {
+			c.prerelease = true
+		}
+		return &v, nil
+	}
+
+	if !strings.ContainsAny(constraint[:1], "[(") {
+		lower, err := parse(constraint)
+		if err != nil {
+			return MavenConstraint{}, err
+		}
+		c.ranges = append(c.ranges, mavenRange{lower: lower, lowerInclusive: true})
+		return c, nil
+	}
+
+	rest := constraint
+	for rest != "" {
+		rest = strings.TrimPrefix(rest, ",")
+
+		if rest == "" || !strings.ContainsAny(rest[:1], "[(") {
+			return MavenConstraint{}, invalid
+		}
+
+		end := strings.IndexAny(rest, "])")
+		if end < 0 {
+			return MavenConstraint{}, invalid
+		}
+
+		r := mavenRange{
+			lowerInclusive: rest[0] == '[',
+			upperInclusive: rest[end] == ']',
+		}
+		bounds := rest[1:end]
+		rest = rest[end+1:]
+
+		lowerBound, upperBound, isRange := strings.Cut(bounds, ",")
+
+		lower, err := parse(lowerBound)
+		if err != nil {
+			return MavenConstraint{}, err
+		}
+
+		if !isRange {
+			// "[1.0]" is an exact version
+			if lower == nil || !r.lowerInclusive || !r.upperInclusive {
+				return MavenConstraint{}, invalid
+			}
+			r.lower, r.upper = lower, lower
+			c.ranges = append(c.ranges, r)
+			continue
+		}
+
+		upper, err := parse(upperBound)
+		if err != nil {
+			return MavenConstraint{}, err
+		}
+
+		r.lower, r.upper = lower, upper
+		c.ranges = append(c.ranges, r)
+	}
+
+	return c, nil
+}
+
+// Check returns true if v is part of any of the constraint ranges
+func (c MavenConstraint) Check(v Maven) bool {
+	if v.IsPrerelease() && !c.prerelease {
+		return false
+	}
+
+	if len(c.ranges) == 0 {
+		return true
+	}
+
+	for _, r := range c.ranges {
+		if r.contains(v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// contains returns true if v is within the range bounds
+func (r mavenRange) contains(v Maven) bool {
+	if r.lower != nil {
+		result := v.Compare(*r.lower)
+		if result < 0 || (result == 0 && !r.lowerInclusive) {
+			return false
+		}
+	}
+
+	if r.upper != nil {
+		result := v.Compare(*r.upper)
+		if result > 0 || (result == 0 && !r.upperInclusive) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// sign returns -1, 0, or 1 depending on the sign of i
+func sign(i int) int {
+	return compareInt(i, 0)
+}
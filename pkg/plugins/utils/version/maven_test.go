@@ -0,0 +1,87 @@
+package version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareMaven(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{a: "1", b: "1.0.0", expected: 0},
+		{a: "1.0", b: "1.0-ga", expected: 0},
+		{a: "1.0.Final", b: "1.0", expected: 0},
+		{a: "1.0-alpha1", b: "1.0-a1", expected: 0},
+		{a: "1.0-alpha1", b: "1.0-beta1", expected: -1},
+		{a: "1.0-beta1", b: "1.0-M1", expected: -1},
+		{a: "1.0-M1", b: "1.0-RC1", expected: -1},
+		{a: "1.0-CR1", b: "1.0-RC1", expected: 0},
+		{a: "1.0-RC1", b: "1.0-SNAPSHOT", expected: -1},
+		{a: "1.0-SNAPSHOT", b: "1.0", expected: -1},
+		{a: "1.0", b: "1.0-sp1", expected: -1},
+		{a: "1.0-sp1", b: "1.0-foo", expected: -1},
+		{a: "1.0-foo", b: "1.0-1", expected: -1},
+		{a: "1.0-1", b: "1.0.1", expected: -1},
+		{a: "1.9", b: "1.10", expected: -1},
+		{a: "2.0.0", b: "10.0", expected: -1},
+		{a: "1.0.0.100000000000000000000", b: "1.0.0.99999999999999999999", expected: 1},
+	}
+
+	for _, tt := range tests {
+		a, err := NewMaven(tt.a)
+		require.NoError(t, err)
+		b, err := NewMaven(tt.b)
+		require.NoError(t, err)
+		assert.Equal(t, tt.expected, a.Compare(b), "%s vs %s", tt.a, tt.b)
+		assert.Equal(t, -tt.expected, b.Compare(a), "%s vs %s", tt.b, tt.a)
+	}
+}
+
+func TestMavenConstraint(t *testing.T) {
+	tests := []struct {
+		constraint  string
+		version     string
+		expected    bool
+		expectedErr bool
+	}{
+		{constraint: "*", version: "1.0", expected: true},
+		{constraint: "*", version: "1.1-SNAPSHOT", expected: false},
+		{constraint: "*", version: "1.1-RC1", expected: false},
+		{constraint: "*", version: "1.1-sp1", expected: true},
+		{constraint: "1.0", version: "1.1", expected: true},
+		{constraint: "1.0", version: "0.9", expected: false},
+		{constraint: "[1.0,2.0)", version: "2.0", expected: false},
+		{constraint: "[1.0,2.0)", version: "2.0-RC1", expected: false},
+		{constraint: "[1.0-RC1,2.0)", version: "2.0-RC1", expected: true},
+		{constraint: "[1.0,2.0]", version: "2.0", expected: true},
+		{constraint: "(1.0,)", version: "1.0", expected: false},
+		{constraint: "(,1.0]", version: "0.1", expected: true},
+		{constraint: "[1.5]", version: "1.5.0", expected: true},
+		{constraint: "(,1.0],[1.2,)", version: "1.1", expected: false},
+		{constraint: "(,1.0],[1.2,)", version: "1.3", expected: true},
+		{constraint: "[1.0-SNAPSHOT,)", version: "1.1-SNAPSHOT", expected: true},
+		{constraint: "[1.0,2.0", expectedErr: true},
+		{constraint: "(1.0)", expectedErr: true},
+		{constraint: "[latest,)", expectedErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.constraint+" "+tt.version, func(t *testing.T) {
+			c, err := NewMavenConstraint(tt.constraint)
+			if tt.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			v, err := NewMaven(tt.version)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, c.Check(v))
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package version
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+// orderedVersion is implemented by the version kinds using their own ordering rules
+type orderedVersion[T any] interface {
+	Compare(T) int
+	String() string
+}
+
+// prefixVersion is implemented by version kinds accepting a constraint without operator
+// as a prefix match, such as "2024.10" matching "2024.10.2"
+type prefixVersion[T any] interface {
+	HasPrefix(T) bool
+}
+
+// searchOrdered parses versions using parse, then returns the newest version accepted by match.
+// Versions which can't be parsed are ignored.
+func searchOrdered[T orderedVersion[T]](versions []string, parse func(string) (T, error), match func(T) bool, pattern string) (Version, error) {
+	foundVersion := Version{}
+
+	parsed := []T{}
+	for _, v := range versions {
+		p, err := parse(v)
+		if err != nil {
+			logrus.Debugf("Skipping %q because %s", v, err)
+			continue
+		}
+		parsed = append(parsed, p)
+	}
+
+	if len(parsed) == 0 {
+		return foundVersion, ErrNoVersionFound
+	}
+
+	slices.SortStableFunc(parsed, func(a, b T) int {
+		return a.Compare(b)
+	})
+
+	for i := len(parsed) - 1; i >= 0; i-- {
+		if match(parsed[i]) {
+			foundVersion.ParsedVersion = parsed[i].String()
+			foundVersion.OriginalVersion = parsed[i].String()
+			return foundVersion, nil
+		}
+	}
+
+	return foundVersion, &ErrNoVersionFoundForPattern{Pattern: pattern}
+}
+
+// operatorConstraint is a single "<operator> <version>" comparison
+type operatorConstraint[T orderedVersion[T]] struct {
+	operator string
+	version  T
+}
+
+// check returns true if v satisfies the comparison
+func (c operatorConstraint[T]) check(v T) bool {
+	result := v.Compare(c.version)
+
+	switch c.operator {
+	case "":
+		if p, ok := any(v).(prefixVersion[T]); ok {
+			return p.HasPrefix(c.version)
+		}
+		return result == 0
+	case "=", "==":
+		return result == 0
+	case "!=":
+		return result != 0
+	case ">", ">>":
+		return result > 0
+	case ">=":
+		return result >= 0
+	case "<", "<<":
+		return result < 0
+	case "<=":
+		return result <= 0
+	}
+
+	return false
+}
+
+// parseOperatorConstraints parses a list of comma or space separated comparisons such as ">= 2024.01, < 2025".
+// Every comparison must be satisfied. An empty pattern or "*" matches any version.
+func parseOperatorConstraints[T orderedVersion[T]](kind, pattern string, operators []string, parse func(string) (T, error)) ([]operatorConstraint[T], error) {
+	var constraints []operatorConstraint[T]
+
+	pattern = strings.TrimSpace(pattern)
+	if pattern == "" || pattern == "*" {
+		return constraints, nil
+	}
+
+	// Longest operators must be tested first
+	sorted := slices.Clone(operators)
+	slices.SortFunc(sorted, func(a, b string) int {
+		return len(b) - len(a)
+	})
+
+	fields := strings.FieldsFunc(pattern, func(r rune) bool {
+		return r == ','
+	})
+
+	for _, field := range fields {
+		field = strings.TrimSpace(field)
+
+		// Support space separated comparisons such as ">=1.0 <2.0"
+		for field != "" {
+			operator := ""
+			for _, op := range sorted {
+				if strings.HasPrefix(field, op) {
+					operator = op
+					field = strings.TrimSpace(strings.TrimPrefix(field, op))
+					break
+				}
+			}
+
+			value := field
+			rest := ""
+			if i := strings.IndexAny(field, " \t"); i >= 0 {
+				value = field[:i]
+				rest = strings.TrimSpace(field[i:])
+			}
+
+			v, err := parse(value)
+			if err != nil {
+				return nil, &ErrIncorrectVersionConstraint{Kind: kind, Constraint: pattern}
+			}
+
+			constraints = append(constraints, operatorConstraint[T]{operator: operator, version: v})
+			field = rest
+		}
+	}
+
+	return constraints, nil
+}
+
+// checkAll returns true if v satisfies every constraint
+func checkAll[T orderedVersion[T]](constraints []operatorConstraint[T], v T) bool {
+	for _, c := range constraints {
+		if !c.check(v) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,439 @@
+package version
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+var (
+	// pep440Regex is the regular expression used by the Python packaging project
+	// to parse versions, as defined in https://peps.python.org/pep-0440/#appendix-b-parsing-version-strings-with-regular-expressions
+	pep440Regex = regexp.MustCompile(`(?i)^\s*v?` +
+		`(?:([0-9]+)!)?` +
+		`([0-9]+(?:\.[0-9]+)*)` +
+		`(?:[-_.]?(a|b|c|rc|alpha|beta|pre|preview)[-_.]?([0-9]+)?)?` +
+		`(?:-([0-9]+)|[-_.]?(post|rev|r)[-_.]?([0-9]+)?)?` +
+		`(?:[-_.]?(dev)[-_.]?([0-9]+)?)?` +
+		`(?:\+([a-z0-9]+(?:[-_.][a-z0-9]+)*))?\s*$`)
+)
+
+const (
+	// pep440Absent is used as the comparison key of a missing pre, post, or dev segment
+	// when it must sort before any value
+	pep440Absent = math.MinInt
+	// pep440Infinite is used as the comparison key of a missing pre or dev segment
+	// when it must sort after any value
+	pep440Infinite = math.MaxInt
+)
+
+// PEP440 represents a Python package version as defined by PEP 440
+// https://peps.python.org/pep-0440/
+type PEP440 struct {
+	// Epoch is the version epoch, 0 if not specified
+	Epoch int
+	// Release holds the release segment numbers
+	Release []int
+	// PreLabel is the normalized pre-release label, one of "a", "b", "rc", or empty
+	PreLabel string
+	// Pre is the pre-release number
+	Pre int
+	// Post is the post-release number, or -1 if not a post-release
+	Post int
+	// Dev is the development release number, or -1 if not a development release
+	Dev int
+	// Local holds the local version label
+	Local string
+	// original holds the string the version was parsed from
+	original string
+}
+
+// NewPEP440 parses a PEP 440 version
+func NewPEP440(version string) (PEP440, error) {
+	m := pep440Regex.FindStringSubmatch(version)
+	if m == nil {
+		return PEP440{}, fmt.Errorf("invalid PEP 440 version %q", version)
+	}
+
+	v := PEP440{
+		Post:     -1,
+		Dev:      -1,
+		Local:    strings.ToLower(m[10]),
+		original: version,
+	}
+
+	if m[1] != "" {
+		v.Epoch, _ = strconv.Atoi(m[1])
+	}
+
+	for _, s := range strings.Split(m[2], ".") {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return PEP440{}, fmt.Errorf("invalid PEP 440 version %q: %w", version, err)
+		}
+		v.Release = append(v.Release, n)
+	}
+
+	if m[3] != "" {
+		switch strings.ToLower(m[3]) {
+		case "a", "alpha":
+			v.PreLabel = "a"
+		case "b", "beta":
+			v.PreLabel = "b"
+		default:
+			v.PreLabel = "rc"
+		}
+		v.Pre, _ = strconv.Atoi(m[4])
+	}
+
+	switch {
+	case m[5] != "":
+		v.Post, _ = strconv.Atoi(m[5])
+	case m[6] != "":
+		v.Post, _ = strconv.Atoi(m[7])
+	}
+
+	if m[8] != "" {
+		v.Dev, _ = strconv.Atoi(m[9])
+	}
+
+	return v, nil
+}
+
+// String returns the original version
+func (v PEP440) String() string {
+	return v.original
+}
+
+// IsPrerelease returns true for pre-releases and development releases
+func (v PEP440) IsPrerelease() bool {
+	return v.PreLabel != "" || v.Dev >= 0
+}
+
+// Compare returns -1, 0, or 1 depending on whether v is older, equal, or newer than o,
+// following the PEP 440 ordering rules.
+func (v PEP440) Compare(o PEP440) int {
+	if c := compareInt(v.Epoch, o.Epoch); c != 0 {
+		return c
+	}
+
+	// Trailing zeros are not significant, so 1.0 == 1.0.0
+	for i := 0; i < len(v.Release) || i < len(o.Release); i++ {
+		if c := compareInt(segment(v.Release, i), segment(o.Release, i)); c != 0 {
+			return c
+		}
+	}
+
+	vLabel, vPre := v.preKey()
+	oLabel, oPre := o.preKey()
+	if c := compareInt(vLabel, oLabel); c != 0 {
+		return c
+	}
+	if c := compareInt(vPre, oPre); c != 0 {
+		return c
+	}
+
+	if c := compareInt(v.postKey(), o.postKey()); c != 0 {
+		return c
+	}
+
+	if c := compareInt(v.devKey(), o.devKey()); c != 0 {
+		return c
+	}
+
+	return compareLocal(v.Local, o.Local)
+}
+
+// preKey returns the pre-release comparison key.
+// A development release without pre or post segment, such as 1.0.dev0, sorts before 1.0a0
+// while a final release sorts after every pre-release.
+func (v PEP440) preKey() (int, int) {
+	switch {
+	case v.PreLabel == "" && v.Post < 0 && v.Dev >= 0:
+		return pep440Absent, 0
+	case v.PreLabel == "":
+		return pep440Infinite, 0
+	}
+
+	return map[string]int{"a": 0, "b": 1, "rc": 2}[v.PreLabel], v.Pre
+}
+
+// postKey returns the post-release comparison key
+func (v PEP440) postKey() int {
+	if v.Post < 0 {
+		return pep440Absent
+	}
+	return v.Post
+}
+
+// devKey returns the development release comparison key
+func (v PEP440) devKey() int {
+	if v.Dev < 0 {
+		return pep440Infinite
+	}
+	return v.Dev
+}
+
+// SortPEP440 returns the valid PEP 440 versions from versions, oldest first.
+// Invalid versions are ignored.
+func SortPEP440(versions []string) []string {
+	parsed := []PEP440{}
+	for _, s := range versions {
+		v, err := NewPEP440(s)
+		if err != nil {
+			continue
+		}
+		parsed = append(parsed, v)
+	}
+
+	slices.SortStableFunc(parsed, func(a, b PEP440) int {
+		return a.Compare(b)
+	})
+
+	sorted := make([]string, len(parsed))
+	for i := range parsed {
+		sorted[i] = parsed[i].String()
+	}
+
+	return sorted
+}
+
+// segment returns the i-th release number or 0 if it doesn't exist
+func segment(release []int, i int) int {
+	if i < len(release) {
+		return release[i]
+	}
+	return 0
+}
+
+// compareInt returns -1, 0, or 1 depending on whether a is lower, equal, or greater than b
+func compareInt(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// compareLocal compares local version labels.
+// Numeric segments are compared as integers and sort after alphanumeric ones.
+func compareLocal(a, b string) int {
+	switch {
+	case a == b:
+		return 0
+	case a == "":
+		return -1
+	case b == "":
+		return 1
+	}
+
+	split := func(s string) []string {
+		return strings.FieldsFunc(s, func(r rune) bool { return r == '.' || r == '-' || r == '_' })
+	}
+
+	as, bs := split(a), split(b)
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		an, aErr := strconv.Atoi(as[i])
+		bn, bErr := strconv.Atoi(bs[i])
+
+		switch {
+		case aErr == nil && bErr == nil:
+			if c := compareInt(an, bn); c != 0 {
+				return c
+			}
+		case aErr == nil:
+			return 1
+		case bErr == nil:
+			return -1
+		default:
+			if c := strings.Compare(as[i], bs[i]); c != 0 {
+				return c
+			}
+		}
+	}
+
+	return compareInt(len(as), len(bs))
+}
+
+// PEP440Constraint is a list of comma separated PEP 440 version specifiers such as ">=1.0, !=1.3.*, <2.0"
+// https://packaging.python.org/en/latest/specifications/version-specifiers/
+type PEP440Constraint struct {
+	specifiers []pep440Specifier
+	// prerelease is true if a specifier explicitly references a pre-release,
+	// otherwise pre-releases and development releases are excluded
+	prerelease bool
+}
+
+// pep440Specifier is a single version specifier such as "~=1.4.2"
+type pep440Specifier struct {
+	operator string
+	version  PEP440
+	// wildcard is true for prefix matching specifiers such as "==1.4.*"
+	wildcard bool
+	// raw holds the specifier version as written, used by the arbitrary equality operator
+	raw string
+}
+
+// pep440Operators lists the supported specifier operators, longest first
+var pep440Operators = []string{"===", "~=", "==", "!=", "<=", ">=", "<", ">"}
+
+// NewPEP440Constraint parses a PEP 440 version specifier set.
+// An empty constraint or "*" matches any final release.
+func NewPEP440Constraint(constraint string) (PEP440Constraint, error) {
+	c := PEP440Constraint{}
+
+	constraint = strings.TrimSpace(constraint)
+	if constraint == "" || constraint == "*" {
+		return c, nil
+	}
+
+	for _, field := range strings.Split(constraint, ",") {
+		field = strings.TrimSpace(field)
+
+		s := pep440Specifier{operator: "=="}
+		for _, op := range pep440Operators {
+			if strings.HasPrefix(field, op) {
+				s.operator = op
+				field = strings.TrimSpace(strings.TrimPrefix(field, op))
+				break
+			}
+		}
+
+		s.raw = field
+
+		if s.operator == "===" {
+			c.specifiers = append(c.specifiers, s)
+			continue
+		}
+
+		if strings.HasSuffix(field, ".*") {
+			if s.operator != "==" && s.operator != "!=" {
+				return PEP440Constraint{}, &ErrIncorrectVersionConstraint{Kind: PEP440VERSIONKIND, Constraint: constraint}
+			}
+			s.wildcard = true
+			field = strings.TrimSuffix(field, ".*")
+		}
+
+		v, err := NewPEP440(field)
+		if err != nil {
+			return PEP440Constraint{}, &ErrIncorrectVersionConstraint{Kind: PEP440VERSIONKIND, Constraint: constraint}
+		}
+
+		if s.operator == "~=" && len(v.Release) < 2 {
+			return PEP440Constraint{}, &ErrIncorrectVersionConstraint{Kind: PEP440VERSIONKIND, Constraint: constraint}
+		}
+
+		s.version = v
+
+		if v.IsPrerelease() && s.operator != "!=" {
+			c.prerelease = true
+		}
+
+		c.specifiers = append(c.specifiers, s)
+	}
+
+	return c, nil
+}
+
+// Check returns true if v satisfies every specifier
+func (c PEP440Constraint) Check(v PEP440) bool {
+	if v.IsPrerelease() && !c.prerelease {
+		return false
+	}
+
+	for _, s := range c.specifiers {
+		if !s.check(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// check returns true if v satisfies the specifier
+func (s pep440Specifier) check(v PEP440) bool {
+	switch s.operator {
+	case "===":
+		return strings.EqualFold(v.String(), s.raw)
+	case "~=":
+		prefix := s.version
+		prefix.Release = prefix.Release[:len(prefix.Release)-1]
+		return v.Compare(s.version) >= 0 && v.hasReleasePrefix(prefix)
+	case "==":
+		if s.wildcard {
+			return v.hasReleasePrefix(s.version)
+		}
+		return v.withoutLocal(s.version.Local == "").Compare(s.version) == 0
+	case "!=":
+		if s.wildcard {
+			return !v.hasReleasePrefix(s.version)
+		}
+		return v.withoutLocal(s.version.Local == "").Compare(s.version) != 0
+	case "<=":
+		return v.withoutLocal(true).Compare(s.version) <= 0
+	case ">=":
+		return v.withoutLocal(true).Compare(s.version) >= 0
+	case "<":
+		// "<1.0" must not match "1.0rc1" unless the specifier is itself a pre-release
+		if v.IsPrerelease() && !s.version.IsPrerelease() && v.sameRelease(s.version) {
+			return false
+		}
+		return v.Compare(s.version) < 0
+	case ">":
+		// ">1.0" must not match "1.0.post1" nor "1.0+local" unless the specifier is itself a post-release
+		if v.Post >= 0 && s.version.Post < 0 && v.sameRelease(s.version) {
+			return false
+		}
+		return v.withoutLocal(true).Compare(s.version) > 0
+	}
+
+	return false
+}
+
+// hasReleasePrefix returns true if v shares the epoch and the release segments of prefix
+func (v PEP440) hasReleasePrefix(prefix PEP440) bool {
+	if v.Epoch != prefix.Epoch {
+		return false
+	}
+
+	for i, n := range prefix.Release {
+		if segment(v.Release, i) != n {
+			return false
+		}
+	}
+
+	return true
+}
+
+// sameRelease returns true if v and o share the same epoch and release segments
+func (v PEP440) sameRelease(o PEP440) bool {
+	return v.hasReleasePrefix(o) && o.hasReleasePrefix(v)
+}
+
+// withoutLocal returns a copy of v without its local version label if strip is true
+func (v PEP440) withoutLocal(strip bool) PEP440 {
+	if strip {
+		v.Local = ""
+	}
+	return v
+}
+
+// pep440Prefix returns the epoch and the first n release segments of v, such as "1!2.3"
+func pep440Prefix(v PEP440, n int) string {
+	segments := []string{}
+	for i := 0; i < n; i++ {
+		segments = append(segments, strconv.Itoa(segment(v.Release, i)))
+	}
+
+	prefix := strings.Join(segments, ".")
+	if v.Epoch > 0 {
+		prefix = fmt.Sprintf("%d!%s", v.Epoch, prefix)
+	}
+
+	return prefix
+}
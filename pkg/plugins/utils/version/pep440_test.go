@@ -0,0 +1,144 @@
+package version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPEP440(t *testing.T) {
+	tests := []struct {
+		version            string
+		expectedPrerelease bool
+		expectedErr        bool
+	}{
+		{version: "1.0"},
+		{version: "v2.31.0"},
+		{version: "1!2.0"},
+		{version: "1.0.post1"},
+		{version: "1.0-1"},
+		{version: "2.0rc1", expectedPrerelease: true},
+		{version: "2.0.0-beta.2", expectedPrerelease: true},
+		{version: "1.0.dev3", expectedPrerelease: true},
+		{version: "1.0+ubuntu.1"},
+		{version: "latest", expectedErr: true},
+		{version: "1.0.0-SNAPSHOT", expectedErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			v, err := NewPEP440(tt.version)
+			if tt.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedPrerelease, v.IsPrerelease())
+			assert.Equal(t, tt.version, v.String())
+		})
+	}
+}
+
+func TestSortPEP440(t *testing.T) {
+	// Ordering taken from https://peps.python.org/pep-0440/#summary-of-permitted-suffixes-and-relative-ordering
+	expected := []string{
+		"1.0.dev456",
+		"1.0a1",
+		"1.0a2.dev456",
+		"1.0a12.dev456",
+		"1.0a12",
+		"1.0b1.dev456",
+		"1.0b2",
+		"1.0b2.post345.dev456",
+		"1.0b2.post345",
+		"1.0rc1.dev456",
+		"1.0rc1",
+		"1.0",
+		"1.0+abc.5",
+		"1.0+abc.7",
+		"1.0+5",
+		"1.0.post456.dev34",
+		"1.0.post456",
+		"1.0.15",
+		"1.1.dev1",
+		"1!0.1",
+	}
+
+	shuffled := []string{
+		"1.0.15", "1.0a12", "1!0.1", "1.0+5", "1.0b2.post345",
+		"1.0rc1", "1.0.post456", "1.0a1", "invalid", "1.0b1.dev456",
+		"1.0+abc.7", "1.0.dev456", "1.1.dev1", "1.0a2.dev456", "1.0",
+		"1.0b2", "1.0rc1.dev456", "1.0.post456.dev34", "1.0a12.dev456",
+		"1.0+abc.5", "1.0b2.post345.dev456",
+	}
+
+	assert.Equal(t, expected, SortPEP440(shuffled))
+}
+
+func TestComparePEP440(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{a: "1.0", b: "1.0.0", expected: 0},
+		{a: "1.0", b: "1.0.1", expected: -1},
+		{a: "2.0rc1", b: "2.0", expected: -1},
+		{a: "1.0.post1", b: "1.0", expected: 1},
+		{a: "1.0-1", b: "1.0.post1", expected: 0},
+		{a: "1.0alpha1", b: "1.0a1", expected: 0},
+		{a: "1.0c1", b: "1.0rc1", expected: 0},
+	}
+
+	for _, tt := range tests {
+		a, err := NewPEP440(tt.a)
+		require.NoError(t, err)
+		b, err := NewPEP440(tt.b)
+		require.NoError(t, err)
+		assert.Equal(t, tt.expected, a.Compare(b), "%s vs %s", tt.a, tt.b)
+	}
+}
+
+func TestPEP440Constraint(t *testing.T) {
+	tests := []struct {
+		constraint  string
+		version     string
+		expected    bool
+		expectedErr bool
+	}{
+		{constraint: "*", version: "1.0", expected: true},
+		{constraint: "*", version: "1.0rc1", expected: false},
+		{constraint: ">=1.0rc1", version: "1.0rc2", expected: true},
+		{constraint: ">=1.0, <2.0", version: "1.9.9", expected: true},
+		{constraint: ">=1.0, <2.0", version: "2.0", expected: false},
+		{constraint: "<2.0", version: "2.0rc1", expected: false},
+		{constraint: "~=1.4.2", version: "1.4.9", expected: true},
+		{constraint: "~=1.4.2", version: "1.5.0", expected: false},
+		{constraint: "~=1.4", version: "1.9", expected: true},
+		{constraint: "==1.4.*", version: "1.4.10", expected: true},
+		{constraint: "!=1.4.*", version: "1.4.10", expected: false},
+		{constraint: "==1.0", version: "1.0+local", expected: true},
+		{constraint: ">1.0", version: "1.0.post1", expected: false},
+		{constraint: ">1.0", version: "1.0.1", expected: true},
+		{constraint: "===1.0", version: "1.0", expected: true},
+		{constraint: "~=1", expectedErr: true},
+		{constraint: ">=1.*", expectedErr: true},
+		{constraint: ">=latest", expectedErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.constraint+" "+tt.version, func(t *testing.T) {
+			c, err := NewPEP440Constraint(tt.constraint)
+			if tt.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			v, err := NewPEP440(tt.version)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, c.Check(v))
+		})
+	}
+}
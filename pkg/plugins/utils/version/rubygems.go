@@ -0,0 +1,240 @@
+package version
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+var (
+	// rubygemsRegex is the regular expression used by RubyGems to validate versions
+	// https://github.com/rubygems/rubygems/blob/master/lib/rubygems/version.rb
+	rubygemsRegex = regexp.MustCompile(`^\s*[0-9]+(?:\.[0-9a-zA-Z]+)*(?:-[0-9A-Za-z-]+(?:\.[0-9A-Za-z-]+)*)?\s*$`)
+	// rubygemsSegmentRegex matches the numeric and alphabetic segments of a version
+	rubygemsSegmentRegex = regexp.MustCompile(`[0-9]+|[a-zA-Z]+`)
+	// rubygemsOperators lists the supported requirement operators
+	rubygemsOperators = []string{"=", "!=", ">", "<", ">=", "<=", "~>"}
+)
+
+// rubygemsSegment is a version segment, either a number or a string such as "pre" or "rc"
+type rubygemsSegment struct {
+	number int
+	text   string
+}
+
+// isText returns true for alphabetic segments
+func (s rubygemsSegment) isText() bool {
+	return s.text != ""
+}
+
+// compare returns -1, 0, or 1 depending on whether s is lower, equal, or greater than o.
+// Alphabetic segments sort before numeric ones, so "1.0.a" is older than "1.0.0".
+func (s rubygemsSegment) compare(o rubygemsSegment) int {
+	switch {
+	case s.isText() && o.isText():
+		return strings.Compare(s.text, o.text)
+	case s.isText():
+		return -1
+	case o.isText():
+		return 1
+	}
+	return compareInt(s.number, o.number)
+}
+
+// RubyGems represents a Ruby gem version as defined by RubyGems
+// https://guides.rubygems.org/patterns/#semantic-versioning
+type RubyGems struct {
+	// Segments holds the version segments, such as 1, 0, "pre", 1 for "1.0.pre.1"
+	Segments []rubygemsSegment
+	// canonical holds the segments used for comparison, without trailing zeros,
+	// so "1.0" and "1" are equal
+	canonical []rubygemsSegment
+	// original holds the string the version was parsed from
+	original string
+}
+
+// NewRubyGems parses a gem version such as "1.2.3", "7.1.0.rc2", or "2.0.0-beta.1"
+func NewRubyGems(version string) (RubyGems, error) {
+	if !rubygemsRegex.MatchString(version) {
+		return RubyGems{}, fmt.Errorf("invalid rubygems version %q", version)
+	}
+
+	v := RubyGems{original: version}
+
+	// RubyGems considers "-" as a pre-release separator, so "1.0.0-rc1" is "1.0.0.pre.rc1"
+	normalized := strings.ReplaceAll(strings.TrimSpace(version), "-", ".pre.")
+	for _, s := range rubygemsSegmentRegex.FindAllString(normalized, -1) {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			v.Segments = append(v.Segments, rubygemsSegment{text: s})
+			continue
+		}
+		v.Segments = append(v.Segments, rubygemsSegment{number: n})
+	}
+
+	// Trailing zeros are removed from both the release and the pre-release segments
+	release, prerelease := v.split()
+	v.canonical = append(trimRubyGemsZeros(release), trimRubyGemsZeros(prerelease)...)
+
+	return v, nil
+}
+
+// split returns the numeric segments preceding the first alphabetic segment, and the remaining segments
+func (v RubyGems) split() ([]rubygemsSegment, []rubygemsSegment) {
+	i := slices.IndexFunc(v.Segments, rubygemsSegment.isText)
+	if i < 0 {
+		return slices.Clone(v.Segments), nil
+	}
+	return slices.Clone(v.Segments[:i]), slices.Clone(v.Segments[i:])
+}
+
+// trimRubyGemsZeros removes the trailing zero segments
+func trimRubyGemsZeros(segments []rubygemsSegment) []rubygemsSegment {
+	for len(segments) > 0 && !segments[len(segments)-1].isText() && segments[len(segments)-1].number == 0 {
+		segments = segments[:len(segments)-1]
+	}
+	return segments
+}
+
+// String returns the original version
+func (v RubyGems) String() string {
+	return v.original
+}
+
+// IsPrerelease returns true if the version contains a letter, such as "1.0.0.pre" or "7.1.0.rc2"
+func (v RubyGems) IsPrerelease() bool {
+	return slices.ContainsFunc(v.Segments, rubygemsSegment.isText)
+}
+
+// Compare returns -1, 0, or 1 depending on whether v is older, equal, or newer than o
+func (v RubyGems) Compare(o RubyGems) int {
+	for i := 0; i < len(v.canonical) || i < len(o.canonical); i++ {
+		a, b := rubygemsSegment{}, rubygemsSegment{}
+		if i < len(v.canonical) {
+			a = v.canonical[i]
+		}
+		if i < len(o.canonical) {
+			b = o.canonical[i]
+		}
+
+		if c := a.compare(b); c != 0 {
+			return c
+		}
+	}
+
+	return 0
+}
+
+// releaseNumbers returns the numeric segments preceding the first alphabetic segment
+func (v RubyGems) releaseNumbers() []int {
+	release, _ := v.split()
+
+	numbers := make([]int, len(release))
+	for i := range release {
+		numbers[i] = release[i].number
+	}
+	return numbers
+}
+
+// release returns the version without its pre-release segments, so "1.2.0.rc1" becomes "1.2.0"
+func (v RubyGems) release() RubyGems {
+	return newRubyGemsFromNumbers(v.releaseNumbers())
+}
+
+// bump returns the next version used as upper bound by the pessimistic operator,
+// so "1.2.3" becomes "1.3" and "1.2" becomes "2"
+func (v RubyGems) bump() RubyGems {
+	numbers := v.releaseNumbers()
+	if len(numbers) > 1 {
+		numbers = numbers[:len(numbers)-1]
+	}
+	numbers[len(numbers)-1]++
+
+	return newRubyGemsFromNumbers(numbers)
+}
+
+// newRubyGemsFromNumbers returns the version made of numbers
+func newRubyGemsFromNumbers(numbers []int) RubyGems {
+	parts := make([]string, len(numbers))
+	for i := range numbers {
+		parts[i] = strconv.Itoa(numbers[i])
+	}
+
+	v, _ := NewRubyGems(strings.Join(parts, "."))
+	return v
+}
+
+// SortRubyGems returns the valid gem versions from versions, oldest first
+func SortRubyGems(versions []string) []string {
+	parsed := []RubyGems{}
+	for _, s := range versions {
+		v, err := NewRubyGems(s)
+		if err != nil {
+			continue
+		}
+		parsed = append(parsed, v)
+	}
+
+	slices.SortStableFunc(parsed, func(a, b RubyGems) int {
+		return a.Compare(b)
+	})
+
+	sorted := make([]string, len(parsed))
+	for i := range parsed {
+		sorted[i] = parsed[i].String()
+	}
+
+	return sorted
+}
+
+// RubyGemsConstraint is a gem requirement such as "~> 7.1", or ">= 1.0, < 2"
+// https://guides.rubygems.org/patterns/#declaring-dependencies
+type RubyGemsConstraint struct {
+	constraints []operatorConstraint[RubyGems]
+	// prerelease is true if a requirement references a pre-release,
+	// otherwise pre-releases are not accepted, as Bundler does
+	prerelease bool
+}
+
+// NewRubyGemsConstraint parses a gem requirement.
+// An empty requirement or "*" matches any release.
+func NewRubyGemsConstraint(constraint string) (RubyGemsConstraint, error) {
+	constraints, err := parseOperatorConstraints(RUBYGEMSVERSIONKIND, constraint, rubygemsOperators, NewRubyGems)
+	if err != nil {
+		return RubyGemsConstraint{}, err
+	}
+
+	c := RubyGemsConstraint{constraints: constraints}
+	for _, r := range constraints {
+		if r.version.IsPrerelease() {
+			c.prerelease = true
+		}
+	}
+
+	return c, nil
+}
+
+// Check returns true if v satisfies every requirement
+func (c RubyGemsConstraint) Check(v RubyGems) bool {
+	if v.IsPrerelease() && !c.prerelease {
+		return false
+	}
+
+	for _, r := range c.constraints {
+		switch r.operator {
+		case "~>":
+			// "~> 1.2.3" means ">= 1.2.3, < 1.3"
+			if v.Compare(r.version) < 0 || v.release().Compare(r.version.bump()) >= 0 {
+				return false
+			}
+		default:
+			if !r.check(v) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
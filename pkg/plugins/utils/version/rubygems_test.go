@@ -0,0 +1,135 @@
+package version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRubyGems(t *testing.T) {
+	tests := []struct {
+		version            string
+		expectedPrerelease bool
+		expectedErr        bool
+	}{
+		{version: "1"},
+		{version: "7.1.3.4"},
+		{version: "1.0.0.pre", expectedPrerelease: true},
+		{version: "7.1.0.rc2", expectedPrerelease: true},
+		{version: "2.0.0-beta.1", expectedPrerelease: true},
+		{version: "1.0.0a1", expectedPrerelease: true},
+		{version: "v1.0.0", expectedErr: true},
+		{version: "1..0", expectedErr: true},
+		{version: "", expectedErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			v, err := NewRubyGems(tt.version)
+			if tt.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedPrerelease, v.IsPrerelease())
+			assert.Equal(t, tt.version, v.String())
+		})
+	}
+}
+
+func TestSortRubyGems(t *testing.T) {
+	expected := []string{
+		"1.0.0.a",
+		"1.0.0.pre",
+		"1.0.0.pre.2",
+		"1.0.0.rc1",
+		"1.0.0",
+		"1.0.1",
+		"1.0.10",
+		"1.1.0.beta1",
+		"1.1.0",
+		"2",
+	}
+
+	reversed := make([]string, 0, len(expected)+1)
+	for i := len(expected) - 1; i >= 0; i-- {
+		reversed = append(reversed, expected[i])
+	}
+	reversed = append(reversed, "latest")
+
+	assert.Equal(t, expected, SortRubyGems(reversed))
+}
+
+func TestCompareRubyGems(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{a: "1", b: "1.0.0", expected: 0},
+		{a: "1.0.0-rc1", b: "1.0.0.pre.rc1", expected: 0},
+		{a: "1.0.0.pre", b: "1.0.0", expected: -1},
+		{a: "1.0.0.rc1", b: "1.0.0.rc2", expected: -1},
+		{a: "1.0.0.rc1", b: "1.0.0.1", expected: -1},
+		{a: "1.0.0.beta", b: "1.0.0.rc", expected: -1},
+		{a: "1.9", b: "1.10", expected: -1},
+		{a: "1.0.a10", b: "1.0.b1", expected: -1},
+	}
+
+	for _, tt := range tests {
+		a, err := NewRubyGems(tt.a)
+		require.NoError(t, err)
+		b, err := NewRubyGems(tt.b)
+		require.NoError(t, err)
+		assert.Equal(t, tt.expected, a.Compare(b), "%s vs %s", tt.a, tt.b)
+		assert.Equal(t, -tt.expected, b.Compare(a), "%s vs %s", tt.b, tt.a)
+	}
+}
+
+func TestRubyGemsConstraint(t *testing.T) {
+	tests := []struct {
+		constraint  string
+		version     string
+		expected    bool
+		expectedErr bool
+	}{
+		{constraint: "*", version: "1.0", expected: true},
+		{constraint: "*", version: "1.1.rc1", expected: false},
+		{constraint: "1.2.3", version: "1.2.3.0", expected: true},
+		{constraint: "= 1.2.3", version: "1.2.4", expected: false},
+		{constraint: "~> 1.2.3", version: "1.2.9", expected: true},
+		{constraint: "~> 1.2.3", version: "1.3.0", expected: false},
+		{constraint: "~> 1.2.3", version: "1.2.2", expected: false},
+		{constraint: "~> 1.2", version: "1.9", expected: true},
+		{constraint: "~> 1.2", version: "2.0", expected: false},
+		{constraint: "~> 1", version: "1.9", expected: true},
+		{constraint: "~> 1", version: "2.0", expected: false},
+		{constraint: ">= 1.0, < 2", version: "1.5", expected: true},
+		{constraint: ">= 1.0, < 2", version: "2.0.0", expected: false},
+		{constraint: ">= 1.0, < 2", version: "2.0.0.beta1", expected: false},
+		{constraint: "!= 1.5", version: "1.5.0", expected: false},
+		{constraint: "> 1.5", version: "1.5.1", expected: true},
+		{constraint: ">= 7.1.0.rc1", version: "7.1.0.rc2", expected: true},
+		{constraint: ">= 7.1.0.rc1", version: "7.1.0.beta1", expected: false},
+		{constraint: "~> 7.1.0.rc1", version: "7.1.0", expected: true},
+		{constraint: "~> 7.1.0.rc1", version: "7.2.0", expected: false},
+		{constraint: "~> latest", expectedErr: true},
+		{constraint: ">= 1.0, <", expectedErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.constraint+" "+tt.version, func(t *testing.T) {
+			c, err := NewRubyGemsConstraint(tt.constraint)
+			if tt.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			v, err := NewRubyGems(tt.version)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, c.Check(v))
+		})
+	}
+}